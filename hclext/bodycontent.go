@@ -6,6 +6,7 @@
 //
 // Key types:
 //   - BodySchema: Defines expected attributes and blocks to extract
+//   - SchemaBuilder: Builds a BodySchema fluently
 //   - BodyContent: Contains extracted attributes and blocks
 //   - Attribute: An extracted HCL attribute with expression and range
 //   - Block: An extracted HCL block with labels and nested content
@@ -15,8 +16,13 @@
 package hclext
 
 import (
+	"strconv"
+	"strings"
+
 	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
 	"github.com/zclconf/go-cty/cty"
+	"github.com/zclconf/go-cty/cty/convert"
 )
 
 // SchemaMode specifies how schema matching behaves.
@@ -27,6 +33,10 @@ const (
 	SchemaDefaultMode SchemaMode = iota
 	// SchemaJustAttributesMode extracts all attributes without explicit declaration.
 	SchemaJustAttributesMode
+	// SchemaExactMode extracts the declared attributes and blocks like
+	// SchemaDefaultMode, but reports any attribute or block the schema does
+	// not declare as an error.
+	SchemaExactMode
 )
 
 // BodySchema represents the expected structure of an HCL body.
@@ -87,12 +97,27 @@ type Attribute struct {
 	Expr hcl.Expression
 	// Value is the pre-evaluated attribute value.
 	// This is populated when the attribute is received over gRPC
-	// (since hcl.Expression cannot be serialized).
+	// (since hcl.Expression cannot be serialized). It is a null value for
+	// attributes set to null, and an unknown value for attributes whose
+	// value cannot be determined, e.g. because they reference a variable.
 	Value cty.Value
+	// SourceBytes is the raw source text of the expression.
+	// Unlike Expr, this is preserved over gRPC, so rules can inspect the
+	// literal source (e.g., to detect "${}" interpolation) even when the
+	// evaluated value is unknown.
+	SourceBytes []byte
 	// Range is the source range of the entire attribute.
 	Range hcl.Range
 	// NameRange is the source range of just the attribute name.
 	NameRange hcl.Range
+	// ItemRanges maps each key of an object constructor expression
+	// (e.g., tags = { env = "prod" }) to the source range of its key/value
+	// pair, so rules can point issues at a single entry. It is nil for
+	// other expressions. Keys that are not static are omitted.
+	ItemRanges map[string]hcl.Range
+	// Refs holds the references of the expression as received over gRPC,
+	// where Expr is nil. Use References, which also works in-process.
+	Refs []string
 }
 
 // Block represents an extracted HCL block.
@@ -109,6 +134,272 @@ type Block struct {
 	TypeRange hcl.Range
 	// LabelRanges are the source ranges of each label.
 	LabelRanges []hcl.Range
+	// LeadingComments are the comments directly above the block, in source
+	// order, with trailing newlines removed (e.g., "# tfbreak:ignore my_rule").
+	// HCL discards comments when parsing, so runners populate this separately
+	// using FillLeadingComments.
+	LeadingComments []string
+	// ModulePath is the path of module call names from the root module to
+	// the module declaring the block, e.g. ["network", "subnets"] for a
+	// block in module.network.module.subnets. It is empty for blocks of
+	// the root module and for nested blocks. Runners populate it for
+	// top-level blocks retrieved with tflint.ModuleCtxAll.
+	ModulePath []string
+}
+
+// GetAttribute returns the attribute at path, where all elements but the
+// last are nested block types and the last is the attribute name.
+// For example, GetAttribute("blob_properties", "versioning_enabled").
+//
+// When a block type has multiple instances, only the first is searched.
+// Walk Blocks directly to inspect every instance.
+func (c *BodyContent) GetAttribute(path ...string) (*Attribute, bool) {
+	if len(path) == 0 {
+		return nil, false
+	}
+	body := c
+	if len(path) > 1 {
+		block, ok := c.GetBlock(path[:len(path)-1]...)
+		if !ok {
+			return nil, false
+		}
+		body = block.Body
+	}
+	if body == nil {
+		return nil, false
+	}
+	attr, ok := body.Attributes[path[len(path)-1]]
+	return attr, ok && attr != nil
+}
+
+// GetBlock returns the nested block at path, where each element is a block
+// type. For example, GetBlock("blob_properties", "delete_retention_policy").
+//
+// When a block type has multiple instances, the first is returned.
+func (c *BodyContent) GetBlock(path ...string) (*Block, bool) {
+	if len(path) == 0 {
+		return nil, false
+	}
+	body := c
+	var found *Block
+	for _, blockType := range path {
+		if body == nil {
+			return nil, false
+		}
+		found = nil
+		for _, block := range body.Blocks {
+			if block.Type == blockType {
+				found = block
+				break
+			}
+		}
+		if found == nil {
+			return nil, false
+		}
+		body = found.Body
+	}
+	return found, true
+}
+
+// Address returns the Terraform-style address of the block:
+//
+//	resource "azurerm_x" "name"  ->  azurerm_x.name
+//	data "azurerm_x" "name"      ->  data.azurerm_x.name
+//	variable "name"              ->  var.name
+//	output "name"                ->  output.name
+//	module "name"                ->  module.name
+//
+// Other blocks are addressed as "<block type>.<labels...>". Missing labels
+// are omitted, so a malformed block yields a best-effort address.
+func (b *Block) Address() string {
+	switch b.Type {
+	case "resource":
+		return strings.Join(b.Labels, ".")
+	case "variable":
+		return strings.Join(append([]string{"var"}, b.Labels...), ".")
+	default:
+		// data, output, module, and all other blocks
+		return strings.Join(append([]string{b.Type}, b.Labels...), ".")
+	}
+}
+
+// FullAddress returns the address of the block prefixed with its module
+// path, e.g. "module.network.azurerm_subnet.app". Unlike Address, it tells
+// apart identically named blocks of different modules. For blocks of the
+// root module, it equals Address.
+func (b *Block) FullAddress() string {
+	if len(b.ModulePath) == 0 {
+		return b.Address()
+	}
+	var sb strings.Builder
+	for _, name := range b.ModulePath {
+		sb.WriteString("module." + name + ".")
+	}
+	sb.WriteString(b.Address())
+	return sb.String()
+}
+
+// HasCount reports whether the block sets the count meta-argument. A resource
+// that gains count changes its address from "x.name" to "x.name[0]", which
+// breaks references to it and moves its state. The count attribute must be
+// part of the extracted body, so add it to the schema, e.g. with
+// tflint.MetaArgumentsSchema.
+func (b *Block) HasCount() bool {
+	return b.hasAttribute("count")
+}
+
+// HasForEach reports whether the block sets the for_each meta-argument,
+// which, like count, makes the block's address indexed. See HasCount.
+func (b *Block) HasForEach() bool {
+	return b.hasAttribute("for_each")
+}
+
+// hasAttribute reports whether the block body contains the attribute.
+func (b *Block) hasAttribute(name string) bool {
+	if b == nil || b.Body == nil {
+		return false
+	}
+	_, ok := b.Body.Attributes[name]
+	return ok
+}
+
+// TypeName returns the resource type of resource and data blocks
+// (e.g., "azurerm_x"), or the block type for all other blocks
+// (e.g., "variable"). It returns an empty string for a resource or data
+// block without labels.
+func (b *Block) TypeName() string {
+	switch b.Type {
+	case "resource", "data":
+		if len(b.Labels) == 0 {
+			return ""
+		}
+		return b.Labels[0]
+	default:
+		return b.Type
+	}
+}
+
+// AsString returns the attribute's value as a string. It returns false if
+// the attribute is nil, its value cannot be determined (see AttributeValue),
+// or the value is null, not wholly known, or not a string.
+func (a *Attribute) AsString() (string, bool) {
+	val, ok := a.primitiveValue(cty.String)
+	if !ok {
+		return "", false
+	}
+	return val.AsString(), true
+}
+
+// AsBool returns the attribute's value as a bool. Like AsString, it returns
+// false instead of panicking if the value is not a known bool.
+func (a *Attribute) AsBool() (bool, bool) {
+	val, ok := a.primitiveValue(cty.Bool)
+	if !ok {
+		return false, false
+	}
+	return val.True(), true
+}
+
+// AsNumber returns the attribute's value as a float64. Like AsString, it
+// returns false instead of panicking if the value is not a known number.
+func (a *Attribute) AsNumber() (float64, bool) {
+	val, ok := a.primitiveValue(cty.Number)
+	if !ok {
+		return 0, false
+	}
+	f, _ := val.AsBigFloat().Float64()
+	return f, true
+}
+
+// AsStringSlice returns the elements of a list, set, or tuple of strings.
+// It returns false if the value is not a known collection or any element
+// is not a known, non-null string. Set elements are returned in cty's
+// set iteration order, which is sorted for strings.
+func (a *Attribute) AsStringSlice() ([]string, bool) {
+	val, ok := AttributeValue(a)
+	if !ok || val.IsNull() || !val.IsWhollyKnown() {
+		return nil, false
+	}
+	ty := val.Type()
+	if !ty.IsListType() && !ty.IsSetType() && !ty.IsTupleType() {
+		return nil, false
+	}
+
+	strs := make([]string, 0, val.LengthInt())
+	for it := val.ElementIterator(); it.Next(); {
+		_, elem := it.Element()
+		if elem.IsNull() || elem.Type() != cty.String {
+			return nil, false
+		}
+		strs = append(strs, elem.AsString())
+	}
+	return strs, true
+}
+
+// References returns the variables and objects referenced by the
+// attribute's expression, such as "var.region" or
+// "azurerm_resource_group.main.location", in source order and without
+// duplicates. It reads Expr when available and Refs otherwise, so it works
+// both in-process and over gRPC. It returns nil for a nil attribute or an
+// expression without references.
+func (a *Attribute) References() []string {
+	if a == nil {
+		return nil
+	}
+	if a.Expr == nil {
+		return a.Refs
+	}
+
+	var refs []string
+	seen := make(map[string]bool)
+	for _, traversal := range a.Expr.Variables() {
+		ref := traversalString(traversal)
+		if !seen[ref] {
+			seen[ref] = true
+			refs = append(refs, ref)
+		}
+	}
+	return refs
+}
+
+// traversalString formats traversal as written in the configuration, e.g.
+// `var.tags["env"]` or "module.network[0].subnet_id". Index keys that are
+// not known strings or numbers end the traversal.
+func traversalString(traversal hcl.Traversal) string {
+	var sb strings.Builder
+	for _, step := range traversal {
+		switch step := step.(type) {
+		case hcl.TraverseRoot:
+			sb.WriteString(step.Name)
+		case hcl.TraverseAttr:
+			sb.WriteString("." + step.Name)
+		case hcl.TraverseIndex:
+			key := step.Key
+			switch {
+			case !key.IsKnown() || key.IsNull():
+				return sb.String()
+			case key.Type() == cty.String:
+				sb.WriteString("[" + strconv.Quote(key.AsString()) + "]")
+			case key.Type() == cty.Number:
+				sb.WriteString("[" + key.AsBigFloat().Text('f', -1) + "]")
+			default:
+				return sb.String()
+			}
+		case hcl.TraverseSplat:
+			sb.WriteString("[*]")
+		}
+	}
+	return sb.String()
+}
+
+// primitiveValue returns the attribute's value if it is a known,
+// non-null value of type ty.
+func (a *Attribute) primitiveValue(ty cty.Type) (cty.Value, bool) {
+	val, ok := AttributeValue(a)
+	if !ok || val.IsNull() || !val.IsKnown() || !val.Type().Equals(ty) {
+		return cty.NilVal, false
+	}
+	return val, true
 }
 
 // ToHCLBodySchema converts a BodySchema to an hcl.BodySchema.
@@ -146,11 +437,37 @@ func FromHCLAttribute(attr *hcl.Attribute) *Attribute {
 		return nil
 	}
 	return &Attribute{
-		Name:      attr.Name,
-		Expr:      attr.Expr,
-		Range:     attr.Range,
-		NameRange: attr.NameRange,
+		Name:       attr.Name,
+		Expr:       attr.Expr,
+		Range:      attr.Range,
+		NameRange:  attr.NameRange,
+		ItemRanges: objectItemRanges(attr.Expr),
+	}
+}
+
+// objectItemRanges returns the range of each key/value pair of an object
+// constructor expression, keyed by the static key. It returns nil if expr
+// is not an object constructor.
+func objectItemRanges(expr hcl.Expression) map[string]hcl.Range {
+	obj, ok := expr.(*hclsyntax.ObjectConsExpr)
+	if !ok {
+		return nil
+	}
+
+	ranges := make(map[string]hcl.Range, len(obj.Items))
+	for _, item := range obj.Items {
+		key, diags := item.KeyExpr.Value(nil)
+		if diags.HasErrors() || !key.IsWhollyKnown() || key.IsNull() {
+			continue
+		}
+		// Object keys are always strings; numeric keys like 1 = "a" convert
+		key, err := convert.Convert(key, cty.String)
+		if err != nil {
+			continue
+		}
+		ranges[key.AsString()] = hcl.RangeBetween(item.KeyExpr.Range(), item.ValueExpr.Range())
 	}
+	return ranges
 }
 
 // FromHCLBlock converts an hcl.Block to a Block.
@@ -5,6 +5,8 @@ import (
 	"testing"
 
 	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/zclconf/go-cty/cty"
 )
 
 func TestSchemaMode_Values(t *testing.T) {
@@ -139,6 +141,94 @@ func TestFromHCLAttribute_Valid(t *testing.T) {
 	}
 }
 
+func TestFromHCLAttribute_ItemRanges(t *testing.T) {
+	src := []byte(`tags = {
+  env    = "prod"
+  "team" = "platform"
+  1      = "one"
+}
+name = "example"
+`)
+	file, diags := hclsyntax.ParseConfig(src, "main.tf", hcl.InitialPos)
+	if diags.HasErrors() {
+		t.Fatalf("parse error: %v", diags)
+	}
+	attrs, diags := file.Body.JustAttributes()
+	if diags.HasErrors() {
+		t.Fatalf("attributes error: %v", diags)
+	}
+
+	tags := FromHCLAttribute(attrs["tags"])
+	if len(tags.ItemRanges) != 3 {
+		t.Fatalf("ItemRanges = %v, want 3 entries", tags.ItemRanges)
+	}
+	wantLines := map[string]int{"env": 2, "team": 3, "1": 4}
+	seen := make(map[hcl.Range]string)
+	for key, line := range wantLines {
+		r, ok := tags.ItemRanges[key]
+		if !ok {
+			t.Errorf("ItemRanges missing %q", key)
+			continue
+		}
+		if r.Empty() || r.Filename != "main.tf" {
+			t.Errorf("ItemRanges[%q] = %v, want a nonzero range in main.tf", key, r)
+		}
+		if r.Start.Line != line || r.End.Line != line {
+			t.Errorf("ItemRanges[%q] lines = %d-%d, want %d", key, r.Start.Line, r.End.Line, line)
+		}
+		if other, dup := seen[r]; dup {
+			t.Errorf("ItemRanges[%q] duplicates ItemRanges[%q]", key, other)
+		}
+		seen[r] = key
+	}
+	if got := string(tags.ItemRanges["env"].SliceBytes(src)); got != `env    = "prod"` {
+		t.Errorf("ItemRanges[env] covers %q, want the whole key/value pair", got)
+	}
+
+	if name := FromHCLAttribute(attrs["name"]); name.ItemRanges != nil {
+		t.Errorf("ItemRanges = %v, want nil for a non-object expression", name.ItemRanges)
+	}
+}
+
+func TestAttribute_References(t *testing.T) {
+	src := []byte(`location = var.region
+name     = "${var.prefix}-${local.env}-${var.prefix}"
+subnet   = module.network[0].subnet_ids["app"]
+ids      = azurerm_subnet.all[*].id
+sku      = "Standard"
+`)
+	file, diags := hclsyntax.ParseConfig(src, "main.tf", hcl.InitialPos)
+	if diags.HasErrors() {
+		t.Fatalf("parse error: %v", diags)
+	}
+	attrs, diags := file.Body.JustAttributes()
+	if diags.HasErrors() {
+		t.Fatalf("attributes error: %v", diags)
+	}
+
+	tests := map[string][]string{
+		"location": {"var.region"},
+		"name":     {"var.prefix", "local.env"},
+		"subnet":   {`module.network[0].subnet_ids["app"]`},
+		"ids":      {"azurerm_subnet.all"},
+		"sku":      nil,
+	}
+	for name, want := range tests {
+		if got := FromHCLAttribute(attrs[name]).References(); !reflect.DeepEqual(got, want) {
+			t.Errorf("%s: References() = %#v, want %#v", name, got, want)
+		}
+	}
+
+	// Without Expr, as received over gRPC, Refs is returned
+	attr := &Attribute{Name: "location", Value: cty.DynamicVal, Refs: []string{"var.region"}}
+	if got := attr.References(); !reflect.DeepEqual(got, []string{"var.region"}) {
+		t.Errorf("References() = %v, want [var.region]", got)
+	}
+	if got := (*Attribute)(nil).References(); got != nil {
+		t.Errorf("References() = %v, want nil for a nil attribute", got)
+	}
+}
+
 func TestFromHCLBlock_Nil(t *testing.T) {
 	result := FromHCLBlock(nil)
 	if result != nil {
@@ -185,6 +275,281 @@ func TestFromHCLBlock_Valid(t *testing.T) {
 	}
 }
 
+func TestBodyContent_GetAttribute(t *testing.T) {
+	versioning := &Attribute{Name: "versioning_enabled"}
+	days := &Attribute{Name: "days"}
+	name := &Attribute{Name: "name"}
+	content := &BodyContent{
+		Attributes: map[string]*Attribute{"name": name},
+		Blocks: []*Block{
+			{
+				Type: "blob_properties",
+				Body: &BodyContent{
+					Attributes: map[string]*Attribute{"versioning_enabled": versioning},
+					Blocks: []*Block{
+						{Type: "delete_retention_policy", Body: &BodyContent{Attributes: map[string]*Attribute{"days": days}}},
+					},
+				},
+			},
+			// Only the first instance of a block type is searched
+			{Type: "blob_properties", Body: &BodyContent{Attributes: map[string]*Attribute{"other": {Name: "other"}}}},
+			{Type: "network_rules"},
+		},
+	}
+
+	tests := []struct {
+		name string
+		path []string
+		want *Attribute
+	}{
+		{"top level", []string{"name"}, name},
+		{"single level", []string{"blob_properties", "versioning_enabled"}, versioning},
+		{"multi level", []string{"blob_properties", "delete_retention_policy", "days"}, days},
+		{"missing attribute", []string{"blob_properties", "missing"}, nil},
+		{"missing block", []string{"missing", "versioning_enabled"}, nil},
+		{"second instance", []string{"blob_properties", "other"}, nil},
+		{"block without body", []string{"network_rules", "default_action"}, nil},
+		{"empty path", nil, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := content.GetAttribute(tt.path...)
+			if got != tt.want || ok != (tt.want != nil) {
+				t.Errorf("GetAttribute(%v) = %v, %v, want %v", tt.path, got, ok, tt.want)
+			}
+		})
+	}
+}
+
+// exprAttribute returns an attribute parsed from native syntax, as
+// extracted by TestRunner.
+func exprAttribute(t *testing.T, src string) *Attribute {
+	t.Helper()
+	expr, diags := hclsyntax.ParseExpression([]byte(src), "main.tf", hcl.InitialPos)
+	if diags.HasErrors() {
+		t.Fatalf("failed to parse %q: %s", src, diags)
+	}
+	return &Attribute{Name: "attr", Expr: expr}
+}
+
+func TestAttribute_AsString(t *testing.T) {
+	tests := []struct {
+		name   string
+		attr   *Attribute
+		want   string
+		wantOK bool
+	}{
+		{"value", &Attribute{Value: cty.StringVal("westeurope")}, "westeurope", true},
+		{"expression", exprAttribute(t, `"west${"europe"}"`), "westeurope", true},
+		{"nil attribute", nil, "", false},
+		{"no value", &Attribute{}, "", false},
+		{"null", &Attribute{Value: cty.NullVal(cty.String)}, "", false},
+		{"unknown", &Attribute{Value: cty.UnknownVal(cty.String)}, "", false},
+		{"reference", exprAttribute(t, `var.location`), "", false},
+		{"wrong type", &Attribute{Value: cty.NumberIntVal(1)}, "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := tt.attr.AsString()
+			if got != tt.want || ok != tt.wantOK {
+				t.Errorf("AsString() = %q, %v, want %q, %v", got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestAttribute_AsBool(t *testing.T) {
+	tests := []struct {
+		name   string
+		attr   *Attribute
+		want   bool
+		wantOK bool
+	}{
+		{"true", &Attribute{Value: cty.True}, true, true},
+		{"false expression", exprAttribute(t, `false`), false, true},
+		{"nil attribute", nil, false, false},
+		{"null", &Attribute{Value: cty.NullVal(cty.Bool)}, false, false},
+		{"unknown", &Attribute{Value: cty.UnknownVal(cty.Bool)}, false, false},
+		{"wrong type", &Attribute{Value: cty.StringVal("true")}, false, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := tt.attr.AsBool()
+			if got != tt.want || ok != tt.wantOK {
+				t.Errorf("AsBool() = %v, %v, want %v, %v", got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestAttribute_AsNumber(t *testing.T) {
+	tests := []struct {
+		name   string
+		attr   *Attribute
+		want   float64
+		wantOK bool
+	}{
+		{"integer", &Attribute{Value: cty.NumberIntVal(30)}, 30, true},
+		{"fraction expression", exprAttribute(t, `1.5`), 1.5, true},
+		{"nil attribute", nil, 0, false},
+		{"null", &Attribute{Value: cty.NullVal(cty.Number)}, 0, false},
+		{"unknown", &Attribute{Value: cty.UnknownVal(cty.Number)}, 0, false},
+		{"wrong type", &Attribute{Value: cty.StringVal("30")}, 0, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := tt.attr.AsNumber()
+			if got != tt.want || ok != tt.wantOK {
+				t.Errorf("AsNumber() = %v, %v, want %v, %v", got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestAttribute_AsStringSlice(t *testing.T) {
+	tests := []struct {
+		name   string
+		attr   *Attribute
+		want   []string
+		wantOK bool
+	}{
+		{"list", &Attribute{Value: cty.ListVal([]cty.Value{cty.StringVal("a"), cty.StringVal("b")})}, []string{"a", "b"}, true},
+		{"set", &Attribute{Value: cty.SetVal([]cty.Value{cty.StringVal("b"), cty.StringVal("a")})}, []string{"a", "b"}, true},
+		{"tuple expression", exprAttribute(t, `["GET", "POST"]`), []string{"GET", "POST"}, true},
+		{"empty list", &Attribute{Value: cty.ListValEmpty(cty.String)}, []string{}, true},
+		{"nil attribute", nil, nil, false},
+		{"null", &Attribute{Value: cty.NullVal(cty.List(cty.String))}, nil, false},
+		{"unknown element", &Attribute{Value: cty.ListVal([]cty.Value{cty.StringVal("a"), cty.UnknownVal(cty.String)})}, nil, false},
+		{"null element", &Attribute{Value: cty.ListVal([]cty.Value{cty.StringVal("a"), cty.NullVal(cty.String)})}, nil, false},
+		{"mixed tuple", exprAttribute(t, `["a", 1]`), nil, false},
+		{"not a collection", &Attribute{Value: cty.StringVal("a")}, nil, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := tt.attr.AsStringSlice()
+			if !reflect.DeepEqual(got, tt.want) || ok != tt.wantOK {
+				t.Errorf("AsStringSlice() = %q, %v, want %q, %v", got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestBodyContent_GetBlock(t *testing.T) {
+	policy := &Block{Type: "delete_retention_policy"}
+	properties := &Block{Type: "blob_properties", Body: &BodyContent{Blocks: []*Block{policy}}}
+	content := &BodyContent{Blocks: []*Block{properties, {Type: "blob_properties"}}}
+
+	tests := []struct {
+		name string
+		path []string
+		want *Block
+	}{
+		{"single level", []string{"blob_properties"}, properties},
+		{"multi level", []string{"blob_properties", "delete_retention_policy"}, policy},
+		{"missing", []string{"blob_properties", "cors_rule"}, nil},
+		{"past leaf", []string{"blob_properties", "delete_retention_policy", "days"}, nil},
+		{"empty path", nil, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := content.GetBlock(tt.path...)
+			if got != tt.want || ok != (tt.want != nil) {
+				t.Errorf("GetBlock(%v) = %v, %v, want %v", tt.path, got, ok, tt.want)
+			}
+		})
+	}
+
+	var nilContent *BodyContent
+	if _, ok := nilContent.GetBlock("blob_properties"); ok {
+		t.Error("GetBlock on nil content should return false")
+	}
+	if _, ok := nilContent.GetAttribute("name"); ok {
+		t.Error("GetAttribute on nil content should return false")
+	}
+}
+
+func TestBlock_Address(t *testing.T) {
+	tests := []struct {
+		name         string
+		block        *Block
+		wantAddress  string
+		wantTypeName string
+	}{
+		{"resource", &Block{Type: "resource", Labels: []string{"azurerm_x", "name"}}, "azurerm_x.name", "azurerm_x"},
+		{"data source", &Block{Type: "data", Labels: []string{"azurerm_x", "name"}}, "data.azurerm_x.name", "azurerm_x"},
+		{"variable", &Block{Type: "variable", Labels: []string{"location"}}, "var.location", "variable"},
+		{"output", &Block{Type: "output", Labels: []string{"id"}}, "output.id", "output"},
+		{"module", &Block{Type: "module", Labels: []string{"network"}}, "module.network", "module"},
+		{"unlabeled", &Block{Type: "terraform"}, "terraform", "terraform"},
+		{"resource missing name", &Block{Type: "resource", Labels: []string{"azurerm_x"}}, "azurerm_x", "azurerm_x"},
+		{"resource without labels", &Block{Type: "resource"}, "", ""},
+		{"data source without labels", &Block{Type: "data"}, "data", ""},
+		{"variable without labels", &Block{Type: "variable"}, "var", "variable"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.block.Address(); got != tt.wantAddress {
+				t.Errorf("Address() = %q, want %q", got, tt.wantAddress)
+			}
+			if got := tt.block.TypeName(); got != tt.wantTypeName {
+				t.Errorf("TypeName() = %q, want %q", got, tt.wantTypeName)
+			}
+		})
+	}
+}
+
+func TestBlock_FullAddress(t *testing.T) {
+	tests := []struct {
+		name  string
+		block *Block
+		want  string
+	}{
+		{"root module", &Block{Type: "resource", Labels: []string{"aws_instance", "web"}}, "aws_instance.web"},
+		{"child module", &Block{Type: "resource", Labels: []string{"aws_instance", "web"}, ModulePath: []string{"app"}}, "module.app.aws_instance.web"},
+		{"nested module", &Block{Type: "data", Labels: []string{"aws_ami", "base"}, ModulePath: []string{"app", "images"}}, "module.app.module.images.data.aws_ami.base"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.block.FullAddress(); got != tt.want {
+				t.Errorf("FullAddress() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBlock_HasCountAndForEach(t *testing.T) {
+	tests := []struct {
+		name        string
+		block       *Block
+		wantCount   bool
+		wantForEach bool
+	}{
+		{"count", &Block{Body: &BodyContent{Attributes: map[string]*Attribute{"count": {Name: "count"}}}}, true, false},
+		{"for_each", &Block{Body: &BodyContent{Attributes: map[string]*Attribute{"for_each": {Name: "for_each"}}}}, false, true},
+		{"neither", &Block{Body: &BodyContent{Attributes: map[string]*Attribute{"name": {Name: "name"}}}}, false, false},
+		{"nil body", &Block{}, false, false},
+		{"nil block", nil, false, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.block.HasCount(); got != tt.wantCount {
+				t.Errorf("HasCount() = %v, want %v", got, tt.wantCount)
+			}
+			if got := tt.block.HasForEach(); got != tt.wantForEach {
+				t.Errorf("HasForEach() = %v, want %v", got, tt.wantForEach)
+			}
+		})
+	}
+}
+
 func TestFromHCLBodyContent_Nil(t *testing.T) {
 	result := FromHCLBodyContent(nil)
 	if result != nil {
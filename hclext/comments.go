@@ -0,0 +1,84 @@
+package hclext
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+)
+
+// FillLeadingComments populates LeadingComments on every block in content
+// (recursively) by re-scanning src, the native HCL source the blocks were
+// extracted from. Blocks from other files are left unchanged.
+//
+// A comment is leading if it ends on the line directly above the block or
+// above another leading comment. A blank line or any other token ends the
+// group, and comments trailing code on the same line are not included.
+//
+// JSON sources have no comments and are ignored.
+func FillLeadingComments(content *BodyContent, src []byte, filename string) {
+	if content == nil || strings.HasSuffix(filename, ".json") {
+		return
+	}
+	tokens, _ := hclsyntax.LexConfig(src, filename, hcl.InitialPos)
+	fillLeadingComments(content, tokens, filename)
+}
+
+// fillLeadingComments populates LeadingComments from pre-lexed tokens.
+func fillLeadingComments(content *BodyContent, tokens hclsyntax.Tokens, filename string) {
+	if content == nil {
+		return
+	}
+	for _, block := range content.Blocks {
+		if block.DefRange.Filename == filename {
+			block.LeadingComments = leadingComments(tokens, block.DefRange.Start.Byte)
+		}
+		fillLeadingComments(block.Body, tokens, filename)
+	}
+}
+
+// leadingComments returns the comments directly preceding the token that
+// starts at the given byte offset.
+func leadingComments(tokens hclsyntax.Tokens, offset int) []string {
+	idx := sort.Search(len(tokens), func(i int) bool {
+		return tokens[i].Range.Start.Byte >= offset
+	})
+	if idx >= len(tokens) || tokens[idx].Range.Start.Byte != offset {
+		return nil
+	}
+
+	var comments []string
+	nextLine := tokens[idx].Range.Start.Line
+	for i := idx - 1; i >= 0; i-- {
+		tok := tokens[i]
+		if tok.Type == hclsyntax.TokenNewline {
+			continue
+		}
+		if tok.Type != hclsyntax.TokenComment {
+			// A comment on the same line as code belongs to that code
+			if len(comments) > 0 && tok.Range.End.Line == nextLine {
+				comments = comments[1:]
+			}
+			break
+		}
+		if lastLine(tok.Range) != nextLine-1 {
+			break
+		}
+		comments = append([]string{strings.TrimRight(string(tok.Bytes), "\r\n")}, comments...)
+		nextLine = tok.Range.Start.Line
+	}
+	if len(comments) == 0 {
+		return nil
+	}
+	return comments
+}
+
+// lastLine returns the last line occupied by a range. Line comments include
+// their trailing newline, so they end at column 1 of the following line.
+func lastLine(r hcl.Range) int {
+	if r.End.Column == 1 && r.End.Line > r.Start.Line {
+		return r.End.Line - 1
+	}
+	return r.End.Line
+}
@@ -0,0 +1,83 @@
+package hclext
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+)
+
+// parseBlocks parses src and extracts blocks with one label, plus nested "inner" blocks.
+func parseBlocks(t *testing.T, src string) *BodyContent {
+	t.Helper()
+
+	file, diags := hclsyntax.ParseConfig([]byte(src), "main.tf", hcl.InitialPos)
+	if diags.HasErrors() {
+		t.Fatalf("parse error: %s", diags)
+	}
+	content, _, diags := file.Body.PartialContent(&hcl.BodySchema{
+		Blocks: []hcl.BlockHeaderSchema{{Type: "resource", LabelNames: []string{"name"}}},
+	})
+	if diags.HasErrors() {
+		t.Fatalf("content error: %s", diags)
+	}
+
+	result := FromHCLBodyContent(content)
+	for i, block := range content.Blocks {
+		inner, _, _ := block.Body.PartialContent(&hcl.BodySchema{
+			Blocks: []hcl.BlockHeaderSchema{{Type: "inner"}},
+		})
+		result.Blocks[i].Body = FromHCLBodyContent(inner)
+	}
+	FillLeadingComments(result, file.Bytes, "main.tf")
+	return result
+}
+
+func TestFillLeadingComments(t *testing.T) {
+	content := parseBlocks(t, `# tfbreak:ignore my_rule
+resource "first" {
+  # nested comment
+  inner {}
+}
+
+# detached comment
+
+// documented
+/* multi
+   line */
+resource "second" {
+  value = 1 # trailing
+  inner {}
+}
+resource "third" {}
+`)
+
+	tests := []struct {
+		name string
+		got  []string
+		want []string
+	}{
+		{"first", content.Blocks[0].LeadingComments, []string{"# tfbreak:ignore my_rule"}},
+		{"first inner", content.Blocks[0].Body.Blocks[0].LeadingComments, []string{"# nested comment"}},
+		{"second", content.Blocks[1].LeadingComments, []string{"// documented", "/* multi\n   line */"}},
+		{"second inner", content.Blocks[1].Body.Blocks[0].LeadingComments, nil},
+		{"third", content.Blocks[2].LeadingComments, nil},
+	}
+	for _, tt := range tests {
+		if diff := cmp.Diff(tt.want, tt.got); diff != "" {
+			t.Errorf("%s: leading comments mismatch (-want +got):\n%s", tt.name, diff)
+		}
+	}
+}
+
+func TestFillLeadingComments_OtherFile(t *testing.T) {
+	content := &BodyContent{
+		Blocks: []*Block{{Type: "resource", DefRange: hcl.Range{Filename: "other.tf"}}},
+	}
+	FillLeadingComments(content, []byte("# comment\nresource \"a\" {}\n"), "main.tf")
+
+	if content.Blocks[0].LeadingComments != nil {
+		t.Errorf("blocks from other files should be unchanged, got %v", content.Blocks[0].LeadingComments)
+	}
+}
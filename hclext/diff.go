@@ -0,0 +1,326 @@
+package hclext
+
+import (
+	"bytes"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/zclconf/go-cty/cty"
+)
+
+// ContentDiff describes the differences between two BodyContents.
+// Use DiffBodyContent to compute it.
+type ContentDiff struct {
+	// AddedAttributes are attributes present only in the new content.
+	AddedAttributes []*Attribute
+	// RemovedAttributes are attributes present only in the old content.
+	RemovedAttributes []*Attribute
+	// ChangedAttributes are attributes present in both contents with different values.
+	ChangedAttributes []*AttributeChange
+	// AddedBlocks are blocks present only in the new content.
+	AddedBlocks []*Block
+	// RemovedBlocks are blocks present only in the old content.
+	RemovedBlocks []*Block
+	// ChangedBlocks are blocks present in both contents with different bodies.
+	ChangedBlocks []*BlockChange
+}
+
+// AttributeChange pairs the old and new versions of an attribute.
+type AttributeChange struct {
+	// Name is the attribute name.
+	Name string
+	// Old is the attribute from the old content.
+	Old *Attribute
+	// New is the attribute from the new content.
+	New *Attribute
+}
+
+// BlockChange pairs the old and new versions of a block.
+type BlockChange struct {
+	// Path is the dotted path of the block from the compared content: its
+	// type and labels, prefixed with the path of any enclosing changed block
+	// (e.g., "blob_properties.delete_retention_policy"). Blocks repeated
+	// with the same type and labels get their position in brackets
+	// (e.g., "ip_rule[1]").
+	Path string
+	// Old is the block from the old content.
+	Old *Block
+	// New is the block from the new content.
+	New *Block
+	// Diff is the difference between the block bodies.
+	Diff *ContentDiff
+}
+
+// AttributePathChange is an attribute difference found at any depth of a
+// ContentDiff, identified by its dotted path.
+type AttributePathChange struct {
+	// Path is the attribute name prefixed with the path of its enclosing
+	// changed blocks (e.g., "blob_properties.versioning_enabled").
+	Path string
+	// Old is the attribute from the old content, or nil if it was added.
+	Old *Attribute
+	// New is the attribute from the new content, or nil if it was removed.
+	New *Attribute
+}
+
+// IsEmpty reports whether the diff contains no differences.
+func (d *ContentDiff) IsEmpty() bool {
+	return d == nil || (len(d.AddedAttributes) == 0 &&
+		len(d.RemovedAttributes) == 0 &&
+		len(d.ChangedAttributes) == 0 &&
+		len(d.AddedBlocks) == 0 &&
+		len(d.RemovedBlocks) == 0 &&
+		len(d.ChangedBlocks) == 0)
+}
+
+// DiffBodyContent compares two BodyContents.
+// A nil BodyContent is treated as empty.
+//
+// Attributes are matched by name and compared by their decoded Value when
+// available on both sides, falling back to SourceBytes otherwise. Blocks are
+// matched by ModulePath, Type and the full Labels slice; repeated blocks with
+// the same type and labels are matched in order of appearance. Source ranges,
+// including file names, are ignored, so content moved between files
+// compares as equal.
+//
+// Attributes are reported in name order and blocks in order of appearance.
+//
+// Example:
+//
+//	diff := hclext.DiffBodyContent(oldBlock.Body, newBlock.Body)
+//	for _, change := range diff.ChangedAttributes {
+//	    runner.EmitIssue(rule, change.Name+" changed", change.New.Range)
+//	}
+func DiffBodyContent(oldContent, newContent *BodyContent) *ContentDiff {
+	return diffBodyContent(orEmpty(oldContent), orEmpty(newContent), "")
+}
+
+// AttributePaths flattens the attribute differences of d and of its changed
+// blocks, at any depth, into a single list. Within each body, attributes are
+// listed in name order before the attributes of its changed blocks.
+// Attributes of added or removed blocks are not listed; those blocks are
+// only reported in AddedBlocks and RemovedBlocks.
+//
+// Example:
+//
+//	for _, change := range hclext.DiffBodyContent(oldBlock.Body, newBlock.Body).AttributePaths() {
+//	    // change.Path is e.g. "blob_properties.versioning_enabled"
+//	}
+func (d *ContentDiff) AttributePaths() []*AttributePathChange {
+	if d == nil {
+		return nil
+	}
+	return d.appendAttributePaths(nil, "")
+}
+
+// appendAttributePaths appends the attribute differences of d to changes,
+// prefixing their names with prefix.
+func (d *ContentDiff) appendAttributePaths(changes []*AttributePathChange, prefix string) []*AttributePathChange {
+	var own []*AttributePathChange
+	for _, attr := range d.AddedAttributes {
+		own = append(own, &AttributePathChange{Path: prefix + attr.Name, New: attr})
+	}
+	for _, attr := range d.RemovedAttributes {
+		own = append(own, &AttributePathChange{Path: prefix + attr.Name, Old: attr})
+	}
+	for _, change := range d.ChangedAttributes {
+		own = append(own, &AttributePathChange{Path: prefix + change.Name, Old: change.Old, New: change.New})
+	}
+	sort.SliceStable(own, func(i, j int) bool { return own[i].Path < own[j].Path })
+	changes = append(changes, own...)
+
+	for _, change := range d.ChangedBlocks {
+		changes = change.Diff.appendAttributePaths(changes, change.Path+".")
+	}
+	return changes
+}
+
+// diffBodyContent compares two non-nil BodyContents. Changed blocks are
+// given paths below prefix.
+func diffBodyContent(oldContent, newContent *BodyContent, prefix string) *ContentDiff {
+	diff := &ContentDiff{}
+	diffAttributes(diff, oldContent.Attributes, newContent.Attributes)
+	diffBlocks(diff, oldContent.Blocks, newContent.Blocks, prefix)
+	return diff
+}
+
+// diffAttributes records attribute differences in diff.
+func diffAttributes(diff *ContentDiff, oldAttrs, newAttrs map[string]*Attribute) {
+	for _, name := range sortedAttributeNames(oldAttrs) {
+		oldAttr := oldAttrs[name]
+		newAttr, ok := newAttrs[name]
+		if !ok {
+			diff.RemovedAttributes = append(diff.RemovedAttributes, oldAttr)
+			continue
+		}
+		if !AttributesEqual(oldAttr, newAttr) {
+			diff.ChangedAttributes = append(diff.ChangedAttributes, &AttributeChange{
+				Name: name,
+				Old:  oldAttr,
+				New:  newAttr,
+			})
+		}
+	}
+
+	for _, name := range sortedAttributeNames(newAttrs) {
+		if _, ok := oldAttrs[name]; !ok {
+			diff.AddedAttributes = append(diff.AddedAttributes, newAttrs[name])
+		}
+	}
+}
+
+// diffBlocks records block differences in diff, giving changed blocks
+// paths below prefix.
+func diffBlocks(diff *ContentDiff, oldBlocks, newBlocks []*Block, prefix string) {
+	// Index old blocks by key, preserving order for repeated keys
+	oldByKey := make(map[string][]*Block)
+	for _, block := range oldBlocks {
+		key := blockKey(block)
+		oldByKey[key] = append(oldByKey[key], block)
+	}
+	// Keys repeated on either side get positions in their paths
+	repeated := make(map[string]bool)
+	newCounts := make(map[string]int)
+	for _, block := range newBlocks {
+		key := blockKey(block)
+		newCounts[key]++
+		repeated[key] = newCounts[key] > 1 || len(oldByKey[key]) > 1
+	}
+
+	matched := make(map[*Block]bool)
+	positions := make(map[string]int)
+	for _, newBlock := range newBlocks {
+		key := blockKey(newBlock)
+		position := positions[key]
+		positions[key]++
+
+		candidates := oldByKey[key]
+		if len(candidates) == 0 {
+			diff.AddedBlocks = append(diff.AddedBlocks, newBlock)
+			continue
+		}
+		oldBlock := candidates[0]
+		oldByKey[key] = candidates[1:]
+		matched[oldBlock] = true
+
+		path := prefix + blockPath(newBlock)
+		if repeated[key] {
+			path += fmt.Sprintf("[%d]", position)
+		}
+		bodyDiff := diffBodyContent(orEmpty(oldBlock.Body), orEmpty(newBlock.Body), path+".")
+		if !bodyDiff.IsEmpty() {
+			diff.ChangedBlocks = append(diff.ChangedBlocks, &BlockChange{
+				Path: path,
+				Old:  oldBlock,
+				New:  newBlock,
+				Diff: bodyDiff,
+			})
+		}
+	}
+
+	for _, block := range oldBlocks {
+		if !matched[block] {
+			diff.RemovedBlocks = append(diff.RemovedBlocks, block)
+		}
+	}
+}
+
+// BlocksEqual reports whether two blocks are the same regardless of which
+// file they are declared in: they have the same type, labels and module
+// path, and their bodies have no differences as reported by DiffBodyContent,
+// so attributes are compared by value and child blocks recursively. Source
+// ranges are ignored. Two nil blocks are equal; a nil and a non-nil block are not.
+//
+// Example:
+//
+//	if !hclext.BlocksEqual(oldSetting, newSetting) {
+//	    runner.EmitIssue(rule, "setting was modified", newSetting.DefRange)
+//	}
+func BlocksEqual(a, b *Block) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.Type == b.Type && slices.Equal(a.Labels, b.Labels) && slices.Equal(a.ModulePath, b.ModulePath) &&
+		DiffBodyContent(a.Body, b.Body).IsEmpty()
+}
+
+// AttributesEqual reports whether two attributes are equal. They are compared
+// by decoded value with ValuesEqual when both sides can be evaluated, falling
+// back to their source bytes otherwise, ignoring formatting as ExprEquivalent
+// does. Two nil attributes are equal; a nil and a non-nil attribute are not.
+func AttributesEqual(a, b *Attribute) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	valA, okA := AttributeValue(a)
+	valB, okB := AttributeValue(b)
+	if okA && okB {
+		return ValuesEqual(valA, valB)
+	}
+	if len(a.SourceBytes) > 0 || len(b.SourceBytes) > 0 {
+		if equivalent, err := ExprEquivalent(a.SourceBytes, b.SourceBytes); err == nil {
+			return equivalent
+		}
+		return bytes.Equal(bytes.TrimSpace(a.SourceBytes), bytes.TrimSpace(b.SourceBytes))
+	}
+	// No source to compare; equal only if neither side has a value
+	return okA == okB
+}
+
+// AttributeValue returns the decoded value of an attribute, preferring the
+// pre-evaluated Value and falling back to evaluating Expr without context.
+// It returns false if the attribute is nil or its value cannot be determined
+// (e.g., it references a variable), including a Value received over gRPC
+// that is marked unknown. A null value is returned as-is.
+func AttributeValue(attr *Attribute) (cty.Value, bool) {
+	if attr == nil {
+		return cty.NilVal, false
+	}
+	if attr.Value != cty.NilVal {
+		if !attr.Value.IsWhollyKnown() {
+			return cty.NilVal, false
+		}
+		return attr.Value, true
+	}
+	if attr.Expr == nil {
+		return cty.NilVal, false
+	}
+	val, diags := attr.Expr.Value(nil)
+	if diags.HasErrors() || !val.IsWhollyKnown() {
+		return cty.NilVal, false
+	}
+	return val, true
+}
+
+// blockKey returns the matching key of a block (module path, type and
+// labels).
+func blockKey(block *Block) string {
+	key := append(slices.Clone(block.ModulePath), "\x01", block.Type)
+	return strings.Join(append(key, block.Labels...), "\x00")
+}
+
+// blockPath returns the path segment of a block: its type and labels
+// joined with dots.
+func blockPath(block *Block) string {
+	return strings.Join(append([]string{block.Type}, block.Labels...), ".")
+}
+
+// orEmpty returns content, or an empty BodyContent if it is nil.
+func orEmpty(content *BodyContent) *BodyContent {
+	if content == nil {
+		return &BodyContent{}
+	}
+	return content
+}
+
+// sortedAttributeNames returns the attribute names in sorted order.
+func sortedAttributeNames(attrs map[string]*Attribute) []string {
+	names := make([]string, 0, len(attrs))
+	for name := range attrs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,358 @@
+package hclext
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/zclconf/go-cty/cty"
+)
+
+func TestDiffBodyContent_NilInputs(t *testing.T) {
+	if diff := DiffBodyContent(nil, nil); !diff.IsEmpty() {
+		t.Errorf("expected empty diff for nil inputs, got %+v", diff)
+	}
+
+	content := &BodyContent{
+		Attributes: map[string]*Attribute{
+			"location": {Name: "location", Value: cty.StringVal("westus")},
+		},
+		Blocks: []*Block{{Type: "timeouts"}},
+	}
+
+	added := DiffBodyContent(nil, content)
+	if len(added.AddedAttributes) != 1 || len(added.AddedBlocks) != 1 {
+		t.Errorf("expected 1 added attribute and block, got %d and %d",
+			len(added.AddedAttributes), len(added.AddedBlocks))
+	}
+
+	removed := DiffBodyContent(content, nil)
+	if len(removed.RemovedAttributes) != 1 || len(removed.RemovedBlocks) != 1 {
+		t.Errorf("expected 1 removed attribute and block, got %d and %d",
+			len(removed.RemovedAttributes), len(removed.RemovedBlocks))
+	}
+}
+
+func TestDiffBodyContent_Attributes(t *testing.T) {
+	oldContent := &BodyContent{
+		Attributes: map[string]*Attribute{
+			"location": {Name: "location", Value: cty.StringVal("westus")},
+			"name":     {Name: "name", Value: cty.StringVal("rg")},
+			"sku":      {Name: "sku", Value: cty.StringVal("Standard")},
+		},
+	}
+	newContent := &BodyContent{
+		Attributes: map[string]*Attribute{
+			"location": {Name: "location", Value: cty.StringVal("eastus")},
+			"name":     {Name: "name", Value: cty.StringVal("rg")},
+			"tags":     {Name: "tags", Value: cty.MapValEmpty(cty.String)},
+		},
+	}
+
+	diff := DiffBodyContent(oldContent, newContent)
+
+	if len(diff.ChangedAttributes) != 1 {
+		t.Fatalf("expected 1 changed attribute, got %d", len(diff.ChangedAttributes))
+	}
+	change := diff.ChangedAttributes[0]
+	if change.Name != "location" {
+		t.Errorf("changed attribute = %q, want %q", change.Name, "location")
+	}
+	if change.Old.Value.AsString() != "westus" || change.New.Value.AsString() != "eastus" {
+		t.Errorf("changed values = %v -> %v, want westus -> eastus", change.Old.Value, change.New.Value)
+	}
+	if len(diff.RemovedAttributes) != 1 || diff.RemovedAttributes[0].Name != "sku" {
+		t.Errorf("RemovedAttributes = %v, want [sku]", diff.RemovedAttributes)
+	}
+	if len(diff.AddedAttributes) != 1 || diff.AddedAttributes[0].Name != "tags" {
+		t.Errorf("AddedAttributes = %v, want [tags]", diff.AddedAttributes)
+	}
+}
+
+func TestDiffBodyContent_ExprValue(t *testing.T) {
+	parse := func(src string) hcl.Expression {
+		expr, diags := hclsyntax.ParseExpression([]byte(src), "main.tf", hcl.InitialPos)
+		if diags.HasErrors() {
+			t.Fatalf("failed to parse %q: %s", src, diags.Error())
+		}
+		return expr
+	}
+
+	oldContent := &BodyContent{
+		Attributes: map[string]*Attribute{
+			"location": {Name: "location", Expr: parse(`"westus"`)},
+		},
+	}
+	newContent := &BodyContent{
+		Attributes: map[string]*Attribute{
+			"location": {Name: "location", Expr: parse(`"westus"`)},
+		},
+	}
+
+	if diff := DiffBodyContent(oldContent, newContent); !diff.IsEmpty() {
+		t.Errorf("expected no differences for equal expressions, got %+v", diff)
+	}
+}
+
+func TestDiffBodyContent_SourceBytesFallback(t *testing.T) {
+	tests := []struct {
+		name    string
+		oldSrc  string
+		newSrc  string
+		changed bool
+	}{
+		{"same reference", "var.location", "var.location", false},
+		{"different reference", "var.location", "var.region", true},
+		{"whitespace only", " var.location", "var.location ", false},
+		{"formatting only", `[var.a,var.b]`, "[\n  var.a,\n  var.b,\n]", false},
+		{"element changed", `[var.a, var.b]`, `[var.a, var.c]`, true},
+	}
+
+	// References have no value when extracted in-process, and an unknown
+	// value when received over gRPC; both fall back to the source
+	values := map[string]cty.Value{"no value": cty.NilVal, "unknown value": cty.DynamicVal}
+
+	for _, tt := range tests {
+		for valueName, value := range values {
+			t.Run(tt.name+"/"+valueName, func(t *testing.T) {
+				oldContent := &BodyContent{
+					Attributes: map[string]*Attribute{
+						"location": {Name: "location", Value: value, SourceBytes: []byte(tt.oldSrc)},
+					},
+				}
+				newContent := &BodyContent{
+					Attributes: map[string]*Attribute{
+						"location": {Name: "location", Value: value, SourceBytes: []byte(tt.newSrc)},
+					},
+				}
+
+				diff := DiffBodyContent(oldContent, newContent)
+				if got := len(diff.ChangedAttributes) == 1; got != tt.changed {
+					t.Errorf("changed = %v, want %v", got, tt.changed)
+				}
+			})
+		}
+	}
+}
+
+func TestDiffBodyContent_NestedBlocks(t *testing.T) {
+	oldContent := &BodyContent{
+		Blocks: []*Block{
+			{
+				Type: "network_rules",
+				Body: &BodyContent{
+					Attributes: map[string]*Attribute{
+						"default_action": {Name: "default_action", Value: cty.StringVal("Allow")},
+					},
+				},
+			},
+			{Type: "identity"},
+		},
+	}
+	newContent := &BodyContent{
+		Blocks: []*Block{
+			{
+				Type: "network_rules",
+				Body: &BodyContent{
+					Attributes: map[string]*Attribute{
+						"default_action": {Name: "default_action", Value: cty.StringVal("Deny")},
+					},
+				},
+			},
+			{Type: "timeouts"},
+		},
+	}
+
+	diff := DiffBodyContent(oldContent, newContent)
+
+	if len(diff.ChangedBlocks) != 1 {
+		t.Fatalf("expected 1 changed block, got %d", len(diff.ChangedBlocks))
+	}
+	nested := diff.ChangedBlocks[0].Diff
+	if len(nested.ChangedAttributes) != 1 || nested.ChangedAttributes[0].Name != "default_action" {
+		t.Errorf("nested ChangedAttributes = %v, want [default_action]", nested.ChangedAttributes)
+	}
+	if len(diff.RemovedBlocks) != 1 || diff.RemovedBlocks[0].Type != "identity" {
+		t.Errorf("RemovedBlocks = %v, want [identity]", diff.RemovedBlocks)
+	}
+	if len(diff.AddedBlocks) != 1 || diff.AddedBlocks[0].Type != "timeouts" {
+		t.Errorf("AddedBlocks = %v, want [timeouts]", diff.AddedBlocks)
+	}
+}
+
+func TestContentDiff_AttributePaths(t *testing.T) {
+	attr := func(name, value string) *Attribute {
+		return &Attribute{Name: name, Value: cty.StringVal(value)}
+	}
+	body := func(attrs ...*Attribute) *BodyContent {
+		content := &BodyContent{Attributes: map[string]*Attribute{}}
+		for _, a := range attrs {
+			content.Attributes[a.Name] = a
+		}
+		return content
+	}
+
+	oldContent := &BodyContent{
+		Attributes: map[string]*Attribute{"name": attr("name", "sa"), "tier": attr("tier", "Standard")},
+		Blocks: []*Block{
+			{Type: "network_rules", Body: &BodyContent{
+				Blocks: []*Block{
+					{Type: "ip_rule", Body: body(attr("ip", "10.0.0.1"))},
+					{Type: "ip_rule", Body: body(attr("ip", "10.0.0.2"))},
+				},
+			}},
+			{Type: "container", Labels: []string{"logs"}, Body: body(attr("access", "private"))},
+			{Type: "identity", Body: body(attr("type", "SystemAssigned"))},
+		},
+	}
+	newContent := &BodyContent{
+		Attributes: map[string]*Attribute{"name": attr("name", "sa"), "kind": attr("kind", "StorageV2")},
+		Blocks: []*Block{
+			{Type: "network_rules", Body: &BodyContent{
+				Attributes: map[string]*Attribute{"default_action": attr("default_action", "Deny")},
+				Blocks: []*Block{
+					{Type: "ip_rule", Body: body(attr("ip", "10.0.0.1"))},
+					{Type: "ip_rule", Body: body(attr("ip", "10.0.0.3"))},
+				},
+			}},
+			{Type: "container", Labels: []string{"logs"}, Body: body(attr("access", "blob"))},
+			{Type: "timeouts", Body: body(attr("create", "30m"))},
+		},
+	}
+
+	diff := DiffBodyContent(oldContent, newContent)
+	if got := diff.ChangedBlocks[0].Path; got != "network_rules" {
+		t.Errorf("ChangedBlocks[0].Path = %q, want network_rules", got)
+	}
+
+	want := []struct {
+		path           string
+		hasOld, hasNew bool
+	}{
+		{"kind", false, true},
+		{"tier", true, false},
+		{"network_rules.default_action", false, true},
+		{"network_rules.ip_rule[1].ip", true, true},
+		{"container.logs.access", true, true},
+	}
+	got := diff.AttributePaths()
+	if len(got) != len(want) {
+		paths := make([]string, len(got))
+		for i, change := range got {
+			paths[i] = change.Path
+		}
+		t.Fatalf("AttributePaths() = %v, want %d paths", paths, len(want))
+	}
+	for i, w := range want {
+		if got[i].Path != w.path || (got[i].Old != nil) != w.hasOld || (got[i].New != nil) != w.hasNew {
+			t.Errorf("AttributePaths()[%d] = %+v, want %s (old %t, new %t)", i, got[i], w.path, w.hasOld, w.hasNew)
+		}
+	}
+
+	if paths := DiffBodyContent(oldContent, oldContent).AttributePaths(); len(paths) != 0 {
+		t.Errorf("AttributePaths() of identical content = %v, want none", paths)
+	}
+	if paths := (*ContentDiff)(nil).AttributePaths(); paths != nil {
+		t.Errorf("AttributePaths() of nil diff = %v, want nil", paths)
+	}
+}
+
+func TestDiffBodyContent_BlocksMatchedByLabels(t *testing.T) {
+	oldContent := &BodyContent{
+		Blocks: []*Block{
+			{Type: "resource", Labels: []string{"aws_instance", "web"}},
+			{Type: "ingress"},
+			{Type: "ingress"},
+		},
+	}
+	newContent := &BodyContent{
+		Blocks: []*Block{
+			{Type: "resource", Labels: []string{"aws_instance", "api"}},
+			{Type: "ingress"},
+		},
+	}
+
+	diff := DiffBodyContent(oldContent, newContent)
+
+	if len(diff.AddedBlocks) != 1 || diff.AddedBlocks[0].Labels[1] != "api" {
+		t.Errorf("AddedBlocks = %v, want [aws_instance.api]", diff.AddedBlocks)
+	}
+	if len(diff.RemovedBlocks) != 2 {
+		t.Fatalf("expected 2 removed blocks, got %d", len(diff.RemovedBlocks))
+	}
+	if diff.RemovedBlocks[0].Type != "resource" || diff.RemovedBlocks[1] != oldContent.Blocks[2] {
+		t.Error("expected removed resource and the second repeated ingress block")
+	}
+}
+
+func TestBlocksEqual(t *testing.T) {
+	at := func(filename string, line int) hcl.Range {
+		return hcl.Range{
+			Filename: filename,
+			Start:    hcl.Pos{Line: line, Column: 1, Byte: line * 10},
+			End:      hcl.Pos{Line: line + 1, Column: 1, Byte: line*10 + 10},
+		}
+	}
+	setting := func(filename string, line int, value string) *Block {
+		return &Block{
+			Type:        "setting",
+			Labels:      []string{"diagnostics"},
+			DefRange:    at(filename, line),
+			TypeRange:   at(filename, line),
+			LabelRanges: []hcl.Range{at(filename, line)},
+			Body: &BodyContent{
+				Attributes: map[string]*Attribute{
+					"enabled": {Name: "enabled", Value: cty.True, Range: at(filename, line+1), NameRange: at(filename, line+1)},
+				},
+				Blocks: []*Block{{
+					Type:     "retention",
+					DefRange: at(filename, line+2),
+					Body: &BodyContent{
+						Attributes: map[string]*Attribute{
+							"days": {Name: "days", Value: cty.StringVal(value), Range: at(filename, line+3)},
+						},
+					},
+				}},
+			},
+		}
+	}
+
+	tests := []struct {
+		name string
+		a, b *Block
+		want bool
+	}{
+		{"same block", setting("main.tf", 1, "30"), setting("main.tf", 1, "30"), true},
+		{"different source positions", setting("main.tf", 1, "30"), setting("settings.tf", 42, "30"), true},
+		{"nested attribute differs", setting("main.tf", 1, "30"), setting("main.tf", 1, "90"), false},
+		{"different labels", setting("main.tf", 1, "30"), &Block{Type: "setting", Labels: []string{"audit"}, Body: setting("main.tf", 1, "30").Body}, false},
+		{"different type", &Block{Type: "setting"}, &Block{Type: "dynamic"}, false},
+		{"nil bodies", &Block{Type: "timeouts"}, &Block{Type: "timeouts", Body: &BodyContent{}}, true},
+		{"both nil", nil, nil, true},
+		{"one nil", setting("main.tf", 1, "30"), nil, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := BlocksEqual(tt.a, tt.b); got != tt.want {
+				t.Errorf("BlocksEqual() = %t, want %t", got, tt.want)
+			}
+			if got := BlocksEqual(tt.b, tt.a); got != tt.want {
+				t.Errorf("BlocksEqual() reversed = %t, want %t", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestContentDiff_IsEmpty(t *testing.T) {
+	var nilDiff *ContentDiff
+	if !nilDiff.IsEmpty() {
+		t.Error("nil diff should be empty")
+	}
+	if !(&ContentDiff{}).IsEmpty() {
+		t.Error("zero diff should be empty")
+	}
+	if (&ContentDiff{AddedBlocks: []*Block{{Type: "x"}}}).IsEmpty() {
+		t.Error("diff with added block should not be empty")
+	}
+}
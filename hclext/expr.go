@@ -0,0 +1,107 @@
+package hclext
+
+import (
+	"bytes"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+)
+
+// ExprEquivalent reports whether two expression sources are the same
+// expression, ignoring formatting. Both are parsed with hclsyntax. If both
+// evaluate without context, their values are compared, so `[1,2]` and
+// `[1, 2]` are equivalent. Otherwise their tokens are compared, ignoring
+// whitespace, comments, trailing commas and line breaks between items, so
+// `{ a = var.x, b = 1 }` is equivalent to the same object written over
+// several lines.
+//
+// It returns an error if either source is not a valid expression.
+//
+// Example:
+//
+//	if ok, err := hclext.ExprEquivalent(oldAttr.SourceBytes, newAttr.SourceBytes); err == nil && ok {
+//	    // only the formatting changed
+//	}
+func ExprEquivalent(oldBytes, newBytes []byte) (bool, error) {
+	oldExpr, diags := hclsyntax.ParseExpression(oldBytes, "", hcl.InitialPos)
+	if diags.HasErrors() {
+		return false, diags
+	}
+	newExpr, diags := hclsyntax.ParseExpression(newBytes, "", hcl.InitialPos)
+	if diags.HasErrors() {
+		return false, diags
+	}
+
+	oldVal, oldDiags := oldExpr.Value(nil)
+	newVal, newDiags := newExpr.Value(nil)
+	if !oldDiags.HasErrors() && !newDiags.HasErrors() && oldVal.IsWhollyKnown() && newVal.IsWhollyKnown() {
+		return oldVal.RawEquals(newVal), nil
+	}
+
+	oldTokens := significantTokens(oldBytes)
+	newTokens := significantTokens(newBytes)
+	if len(oldTokens) != len(newTokens) {
+		return false, nil
+	}
+	for i := range oldTokens {
+		if oldTokens[i].Type != newTokens[i].Type || !bytes.Equal(oldTokens[i].Bytes, newTokens[i].Bytes) {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// significantTokens returns the tokens of an expression that affect its
+// meaning. Comments are dropped, and a line break ending an item is turned
+// into a comma, as both separate object attributes. Commas directly inside
+// brackets, braces or parentheses are dropped, as are repeated commas.
+func significantTokens(src []byte) hclsyntax.Tokens {
+	tokens, _ := hclsyntax.LexExpression(src, "", hcl.InitialPos)
+
+	var result hclsyntax.Tokens
+	last := func() hclsyntax.TokenType {
+		if len(result) == 0 {
+			return hclsyntax.TokenNil
+		}
+		return result[len(result)-1].Type
+	}
+	for _, token := range tokens {
+		switch token.Type {
+		case hclsyntax.TokenComment, hclsyntax.TokenEOF:
+			continue
+		case hclsyntax.TokenNewline:
+			if !endsOperand(last()) {
+				continue
+			}
+			token = hclsyntax.Token{Type: hclsyntax.TokenComma, Bytes: []byte(",")}
+		}
+
+		switch token.Type {
+		case hclsyntax.TokenComma:
+			switch last() {
+			case hclsyntax.TokenNil, hclsyntax.TokenComma, hclsyntax.TokenOBrack, hclsyntax.TokenOBrace, hclsyntax.TokenOParen:
+				continue
+			}
+		case hclsyntax.TokenCBrack, hclsyntax.TokenCBrace, hclsyntax.TokenCParen:
+			if last() == hclsyntax.TokenComma {
+				result = result[:len(result)-1]
+			}
+		}
+		result = append(result, token)
+	}
+	if last() == hclsyntax.TokenComma {
+		result = result[:len(result)-1]
+	}
+	return result
+}
+
+// endsOperand reports whether a token of type t can end an operand, so a
+// line break after it ends the item rather than continuing an expression.
+func endsOperand(t hclsyntax.TokenType) bool {
+	switch t {
+	case hclsyntax.TokenIdent, hclsyntax.TokenNumberLit, hclsyntax.TokenCQuote, hclsyntax.TokenCHeredoc,
+		hclsyntax.TokenCBrack, hclsyntax.TokenCBrace, hclsyntax.TokenCParen:
+		return true
+	}
+	return false
+}
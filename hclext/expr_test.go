@@ -0,0 +1,46 @@
+package hclext
+
+import "testing"
+
+func TestExprEquivalent(t *testing.T) {
+	tests := []struct {
+		name     string
+		old, new string
+		want     bool
+	}{
+		{"spacing in a list", `[1,2]`, `[1, 2]`, true},
+		{"trailing comma", `[1, 2]`, "[\n  1,\n  2,\n]", true},
+		{"list element changed", `[1, 2]`, `[1, 3]`, false},
+		{"list element added", `[1, 2]`, `[1, 2, 3]`, false},
+		{"spacing around references", `var.a+var.b`, `var.a + var.b`, true},
+		{"comment", `var.region`, `var.region # the primary region`, true},
+		{"object over several lines", `{ env = var.env, team = "platform" }`, "{\n  env  = var.env\n  team = \"platform\"\n}", true},
+		{"object value changed", `{ env = var.env }`, `{ env = var.environment }`, false},
+		{"reference changed", `var.a`, `var.b`, false},
+		{"literal became a reference", `"westeurope"`, `var.region`, false},
+		{"line break inside an expression", `var.a ? "x" : "y"`, "var.a ?\n  \"x\" :\n  \"y\"", true},
+		{"unary minus is not a separate element", `[var.a, -var.b]`, `[var.a -var.b]`, false},
+		{"template spacing is significant", `"${var.a}-x"`, `"${var.a} -x"`, false},
+		{"function call formatting", `merge(var.tags,{env="prod"})`, `merge(var.tags, { env = "prod" })`, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ExprEquivalent([]byte(tt.old), []byte(tt.new))
+			if err != nil {
+				t.Fatalf("ExprEquivalent() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("ExprEquivalent(%q, %q) = %v, want %v", tt.old, tt.new, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestExprEquivalent_InvalidSource(t *testing.T) {
+	if _, err := ExprEquivalent([]byte(`[1, 2`), []byte(`[1, 2]`)); err == nil {
+		t.Error("ExprEquivalent() error = nil, want a parse error for the old source")
+	}
+	if _, err := ExprEquivalent([]byte(`var.a`), []byte(`var.`)); err == nil {
+		t.Error("ExprEquivalent() error = nil, want a parse error for the new source")
+	}
+}
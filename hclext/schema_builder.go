@@ -0,0 +1,72 @@
+package hclext
+
+// SchemaBuilder builds a BodySchema fluently, which is shorter than a
+// nested literal for schemas with several levels of blocks.
+//
+// Example:
+//
+//	schema := hclext.NewSchema().
+//	    Attr("name", true).
+//	    Block("blob_properties", func(b *hclext.SchemaBuilder) {
+//	        b.Attr("versioning_enabled", false).
+//	            Block("cors_rule", func(b *hclext.SchemaBuilder) {
+//	                b.Attrs("allowed_methods", "allowed_origins")
+//	            })
+//	    }).
+//	    Build()
+type SchemaBuilder struct {
+	schema *BodySchema
+}
+
+// NewSchema returns a builder for an empty BodySchema.
+func NewSchema() *SchemaBuilder {
+	return &SchemaBuilder{schema: &BodySchema{}}
+}
+
+// Attr adds an attribute, which must be present if required is true.
+func (b *SchemaBuilder) Attr(name string, required bool) *SchemaBuilder {
+	b.schema.Attributes = append(b.schema.Attributes, AttributeSchema{Name: name, Required: required})
+	return b
+}
+
+// Attrs adds optional attributes.
+func (b *SchemaBuilder) Attrs(names ...string) *SchemaBuilder {
+	for _, name := range names {
+		b.Attr(name, false)
+	}
+	return b
+}
+
+// Block adds a block without labels. body is called with a builder for the
+// block's body schema; if body is nil, the block body is an empty schema.
+func (b *SchemaBuilder) Block(blockType string, body func(*SchemaBuilder)) *SchemaBuilder {
+	return b.LabeledBlock(blockType, nil, body)
+}
+
+// LabeledBlock adds a block with the given label names, such as
+// []string{"type", "name"} for resources. See Block.
+func (b *SchemaBuilder) LabeledBlock(blockType string, labelNames []string, body func(*SchemaBuilder)) *SchemaBuilder {
+	nested := NewSchema()
+	if body != nil {
+		body(nested)
+	}
+	b.schema.Blocks = append(b.schema.Blocks, BlockSchema{
+		Type:       blockType,
+		LabelNames: labelNames,
+		Body:       nested.schema,
+	})
+	return b
+}
+
+// JustAttributes switches the schema to SchemaJustAttributesMode, which
+// extracts every attribute without declaring them.
+func (b *SchemaBuilder) JustAttributes() *SchemaBuilder {
+	b.schema.Mode = SchemaJustAttributesMode
+	return b
+}
+
+// Build returns the schema. Further calls on the builder modify the
+// returned schema.
+func (b *SchemaBuilder) Build() *BodySchema {
+	return b.schema
+}
@@ -0,0 +1,84 @@
+package hclext
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSchemaBuilder_Nested(t *testing.T) {
+	got := NewSchema().
+		Attr("name", true).
+		Block("blob_properties", func(b *SchemaBuilder) {
+			b.Attr("versioning_enabled", false).
+				Block("cors_rule", func(b *SchemaBuilder) {
+					b.Attrs("allowed_methods", "allowed_origins")
+				})
+		}).
+		Build()
+
+	want := &BodySchema{
+		Attributes: []AttributeSchema{
+			{Name: "name", Required: true},
+		},
+		Blocks: []BlockSchema{
+			{
+				Type: "blob_properties",
+				Body: &BodySchema{
+					Attributes: []AttributeSchema{
+						{Name: "versioning_enabled"},
+					},
+					Blocks: []BlockSchema{
+						{
+							Type: "cors_rule",
+							Body: &BodySchema{
+								Attributes: []AttributeSchema{
+									{Name: "allowed_methods"},
+									{Name: "allowed_origins"},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Build() = %#v, want %#v", got, want)
+	}
+}
+
+func TestSchemaBuilder_LabeledBlocks(t *testing.T) {
+	got := NewSchema().
+		LabeledBlock("resource", []string{"type", "name"}, func(b *SchemaBuilder) {
+			b.Attr("location", true).Block("lifecycle", nil)
+		}).
+		LabeledBlock("locals", nil, func(b *SchemaBuilder) {
+			b.JustAttributes()
+		}).
+		Build()
+
+	want := &BodySchema{
+		Blocks: []BlockSchema{
+			{
+				Type:       "resource",
+				LabelNames: []string{"type", "name"},
+				Body: &BodySchema{
+					Attributes: []AttributeSchema{{Name: "location", Required: true}},
+					Blocks:     []BlockSchema{{Type: "lifecycle", Body: &BodySchema{}}},
+				},
+			},
+			{Type: "locals", Body: &BodySchema{Mode: SchemaJustAttributesMode}},
+		},
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Build() = %#v, want %#v", got, want)
+	}
+}
+
+func TestSchemaBuilder_Empty(t *testing.T) {
+	if got := NewSchema().Build(); !reflect.DeepEqual(got, &BodySchema{}) {
+		t.Errorf("Build() = %#v, want an empty schema", got)
+	}
+}
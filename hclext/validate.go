@@ -0,0 +1,87 @@
+package hclext
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/hcl/v2"
+)
+
+// ValidateRequired reports attributes marked Required in schema that are
+// missing from content. Nested block schemas are checked against the body of
+// every matching block, so a single call validates the whole content tree.
+//
+// Diagnostics for nested blocks point at the block's DefRange. Missing
+// top-level attributes have no single location, so their diagnostics carry
+// no Subject.
+//
+// Runners use this after merging content from several files, where a
+// required module-level attribute may be defined in any one of them.
+func ValidateRequired(schema *BodySchema, content *BodyContent) hcl.Diagnostics {
+	return validateRequired(schema, content, nil)
+}
+
+// validateRequired checks content against schema, attributing missing
+// attributes to subject.
+func validateRequired(schema *BodySchema, content *BodyContent, subject *hcl.Range) hcl.Diagnostics {
+	if schema == nil {
+		return nil
+	}
+
+	var diags hcl.Diagnostics
+	for _, attrS := range schema.Attributes {
+		if !attrS.Required {
+			continue
+		}
+		if content != nil {
+			if _, ok := content.Attributes[attrS.Name]; ok {
+				continue
+			}
+		}
+		diags = append(diags, &hcl.Diagnostic{
+			Severity: hcl.DiagError,
+			Summary:  "Missing required argument",
+			Detail:   fmt.Sprintf("The argument %q is required, but no definition was found.", attrS.Name),
+			Subject:  subject,
+		})
+	}
+
+	if content == nil {
+		return diags
+	}
+	for _, block := range content.Blocks {
+		for _, blockS := range schema.Blocks {
+			if blockS.Type != block.Type || blockS.Body == nil {
+				continue
+			}
+			defRange := block.DefRange
+			diags = append(diags, validateRequired(blockS.Body, block.Body, &defRange)...)
+		}
+	}
+	return diags
+}
+
+// WithoutRequired returns a copy of schema with Required cleared on every
+// attribute, recursively. Use it to extract content with hcl.Body.PartialContent
+// without failing on missing attributes, then check them with ValidateRequired.
+func WithoutRequired(schema *BodySchema) *BodySchema {
+	if schema == nil {
+		return nil
+	}
+
+	result := &BodySchema{
+		Attributes: make([]AttributeSchema, len(schema.Attributes)),
+		Blocks:     make([]BlockSchema, len(schema.Blocks)),
+		Mode:       schema.Mode,
+	}
+	for i, attrS := range schema.Attributes {
+		result.Attributes[i] = AttributeSchema{Name: attrS.Name}
+	}
+	for i, blockS := range schema.Blocks {
+		result.Blocks[i] = BlockSchema{
+			Type:       blockS.Type,
+			LabelNames: blockS.LabelNames,
+			Body:       WithoutRequired(blockS.Body),
+		}
+	}
+	return result
+}
@@ -0,0 +1,90 @@
+package hclext
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+)
+
+func TestValidateRequired(t *testing.T) {
+	schema := &BodySchema{
+		Attributes: []AttributeSchema{
+			{Name: "name", Required: true},
+			{Name: "tags"},
+		},
+		Blocks: []BlockSchema{
+			{
+				Type: "network",
+				Body: &BodySchema{
+					Attributes: []AttributeSchema{{Name: "cidr", Required: true}},
+				},
+			},
+		},
+	}
+
+	defRange := hcl.Range{Filename: "main.tf", Start: hcl.Pos{Line: 3, Column: 1}}
+	content := &BodyContent{
+		Attributes: map[string]*Attribute{},
+		Blocks: []*Block{
+			{Type: "network", Body: &BodyContent{Attributes: map[string]*Attribute{}}, DefRange: defRange},
+			{Type: "network", Body: &BodyContent{Attributes: map[string]*Attribute{"cidr": {Name: "cidr"}}}},
+		},
+	}
+
+	diags := ValidateRequired(schema, content)
+	if len(diags) != 2 {
+		t.Fatalf("expected 2 diagnostics, got %d: %v", len(diags), diags)
+	}
+	if !strings.Contains(diags[0].Detail, `"name"`) || diags[0].Subject != nil {
+		t.Errorf("diags[0] = %v, want missing name without subject", diags[0])
+	}
+	if !strings.Contains(diags[1].Detail, `"cidr"`) || diags[1].Subject == nil || diags[1].Subject.Start.Line != 3 {
+		t.Errorf("diags[1] = %v, want missing cidr at line 3", diags[1])
+	}
+}
+
+func TestValidateRequired_AllPresent(t *testing.T) {
+	schema := &BodySchema{
+		Attributes: []AttributeSchema{{Name: "name", Required: true}},
+	}
+	content := &BodyContent{
+		Attributes: map[string]*Attribute{"name": {Name: "name"}},
+	}
+
+	if diags := ValidateRequired(schema, content); len(diags) != 0 {
+		t.Errorf("expected no diagnostics, got %v", diags)
+	}
+	if diags := ValidateRequired(nil, content); len(diags) != 0 {
+		t.Errorf("nil schema: expected no diagnostics, got %v", diags)
+	}
+}
+
+func TestWithoutRequired(t *testing.T) {
+	schema := &BodySchema{
+		Attributes: []AttributeSchema{{Name: "name", Required: true}},
+		Blocks: []BlockSchema{
+			{
+				Type:       "resource",
+				LabelNames: []string{"type", "name"},
+				Body: &BodySchema{
+					Attributes: []AttributeSchema{{Name: "location", Required: true}},
+				},
+			},
+		},
+	}
+
+	got := WithoutRequired(schema)
+	if got.Attributes[0].Required || got.Blocks[0].Body.Attributes[0].Required {
+		t.Error("WithoutRequired should clear Required recursively")
+	}
+	if got.Blocks[0].Body.Attributes[0].Name != "location" || len(got.Blocks[0].LabelNames) != 2 {
+		t.Error("WithoutRequired should keep names and labels")
+	}
+	if !schema.Attributes[0].Required || !schema.Blocks[0].Body.Attributes[0].Required {
+		t.Error("WithoutRequired should not modify the original schema")
+	}
+	if WithoutRequired(nil) != nil {
+		t.Error("WithoutRequired(nil) should return nil")
+	}
+}
@@ -0,0 +1,134 @@
+package hclext
+
+import (
+	"github.com/zclconf/go-cty/cty"
+)
+
+// ValuesEqual reports whether two values are the same for the purpose of
+// detecting a change. Unlike cty.Value.Equals, it always returns a bool:
+//
+//   - Numbers are equal when they are numerically equal, or equal once
+//     converted to float64, so `1` and `1.0`, or a number that lost
+//     precision in a JSON roundtrip, are equal.
+//   - cty.NilVal, for an absent value, and null values of any type are
+//     equal to each other. An object or map attribute that is null on one
+//     side and absent on the other is therefore equal too.
+//   - Lists and tuples are compared element by element, as are maps and
+//     objects by key, so `["a"]` written as a tuple equals a list of the
+//     same string. Sets are equal when every element of each set has an
+//     equal element in the other.
+//   - Values that are not wholly known are equal only if they are
+//     identical, including the position and type of their unknowns.
+//
+// Example:
+//
+//	oldVal, _ := hclext.AttributeValue(oldAttr)
+//	newVal, _ := hclext.AttributeValue(newAttr)
+//	if !hclext.ValuesEqual(oldVal, newVal) {
+//	    runner.EmitIssue(rule, "value changed", newAttr.Range)
+//	}
+func ValuesEqual(old, new cty.Value) bool {
+	if isNullOrAbsent(old) || isNullOrAbsent(new) {
+		return isNullOrAbsent(old) && isNullOrAbsent(new)
+	}
+	if !old.IsWhollyKnown() || !new.IsWhollyKnown() {
+		return old.RawEquals(new)
+	}
+	old, _ = old.UnmarkDeep()
+	new, _ = new.UnmarkDeep()
+
+	oldType, newType := old.Type(), new.Type()
+	switch {
+	case oldType == cty.Number && newType == cty.Number:
+		return numbersEqual(old, new)
+	case oldType.IsPrimitiveType() || newType.IsPrimitiveType():
+		return old.RawEquals(new)
+	case isSequenceType(oldType) && isSequenceType(newType):
+		return sequencesEqual(old.AsValueSlice(), new.AsValueSlice())
+	case oldType.IsSetType() && newType.IsSetType():
+		return setsEqual(old.AsValueSlice(), new.AsValueSlice())
+	case isMappingType(oldType) && isMappingType(newType):
+		return mappingsEqual(old.AsValueMap(), new.AsValueMap())
+	}
+	return old.RawEquals(new)
+}
+
+// isNullOrAbsent reports whether val is cty.NilVal or null.
+func isNullOrAbsent(val cty.Value) bool {
+	return val == cty.NilVal || val.IsNull()
+}
+
+// numbersEqual reports whether two known numbers are numerically equal,
+// either exactly or at float64 precision.
+func numbersEqual(a, b cty.Value) bool {
+	bigA, bigB := a.AsBigFloat(), b.AsBigFloat()
+	if bigA.Cmp(bigB) == 0 {
+		return true
+	}
+	floatA, _ := bigA.Float64()
+	floatB, _ := bigB.Float64()
+	return floatA == floatB
+}
+
+// isSequenceType reports whether values of ty are ordered sequences.
+func isSequenceType(ty cty.Type) bool {
+	return ty.IsListType() || ty.IsTupleType()
+}
+
+// isMappingType reports whether values of ty are keyed by string.
+func isMappingType(ty cty.Type) bool {
+	return ty.IsMapType() || ty.IsObjectType()
+}
+
+// sequencesEqual reports whether a and b have equal elements in order.
+func sequencesEqual(a, b []cty.Value) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !ValuesEqual(a[i], b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// setsEqual reports whether every element of a has an equal element in b
+// and vice versa.
+func setsEqual(a, b []cty.Value) bool {
+	return containsAll(a, b) && containsAll(b, a)
+}
+
+// containsAll reports whether every element of b has an equal element in a.
+func containsAll(a, b []cty.Value) bool {
+	for _, vb := range b {
+		found := false
+		for _, va := range a {
+			if ValuesEqual(va, vb) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// mappingsEqual reports whether a and b have equal values for every key.
+// A key missing from one side is compared as an absent value, so it equals
+// a null value on the other side.
+func mappingsEqual(a, b map[string]cty.Value) bool {
+	for key, va := range a {
+		if !ValuesEqual(va, b[key]) {
+			return false
+		}
+	}
+	for key, vb := range b {
+		if _, ok := a[key]; !ok && !isNullOrAbsent(vb) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,118 @@
+package hclext
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/zclconf/go-cty/cty"
+)
+
+func TestValuesEqual(t *testing.T) {
+	tests := []struct {
+		name     string
+		old, new cty.Value
+		want     bool
+	}{
+		{"integer and decimal", cty.NumberIntVal(1), cty.MustParseNumberVal("1.0"), true},
+		{"float64 precision", cty.NumberFloatVal(0.1), cty.MustParseNumberVal("0.1"), true},
+		{"different numbers", cty.NumberIntVal(1), cty.NumberFloatVal(1.5), false},
+		{"number and string", cty.NumberIntVal(1), cty.StringVal("1"), false},
+		{"same string", cty.StringVal("westeurope"), cty.StringVal("westeurope"), true},
+		{"different strings", cty.StringVal("westeurope"), cty.StringVal("northeurope"), false},
+		{"null and absent", cty.NullVal(cty.String), cty.NilVal, true},
+		{"nulls of different types", cty.NullVal(cty.String), cty.NullVal(cty.DynamicPseudoType), true},
+		{"null and value", cty.NullVal(cty.String), cty.StringVal(""), false},
+		{"absent and value", cty.NilVal, cty.False, false},
+		{"same unknowns", cty.UnknownVal(cty.String), cty.UnknownVal(cty.String), true},
+		{"unknown and value", cty.UnknownVal(cty.String), cty.StringVal("a"), false},
+		{
+			"list and tuple",
+			cty.ListVal([]cty.Value{cty.StringVal("a"), cty.StringVal("b")}),
+			cty.TupleVal([]cty.Value{cty.StringVal("a"), cty.StringVal("b")}),
+			true,
+		},
+		{
+			"reordered list",
+			cty.ListVal([]cty.Value{cty.StringVal("a"), cty.StringVal("b")}),
+			cty.ListVal([]cty.Value{cty.StringVal("b"), cty.StringVal("a")}),
+			false,
+		},
+		{
+			"sets",
+			cty.SetVal([]cty.Value{cty.NumberIntVal(1), cty.NumberIntVal(2)}),
+			cty.SetVal([]cty.Value{cty.MustParseNumberVal("2.0"), cty.NumberIntVal(1)}),
+			true,
+		},
+		{
+			"map and object",
+			cty.MapVal(map[string]cty.Value{"env": cty.StringVal("prod")}),
+			cty.ObjectVal(map[string]cty.Value{"env": cty.StringVal("prod")}),
+			true,
+		},
+		{
+			"null attribute and absent attribute",
+			cty.ObjectVal(map[string]cty.Value{"env": cty.StringVal("prod"), "team": cty.NullVal(cty.String)}),
+			cty.ObjectVal(map[string]cty.Value{"env": cty.StringVal("prod")}),
+			true,
+		},
+		{
+			"attribute added",
+			cty.ObjectVal(map[string]cty.Value{"env": cty.StringVal("prod")}),
+			cty.ObjectVal(map[string]cty.Value{"env": cty.StringVal("prod"), "team": cty.StringVal("platform")}),
+			false,
+		},
+		{
+			"nested collections",
+			cty.ObjectVal(map[string]cty.Value{
+				"rules": cty.TupleVal([]cty.Value{
+					cty.ObjectVal(map[string]cty.Value{"port": cty.NumberIntVal(443), "cidrs": cty.TupleVal([]cty.Value{cty.StringVal("10.0.0.0/8")})}),
+				}),
+			}),
+			cty.ObjectVal(map[string]cty.Value{
+				"rules": cty.ListVal([]cty.Value{
+					cty.ObjectVal(map[string]cty.Value{"port": cty.MustParseNumberVal("443.0"), "cidrs": cty.ListVal([]cty.Value{cty.StringVal("10.0.0.0/8")})}),
+				}),
+			}),
+			true,
+		},
+		{
+			"nested element changed",
+			cty.ObjectVal(map[string]cty.Value{"ports": cty.TupleVal([]cty.Value{cty.NumberIntVal(80), cty.NumberIntVal(443)})}),
+			cty.ObjectVal(map[string]cty.Value{"ports": cty.TupleVal([]cty.Value{cty.NumberIntVal(80), cty.NumberIntVal(8443)})}),
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ValuesEqual(tt.old, tt.new); got != tt.want {
+				t.Errorf("ValuesEqual(%#v, %#v) = %v, want %v", tt.old, tt.new, got, tt.want)
+			}
+			if got := ValuesEqual(tt.new, tt.old); got != tt.want {
+				t.Errorf("ValuesEqual(%#v, %#v) = %v, want %v", tt.new, tt.old, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValuesEqual_ParsedNumbers(t *testing.T) {
+	value := func(src string) cty.Value {
+		t.Helper()
+		expr, diags := hclsyntax.ParseExpression([]byte(src), "", hcl.InitialPos)
+		if diags.HasErrors() {
+			t.Fatalf("parse %q: %s", src, diags)
+		}
+		val, diags := expr.Value(nil)
+		if diags.HasErrors() {
+			t.Fatalf("evaluate %q: %s", src, diags)
+		}
+		return val
+	}
+
+	if !ValuesEqual(value(`{ size = 1 }`), value(`{ size = 1.0 }`)) {
+		t.Error("expected 1 and 1.0 to be equal")
+	}
+	if ValuesEqual(value(`{ size = 1 }`), value(`{ size = 1.01 }`)) {
+		t.Error("expected 1 and 1.01 to differ")
+	}
+}
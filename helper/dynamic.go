@@ -0,0 +1,144 @@
+package helper
+
+import (
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/ext/dynblock"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/zclconf/go-cty/cty"
+	"github.com/zclconf/go-cty/cty/function"
+	"github.com/zclconf/go-cty/cty/function/stdlib"
+)
+
+// skippedDynamicBlockSummary marks diagnostics for dynamic blocks whose
+// for_each is unknown. Such blocks are skipped rather than reported.
+const skippedDynamicBlockSummary = "Unknown dynamic for_each value"
+
+// dynamicFunctions are the functions available to for_each expressions.
+// They cover the collection functions commonly used to build for_each values.
+var dynamicFunctions = map[string]function.Function{
+	"compact":    stdlib.CompactFunc,
+	"concat":     stdlib.ConcatFunc,
+	"distinct":   stdlib.DistinctFunc,
+	"element":    stdlib.ElementFunc,
+	"flatten":    stdlib.FlattenFunc,
+	"jsondecode": stdlib.JSONDecodeFunc,
+	"keys":       stdlib.KeysFunc,
+	"length":     stdlib.LengthFunc,
+	"lookup":     stdlib.LookupFunc,
+	"merge":      stdlib.MergeFunc,
+	"range":      stdlib.RangeFunc,
+	"setproduct": stdlib.SetProductFunc,
+	"split":      stdlib.SplitFunc,
+	"tolist":     stdlib.MakeToFunc(cty.List(cty.DynamicPseudoType)),
+	"tomap":      stdlib.MakeToFunc(cty.Map(cty.DynamicPseudoType)),
+	"toset":      stdlib.MakeToFunc(cty.Set(cty.DynamicPseudoType)),
+	"values":     stdlib.ValuesFunc,
+	"zipmap":     stdlib.ZipmapFunc,
+}
+
+// expandDynamicBlocks returns body with its "dynamic" blocks expanded using ctx.
+// Dynamic blocks whose for_each cannot be determined are skipped; call
+// withoutSkippedDynamicBlocks on the content diagnostics to drop them.
+func expandDynamicBlocks(body hcl.Body, ctx *hcl.EvalContext) hcl.Body {
+	return dynblock.Expand(body, ctx, dynblock.OptCheckForEach(skipUnknownForEach))
+}
+
+// skipUnknownForEach rejects unknown for_each values, so the dynamic block
+// is dropped instead of being expanded into a single block of unknown values.
+func skipUnknownForEach(val cty.Value, expr hcl.Expression, _ *hcl.EvalContext) hcl.Diagnostics {
+	if val.IsWhollyKnown() {
+		return nil
+	}
+	return hcl.Diagnostics{{
+		Severity: hcl.DiagError,
+		Summary:  skippedDynamicBlockSummary,
+		Subject:  expr.Range().Ptr(),
+	}}
+}
+
+// withoutSkippedDynamicBlocks removes the diagnostics of skipped dynamic blocks.
+func withoutSkippedDynamicBlocks(diags hcl.Diagnostics) hcl.Diagnostics {
+	var ret hcl.Diagnostics
+	for _, diag := range diags {
+		if diag.Summary != skippedDynamicBlockSummary {
+			ret = append(ret, diag)
+		}
+	}
+	return ret
+}
+
+// dynamicEvalContext builds the context used to evaluate for_each in files.
+// Input variables resolve to their defaults, and every other referenced
+// name (locals, resources, data sources, ...) is unknown, so for_each
+// expressions that depend on them are skipped rather than failing.
+// Common collection functions are available, and variables and functions
+// from the Runner's EvalContext take precedence.
+func (r *Runner) dynamicEvalContext(files map[string]*hcl.File) *hcl.EvalContext {
+	variables := make(map[string]cty.Value)
+	for _, file := range files {
+		for _, root := range referencedRoots(file.Body) {
+			variables[root] = cty.DynamicVal
+		}
+	}
+	variables["var"] = variableDefaults(files)
+
+	functions := make(map[string]function.Function, len(dynamicFunctions))
+	for name, fn := range dynamicFunctions {
+		functions[name] = fn
+	}
+
+	if r.EvalContext != nil {
+		for name, val := range r.EvalContext.Variables {
+			variables[name] = val
+		}
+		for name, fn := range r.EvalContext.Functions {
+			functions[name] = fn
+		}
+	}
+	return &hcl.EvalContext{Variables: variables, Functions: functions}
+}
+
+// referencedRoots returns the root names of all traversals in body.
+func referencedRoots(body hcl.Body) []string {
+	syntaxBody, ok := body.(*hclsyntax.Body)
+	if !ok {
+		return nil
+	}
+
+	var roots []string
+	_ = hclsyntax.VisitAll(syntaxBody, func(node hclsyntax.Node) hcl.Diagnostics {
+		if expr, ok := node.(*hclsyntax.ScopeTraversalExpr); ok {
+			roots = append(roots, expr.Traversal.RootName())
+		}
+		return nil
+	})
+	return roots
+}
+
+// variableDefaults returns an object of input variable defaults, with
+// variables that have no usable default set to unknown.
+func variableDefaults(files map[string]*hcl.File) cty.Value {
+	schema := &hcl.BodySchema{
+		Blocks: []hcl.BlockHeaderSchema{{Type: "variable", LabelNames: []string{"name"}}},
+	}
+	defaultSchema := &hcl.BodySchema{
+		Attributes: []hcl.AttributeSchema{{Name: "default"}},
+	}
+
+	defaults := make(map[string]cty.Value)
+	for _, file := range files {
+		content, _, _ := file.Body.PartialContent(schema)
+		for _, block := range content.Blocks {
+			name := block.Labels[0]
+			defaults[name] = cty.DynamicVal
+
+			body, _, _ := block.Body.PartialContent(defaultSchema)
+			if attr, ok := body.Attributes["default"]; ok {
+				if val, diags := attr.Expr.Value(nil); !diags.HasErrors() {
+					defaults[name] = val
+				}
+			}
+		}
+	}
+	return cty.ObjectVal(defaults)
+}
@@ -0,0 +1,176 @@
+package helper
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/hcl/v2"
+
+	"github.com/jokarl/tfbreak-plugin-sdk/tflint"
+)
+
+// UpdateGoldenEnv is the environment variable that makes AssertIssuesGolden
+// rewrite golden files instead of comparing against them when set to a true
+// value such as "1" or "true".
+const UpdateGoldenEnv = "TFBREAK_UPDATE_GOLDEN"
+
+// updateGolden reports whether UpdateGoldenEnv is set to a true value.
+func updateGolden() bool {
+	update, err := strconv.ParseBool(os.Getenv(UpdateGoldenEnv))
+	return err == nil && update
+}
+
+// goldenIssue is the JSON form of an Issue written by MarshalIssues.
+type goldenIssue struct {
+	Rule     goldenRule   `json:"rule"`
+	Message  string       `json:"message"`
+	Range    goldenRange  `json:"range"`
+	Severity string       `json:"severity"`
+	Kind     string       `json:"kind,omitempty"`
+	Address  string       `json:"resource_address,omitempty"`
+	Side     string       `json:"side,omitempty"`
+	Fix      []goldenEdit `json:"fix,omitempty"`
+}
+
+// goldenRule identifies the rule of a goldenIssue.
+type goldenRule struct {
+	Name     string `json:"name"`
+	Severity string `json:"severity"`
+}
+
+// goldenRange is a source range without byte offsets, which change with
+// unrelated edits such as whitespace.
+type goldenRange struct {
+	Filename string    `json:"filename"`
+	Start    goldenPos `json:"start"`
+	End      goldenPos `json:"end"`
+}
+
+// goldenPos is a line and column position.
+type goldenPos struct {
+	Line   int `json:"line"`
+	Column int `json:"column"`
+}
+
+// goldenEdit is a text edit of a fix.
+type goldenEdit struct {
+	Range   goldenRange `json:"range"`
+	NewText string      `json:"new_text"`
+}
+
+// MarshalIssues returns issues as indented JSON suitable for golden files.
+// The output is deterministic: issues are sorted by file, position, rule,
+// and message, rules are represented by name and severity, and ranges keep
+// lines and columns but not byte offsets.
+func MarshalIssues(issues Issues) ([]byte, error) {
+	result := make([]goldenIssue, len(issues))
+	for i, issue := range issues {
+		gi := goldenIssue{
+			Message:  issue.Message,
+			Range:    toGoldenRange(issue.Range),
+			Severity: issue.Severity.String(),
+			Address:  issue.ResourceAddress,
+		}
+		if issue.Kind != tflint.KindUnspecified {
+			gi.Kind = issue.Kind.String()
+		}
+		if issue.Side != tflint.SideUnspecified {
+			gi.Side = issue.Side.String()
+		}
+		if issue.Rule != nil {
+			gi.Rule = goldenRule{Name: issue.Rule.Name(), Severity: issue.Rule.Severity().String()}
+		}
+		if issue.Fix != nil {
+			for _, edit := range issue.Fix.Edits {
+				gi.Fix = append(gi.Fix, goldenEdit{Range: toGoldenRange(edit.Range), NewText: edit.NewText})
+			}
+		}
+		result[i] = gi
+	}
+
+	sort.SliceStable(result, func(i, j int) bool {
+		a, b := result[i], result[j]
+		switch {
+		case a.Range.Filename != b.Range.Filename:
+			return a.Range.Filename < b.Range.Filename
+		case a.Range.Start.Line != b.Range.Start.Line:
+			return a.Range.Start.Line < b.Range.Start.Line
+		case a.Range.Start.Column != b.Range.Start.Column:
+			return a.Range.Start.Column < b.Range.Start.Column
+		case a.Rule.Name != b.Rule.Name:
+			return a.Rule.Name < b.Rule.Name
+		default:
+			return a.Message < b.Message
+		}
+	})
+
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetIndent("", "  ")
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(result); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// toGoldenRange converts r, dropping byte offsets.
+func toGoldenRange(r hcl.Range) goldenRange {
+	return goldenRange{
+		Filename: r.Filename,
+		Start:    goldenPos{Line: r.Start.Line, Column: r.Start.Column},
+		End:      goldenPos{Line: r.End.Line, Column: r.End.Column},
+	}
+}
+
+// AssertIssuesGolden compares issues, marshaled with MarshalIssues, against
+// the golden file at goldenPath. Run the tests with UpdateGoldenEnv set to
+// write the current issues to the golden file instead, creating it if needed:
+//
+//	TFBREAK_UPDATE_GOLDEN=1 go test ./rules/...
+//
+// Example:
+//
+//	helper.AssertIssuesGolden(t, "testdata/location_changed.golden.json", runner.Issues)
+func AssertIssuesGolden(t *testing.T, goldenPath string, got Issues) {
+	t.Helper()
+
+	data, err := MarshalIssues(got)
+	if err != nil {
+		t.Fatalf("failed to marshal issues: %v", err)
+	}
+
+	if updateGolden() {
+		if err := os.MkdirAll(filepath.Dir(goldenPath), 0o755); err != nil {
+			t.Fatalf("failed to create golden file directory: %v", err)
+		}
+		if err := os.WriteFile(goldenPath, data, 0o644); err != nil {
+			t.Fatalf("failed to update golden file: %v", err)
+		}
+		return
+	}
+
+	diff, err := goldenDiff(goldenPath, data)
+	if err != nil {
+		t.Fatalf("failed to read golden file (run with TFBREAK_UPDATE_GOLDEN=1 to create it): %v", err)
+	}
+	if diff != "" {
+		t.Errorf("issues do not match golden file %s (-want +got):\n%s", goldenPath, diff)
+	}
+}
+
+// goldenDiff returns the difference between the golden file and got,
+// or an empty string if they match.
+func goldenDiff(goldenPath string, got []byte) (string, error) {
+	want, err := os.ReadFile(goldenPath)
+	if err != nil {
+		return "", err
+	}
+	return cmp.Diff(string(want), string(got)), nil
+}
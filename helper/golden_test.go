@@ -0,0 +1,127 @@
+package helper
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/jokarl/tfbreak-plugin-sdk/tflint"
+)
+
+// goldenTestIssues returns issues from two rules in no particular order.
+func goldenTestIssues() Issues {
+	location := &testRuleForIssue{name: "location_changed"}
+	sku := &testRuleForIssue{name: "sku_changed"}
+	return Issues{
+		{
+			Rule:            sku,
+			Message:         "sku changed",
+			Range:           hcl.Range{Filename: "main.tf", Start: hcl.Pos{Line: 8, Column: 3, Byte: 120}, End: hcl.Pos{Line: 8, Column: 20, Byte: 137}},
+			Severity:        tflint.WARNING,
+			Kind:            tflint.KindBreaking,
+			ResourceAddress: "azurerm_storage_account.main",
+		},
+		{
+			Rule:     location,
+			Message:  "location changed",
+			Range:    hcl.Range{Filename: "main.tf", Start: hcl.Pos{Line: 3, Column: 3, Byte: 40}, End: hcl.Pos{Line: 3, Column: 25, Byte: 62}},
+			Severity: tflint.ERROR,
+			Fix: &tflint.Fix{Edits: []tflint.TextEdit{{
+				Range:   hcl.Range{Filename: "main.tf", Start: hcl.Pos{Line: 3, Column: 14, Byte: 51}, End: hcl.Pos{Line: 3, Column: 25, Byte: 62}},
+				NewText: `"westeurope"`,
+			}}},
+		},
+	}
+}
+
+func TestMarshalIssues_Deterministic(t *testing.T) {
+	issues := goldenTestIssues()
+	first, err := MarshalIssues(issues)
+	if err != nil {
+		t.Fatalf("MarshalIssues error: %v", err)
+	}
+
+	reversed := Issues{issues[1], issues[0]}
+	second, err := MarshalIssues(reversed)
+	if err != nil {
+		t.Fatalf("MarshalIssues error: %v", err)
+	}
+
+	if string(first) != string(second) {
+		t.Errorf("MarshalIssues output differs between runs:\n%s\n---\n%s", first, second)
+	}
+	if strings.Contains(string(first), "byte") {
+		t.Errorf("output should not contain byte offsets:\n%s", first)
+	}
+	if strings.Index(string(first), "location changed") > strings.Index(string(first), "sku changed") {
+		t.Errorf("issues should be sorted by position:\n%s", first)
+	}
+}
+
+func TestMarshalIssues_Side(t *testing.T) {
+	rule := &testRuleForIssue{name: "resource_removed"}
+	got, err := MarshalIssues(Issues{
+		{Rule: rule, Message: "removed", Range: hcl.Range{Filename: "main.tf"}, Side: tflint.SideOld},
+		{Rule: rule, Message: "renamed", Range: hcl.Range{Filename: "main.tf"}},
+	})
+	if err != nil {
+		t.Fatalf("MarshalIssues error: %v", err)
+	}
+	if n := strings.Count(string(got), `"side": "old"`); n != 1 {
+		t.Errorf("output has %d old sides, want 1:\n%s", n, got)
+	}
+	if n := strings.Count(string(got), `"side"`); n != 1 {
+		t.Errorf("output has %d sides, want only the one set:\n%s", n, got)
+	}
+}
+
+func TestMarshalIssues_Empty(t *testing.T) {
+	got, err := MarshalIssues(nil)
+	if err != nil {
+		t.Fatalf("MarshalIssues error: %v", err)
+	}
+	if string(got) != "[]\n" {
+		t.Errorf("MarshalIssues(nil) = %q, want %q", got, "[]\n")
+	}
+}
+
+func TestAssertIssuesGolden(t *testing.T) {
+	AssertIssuesGolden(t, "testdata/golden/issues.golden.json", goldenTestIssues())
+}
+
+func TestAssertIssuesGolden_Mismatch(t *testing.T) {
+	data, err := MarshalIssues(goldenTestIssues()[:1])
+	if err != nil {
+		t.Fatalf("MarshalIssues error: %v", err)
+	}
+
+	diff, err := goldenDiff("testdata/golden/issues.golden.json", data)
+	if err != nil {
+		t.Fatalf("goldenDiff error: %v", err)
+	}
+	if !strings.Contains(diff, "location changed") {
+		t.Errorf("diff should show the missing issue, got:\n%s", diff)
+	}
+
+	if _, err := goldenDiff("testdata/golden/missing.golden.json", data); err == nil {
+		t.Error("expected an error for a missing golden file")
+	}
+}
+
+func TestAssertIssuesGolden_Update(t *testing.T) {
+	t.Setenv(UpdateGoldenEnv, "1")
+
+	path := filepath.Join(t.TempDir(), "nested", "issues.golden.json")
+	AssertIssuesGolden(t, path, goldenTestIssues())
+
+	got, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("golden file was not written: %v", err)
+	}
+	want, _ := MarshalIssues(goldenTestIssues())
+	if string(got) != string(want) {
+		t.Errorf("golden file = %s, want %s", got, want)
+	}
+}
@@ -1,6 +1,9 @@
 package helper
 
 import (
+	"fmt"
+	"slices"
+	"strings"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -17,13 +20,33 @@ type Issue struct {
 	Message string
 	// Range is the source location of the issue.
 	Range hcl.Range
+	// Fix is the suggested fix, or nil if none was provided.
+	Fix *tflint.Fix
+	// Severity is the rule's severity at the time the issue was emitted,
+	// or the per-issue severity passed to EmitIssueWithSeverity or
+	// EmitIssueWithOptions.
+	// Only compared by AssertIssuesWithSeverity.
+	Severity tflint.Severity
+	// Kind is the kind passed to EmitIssueWithOptions, or
+	// tflint.KindUnspecified. Only compared when an expected issue sets it.
+	Kind tflint.IssueKind
+	// ResourceAddress is the address passed to EmitIssueWithOptions, or
+	// empty. Only compared when an expected issue sets it.
+	ResourceAddress string
+	// Side is the side passed to EmitIssueWithOptions, or
+	// tflint.SideUnspecified, in which case Range refers to the NEW
+	// configuration. Only compared when an expected issue sets it.
+	Side tflint.Side
 }
 
 // Issues is a slice of Issue for convenience.
 type Issues []Issue
 
 // AssertIssues compares expected and actual issues.
-// It ignores issue order and byte positions in ranges.
+// It ignores issue order and byte positions in ranges. Kinds are compared
+// only if at least one expected issue sets Kind; an expected issue that
+// leaves it unset then expects tflint.KindUnspecified. Resource addresses
+// and sides are compared the same way.
 //
 // Example:
 //
@@ -33,6 +56,53 @@ type Issues []Issue
 func AssertIssues(t *testing.T, want, got Issues) {
 	t.Helper()
 
+	opts := append(issuesCmpOptions(want),
+		// Severity is only compared by AssertIssuesWithSeverity
+		cmpopts.IgnoreFields(Issue{}, "Severity"),
+	)
+
+	if diff := cmp.Diff(want, got, opts...); diff != "" {
+		t.Errorf("issues mismatch (-want +got):\n%s", diff)
+	}
+}
+
+// AssertIssuesWithSeverity compares expected and actual issues including
+// the severity each issue was emitted with.
+// Like AssertIssues, it ignores issue order and byte positions in ranges,
+// and only compares kinds, resource addresses and sides if an expected
+// issue sets them.
+// If an expected issue has no Severity, the severity of its Rule is expected.
+//
+// Example:
+//
+//	helper.AssertIssuesWithSeverity(t, helper.Issues{
+//	    {Rule: rule, Message: "location changed", Severity: tflint.ERROR},
+//	}, runner.Issues)
+func AssertIssuesWithSeverity(t *testing.T, want, got Issues) {
+	t.Helper()
+
+	if diff := issuesWithSeverityDiff(want, got); diff != "" {
+		t.Errorf("issues mismatch (-want +got):\n%s", diff)
+	}
+}
+
+// issuesWithSeverityDiff returns the difference between want and got
+// including severities, or an empty string if they match.
+func issuesWithSeverityDiff(want, got Issues) string {
+	expected := make(Issues, len(want))
+	for i, issue := range want {
+		if issue.Severity == 0 && issue.Rule != nil {
+			issue.Severity = issue.Rule.Severity()
+		}
+		expected[i] = issue
+	}
+	return cmp.Diff(expected, got, issuesCmpOptions(want)...)
+}
+
+// issuesCmpOptions returns the comparison options shared by AssertIssues
+// and AssertIssuesWithSeverity. Kind, ResourceAddress and Side are each
+// ignored unless an issue of want sets them.
+func issuesCmpOptions(want Issues) []cmp.Option {
 	opts := []cmp.Option{
 		// Ignore byte positions (only compare line/column)
 		cmpopts.IgnoreFields(hcl.Pos{}, "Byte"),
@@ -57,10 +127,16 @@ func AssertIssues(t *testing.T, want, got Issues) {
 			return a.Name() == b.Name()
 		}),
 	}
-
-	if diff := cmp.Diff(want, got, opts...); diff != "" {
-		t.Errorf("issues mismatch (-want +got):\n%s", diff)
+	if !slices.ContainsFunc(want, func(issue Issue) bool { return issue.Kind != tflint.KindUnspecified }) {
+		opts = append(opts, cmpopts.IgnoreFields(Issue{}, "Kind"))
+	}
+	if !slices.ContainsFunc(want, func(issue Issue) bool { return issue.ResourceAddress != "" }) {
+		opts = append(opts, cmpopts.IgnoreFields(Issue{}, "ResourceAddress"))
 	}
+	if !slices.ContainsFunc(want, func(issue Issue) bool { return issue.Side != tflint.SideUnspecified }) {
+		opts = append(opts, cmpopts.IgnoreFields(Issue{}, "Side"))
+	}
+	return opts
 }
 
 // AssertIssuesWithoutRange compares issues ignoring the Range field entirely.
@@ -76,7 +152,7 @@ func AssertIssuesWithoutRange(t *testing.T, want, got Issues) {
 
 	opts := []cmp.Option{
 		// Ignore Range field entirely
-		cmpopts.IgnoreFields(Issue{}, "Range"),
+		cmpopts.IgnoreFields(Issue{}, "Range", "Severity", "Kind", "ResourceAddress", "Side"),
 		// Ignore issue order
 		cmpopts.SortSlices(func(a, b Issue) bool {
 			return a.Message < b.Message
@@ -104,7 +180,84 @@ func AssertNoIssues(t *testing.T, got Issues) {
 	if len(got) > 0 {
 		t.Errorf("expected no issues, got %d:", len(got))
 		for i, issue := range got {
-			t.Errorf("  [%d] %s: %s", i, issue.Rule.Name(), issue.Message)
+			t.Errorf("  [%d] %s: %s", i, issueRuleName(issue), issue.Message)
+		}
+	}
+}
+
+// issueRuleName returns the name of the issue's rule, or "<nil rule>" if
+// it was emitted without one.
+func issueRuleName(issue Issue) string {
+	if issue.Rule == nil {
+		return "<nil rule>"
+	}
+	return issue.Rule.Name()
+}
+
+// AssertIssueCount verifies that exactly n issues were emitted.
+// Combine it with AssertIssueMessagesContain when messages embed runtime
+// data, such as old and new locations, that makes exact matching brittle.
+//
+// Example:
+//
+//	helper.AssertIssueCount(t, runner.Issues, 2)
+func AssertIssueCount(t *testing.T, got Issues, n int) {
+	t.Helper()
+	if msg := issueCountMismatch(got, n); msg != "" {
+		t.Error(msg)
+	}
+}
+
+// AssertIssueMessagesContain verifies that each substring appears in the
+// message of at least one issue. Issues whose messages match none of the
+// substrings are allowed; use AssertIssueCount to rule them out.
+//
+// Example:
+//
+//	helper.AssertIssueMessagesContain(t, runner.Issues, []string{
+//	    "location changed",
+//	    "sku changed",
+//	})
+func AssertIssueMessagesContain(t *testing.T, got Issues, substrings []string) {
+	t.Helper()
+	if missing := missingMessageSubstrings(got, substrings); len(missing) > 0 {
+		t.Errorf("no issue message contains %q, got %d issues:%s", missing, len(got), issueMessageList(got))
+	}
+}
+
+// issueCountMismatch describes how got differs from n issues, or returns
+// an empty string if there are exactly n.
+func issueCountMismatch(got Issues, n int) string {
+	if len(got) == n {
+		return ""
+	}
+	return fmt.Sprintf("expected %d issues, got %d:%s", n, len(got), issueMessageList(got))
+}
+
+// issueMessageList formats the issue messages one per line for failure output.
+func issueMessageList(got Issues) string {
+	var b strings.Builder
+	for i, issue := range got {
+		fmt.Fprintf(&b, "\n  [%d] %s", i, issue.Message)
+	}
+	return b.String()
+}
+
+// missingMessageSubstrings returns the substrings that appear in no issue
+// message, in the given order.
+func missingMessageSubstrings(got Issues, substrings []string) []string {
+	var missing []string
+	for _, sub := range substrings {
+		found := false
+		for _, issue := range got {
+			if strings.Contains(issue.Message, sub) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			missing = append(missing, sub)
 		}
 	}
+	return missing
 }
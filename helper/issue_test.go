@@ -1,8 +1,11 @@
 package helper
 
 import (
+	"context"
+	"strings"
 	"testing"
 
+	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/hcl/v2"
 	"github.com/jokarl/tfbreak-plugin-sdk/tflint"
 )
@@ -15,7 +18,7 @@ type testRuleForIssue struct {
 
 func (r *testRuleForIssue) Name() string        { return r.name }
 func (r *testRuleForIssue) Link() string        { return "" }
-func (r *testRuleForIssue) Check(_ tflint.Runner) error { return nil }
+func (r *testRuleForIssue) Check(_ context.Context, _ tflint.Runner) error { return nil }
 
 func TestAssertIssues_Match(t *testing.T) {
 	rule := &testRuleForIssue{name: "test_rule"}
@@ -32,6 +35,32 @@ func TestAssertIssues_Match(t *testing.T) {
 	AssertIssues(t, want, got)
 }
 
+func TestAssertIssues_WithFix(t *testing.T) {
+	rule := &testRuleForIssue{name: "test_rule"}
+	fixRange := hcl.Range{
+		Filename: "main.tf",
+		Start:    hcl.Pos{Line: 3, Column: 3, Byte: 40},
+		End:      hcl.Pos{Line: 3, Column: 11, Byte: 48},
+	}
+
+	want := Issues{{
+		Rule:    rule,
+		Message: "argument renamed",
+		Fix: &tflint.Fix{
+			Edits: []tflint.TextEdit{{Range: fixRange, NewText: "location"}},
+		},
+	}}
+	got := Issues{{
+		Rule:    rule,
+		Message: "argument renamed",
+		Fix: &tflint.Fix{
+			Edits: []tflint.TextEdit{{Range: fixRange, NewText: "location"}},
+		},
+	}}
+
+	AssertIssues(t, want, got)
+}
+
 func TestAssertIssues_IgnoresOrder(t *testing.T) {
 	rule1 := &testRuleForIssue{name: "rule1"}
 	rule2 := &testRuleForIssue{name: "rule2"}
@@ -141,3 +170,181 @@ func TestAssertIssues_NilRules(t *testing.T) {
 // Note: Testing assertion failures would require interfaces instead of *testing.T.
 // For now, we only test successful comparisons. The assertion functions are
 // simple wrappers around go-cmp, so extensive failure testing is not critical.
+
+func TestAssertIssuesWithSeverity_Match(t *testing.T) {
+	rule := &testRuleForIssue{name: "test_rule"}
+	runner := TestRunner(t, map[string]string{}, map[string]string{})
+
+	_ = runner.EmitIssue(rule, "second", hcl.Range{Filename: "main.tf", Start: hcl.Pos{Line: 2, Byte: 10}})
+	_ = runner.EmitIssue(rule, "first", hcl.Range{Filename: "main.tf", Start: hcl.Pos{Line: 1, Byte: 0}})
+
+	if runner.Issues[0].Severity != tflint.ERROR {
+		t.Errorf("recorded severity = %v, want ERROR", runner.Issues[0].Severity)
+	}
+
+	// Order and byte positions are ignored; Severity defaults to the rule's
+	AssertIssuesWithSeverity(t, Issues{
+		{Rule: rule, Message: "first", Range: hcl.Range{Filename: "main.tf", Start: hcl.Pos{Line: 1}}},
+		{Rule: rule, Message: "second", Range: hcl.Range{Filename: "main.tf", Start: hcl.Pos{Line: 2}}, Severity: tflint.ERROR},
+	}, runner.Issues)
+}
+
+func TestAssertIssuesWithSeverity_Mismatch(t *testing.T) {
+	rule := &testRuleForIssue{name: "test_rule"}
+	got := Issues{{Rule: rule, Message: "test message", Severity: tflint.WARNING}}
+
+	if diff := issuesWithSeverityDiff(Issues{{Rule: rule, Message: "test message", Severity: tflint.ERROR}}, got); diff == "" {
+		t.Error("expected severity mismatch to be detected")
+	}
+	// Rule severity (ERROR) is expected when Severity is unset
+	if diff := issuesWithSeverityDiff(Issues{{Rule: rule, Message: "test message"}}, got); diff == "" {
+		t.Error("expected mismatch against the rule's severity to be detected")
+	}
+}
+
+func TestAssertIssues_IgnoresSeverity(t *testing.T) {
+	rule := &testRuleForIssue{name: "test_rule"}
+
+	AssertIssues(t,
+		Issues{{Rule: rule, Message: "test message"}},
+		Issues{{Rule: rule, Message: "test message", Severity: tflint.WARNING}},
+	)
+	AssertIssuesWithoutRange(t,
+		Issues{{Rule: rule, Message: "test message"}},
+		Issues{{Rule: rule, Message: "test message", Severity: tflint.WARNING}},
+	)
+}
+
+func TestAssertIssues_Kind(t *testing.T) {
+	rule := &testRuleForIssue{name: "test_rule"}
+	runner := TestRunner(t, map[string]string{}, map[string]string{})
+
+	_ = runner.EmitIssueWithOptions(rule, "variable removed", hcl.Range{}, tflint.IssueOptions{Kind: tflint.KindBreaking})
+	_ = runner.EmitIssue(rule, "description changed", hcl.Range{})
+
+	// Kinds are ignored when no expected issue sets one
+	AssertIssues(t, Issues{
+		{Rule: rule, Message: "variable removed"},
+		{Rule: rule, Message: "description changed"},
+	}, runner.Issues)
+	AssertIssues(t, Issues{
+		{Rule: rule, Message: "variable removed", Kind: tflint.KindBreaking},
+		{Rule: rule, Message: "description changed"},
+	}, runner.Issues)
+
+	mismatches := []Issues{
+		{
+			{Rule: rule, Message: "variable removed", Kind: tflint.KindInfo},
+			{Rule: rule, Message: "description changed"},
+		},
+		{
+			{Rule: rule, Message: "variable removed", Kind: tflint.KindBreaking},
+			{Rule: rule, Message: "description changed", Kind: tflint.KindBreaking},
+		},
+	}
+	for _, want := range mismatches {
+		if diff := cmp.Diff(want, runner.Issues, issuesCmpOptions(want)...); diff == "" {
+			t.Errorf("expected kind mismatch to be detected for %v", want)
+		}
+	}
+}
+
+func TestIssueRuleName_NilRule(t *testing.T) {
+	if got := issueRuleName(Issue{Message: "no rule"}); got != "<nil rule>" {
+		t.Errorf("issueRuleName = %q, want %q", got, "<nil rule>")
+	}
+	if got := issueRuleName(Issue{Rule: &testRuleForIssue{name: "test_rule"}}); got != "test_rule" {
+		t.Errorf("issueRuleName = %q, want %q", got, "test_rule")
+	}
+}
+
+func TestAssertIssues_ResourceAddress(t *testing.T) {
+	rule := &testRuleForIssue{name: "test_rule"}
+	runner := TestRunner(t, map[string]string{}, map[string]string{})
+
+	_ = runner.EmitIssueWithOptions(rule, "sku changed", hcl.Range{}, tflint.IssueOptions{ResourceAddress: "azurerm_storage_account.main"})
+	_ = runner.EmitIssue(rule, "description changed", hcl.Range{})
+
+	// Addresses are ignored when no expected issue sets one
+	AssertIssues(t, Issues{
+		{Rule: rule, Message: "sku changed"},
+		{Rule: rule, Message: "description changed"},
+	}, runner.Issues)
+	AssertIssues(t, Issues{
+		{Rule: rule, Message: "sku changed", ResourceAddress: "azurerm_storage_account.main"},
+		{Rule: rule, Message: "description changed"},
+	}, runner.Issues)
+
+	want := Issues{
+		{Rule: rule, Message: "sku changed", ResourceAddress: "azurerm_storage_account.other"},
+		{Rule: rule, Message: "description changed"},
+	}
+	if diff := cmp.Diff(want, runner.Issues, issuesCmpOptions(want)...); diff == "" {
+		t.Error("expected address mismatch to be detected")
+	}
+}
+
+func TestAssertIssues_Side(t *testing.T) {
+	rule := &testRuleForIssue{name: "test_rule"}
+	runner := TestRunner(t, map[string]string{}, map[string]string{})
+
+	_ = runner.EmitIssueWithOptions(rule, "resource removed", hcl.Range{}, tflint.IssueOptions{Side: tflint.SideOld})
+	_ = runner.EmitIssue(rule, "description changed", hcl.Range{})
+
+	// Sides are ignored when no expected issue sets one
+	AssertIssues(t, Issues{
+		{Rule: rule, Message: "resource removed"},
+		{Rule: rule, Message: "description changed"},
+	}, runner.Issues)
+	AssertIssues(t, Issues{
+		{Rule: rule, Message: "resource removed", Side: tflint.SideOld},
+		{Rule: rule, Message: "description changed"},
+	}, runner.Issues)
+
+	want := Issues{
+		{Rule: rule, Message: "resource removed", Side: tflint.SideOld},
+		{Rule: rule, Message: "description changed", Side: tflint.SideOld},
+	}
+	if diff := cmp.Diff(want, runner.Issues, issuesCmpOptions(want)...); diff == "" {
+		t.Error("expected side mismatch to be detected")
+	}
+}
+
+func TestAssertIssueCount(t *testing.T) {
+	rule := &testRuleForIssue{name: "test_rule"}
+	got := Issues{
+		{Rule: rule, Message: "location changed from westeurope to northeurope"},
+		{Rule: rule, Message: "sku changed from Basic to Standard"},
+	}
+
+	AssertIssueCount(t, got, 2)
+	AssertIssueCount(t, Issues{}, 0)
+
+	msg := issueCountMismatch(got, 1)
+	if msg == "" {
+		t.Fatal("expected count mismatch to be detected")
+	}
+	if !strings.Contains(msg, "expected 1 issues, got 2") || !strings.Contains(msg, "sku changed") {
+		t.Errorf("mismatch message should state the counts and list the issues, got %q", msg)
+	}
+}
+
+func TestAssertIssueMessagesContain(t *testing.T) {
+	rule := &testRuleForIssue{name: "test_rule"}
+	got := Issues{
+		{Rule: rule, Message: "location changed from westeurope to northeurope"},
+		{Rule: rule, Message: "sku changed from Basic to Standard"},
+	}
+
+	// Substrings may match any issue, in any order, and more than once
+	AssertIssueMessagesContain(t, got, []string{"sku changed", "location changed", "changed from"})
+	AssertIssueMessagesContain(t, got, nil)
+
+	missing := missingMessageSubstrings(got, []string{"location changed", "tags removed", "to Premium"})
+	if len(missing) != 2 || missing[0] != "tags removed" || missing[1] != "to Premium" {
+		t.Errorf("missing = %q, want [tags removed to Premium]", missing)
+	}
+	if missing := missingMessageSubstrings(Issues{}, []string{"location changed"}); len(missing) != 1 {
+		t.Errorf("missing = %q, want every substring when there are no issues", missing)
+	}
+}
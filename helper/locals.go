@@ -0,0 +1,151 @@
+package helper
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// GetOldLocals evaluates the locals blocks of the old files.
+func (r *Runner) GetOldLocals() (map[string]cty.Value, error) {
+	if err := r.contextErr(); err != nil {
+		return nil, err
+	}
+	return locals(r.oldFiles)
+}
+
+// GetNewLocals evaluates the locals blocks of the new files.
+func (r *Runner) GetNewLocals() (map[string]cty.Value, error) {
+	if err := r.contextErr(); err != nil {
+		return nil, err
+	}
+	return locals(r.newFiles)
+}
+
+// locals evaluates the local values declared in files. A local resolves
+// when its expression only references other resolvable locals and uses
+// the functions in dynamicFunctions. Locals referencing input variables,
+// resources or any other object, locals that fail to evaluate, and locals
+// that depend on each other in a cycle are unknown.
+func locals(files map[string]*hcl.File) (map[string]cty.Value, error) {
+	schema := &hcl.BodySchema{
+		Blocks: []hcl.BlockHeaderSchema{{Type: "locals"}},
+	}
+
+	pending := make(map[string]*hcl.Attribute)
+	var diags hcl.Diagnostics
+	for _, name := range listFiles(files) {
+		content, _, fileDiags := files[name].Body.PartialContent(schema)
+		diags = append(diags, fileDiags...)
+		if fileDiags.HasErrors() {
+			continue
+		}
+
+		for _, block := range content.Blocks {
+			attrs, attrDiags := block.Body.JustAttributes()
+			diags = append(diags, attrDiags...)
+			for _, attr := range attrs {
+				if prev, exists := pending[attr.Name]; exists {
+					diags = append(diags, &hcl.Diagnostic{
+						Severity: hcl.DiagError,
+						Summary:  "Duplicate local value definition",
+						Detail:   fmt.Sprintf("A local value named %q was already defined at %s.", attr.Name, prev.NameRange),
+						Subject:  attr.NameRange.Ptr(),
+					})
+					continue
+				}
+				pending[attr.Name] = attr
+			}
+		}
+	}
+	if diags.HasErrors() {
+		return nil, diags
+	}
+
+	names := make([]string, 0, len(pending))
+	for name := range pending {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	// Locals are resolved in rounds: each round evaluates the locals whose
+	// dependencies are all resolved. Whatever is left is part of a cycle.
+	values := make(map[string]cty.Value, len(pending))
+	for progress := true; progress; {
+		progress = false
+		for _, name := range names {
+			attr, ok := pending[name]
+			if !ok {
+				continue
+			}
+			deps, ok := localDependencies(attr.Expr)
+			if !ok {
+				values[name] = cty.DynamicVal
+				delete(pending, name)
+				progress = true
+				continue
+			}
+			if waitsFor(deps, pending) {
+				continue
+			}
+
+			ctx := &hcl.EvalContext{
+				Variables: map[string]cty.Value{"local": cty.ObjectVal(localsOf(deps, values))},
+				Functions: dynamicFunctions,
+			}
+			val, valDiags := attr.Expr.Value(ctx)
+			if valDiags.HasErrors() {
+				val = cty.DynamicVal
+			}
+			values[name] = val
+			delete(pending, name)
+			progress = true
+		}
+	}
+	for name := range pending {
+		values[name] = cty.DynamicVal
+	}
+	return values, nil
+}
+
+// localDependencies returns the names of the locals referenced by expr.
+// It reports false if expr references anything other than a named local.
+func localDependencies(expr hcl.Expression) ([]string, bool) {
+	var deps []string
+	for _, traversal := range expr.Variables() {
+		if traversal.RootName() != "local" || len(traversal) < 2 {
+			return nil, false
+		}
+		attr, ok := traversal[1].(hcl.TraverseAttr)
+		if !ok {
+			return nil, false
+		}
+		deps = append(deps, attr.Name)
+	}
+	return deps, true
+}
+
+// waitsFor reports whether any of deps is still pending.
+func waitsFor(deps []string, pending map[string]*hcl.Attribute) bool {
+	for _, dep := range deps {
+		if _, ok := pending[dep]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// localsOf returns the values of deps, with undeclared locals unknown.
+func localsOf(deps []string, values map[string]cty.Value) map[string]cty.Value {
+	result := make(map[string]cty.Value, len(deps))
+	for _, dep := range deps {
+		if val, ok := values[dep]; ok {
+			result[dep] = val
+		} else {
+			result[dep] = cty.DynamicVal
+		}
+	}
+	return result
+}
@@ -0,0 +1,74 @@
+package helper
+
+import (
+	"github.com/hashicorp/hcl/v2"
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/jokarl/tfbreak-plugin-sdk/tflint"
+)
+
+// GetOldModuleCalls parses the module blocks of the old files.
+func (r *Runner) GetOldModuleCalls() ([]tflint.ModuleCall, error) {
+	if err := r.contextErr(); err != nil {
+		return nil, err
+	}
+	return moduleCalls(r.oldFiles)
+}
+
+// GetNewModuleCalls parses the module blocks of the new files.
+func (r *Runner) GetNewModuleCalls() ([]tflint.ModuleCall, error) {
+	if err := r.contextErr(); err != nil {
+		return nil, err
+	}
+	return moduleCalls(r.newFiles)
+}
+
+// moduleCalls collects the module blocks in files, in file name order.
+// The source argument is required; both source and version must be
+// literal strings, as Terraform resolves modules before evaluation.
+func moduleCalls(files map[string]*hcl.File) ([]tflint.ModuleCall, error) {
+	fileSchema := &hcl.BodySchema{
+		Blocks: []hcl.BlockHeaderSchema{{Type: "module", LabelNames: []string{"name"}}},
+	}
+	callSchema := &hcl.BodySchema{
+		Attributes: []hcl.AttributeSchema{{Name: "source", Required: true}, {Name: "version"}},
+	}
+
+	calls := []tflint.ModuleCall{}
+	var diags hcl.Diagnostics
+	for _, name := range listFiles(files) {
+		content, _, fileDiags := files[name].Body.PartialContent(fileSchema)
+		diags = append(diags, fileDiags...)
+		if fileDiags.HasErrors() {
+			continue
+		}
+
+		for _, block := range content.Blocks {
+			callContent, _, callDiags := block.Body.PartialContent(callSchema)
+			diags = append(diags, callDiags...)
+			if callDiags.HasErrors() {
+				continue
+			}
+
+			call := tflint.ModuleCall{Name: block.Labels[0], DeclRange: block.DefRange}
+			val, valDiags := literalValue(callContent.Attributes["source"], cty.String)
+			diags = append(diags, valDiags...)
+			if !valDiags.HasErrors() {
+				call.Source = val.AsString()
+			}
+			if attr, ok := callContent.Attributes["version"]; ok {
+				val, valDiags := literalValue(attr, cty.String)
+				diags = append(diags, valDiags...)
+				if !valDiags.HasErrors() {
+					call.Version = val.AsString()
+				}
+			}
+			calls = append(calls, call)
+		}
+	}
+
+	if diags.HasErrors() {
+		return nil, diags
+	}
+	return calls, nil
+}
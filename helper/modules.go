@@ -0,0 +1,123 @@
+package helper
+
+import (
+	"maps"
+	"path"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// moduleInstance is a module of the test configuration and its files.
+type moduleInstance struct {
+	// path lists the module call names from the root module, empty for
+	// the root module.
+	path  []string
+	files map[string]*hcl.File
+}
+
+// moduleInstances splits files into the modules they belong to, starting
+// with the root module. A directory is a module when a module call with a
+// local source ("./" or "../") refers to it; it is instantiated once per
+// call. The directories of registered, keyed by directory, are modules
+// with the given paths, after the called ones. Every other file, wherever
+// it is, belongs to the root module.
+func moduleInstances(files map[string]*hcl.File, registered map[string][]string) []moduleInstance {
+	dirs := make(map[string]map[string]*hcl.File)
+	for name, file := range files {
+		dir := path.Dir(name)
+		if dirs[dir] == nil {
+			dirs[dir] = make(map[string]*hcl.File)
+		}
+		dirs[dir][name] = file
+	}
+
+	var modules []moduleInstance
+	called := make(map[string]bool)
+	var walk func(dir string, modulePath, ancestors []string)
+	walk = func(dir string, modulePath, ancestors []string) {
+		for _, call := range localModuleCalls(dirs[dir]) {
+			target := path.Join(dir, call.source)
+			if dirs[target] == nil || slices.Contains(ancestors, target) {
+				continue
+			}
+			called[target] = true
+			callPath := append(slices.Clone(modulePath), call.name)
+			modules = append(modules, moduleInstance{path: callPath, files: dirs[target]})
+			walk(target, callPath, append(slices.Clone(ancestors), target))
+		}
+	}
+	walk(".", nil, []string{"."})
+
+	registeredDirs := slices.Sorted(maps.Keys(registered))
+	for _, dir := range registeredDirs {
+		if dirs[dir] == nil || called[dir] {
+			continue
+		}
+		called[dir] = true
+		modules = append(modules, moduleInstance{path: registered[dir], files: dirs[dir]})
+		walk(dir, registered[dir], []string{dir})
+	}
+
+	root := moduleInstance{files: make(map[string]*hcl.File)}
+	for dir, dirFiles := range dirs {
+		if called[dir] {
+			continue
+		}
+		for name, file := range dirFiles {
+			root.files[name] = file
+		}
+	}
+	return append([]moduleInstance{root}, modules...)
+}
+
+// registeredModuleDir returns the directory the files of a module
+// registered with TestRunnerWithModules are placed in, following the
+// conventional "modules/<name>" layout at every level.
+func registeredModuleDir(modulePath []string) string {
+	return "modules/" + strings.Join(modulePath, "/modules/")
+}
+
+// localModuleCall is a module call with a local source.
+type localModuleCall struct {
+	name   string
+	source string
+}
+
+// localModuleCalls returns the module calls in files whose source is a
+// literal local path, in file name order. Invalid module calls are skipped;
+// GetOldModuleCalls and GetNewModuleCalls report them.
+func localModuleCalls(files map[string]*hcl.File) []localModuleCall {
+	fileSchema := &hcl.BodySchema{
+		Blocks: []hcl.BlockHeaderSchema{{Type: "module", LabelNames: []string{"name"}}},
+	}
+	callSchema := &hcl.BodySchema{
+		Attributes: []hcl.AttributeSchema{{Name: "source"}},
+	}
+
+	var calls []localModuleCall
+	for _, name := range listFiles(files) {
+		content, _, diags := files[name].Body.PartialContent(fileSchema)
+		if diags.HasErrors() {
+			continue
+		}
+		for _, block := range content.Blocks {
+			callContent, _, diags := block.Body.PartialContent(callSchema)
+			attr, ok := callContent.Attributes["source"]
+			if diags.HasErrors() || !ok {
+				continue
+			}
+			val, diags := literalValue(attr, cty.String)
+			if diags.HasErrors() {
+				continue
+			}
+			source := val.AsString()
+			if strings.HasPrefix(source, "./") || strings.HasPrefix(source, "../") {
+				calls = append(calls, localModuleCall{name: block.Labels[0], source: source})
+			}
+		}
+	}
+	return calls
+}
@@ -0,0 +1,52 @@
+package helper
+
+import (
+	"maps"
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+)
+
+// ParsedFiles is a set of configuration files parsed once by
+// NewParsedFiles, which any number of runners can share. Runners only read
+// the parsed files, so a ParsedFiles can be used by runners in parallel.
+type ParsedFiles struct {
+	files map[string]*hcl.File
+}
+
+// NewParsedFiles parses files, keyed by filename, the same way TestRunner
+// does. Use it with TestRunnerFromParsed to keep parsing out of benchmarks
+// and out of tests that build many runners over the same configuration.
+//
+// Example:
+//
+//	func BenchmarkMyRule(b *testing.B) {
+//	    oldFiles := helper.NewParsedFiles(b, oldSources)
+//	    newFiles := helper.NewParsedFiles(b, newSources)
+//	    for b.Loop() {
+//	        runner := helper.TestRunnerFromParsed(b, oldFiles, newFiles)
+//	        _ = rule.Check(b.Context(), runner)
+//	    }
+//	}
+func NewParsedFiles(t testing.TB, files map[string]string) *ParsedFiles {
+	t.Helper()
+	return &ParsedFiles{files: parseConfigFiles(t, "config", files)}
+}
+
+// TestRunnerFromParsed creates a new Runner for testing over files parsed
+// by NewParsedFiles. It behaves like TestRunner with the same sources, but
+// does not parse them again. A nil ParsedFiles is an empty configuration.
+// The same ParsedFiles may be passed as both the old and the new files.
+func TestRunnerFromParsed(t testing.TB, oldFiles, newFiles *ParsedFiles) *Runner {
+	t.Helper()
+	return newRunner(t, oldFiles.clone(), newFiles.clone())
+}
+
+// clone returns a copy of the file map, so runners can't affect each other
+// or the ParsedFiles. Files themselves are shared.
+func (p *ParsedFiles) clone() map[string]*hcl.File {
+	if p == nil {
+		return make(map[string]*hcl.File)
+	}
+	return maps.Clone(p.files)
+}
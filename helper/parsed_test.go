@@ -0,0 +1,124 @@
+package helper
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/jokarl/tfbreak-plugin-sdk/hclext"
+	"github.com/jokarl/tfbreak-plugin-sdk/tflint"
+)
+
+// storageAccounts returns a main.tf with n storage accounts of the given
+// kind, each with a nested network_rules block.
+func storageAccounts(n int, kind string) map[string]string {
+	var b strings.Builder
+	for i := range n {
+		fmt.Fprintf(&b, `resource "azurerm_storage_account" "sa%d" {
+  name         = "sa%d"
+  account_kind = %q
+
+  network_rules {
+    default_action = "Deny"
+  }
+}
+
+`, i, i, kind)
+	}
+	return map[string]string{"main.tf": b.String()}
+}
+
+func TestTestRunnerFromParsed(t *testing.T) {
+	oldSources := storageAccounts(3, "StorageV2")
+	newSources := storageAccounts(3, "BlobStorage")
+	oldFiles := NewParsedFiles(t, oldSources)
+	newFiles := NewParsedFiles(t, newSources)
+
+	schema := &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{{Name: "name"}, {Name: "account_kind"}},
+		Blocks: []hclext.BlockSchema{{
+			Type: "network_rules",
+			Body: &hclext.BodySchema{Attributes: []hclext.AttributeSchema{{Name: "default_action"}}},
+		}},
+	}
+	opts := []cmp.Option{
+		cmpopts.IgnoreFields(hclext.Attribute{}, "Expr"),
+		cmp.Comparer(func(a, b cty.Value) bool { return a.RawEquals(b) }),
+	}
+
+	fresh := TestRunner(t, oldSources, newSources)
+	wantOld, wantNew, err := fresh.GetResourceContentPair("azurerm_storage_account", schema, nil)
+	if err != nil {
+		t.Fatalf("GetResourceContentPair error: %v", err)
+	}
+
+	// Every runner built from the same bundle sees the same content as a
+	// runner that parsed the sources itself
+	for i := range 2 {
+		runner := TestRunnerFromParsed(t, oldFiles, newFiles)
+		gotOld, gotNew, err := runner.GetResourceContentPair("azurerm_storage_account", schema, nil)
+		if err != nil {
+			t.Fatalf("runner %d: GetResourceContentPair error: %v", i, err)
+		}
+		if diff := cmp.Diff(wantOld, gotOld, opts...); diff != "" {
+			t.Errorf("runner %d: old content mismatch (-want +got):\n%s", i, diff)
+		}
+		if diff := cmp.Diff(wantNew, gotNew, opts...); diff != "" {
+			t.Errorf("runner %d: new content mismatch (-want +got):\n%s", i, diff)
+		}
+
+		rule := tflint.NewAttributeChangeRule("account_kind_changed", "azurerm_storage_account", "account_kind", tflint.ERROR)
+		if err := rule.Check(context.Background(), runner); err != nil {
+			t.Fatalf("runner %d: Check error: %v", i, err)
+		}
+		// Issues of earlier runners are not carried over
+		AssertIssueCount(t, runner.Issues, 3)
+	}
+}
+
+func TestTestRunnerFromParsed_NilFiles(t *testing.T) {
+	newFiles := NewParsedFiles(t, map[string]string{"main.tf": `variable "region" {}`})
+	runner := TestRunnerFromParsed(t, nil, newFiles)
+
+	if files := runner.ListOldFiles(); len(files) != 0 {
+		t.Errorf("ListOldFiles() = %v, want none", files)
+	}
+	if files := runner.ListNewFiles(); len(files) != 1 || files[0] != "main.tf" {
+		t.Errorf("ListNewFiles() = %v, want [main.tf]", files)
+	}
+}
+
+// BenchmarkTestRunner measures a rule over a large, unchanged module,
+// parsing the sources for every runner.
+func BenchmarkTestRunner(b *testing.B) {
+	oldSources := storageAccounts(500, "StorageV2")
+	newSources := storageAccounts(500, "StorageV2")
+	rule := tflint.NewAttributeChangeRule("account_kind_changed", "azurerm_storage_account", "account_kind", tflint.ERROR)
+
+	for b.Loop() {
+		runner := newRunner(b, parseConfigFiles(b, "old", oldSources), parseConfigFiles(b, "new", newSources))
+		if err := rule.Check(context.Background(), runner); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// BenchmarkTestRunnerFromParsed measures the same rule with the sources
+// parsed once, so the result reflects the rule rather than the parser.
+func BenchmarkTestRunnerFromParsed(b *testing.B) {
+	oldFiles := NewParsedFiles(b, storageAccounts(500, "StorageV2"))
+	newFiles := NewParsedFiles(b, storageAccounts(500, "StorageV2"))
+	rule := tflint.NewAttributeChangeRule("account_kind_changed", "azurerm_storage_account", "account_kind", tflint.ERROR)
+
+	for b.Loop() {
+		runner := TestRunnerFromParsed(b, oldFiles, newFiles)
+		if err := rule.Check(context.Background(), runner); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
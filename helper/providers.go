@@ -0,0 +1,200 @@
+package helper
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/jokarl/tfbreak-plugin-sdk/hclext"
+	"github.com/jokarl/tfbreak-plugin-sdk/tflint"
+)
+
+// GetOldProviderRequirements parses the required_providers blocks of the old files.
+func (r *Runner) GetOldProviderRequirements() (map[string]tflint.ProviderRequirement, error) {
+	if err := r.contextErr(); err != nil {
+		return nil, err
+	}
+	return providerRequirements(r.oldFiles)
+}
+
+// GetNewProviderRequirements parses the required_providers blocks of the new files.
+func (r *Runner) GetNewProviderRequirements() (map[string]tflint.ProviderRequirement, error) {
+	if err := r.contextErr(); err != nil {
+		return nil, err
+	}
+	return providerRequirements(r.newFiles)
+}
+
+// providerRequirements collects the entries of all
+// `terraform { required_providers { ... } }` blocks in files.
+// A provider declared more than once keeps the last declaration, in file name order.
+func providerRequirements(files map[string]*hcl.File) (map[string]tflint.ProviderRequirement, error) {
+	terraformSchema := &hcl.BodySchema{
+		Blocks: []hcl.BlockHeaderSchema{{Type: "terraform"}},
+	}
+	requiredProvidersSchema := &hcl.BodySchema{
+		Blocks: []hcl.BlockHeaderSchema{{Type: "required_providers"}},
+	}
+
+	reqs := make(map[string]tflint.ProviderRequirement)
+	var diags hcl.Diagnostics
+	for _, name := range listFiles(files) {
+		content, _, fileDiags := files[name].Body.PartialContent(terraformSchema)
+		diags = append(diags, fileDiags...)
+		if fileDiags.HasErrors() {
+			continue
+		}
+
+		for _, terraform := range content.Blocks {
+			tfContent, _, tfDiags := terraform.Body.PartialContent(requiredProvidersSchema)
+			diags = append(diags, tfDiags...)
+			if tfDiags.HasErrors() {
+				continue
+			}
+
+			for _, block := range tfContent.Blocks {
+				attrs, attrDiags := block.Body.JustAttributes()
+				diags = append(diags, attrDiags...)
+				for providerName, attr := range attrs {
+					req, reqDiags := decodeProviderRequirement(attr)
+					diags = append(diags, reqDiags...)
+					if !reqDiags.HasErrors() {
+						reqs[providerName] = req
+					}
+				}
+			}
+		}
+	}
+
+	if diags.HasErrors() {
+		return nil, diags
+	}
+	return reqs, nil
+}
+
+// decodeProviderRequirement decodes a required_providers entry, which is either
+// an object with "source" and "version" or a legacy version constraint string.
+// Other object keys, such as configuration_aliases, are ignored without being
+// evaluated, since they hold references.
+func decodeProviderRequirement(attr *hcl.Attribute) (tflint.ProviderRequirement, hcl.Diagnostics) {
+	var req tflint.ProviderRequirement
+
+	pairs, diags := hcl.ExprMap(attr.Expr)
+	if diags.HasErrors() {
+		// Legacy form: azurerm = "~> 3.0"
+		version, diags := stringValue(attr.Name, "version", attr.Expr)
+		if diags.HasErrors() {
+			return req, diags
+		}
+		req.VersionConstraint = version
+		return req, nil
+	}
+
+	for _, pair := range pairs {
+		var field *string
+		switch objectKey(pair.Key) {
+		case "source":
+			field = &req.Source
+		case "version":
+			field = &req.VersionConstraint
+		default:
+			continue
+		}
+		value, diags := stringValue(attr.Name, objectKey(pair.Key), pair.Value)
+		if diags.HasErrors() {
+			return req, diags
+		}
+		*field = value
+	}
+	return req, nil
+}
+
+// objectKey returns the name of an object key, which may be a bare keyword
+// or a quoted string, or "" if it is neither.
+func objectKey(expr hcl.Expression) string {
+	if key := hcl.ExprAsKeyword(expr); key != "" {
+		return key
+	}
+	val, diags := expr.Value(nil)
+	if diags.HasErrors() || !val.IsKnown() || val.IsNull() || val.Type() != cty.String {
+		return ""
+	}
+	return val.AsString()
+}
+
+// stringValue evaluates expr, which must be a literal string, without context.
+func stringValue(providerName, key string, expr hcl.Expression) (string, hcl.Diagnostics) {
+	val, diags := expr.Value(nil)
+	if diags.HasErrors() {
+		return "", diags
+	}
+	if !val.IsKnown() || val.IsNull() || val.Type() != cty.String {
+		return "", hcl.Diagnostics{{
+			Severity: hcl.DiagError,
+			Summary:  "Invalid required_providers entry",
+			Detail:   fmt.Sprintf("The %s of provider %q must be a string.", key, providerName),
+			Subject:  expr.Range().Ptr(),
+		}}
+	}
+	return val.AsString(), nil
+}
+
+// GetOldProviderConfig returns a provider block of the old files.
+func (r *Runner) GetOldProviderConfig(name string, schema *hclext.BodySchema) (*hclext.Block, error) {
+	if err := r.contextErr(); err != nil {
+		return nil, err
+	}
+	return r.providerConfig(r.oldFiles, name, schema)
+}
+
+// GetNewProviderConfig returns a provider block of the new files.
+func (r *Runner) GetNewProviderConfig(name string, schema *hclext.BodySchema) (*hclext.Block, error) {
+	if err := r.contextErr(); err != nil {
+		return nil, err
+	}
+	return r.providerConfig(r.newFiles, name, schema)
+}
+
+// providerConfig returns the root module provider block of files named
+// "<local name>" or "<local name>.<alias>", or nil if there is none. A
+// provider block without an alias attribute is the default configuration.
+func (r *Runner) providerConfig(files map[string]*hcl.File, name string, schema *hclext.BodySchema) (*hclext.Block, error) {
+	localName, alias, _ := strings.Cut(name, ".")
+	content, err := r.getBlockContent(files, "provider", []string{"name"}, []string{localName}, withAlias(schema), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, block := range content.Blocks {
+		if len(block.ModulePath) > 0 {
+			continue
+		}
+		var blockAlias string
+		if val, ok := hclext.AttributeValue(block.Body.Attributes["alias"]); ok && !val.IsNull() && val.Type() == cty.String {
+			blockAlias = val.AsString()
+		}
+		if blockAlias == alias {
+			return block, nil
+		}
+	}
+	return nil, nil
+}
+
+// withAlias returns schema with the provider alias attribute added, unless
+// it already declares it or extracts every attribute.
+func withAlias(schema *hclext.BodySchema) *hclext.BodySchema {
+	if schema == nil {
+		schema = &hclext.BodySchema{}
+	}
+	if schema.Mode == hclext.SchemaJustAttributesMode ||
+		slices.ContainsFunc(schema.Attributes, func(attr hclext.AttributeSchema) bool { return attr.Name == "alias" }) {
+		return schema
+	}
+
+	result := *schema
+	result.Attributes = append(slices.Clip(schema.Attributes), hclext.AttributeSchema{Name: "alias"})
+	return &result
+}
@@ -0,0 +1,30 @@
+package helper
+
+import "github.com/hashicorp/hcl/v2"
+
+// Pos returns the position at line and column, both 1-based. Byte is left
+// at zero, as the issue assertions ignore byte offsets.
+//
+// Example:
+//
+//	helper.Pos(3, 5) // hcl.Pos{Line: 3, Column: 5}
+func Pos(line, col int) hcl.Pos {
+	return hcl.Pos{Line: line, Column: col}
+}
+
+// Range returns the range of filename from startLine:startCol to
+// endLine:endCol, so expected issues fit on one line. Byte offsets are left
+// at zero, as the issue assertions ignore them.
+//
+// Example:
+//
+//	helper.AssertIssues(t, helper.Issues{
+//	    {Rule: rule, Message: "sku changed", Range: helper.Range("main.tf", 3, 3, 3, 20)},
+//	}, runner.Issues)
+func Range(filename string, startLine, startCol, endLine, endCol int) hcl.Range {
+	return hcl.Range{
+		Filename: filename,
+		Start:    Pos(startLine, startCol),
+		End:      Pos(endLine, endCol),
+	}
+}
@@ -0,0 +1,40 @@
+package helper
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+)
+
+func TestPos(t *testing.T) {
+	if got, want := Pos(3, 5), (hcl.Pos{Line: 3, Column: 5}); got != want {
+		t.Errorf("Pos(3, 5) = %#v, want %#v", got, want)
+	}
+}
+
+func TestRange(t *testing.T) {
+	want := hcl.Range{
+		Filename: "main.tf",
+		Start:    hcl.Pos{Line: 3, Column: 3},
+		End:      hcl.Pos{Line: 4, Column: 20},
+	}
+	if got := Range("main.tf", 3, 3, 4, 20); got != want {
+		t.Errorf("Range() = %#v, want %#v", got, want)
+	}
+}
+
+func TestRange_AssertIssues(t *testing.T) {
+	rule := &testRuleForIssue{name: "test_rule"}
+
+	// Emitted ranges carry byte offsets, which AssertIssues ignores
+	got := Issues{{
+		Rule:    rule,
+		Message: "sku changed",
+		Range: hcl.Range{
+			Filename: "main.tf",
+			Start:    hcl.Pos{Line: 3, Column: 3, Byte: 42},
+			End:      hcl.Pos{Line: 3, Column: 20, Byte: 59},
+		},
+	}}
+	AssertIssues(t, Issues{{Rule: rule, Message: "sku changed", Range: Range("main.tf", 3, 3, 3, 20)}}, got)
+}
@@ -0,0 +1,89 @@
+package helper
+
+import (
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+
+	"github.com/jokarl/tfbreak-plugin-sdk/hclext"
+)
+
+// GetAllOldResources returns every resource block of the old files.
+func (r *Runner) GetAllOldResources() ([]*hclext.Block, error) {
+	if err := r.contextErr(); err != nil {
+		return nil, err
+	}
+	return allResources(r.oldFiles, r.modules)
+}
+
+// GetAllNewResources returns every resource block of the new files.
+func (r *Runner) GetAllNewResources() ([]*hclext.Block, error) {
+	if err := r.contextErr(); err != nil {
+		return nil, err
+	}
+	return allResources(r.newFiles, r.modules)
+}
+
+// allResources returns the resource blocks of the root module, in file name
+// and then source order, with their whole body extracted by rawContent.
+func allResources(files map[string]*hcl.File, registered map[string][]string) ([]*hclext.Block, error) {
+	schema := &hcl.BodySchema{
+		Blocks: []hcl.BlockHeaderSchema{{Type: "resource", LabelNames: []string{"type", "name"}}},
+	}
+	files = moduleInstances(files, registered)[0].files
+
+	blocks := []*hclext.Block{}
+	var diags hcl.Diagnostics
+	for _, name := range listFiles(files) {
+		file := files[name]
+		content, _, fileDiags := file.Body.PartialContent(schema)
+		diags = append(diags, fileDiags...)
+		if fileDiags.HasErrors() {
+			continue
+		}
+
+		first := len(blocks)
+		for _, block := range content.Blocks {
+			b := hclext.FromHCLBlock(block)
+			body, bodyDiags := rawContent(block.Body)
+			diags = append(diags, bodyDiags...)
+			fillSourceBytes(body, file.Bytes)
+			b.Body = body
+			blocks = append(blocks, b)
+		}
+		hclext.FillLeadingComments(&hclext.BodyContent{Blocks: blocks[first:]}, file.Bytes, name)
+	}
+
+	if diags.HasErrors() {
+		return nil, diags
+	}
+	return blocks, nil
+}
+
+// rawContent extracts every attribute and nested block of body, recursively,
+// without a schema. JSON bodies cannot tell blocks from attributes without
+// a schema, so all their properties are extracted as attributes.
+func rawContent(body hcl.Body) (*hclext.BodyContent, hcl.Diagnostics) {
+	syntaxBody, ok := body.(*hclsyntax.Body)
+	if !ok {
+		attrs, diags := body.JustAttributes()
+		return hclext.FromHCLBodyContent(&hcl.BodyContent{Attributes: attrs}), diags
+	}
+
+	content := &hclext.BodyContent{
+		Attributes: make(map[string]*hclext.Attribute, len(syntaxBody.Attributes)),
+		Blocks:     make([]*hclext.Block, 0, len(syntaxBody.Blocks)),
+	}
+	for name, attr := range syntaxBody.Attributes {
+		content.Attributes[name] = hclext.FromHCLAttribute(attr.AsHCLAttribute())
+	}
+
+	var diags hcl.Diagnostics
+	for _, block := range syntaxBody.Blocks {
+		b := hclext.FromHCLBlock(block.AsHCLBlock())
+		nested, nestedDiags := rawContent(block.Body)
+		diags = append(diags, nestedDiags...)
+		b.Body = nested
+		content.Blocks = append(content.Blocks, b)
+	}
+	return content, diags
+}
@@ -0,0 +1,114 @@
+package helper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"runtime/debug"
+	"testing"
+
+	"github.com/jokarl/tfbreak-plugin-sdk/tflint"
+)
+
+// RunRuleSet runs a whole ruleset against the runner, the way the plugin
+// server does during Check: it applies runner.Config as the global config,
+// lets the ruleset wrap the runner, applies severity overrides, and checks
+// every enabled rule in order, after preparing those implementing
+// tflint.PreparableRule. Use it to test enabling and disabling logic
+// that calling a single rule's Check would bypass.
+//
+// Rules are checked with runner.Context if set, and t.Context otherwise.
+// Every enabled rule runs even if an earlier one fails. Failures, including
+// recovered panics, are returned as a *tflint.MultiRuleError.
+//
+// Example:
+//
+//	runner := helper.TestRunner(t, oldFiles, newFiles)
+//	runner.Config = &tflint.Config{Only: []string{"my_rule"}}
+//	if err := helper.RunRuleSet(t, ruleset, runner); err != nil {
+//	    t.Fatal(err)
+//	}
+//	helper.AssertIssues(t, helper.Issues{...}, runner.Issues)
+func RunRuleSet(t *testing.T, rs tflint.RuleSet, runner *Runner) error {
+	t.Helper()
+
+	if err := rs.ApplyGlobalConfig(runner.Config); err != nil {
+		return err
+	}
+	builtin := rs.BuiltinImpl()
+	if builtin == nil {
+		return errors.New("ruleset does not provide a BuiltinRuleSet")
+	}
+
+	wrapped, err := rs.NewRunner(runner)
+	if err != nil {
+		return err
+	}
+	wrapped = builtin.ApplySeverityOverrides(wrapped)
+
+	ctx := runner.Context
+	if ctx == nil {
+		ctx = t.Context()
+	}
+
+	rules := builtin.EnabledRules()
+	errs := make([]*tflint.RuleError, len(rules))
+	for i, rule := range rules {
+		errs[i] = prepareRule(rule, wrapped)
+	}
+
+	var ruleErrors []*tflint.RuleError
+	for i, rule := range rules {
+		if errs[i] == nil {
+			errs[i] = checkRule(ctx, rule, wrapped)
+		}
+		if errs[i] != nil {
+			ruleErrors = append(ruleErrors, errs[i])
+		}
+	}
+	if len(ruleErrors) > 0 {
+		return &tflint.MultiRuleError{Errors: ruleErrors}
+	}
+	return nil
+}
+
+// checkRule runs a single rule, recovering a panic as a rule error.
+func checkRule(ctx context.Context, rule tflint.Rule, runner tflint.Runner) (ruleErr *tflint.RuleError) {
+	defer func() {
+		if r := recover(); r != nil {
+			ruleErr = &tflint.RuleError{
+				Rule:     rule.Name(),
+				Category: tflint.ErrorCategoryPanic,
+				Err:      fmt.Errorf("%v\n%s", r, debug.Stack()),
+			}
+		}
+	}()
+
+	if err := rule.Check(ctx, runner); err != nil {
+		return tflint.NewRuleError(rule.Name(), err)
+	}
+	return nil
+}
+
+// prepareRule calls Prepare on a rule implementing tflint.PreparableRule,
+// recovering a panic as a rule error.
+func prepareRule(rule tflint.Rule, runner tflint.Runner) (ruleErr *tflint.RuleError) {
+	preparable, ok := rule.(tflint.PreparableRule)
+	if !ok {
+		return nil
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			ruleErr = &tflint.RuleError{
+				Rule:     rule.Name(),
+				Category: tflint.ErrorCategoryPanic,
+				Err:      fmt.Errorf("%v\n%s", r, debug.Stack()),
+			}
+		}
+	}()
+
+	if err := preparable.Prepare(runner); err != nil {
+		return tflint.NewRuleError(rule.Name(), err)
+	}
+	return nil
+}
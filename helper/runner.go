@@ -10,7 +10,7 @@
 //	    )
 //
 //	    rule := &MyRule{}
-//	    if err := rule.Check(runner); err != nil {
+//	    if err := rule.Check(t.Context(), runner); err != nil {
 //	        t.Fatal(err)
 //	    }
 //
@@ -21,9 +21,19 @@
 package helper
 
 import (
+	"context"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
 	"testing"
 
 	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/gohcl"
 	"github.com/hashicorp/hcl/v2/hclparse"
 	"github.com/jokarl/tfbreak-plugin-sdk/hclext"
 	"github.com/jokarl/tfbreak-plugin-sdk/tflint"
@@ -32,11 +42,32 @@ import (
 // Runner is a mock tflint.Runner for testing.
 // Use TestRunner to create an instance.
 type Runner struct {
-	t        *testing.T
+	t        testing.TB
 	oldFiles map[string]*hcl.File
 	newFiles map[string]*hcl.File
+	// modules maps the directories of the modules registered with
+	// TestRunnerWithModules to their module paths.
+	modules map[string][]string
+	// ruleConfigs maps rule names to their parsed configuration bodies.
+	ruleConfigs map[string]hcl.Body
+	// mu guards Issues, since rules may emit issues concurrently.
+	mu sync.Mutex
 	// Issues contains all issues emitted during rule execution.
+	// Reading it while rules are still emitting issues is a data race;
+	// use GetIssues instead when rules run concurrently.
 	Issues Issues
+	// EvalContext is used to evaluate for_each when dynamic blocks are
+	// expanded with tflint.ExpandModeExpand. Its variables and functions
+	// take precedence over input variable defaults. Optional.
+	EvalContext *hcl.EvalContext
+	// Config is the global configuration RunRuleSet applies to the ruleset
+	// before running its rules. Nil runs every rule with its defaults.
+	Config *tflint.Config
+	// Context mirrors the context of the host's Check call. Once it is done,
+	// the GetOld*, GetNew* and GetFileSource methods return its error, as
+	// Runner callbacks to the host do when Check is cancelled. RunRuleSet
+	// also passes it to each rule's Check. Nil is never done.
+	Context context.Context
 }
 
 // Ensure Runner implements tflint.Runner.
@@ -60,185 +91,731 @@ var _ tflint.Runner = (*Runner)(nil)
 //	)
 //
 //	rule := &MyRule{}
-//	rule.Check(runner)
+//	rule.Check(t.Context(), runner)
 //	helper.AssertIssues(t, expected, runner.Issues)
 func TestRunner(t *testing.T, oldFiles, newFiles map[string]string) *Runner {
 	t.Helper()
 
-	runner := &Runner{
-		t:        t,
-		oldFiles: make(map[string]*hcl.File),
-		newFiles: make(map[string]*hcl.File),
-		Issues:   make(Issues, 0),
-	}
-
 	// Use separate parsers for old and new files because hclparse.Parser
 	// caches files by filename. Using a single parser would cause the
 	// second parse of "main.tf" to return the cached first parse.
-	oldParser := hclparse.NewParser()
-	newParser := hclparse.NewParser()
+	return newRunner(t, parseConfigFiles(t, "old", oldFiles), parseConfigFiles(t, "new", newFiles))
+}
+
+// newRunner returns a Runner over already parsed old and new files.
+func newRunner(t testing.TB, oldFiles, newFiles map[string]*hcl.File) *Runner {
+	return &Runner{
+		t:           t,
+		oldFiles:    oldFiles,
+		newFiles:    newFiles,
+		ruleConfigs: make(map[string]hcl.Body),
+		Issues:      make(Issues, 0),
+	}
+}
+
+// parseConfigFiles parses files with a parser of their own. side names the
+// configuration in error messages.
+func parseConfigFiles(t testing.TB, side string, files map[string]string) map[string]*hcl.File {
+	t.Helper()
 
-	// Parse old files
-	for name, content := range oldFiles {
-		file, diags := oldParser.ParseHCL([]byte(content), name)
+	parser := hclparse.NewParser()
+	parsed := make(map[string]*hcl.File, len(files))
+	for name, content := range files {
+		parsed[name] = parseConfigFile(t, parser, side, name, content)
+	}
+	return parsed
+}
+
+// parseConfigFile parses a configuration file, choosing the syntax by suffix
+// the same way Terraform does: *.tf.json files are parsed as JSON and *.tf
+// files as native HCL. Files with any other suffix are parsed as native HCL.
+func parseConfigFile(t testing.TB, parser *hclparse.Parser, side, name, content string) *hcl.File {
+	t.Helper()
+
+	if strings.HasSuffix(name, ".tf.json") {
+		file, diags := parser.ParseJSON([]byte(content), name)
 		if diags.HasErrors() {
-			t.Fatalf("failed to parse old file %s: %s", name, diags.Error())
+			t.Fatalf("failed to parse %s file %s as JSON: %s", side, name, diags.Error())
+		}
+		return file
+	}
+
+	file, diags := parser.ParseHCL([]byte(content), name)
+	if diags.HasErrors() {
+		if !strings.HasSuffix(name, ".tf") {
+			t.Fatalf("failed to parse %s file %s: %s (no .tf or .tf.json suffix, parsed as native HCL)", side, name, diags.Error())
 		}
-		runner.oldFiles[name] = file
+		t.Fatalf("failed to parse %s file %s: %s", side, name, diags.Error())
 	}
+	return file
+}
 
-	// Parse new files
-	for name, content := range newFiles {
-		file, diags := newParser.ParseHCL([]byte(content), name)
+// TestRunnerWithConfig creates a new Runner for testing with rule configuration.
+// The configs map rule names to HCL bodies, which DecodeRuleConfig decodes
+// into the target struct.
+//
+// Example:
+//
+//	runner := helper.TestRunnerWithConfig(t, oldFiles, newFiles, map[string]string{
+//	    "my_rule": `ignore_patterns = ["^legacy_"]`,
+//	})
+//
+//	var config MyRuleConfig
+//	runner.DecodeRuleConfig("my_rule", &config)
+func TestRunnerWithConfig(t *testing.T, oldFiles, newFiles, configs map[string]string) *Runner {
+	t.Helper()
+
+	runner := TestRunner(t, oldFiles, newFiles)
+
+	parser := hclparse.NewParser()
+	for ruleName, content := range configs {
+		file, diags := parser.ParseHCL([]byte(content), ruleName+".hcl")
 		if diags.HasErrors() {
-			t.Fatalf("failed to parse new file %s: %s", name, diags.Error())
+			t.Fatalf("failed to parse config for rule %s: %s", ruleName, diags.Error())
 		}
-		runner.newFiles[name] = file
+		runner.ruleConfigs[ruleName] = file.Body
 	}
 
 	return runner
 }
 
+// TestRunnerFromDir creates a new Runner for testing from directories on disk.
+// All *.tf and *.tf.json files under oldDir and newDir (including nested
+// subdirectories) are loaded, keyed by their slash-separated path relative to
+// the directory. Other files are ignored.
+//
+// Example:
+//
+//	runner := helper.TestRunnerFromDir(t,
+//	    "testdata/location_changed/old",
+//	    "testdata/location_changed/new",
+//	)
+func TestRunnerFromDir(t *testing.T, oldDir, newDir string) *Runner {
+	t.Helper()
+	return TestRunner(t, readTerraformDir(t, oldDir), readTerraformDir(t, newDir))
+}
+
+// TestRunnerWithModules creates a new Runner for testing with child modules
+// that are not called through a local source. The modules map module paths,
+// the module call names joined with dots (e.g., "app" or "app.db"), to the
+// files of each module. With tflint.ModuleCtxAll, the content methods return
+// the blocks of every module after the root module's, with ModulePath set.
+//
+// The same configuration is used as the old and the new one. Module files
+// are keyed as "modules/app/main.tf" (or "modules/app/modules/db/main.tf"),
+// which is the filename of their ranges.
+//
+// Example:
+//
+//	runner := helper.TestRunnerWithModules(t,
+//	    map[string]string{"main.tf": `module "app" { source = "example/app/aws" }`},
+//	    map[string]map[string]string{
+//	        "app": {"main.tf": `resource "aws_instance" "web" {}`},
+//	    },
+//	)
+//
+//	content, err := runner.GetOldResourceContent("aws_instance", schema,
+//	    &tflint.GetModuleContentOption{ModuleCtx: tflint.ModuleCtxAll})
+func TestRunnerWithModules(t *testing.T, root map[string]string, modules map[string]map[string]string) *Runner {
+	t.Helper()
+
+	files := make(map[string]string, len(root))
+	for name, content := range root {
+		files[name] = content
+	}
+	registered := make(map[string][]string, len(modules))
+	for key, moduleFiles := range modules {
+		modulePath := strings.Split(key, ".")
+		if slices.Contains(modulePath, "") {
+			t.Fatalf("invalid module path %q: want module call names joined with dots", key)
+		}
+		dir := registeredModuleDir(modulePath)
+		registered[dir] = modulePath
+		for name, content := range moduleFiles {
+			filename := dir + "/" + name
+			if _, exists := files[filename]; exists {
+				t.Fatalf("module %s file %s conflicts with %s", key, name, filename)
+			}
+			files[filename] = content
+		}
+	}
+
+	runner := TestRunner(t, files, files)
+	runner.modules = registered
+	return runner
+}
+
+// readTerraformDir reads all *.tf and *.tf.json files under dir into a map keyed by relative path.
+func readTerraformDir(t *testing.T, dir string) map[string]string {
+	t.Helper()
+
+	files := make(map[string]string)
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() || !isTerraformFile(d.Name()) {
+			return nil
+		}
+
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+		files[filepath.ToSlash(rel)] = string(content)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("failed to read directory %s: %s", dir, err)
+	}
+
+	return files
+}
+
+// isTerraformFile reports whether name is a Terraform configuration file in
+// native (*.tf) or JSON (*.tf.json) syntax.
+func isTerraformFile(name string) bool {
+	return strings.HasSuffix(name, ".tf") || strings.HasSuffix(name, ".tf.json")
+}
+
 // GetOldModuleContent retrieves content from old files.
-func (r *Runner) GetOldModuleContent(schema *hclext.BodySchema, _ *tflint.GetModuleContentOption) (*hclext.BodyContent, error) {
-	return r.getModuleContent(r.oldFiles, schema)
+func (r *Runner) GetOldModuleContent(schema *hclext.BodySchema, opts *tflint.GetModuleContentOption) (*hclext.BodyContent, error) {
+	if err := r.contextErr(); err != nil {
+		return nil, err
+	}
+	return r.getModuleContent(r.oldFiles, schema, opts)
 }
 
 // GetNewModuleContent retrieves content from new files.
-func (r *Runner) GetNewModuleContent(schema *hclext.BodySchema, _ *tflint.GetModuleContentOption) (*hclext.BodyContent, error) {
-	return r.getModuleContent(r.newFiles, schema)
+func (r *Runner) GetNewModuleContent(schema *hclext.BodySchema, opts *tflint.GetModuleContentOption) (*hclext.BodyContent, error) {
+	if err := r.contextErr(); err != nil {
+		return nil, err
+	}
+	return r.getModuleContent(r.newFiles, schema, opts)
 }
 
 // GetOldResourceContent retrieves resources of a specific type from old files.
-func (r *Runner) GetOldResourceContent(resourceType string, schema *hclext.BodySchema, _ *tflint.GetModuleContentOption) (*hclext.BodyContent, error) {
-	return r.getResourceContent(r.oldFiles, resourceType, schema)
+func (r *Runner) GetOldResourceContent(resourceType string, schema *hclext.BodySchema, opts *tflint.GetModuleContentOption) (*hclext.BodyContent, error) {
+	if err := r.contextErr(); err != nil {
+		return nil, err
+	}
+	return r.getResourceContent(r.oldFiles, resourceType, schema, opts)
 }
 
 // GetNewResourceContent retrieves resources of a specific type from new files.
-func (r *Runner) GetNewResourceContent(resourceType string, schema *hclext.BodySchema, _ *tflint.GetModuleContentOption) (*hclext.BodyContent, error) {
-	return r.getResourceContent(r.newFiles, resourceType, schema)
+func (r *Runner) GetNewResourceContent(resourceType string, schema *hclext.BodySchema, opts *tflint.GetModuleContentOption) (*hclext.BodyContent, error) {
+	if err := r.contextErr(); err != nil {
+		return nil, err
+	}
+	return r.getResourceContent(r.newFiles, resourceType, schema, opts)
+}
+
+// GetResourceContentPair retrieves resources of a specific type from both
+// old and new files.
+func (r *Runner) GetResourceContentPair(resourceType string, schema *hclext.BodySchema, opts *tflint.GetModuleContentOption) (*hclext.BodyContent, *hclext.BodyContent, error) {
+	if err := r.contextErr(); err != nil {
+		return nil, nil, err
+	}
+	oldContent, err := r.getResourceContent(r.oldFiles, resourceType, schema, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+	newContent, err := r.getResourceContent(r.newFiles, resourceType, schema, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+	return oldContent, newContent, nil
 }
 
-// EmitIssue records an issue.
+// GetOldDataSourceContent retrieves data sources of a specific type from old files.
+func (r *Runner) GetOldDataSourceContent(dataSourceType string, schema *hclext.BodySchema, opts *tflint.GetModuleContentOption) (*hclext.BodyContent, error) {
+	if err := r.contextErr(); err != nil {
+		return nil, err
+	}
+	return r.getDataSourceContent(r.oldFiles, dataSourceType, schema, opts)
+}
+
+// GetNewDataSourceContent retrieves data sources of a specific type from new files.
+func (r *Runner) GetNewDataSourceContent(dataSourceType string, schema *hclext.BodySchema, opts *tflint.GetModuleContentOption) (*hclext.BodyContent, error) {
+	if err := r.contextErr(); err != nil {
+		return nil, err
+	}
+	return r.getDataSourceContent(r.newFiles, dataSourceType, schema, opts)
+}
+
+// GetOldFile returns the parsed old file with the given name.
+func (r *Runner) GetOldFile(filename string) (*hcl.File, error) {
+	if err := r.contextErr(); err != nil {
+		return nil, err
+	}
+	return getFile(r.oldFiles, filename)
+}
+
+// GetNewFile returns the parsed new file with the given name.
+func (r *Runner) GetNewFile(filename string) (*hcl.File, error) {
+	if err := r.contextErr(); err != nil {
+		return nil, err
+	}
+	return getFile(r.newFiles, filename)
+}
+
+// GetFileSource returns the source of the old or new file with the given name.
+func (r *Runner) GetFileSource(filename string, side tflint.Side) ([]byte, error) {
+	if err := r.contextErr(); err != nil {
+		return nil, err
+	}
+	var files map[string]*hcl.File
+	switch side {
+	case tflint.SideOld:
+		files = r.oldFiles
+	case tflint.SideNew:
+		files = r.newFiles
+	default:
+		return nil, fmt.Errorf("unknown side: %s", side)
+	}
+
+	file, err := getFile(files, filename)
+	if err != nil {
+		return nil, err
+	}
+	return file.Bytes, nil
+}
+
+// ListOldFiles returns the names of the old files, sorted.
+func (r *Runner) ListOldFiles() []string {
+	return listFiles(r.oldFiles)
+}
+
+// ListNewFiles returns the names of the new files, sorted.
+func (r *Runner) ListNewFiles() []string {
+	return listFiles(r.newFiles)
+}
+
+// FileChanges compares the names of the old and new files.
+func (r *Runner) FileChanges() (added, removed, common []string) {
+	return tflint.FileChanges(r)
+}
+
+// OldResourceAddresses returns the sorted addresses of the resource blocks
+// in the old files.
+func (r *Runner) OldResourceAddresses() ([]string, error) {
+	return tflint.OldResourceAddresses(r)
+}
+
+// NewResourceAddresses returns the sorted addresses of the resource blocks
+// in the new files.
+func (r *Runner) NewResourceAddresses() ([]string, error) {
+	return tflint.NewResourceAddresses(r)
+}
+
+// GetMovedBlocks parses the moved blocks declared in the new files.
+// Moved blocks missing "from" or "to" are skipped.
+func (r *Runner) GetMovedBlocks() []tflint.MovedBlock {
+	schema := &hcl.BodySchema{
+		Blocks: []hcl.BlockHeaderSchema{{Type: "moved"}},
+	}
+	movedSchema := &hcl.BodySchema{
+		Attributes: []hcl.AttributeSchema{
+			{Name: "from", Required: true},
+			{Name: "to", Required: true},
+		},
+	}
+
+	var moved []tflint.MovedBlock
+	for _, name := range listFiles(r.newFiles) {
+		file := r.newFiles[name]
+		content, _, diags := file.Body.PartialContent(schema)
+		if diags.HasErrors() {
+			continue
+		}
+		for _, block := range content.Blocks {
+			attrs, _, diags := block.Body.PartialContent(movedSchema)
+			if diags.HasErrors() {
+				continue
+			}
+			moved = append(moved, tflint.MovedBlock{
+				From:      traversalSource(attrs.Attributes["from"], file.Bytes),
+				To:        traversalSource(attrs.Attributes["to"], file.Bytes),
+				DeclRange: block.DefRange,
+			})
+		}
+	}
+	return moved
+}
+
+// traversalSource returns the raw source of an attribute's expression.
+func traversalSource(attr *hcl.Attribute, src []byte) string {
+	return strings.TrimSpace(string(attr.Expr.Range().SliceBytes(src)))
+}
+
+// GetModuleDiff retrieves content from old and new files and pairs blocks by address.
+func (r *Runner) GetModuleDiff(schema *hclext.BodySchema, opts *tflint.GetModuleContentOption) (*tflint.ModuleDiff, error) {
+	return tflint.GetModuleDiff(r, schema, opts)
+}
+
+// WalkOldResources calls fn for every resource block in the old files.
+func (r *Runner) WalkOldResources(schema *hclext.BodySchema, fn tflint.WalkResourcesFunc) error {
+	return tflint.WalkOldResources(r, schema, fn)
+}
+
+// WalkNewResources calls fn for every resource block in the new files.
+func (r *Runner) WalkNewResources(schema *hclext.BodySchema, fn tflint.WalkResourcesFunc) error {
+	return tflint.WalkNewResources(r, schema, fn)
+}
+
+// EmitIssue records an issue, unless it is suppressed by a tfbreak:ignore
+// directive in the new configuration.
 func (r *Runner) EmitIssue(rule tflint.Rule, message string, issueRange hcl.Range) error {
-	r.Issues = append(r.Issues, Issue{
-		Rule:    rule,
-		Message: message,
-		Range:   issueRange,
+	if r.isIgnored(rule, tflint.SideNew, issueRange) {
+		return nil
+	}
+	return r.addIssue(Issue{
+		Rule:     rule,
+		Message:  message,
+		Range:    issueRange,
+		Severity: ruleSeverity(rule),
+	})
+}
+
+// EmitIssueWithFix records an issue along with its suggested fix.
+func (r *Runner) EmitIssueWithFix(rule tflint.Rule, message string, issueRange hcl.Range, fix *tflint.Fix) error {
+	if r.isIgnored(rule, tflint.SideNew, issueRange) {
+		return nil
+	}
+	return r.addIssue(Issue{
+		Rule:     rule,
+		Message:  message,
+		Range:    issueRange,
+		Fix:      fix,
+		Severity: ruleSeverity(rule),
 	})
-	return nil
 }
 
-// DecodeRuleConfig decodes rule configuration.
-// This is a stub implementation that always returns nil (no config).
-func (r *Runner) DecodeRuleConfig(_ string, _ any) error {
+// EmitIssueWithSeverity records an issue with a severity that replaces the
+// rule's own severity.
+func (r *Runner) EmitIssueWithSeverity(rule tflint.Rule, severity tflint.Severity, message string, issueRange hcl.Range) error {
+	if r.isIgnored(rule, tflint.SideNew, issueRange) {
+		return nil
+	}
+	return r.addIssue(Issue{
+		Rule:     rule,
+		Message:  message,
+		Range:    issueRange,
+		Severity: severity,
+	})
+}
+
+// EmitIssueWithOptions records an issue with the attributes in opts. Issues
+// whose range refers to the OLD configuration are matched against the
+// tfbreak:ignore directives of the old files.
+func (r *Runner) EmitIssueWithOptions(rule tflint.Rule, message string, issueRange hcl.Range, opts tflint.IssueOptions) error {
+	if r.isIgnored(rule, opts.Side, issueRange) {
+		return nil
+	}
+	severity := opts.Severity
+	if severity == 0 {
+		severity = ruleSeverity(rule)
+	}
+	return r.addIssue(Issue{
+		Rule:            rule,
+		Message:         message,
+		Range:           issueRange,
+		Fix:             opts.Fix,
+		Severity:        severity,
+		Kind:            opts.Kind,
+		ResourceAddress: opts.ResourceAddress,
+		Side:            opts.Side,
+	})
+}
+
+// contextErr returns the error of the runner's Context, or nil if it is
+// unset or not done yet.
+func (r *Runner) contextErr() error {
+	if r.Context == nil {
+		return nil
+	}
+	return r.Context.Err()
+}
+
+// addIssue records an issue. It is safe for concurrent use. Like the
+// plugin's Runner, it rejects issues without a rule.
+func (r *Runner) addIssue(issue Issue) error {
+	if issue.Rule == nil {
+		return fmt.Errorf("%w: %q", tflint.ErrNilRule, issue.Message)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.Issues = append(r.Issues, issue)
 	return nil
 }
 
+// GetIssues returns a copy of the issues emitted so far.
+// It is safe to call while rules are emitting issues concurrently.
+func (r *Runner) GetIssues() Issues {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return slices.Clone(r.Issues)
+}
+
+// isIgnored reports whether an issue is suppressed by a tfbreak:ignore
+// directive in the file its range points to, in the configuration of side.
+func (r *Runner) isIgnored(rule tflint.Rule, side tflint.Side, issueRange hcl.Range) bool {
+	if rule == nil {
+		return false
+	}
+	files := r.newFiles
+	if side == tflint.SideOld {
+		files = r.oldFiles
+	}
+	file, ok := files[issueRange.Filename]
+	if !ok || file == nil {
+		return false
+	}
+	return tflint.IsIgnored(file.Bytes, rule.Name(), issueRange)
+}
+
+// ruleSeverity returns the severity of rule, or 0 if rule is nil.
+func ruleSeverity(rule tflint.Rule) tflint.Severity {
+	if rule == nil {
+		return 0
+	}
+	return rule.Severity()
+}
+
+// DecodeRuleConfig decodes rule configuration provided via TestRunnerWithConfig.
+// Returns nil without modifying target if no configuration exists for the rule.
+func (r *Runner) DecodeRuleConfig(ruleName string, target any) error {
+	_, err := r.DecodeRuleConfigExists(ruleName, target)
+	return err
+}
+
+// DecodeRuleConfigExists decodes rule configuration like DecodeRuleConfig,
+// reporting whether TestRunnerWithConfig provided configuration for the rule.
+func (r *Runner) DecodeRuleConfigExists(ruleName string, target any) (bool, error) {
+	body, ok := r.ruleConfigs[ruleName]
+	if !ok {
+		return false, nil
+	}
+
+	diags := gohcl.DecodeBody(body, nil, target)
+	if diags.HasErrors() {
+		return true, diags
+	}
+	return true, nil
+}
+
+// getFile looks up a parsed file by name.
+func getFile(files map[string]*hcl.File, filename string) (*hcl.File, error) {
+	file, ok := files[filename]
+	if !ok {
+		return nil, fmt.Errorf("file not found: %s", filename)
+	}
+	return file, nil
+}
+
+// listFiles returns the sorted names of files.
+func listFiles(files map[string]*hcl.File) []string {
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
 // getModuleContent extracts content from files using the schema.
-func (r *Runner) getModuleContent(files map[string]*hcl.File, schema *hclext.BodySchema) (*hclext.BodyContent, error) {
+// Attributes marked Required must be present; all missing attributes are
+// reported together as hcl.Diagnostics. With tflint.ExpandModeExpand,
+// dynamic blocks are expanded into the blocks they generate.
+func (r *Runner) getModuleContent(files map[string]*hcl.File, schema *hclext.BodySchema, opts *tflint.GetModuleContentOption) (*hclext.BodyContent, error) {
+	content, err := r.extractModuleContent(files, schema, opts)
+	if err != nil {
+		return nil, err
+	}
+	if diags := hclext.ValidateRequired(schema, content); diags.HasErrors() {
+		return nil, diags
+	}
+	return content, nil
+}
+
+// extractModuleContent extracts content from files using the schema without
+// checking required attributes. A required module-level attribute may be
+// defined in any file, so Required can only be checked on the merged content.
+// Only the root module is read, unless opts asks for tflint.ModuleCtxAll;
+// then the blocks of every module follow, with their ModulePath set. See
+// moduleInstances for how files are assigned to modules.
+func (r *Runner) extractModuleContent(files map[string]*hcl.File, schema *hclext.BodySchema, opts *tflint.GetModuleContentOption) (*hclext.BodyContent, error) {
+	modules := moduleInstances(files, r.modules)
+	if opts == nil || opts.ModuleCtx != tflint.ModuleCtxAll {
+		modules = modules[:1]
+	}
+
+	content, err := r.extractFilesContent(modules[0].files, schema, opts)
+	if err != nil {
+		return nil, err
+	}
+	for _, module := range modules[1:] {
+		moduleContent, err := r.extractFilesContent(module.files, schema, opts)
+		if err != nil {
+			return nil, err
+		}
+		for _, block := range moduleContent.Blocks {
+			block.ModulePath = module.path
+		}
+		content.Blocks = append(content.Blocks, moduleContent.Blocks...)
+	}
+	return content, nil
+}
+
+// extractFilesContent extracts the content of the files of one module.
+func (r *Runner) extractFilesContent(files map[string]*hcl.File, schema *hclext.BodySchema, opts *tflint.GetModuleContentOption) (*hclext.BodyContent, error) {
 	content := &hclext.BodyContent{
 		Attributes: make(map[string]*hclext.Attribute),
 		Blocks:     make([]*hclext.Block, 0),
 	}
 
+	schema = hclext.WithoutRequired(schema)
 	hclSchema := hclext.ToHCLBodySchema(schema)
 
-	for _, file := range files {
-		bodyContent, _, diags := file.Body.PartialContent(hclSchema)
-		if diags.HasErrors() {
-			return nil, diags
+	var evalCtx *hcl.EvalContext
+	if opts != nil && opts.ExpandMode == tflint.ExpandModeExpand {
+		evalCtx = r.dynamicEvalContext(files)
+	}
+
+	var diags hcl.Diagnostics
+	for name, file := range files {
+		bodyContent, fileDiags := schemaContent(file.Body, schema, hclSchema)
+		if fileDiags.HasErrors() {
+			// Keep going so diagnostics from all files are reported together
+			diags = append(diags, fileDiags...)
+			continue
 		}
 
 		// Merge attributes
 		for name, attr := range bodyContent.Attributes {
-			content.Attributes[name] = hclext.FromHCLAttribute(attr)
+			a := hclext.FromHCLAttribute(attr)
+			a.SourceBytes = attr.Expr.Range().SliceBytes(file.Bytes)
+			content.Attributes[name] = a
 		}
 
 		// Append blocks
+		firstBlock := len(content.Blocks)
 		for _, block := range bodyContent.Blocks {
 			b := hclext.FromHCLBlock(block)
 			// Process nested body if schema specifies it
 			if schema != nil {
 				for _, bs := range schema.Blocks {
 					if bs.Type == block.Type && bs.Body != nil {
-						nestedContent, err := r.extractBlockContent(block.Body, bs.Body)
+						body := block.Body
+						if evalCtx != nil {
+							body = expandDynamicBlocks(body, evalCtx)
+						}
+						nestedContent, err := r.extractBlockContent(body, bs.Body)
 						if err != nil {
 							return nil, err
 						}
+						fillSourceBytes(nestedContent, file.Bytes)
 						b.Body = nestedContent
 					}
 				}
 			}
 			content.Blocks = append(content.Blocks, b)
 		}
+
+		// Attach comments, which HCL discards, to this file's blocks
+		hclext.FillLeadingComments(&hclext.BodyContent{Blocks: content.Blocks[firstBlock:]}, file.Bytes, name)
 	}
 
+	if diags.HasErrors() {
+		return nil, diags
+	}
 	return content, nil
 }
 
 // getResourceContent extracts resources of a specific type.
-func (r *Runner) getResourceContent(files map[string]*hcl.File, resourceType string, bodySchema *hclext.BodySchema) (*hclext.BodyContent, error) {
-	// Create a schema that looks for resource blocks
-	resourceSchema := &hclext.BodySchema{
+func (r *Runner) getResourceContent(files map[string]*hcl.File, resourceType string, bodySchema *hclext.BodySchema, opts *tflint.GetModuleContentOption) (*hclext.BodyContent, error) {
+	return r.getBlockContent(files, "resource", []string{"type", "name"}, []string{resourceType}, bodySchema, opts)
+}
+
+// getDataSourceContent extracts data sources of a specific type.
+func (r *Runner) getDataSourceContent(files map[string]*hcl.File, dataSourceType string, bodySchema *hclext.BodySchema, opts *tflint.GetModuleContentOption) (*hclext.BodyContent, error) {
+	return r.getBlockContent(files, "data", []string{"type", "name"}, []string{dataSourceType}, bodySchema, opts)
+}
+
+// getBlockContent extracts top-level blocks of blockType with the given label
+// names, keeping only blocks whose leading labels equal labelPrefix.
+func (r *Runner) getBlockContent(files map[string]*hcl.File, blockType string, labelNames, labelPrefix []string, bodySchema *hclext.BodySchema, opts *tflint.GetModuleContentOption) (*hclext.BodyContent, error) {
+	// Create a schema that looks for the requested blocks
+	blockSchema := &hclext.BodySchema{
 		Blocks: []hclext.BlockSchema{
 			{
-				Type:       "resource",
-				LabelNames: []string{"type", "name"},
+				Type:       blockType,
+				LabelNames: labelNames,
 				Body:       bodySchema,
 			},
 		},
 	}
 
-	allContent, err := r.getModuleContent(files, resourceSchema)
+	allContent, err := r.extractModuleContent(files, blockSchema, opts)
 	if err != nil {
 		return nil, err
 	}
 
-	// Filter to only the requested resource type
+	// Filter to only the requested blocks
 	result := &hclext.BodyContent{
 		Attributes: make(map[string]*hclext.Attribute),
 		Blocks:     make([]*hclext.Block, 0),
 	}
 
 	for _, block := range allContent.Blocks {
-		if block.Type == "resource" && len(block.Labels) >= 1 && block.Labels[0] == resourceType {
+		if block.Type == blockType && len(block.Labels) >= len(labelPrefix) && labelsMatch(block.Labels[:len(labelPrefix)], labelPrefix) {
 			result.Blocks = append(result.Blocks, block)
 		}
 	}
 
+	// Check required attributes only on the requested blocks
+	if diags := hclext.ValidateRequired(blockSchema, result); diags.HasErrors() {
+		return nil, diags
+	}
 	return result, nil
 }
 
 // extractBlockContent extracts nested block content recursively.
+// With hclext.SchemaJustAttributesMode, every attribute of the body is
+// extracted, whether declared or not.
 func (r *Runner) extractBlockContent(body hcl.Body, schema *hclext.BodySchema) (*hclext.BodyContent, error) {
 	if body == nil || schema == nil {
 		return nil, nil
 	}
+	if schema.Mode == hclext.SchemaJustAttributesMode {
+		return justAttributesContent(body, schema)
+	}
 
 	hclSchema := hclext.ToHCLBodySchema(schema)
-	bodyContent, _, diags := body.PartialContent(hclSchema)
-	if diags.HasErrors() {
+	bodyContent, diags := schemaContent(body, schema, hclSchema)
+	if diags = withoutSkippedDynamicBlocks(diags); diags.HasErrors() {
 		return nil, diags
 	}
 
 	content := hclext.FromHCLBodyContent(bodyContent)
 
-	// Recursively process nested blocks
-	for i, block := range content.Blocks {
+	// Recursively process nested blocks. Blocks are converted in order, so
+	// each extracted block corresponds to the HCL block at the same index;
+	// matching by type and labels would conflate repeated unlabeled blocks.
+	for i, hclBlock := range bodyContent.Blocks {
 		for _, bs := range schema.Blocks {
-			if bs.Type == block.Type && bs.Body != nil {
-				// Find the original HCL block to get its body
-				for _, hclBlock := range bodyContent.Blocks {
-					if hclBlock.Type == block.Type && labelsMatch(hclBlock.Labels, block.Labels) {
-						nestedContent, err := r.extractBlockContent(hclBlock.Body, bs.Body)
-						if err != nil {
-							return nil, err
-						}
-						content.Blocks[i].Body = nestedContent
-						break
-					}
+			if bs.Type == hclBlock.Type && bs.Body != nil {
+				nestedContent, err := r.extractBlockContent(hclBlock.Body, bs.Body)
+				if err != nil {
+					return nil, err
 				}
+				content.Blocks[i].Body = nestedContent
+				break
 			}
 		}
 	}
@@ -246,6 +823,52 @@ func (r *Runner) extractBlockContent(body hcl.Body, schema *hclext.BodySchema) (
 	return content, nil
 }
 
+// schemaContent extracts the content of body matching hclSchema, the HCL
+// form of schema. With hclext.SchemaExactMode, attributes and blocks that
+// schema does not declare are errors.
+func schemaContent(body hcl.Body, schema *hclext.BodySchema, hclSchema *hcl.BodySchema) (*hcl.BodyContent, hcl.Diagnostics) {
+	if schema != nil && schema.Mode == hclext.SchemaExactMode {
+		return body.Content(hclSchema)
+	}
+	content, _, diags := body.PartialContent(hclSchema)
+	return content, diags
+}
+
+// justAttributesContent extracts all attributes of body. Like HCL, it does
+// not allow blocks: declaring nested block schemas is an error, as is a body
+// containing blocks.
+func justAttributesContent(body hcl.Body, schema *hclext.BodySchema) (*hclext.BodyContent, error) {
+	if len(schema.Blocks) > 0 {
+		return nil, hcl.Diagnostics{{
+			Severity: hcl.DiagError,
+			Summary:  "Invalid schema",
+			Detail:   fmt.Sprintf("A schema in JustAttributes mode cannot declare nested blocks, got %q.", schema.Blocks[0].Type),
+		}}
+	}
+
+	attrs, diags := body.JustAttributes()
+	if diags.HasErrors() {
+		return nil, diags
+	}
+	return hclext.FromHCLBodyContent(&hcl.BodyContent{Attributes: attrs}), nil
+}
+
+// fillSourceBytes populates SourceBytes for all attributes in content
+// (recursively) from the source of the file they were extracted from.
+func fillSourceBytes(content *hclext.BodyContent, src []byte) {
+	if content == nil {
+		return
+	}
+	for _, attr := range content.Attributes {
+		if attr.Expr != nil {
+			attr.SourceBytes = attr.Expr.Range().SliceBytes(src)
+		}
+	}
+	for _, block := range content.Blocks {
+		fillSourceBytes(block.Body, src)
+	}
+}
+
 // labelsMatch checks if two label slices are equal.
 func labelsMatch(a, b []string) bool {
 	if len(a) != len(b) {
@@ -1,11 +1,20 @@
 package helper
 
 import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"reflect"
+	"slices"
+	"strings"
+	"sync"
 	"testing"
 
 	"github.com/hashicorp/hcl/v2"
 	"github.com/jokarl/tfbreak-plugin-sdk/hclext"
 	"github.com/jokarl/tfbreak-plugin-sdk/tflint"
+	"github.com/zclconf/go-cty/cty"
 )
 
 // testRule is a minimal rule for testing.
@@ -16,7 +25,7 @@ type testRule struct {
 
 func (r *testRule) Name() string        { return r.name }
 func (r *testRule) Link() string        { return "" }
-func (r *testRule) Check(_ tflint.Runner) error { return nil }
+func (r *testRule) Check(_ context.Context, _ tflint.Runner) error { return nil }
 
 func TestTestRunner_ParsesOldFiles(t *testing.T) {
 	runner := TestRunner(t,
@@ -88,6 +97,98 @@ func TestTestRunner_EmptyFiles(t *testing.T) {
 	}
 }
 
+func TestTestRunner_ParsesJSONFiles(t *testing.T) {
+	runner := TestRunner(t,
+		map[string]string{
+			"main.tf": `
+resource "azurerm_resource_group" "rg" {
+  name     = "example"
+  location = "westus"
+}`,
+		},
+		map[string]string{
+			"main.tf.json": `{
+  "resource": {
+    "azurerm_resource_group": {
+      "rg": {
+        "name": "example",
+        "location": "westus"
+      }
+    }
+  }
+}`,
+		},
+	)
+
+	schema := &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{
+			{Name: "name"},
+			{Name: "location"},
+		},
+	}
+
+	oldContent, err := runner.GetOldResourceContent("azurerm_resource_group", schema, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	newContent, err := runner.GetNewResourceContent("azurerm_resource_group", schema, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(oldContent.Blocks) != 1 || len(newContent.Blocks) != 1 {
+		t.Fatalf("expected 1 block on each side, got %d old and %d new", len(oldContent.Blocks), len(newContent.Blocks))
+	}
+	oldBlock, newBlock := oldContent.Blocks[0], newContent.Blocks[0]
+	if oldBlock.Address() != newBlock.Address() {
+		t.Errorf("address mismatch: old %q, new %q", oldBlock.Address(), newBlock.Address())
+	}
+	if newBlock.DefRange.Filename != "main.tf.json" {
+		t.Errorf("expected block from main.tf.json, got %s", newBlock.DefRange.Filename)
+	}
+	if diff := hclext.DiffBodyContent(oldBlock.Body, newBlock.Body); !diff.IsEmpty() {
+		t.Errorf("expected HCL and JSON content to match, got %+v", diff)
+	}
+	if v, _ := hclext.AttributeValue(newBlock.Body.Attributes["location"]); !v.RawEquals(cty.StringVal("westus")) {
+		t.Errorf("expected location westus, got %#v", v)
+	}
+}
+
+func TestTestRunnerFromDir(t *testing.T) {
+	runner := TestRunnerFromDir(t, "testdata/fromdir/old", "testdata/fromdir/new")
+
+	for _, side := range []struct {
+		name  string
+		files map[string]*hcl.File
+	}{
+		{"old", runner.oldFiles},
+		{"new", runner.newFiles},
+	} {
+		if len(side.files) != 3 {
+			t.Errorf("expected 3 %s files, got %d", side.name, len(side.files))
+		}
+		if side.files["main.tf"] == nil {
+			t.Errorf("expected main.tf in %s files", side.name)
+		}
+		if side.files["modules/network/main.tf"] == nil {
+			t.Errorf("expected modules/network/main.tf in %s files", side.name)
+		}
+		if side.files["variables.tf.json"] == nil {
+			t.Errorf("expected variables.tf.json in %s files", side.name)
+		}
+	}
+
+	content, err := runner.GetNewResourceContent("azurerm_resource_group", &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{{Name: "location"}},
+	}, nil)
+	if err != nil {
+		t.Fatalf("GetNewResourceContent failed: %v", err)
+	}
+	if len(content.Blocks) != 1 {
+		t.Fatalf("expected 1 resource, got %d", len(content.Blocks))
+	}
+}
+
 func TestRunner_GetOldResourceContent(t *testing.T) {
 	runner := TestRunner(t,
 		map[string]string{
@@ -158,136 +259,2295 @@ resource "azurerm_resource_group" "example" {
 		t.Fatalf("GetNewResourceContent failed: %v", err)
 	}
 
-	if len(content.Blocks) != 1 {
-		t.Fatalf("expected 1 block, got %d", len(content.Blocks))
+	if len(content.Blocks) != 1 {
+		t.Fatalf("expected 1 block, got %d", len(content.Blocks))
+	}
+}
+
+func TestRunner_GetResourceContentPair(t *testing.T) {
+	runner := TestRunner(t,
+		map[string]string{
+			"main.tf": `
+resource "azurerm_resource_group" "example" {
+  location = "westeurope"
+}`,
+		},
+		map[string]string{
+			"main.tf": `
+resource "azurerm_resource_group" "example" {
+  location = "eastus"
+}
+
+resource "azurerm_resource_group" "added" {
+  location = "eastus"
+}`,
+		},
+	)
+	schema := &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{{Name: "location"}},
+	}
+
+	oldContent, newContent, err := runner.GetResourceContentPair("azurerm_resource_group", schema, nil)
+	if err != nil {
+		t.Fatalf("GetResourceContentPair() error = %v", err)
+	}
+	wantOld, err := runner.GetOldResourceContent("azurerm_resource_group", schema, nil)
+	if err != nil {
+		t.Fatalf("GetOldResourceContent() error = %v", err)
+	}
+	wantNew, err := runner.GetNewResourceContent("azurerm_resource_group", schema, nil)
+	if err != nil {
+		t.Fatalf("GetNewResourceContent() error = %v", err)
+	}
+
+	if !reflect.DeepEqual(oldContent, wantOld) {
+		t.Errorf("old content = %+v, want %+v", oldContent, wantOld)
+	}
+	if !reflect.DeepEqual(newContent, wantNew) {
+		t.Errorf("new content = %+v, want %+v", newContent, wantNew)
+	}
+	if len(oldContent.Blocks) != 1 || len(newContent.Blocks) != 2 {
+		t.Errorf("got %d old and %d new blocks, want 1 and 2", len(oldContent.Blocks), len(newContent.Blocks))
+	}
+}
+
+func TestRunner_GetResourceContent_FiltersByType(t *testing.T) {
+	runner := TestRunner(t,
+		map[string]string{
+			"main.tf": `
+resource "azurerm_resource_group" "rg" {
+  name = "my-rg"
+}
+resource "azurerm_virtual_network" "vnet" {
+  name = "my-vnet"
+}
+resource "azurerm_resource_group" "rg2" {
+  name = "my-rg-2"
+}`,
+		},
+		map[string]string{},
+	)
+
+	schema := &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{
+			{Name: "name"},
+		},
+	}
+
+	content, err := runner.GetOldResourceContent("azurerm_resource_group", schema, nil)
+	if err != nil {
+		t.Fatalf("GetOldResourceContent failed: %v", err)
+	}
+
+	if len(content.Blocks) != 2 {
+		t.Errorf("expected 2 resource_group blocks, got %d", len(content.Blocks))
+	}
+
+	for _, block := range content.Blocks {
+		if block.Labels[0] != "azurerm_resource_group" {
+			t.Errorf("unexpected resource type: %s", block.Labels[0])
+		}
+	}
+}
+
+func TestRunner_GetDataSourceContent_MixedBlocks(t *testing.T) {
+	src := `
+resource "azurerm_resource_group" "rg" {
+  name = "resource-rg"
+}
+data "azurerm_resource_group" "existing" {
+  name = "data-rg"
+}
+data "azurerm_client_config" "current" {}
+`
+	runner := TestRunner(t,
+		map[string]string{"main.tf": src},
+		map[string]string{"main.tf": src},
+	)
+
+	schema := &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{
+			{Name: "name"},
+		},
+	}
+
+	resources, err := runner.GetOldResourceContent("azurerm_resource_group", schema, nil)
+	if err != nil {
+		t.Fatalf("GetOldResourceContent failed: %v", err)
+	}
+	if len(resources.Blocks) != 1 || resources.Blocks[0].Type != "resource" || resources.Blocks[0].Labels[1] != "rg" {
+		t.Errorf("expected only resource rg, got %d blocks", len(resources.Blocks))
+	}
+
+	dataSources, err := runner.GetOldDataSourceContent("azurerm_resource_group", schema, nil)
+	if err != nil {
+		t.Fatalf("GetOldDataSourceContent failed: %v", err)
+	}
+	if len(dataSources.Blocks) != 1 {
+		t.Fatalf("expected 1 data source, got %d", len(dataSources.Blocks))
+	}
+	block := dataSources.Blocks[0]
+	if block.Type != "data" || block.Labels[1] != "existing" {
+		t.Errorf("data source = %s %v, want data [azurerm_resource_group existing]", block.Type, block.Labels)
+	}
+	if block.Body.Attributes["name"] == nil {
+		t.Error("expected name attribute in data source body")
+	}
+
+	newDataSources, err := runner.GetNewDataSourceContent("azurerm_client_config", schema, nil)
+	if err != nil {
+		t.Fatalf("GetNewDataSourceContent failed: %v", err)
+	}
+	if len(newDataSources.Blocks) != 1 || newDataSources.Blocks[0].Labels[1] != "current" {
+		t.Errorf("expected data source current, got %d blocks", len(newDataSources.Blocks))
+	}
+}
+
+func TestRunner_RequiredAttribute(t *testing.T) {
+	runner := TestRunner(t,
+		map[string]string{"main.tf": `
+resource "azurerm_resource_group" "rg" {
+  location = "westus"
+}`},
+		map[string]string{"main.tf": `
+resource "azurerm_resource_group" "rg" {
+}
+resource "azurerm_virtual_network" "vnet" {
+}`},
+	)
+
+	schema := &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{
+			{Name: "location", Required: true},
+		},
+	}
+
+	if _, err := runner.GetOldResourceContent("azurerm_resource_group", schema, nil); err != nil {
+		t.Errorf("GetOldResourceContent with required attribute present failed: %v", err)
+	}
+
+	_, err := runner.GetNewResourceContent("azurerm_resource_group", schema, nil)
+	if err == nil {
+		t.Fatal("GetNewResourceContent should fail when a required attribute is missing")
+	}
+	if !strings.Contains(err.Error(), `"location" is required`) {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	// Blocks of other types are not validated against the schema
+	if _, err := runner.GetNewResourceContent("azurerm_storage_account", schema, nil); err != nil {
+		t.Errorf("GetNewResourceContent for other type failed: %v", err)
+	}
+}
+
+func TestRunner_RequiredAttribute_AcrossFiles(t *testing.T) {
+	runner := TestRunner(t,
+		map[string]string{
+			"main.tf":      `resource "azurerm_resource_group" "rg" {}`,
+			"terraform.tf": `required_version = ">= 1.0"`,
+		},
+		map[string]string{},
+	)
+
+	schema := &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{
+			{Name: "required_version", Required: true},
+		},
+	}
+
+	content, err := runner.GetOldModuleContent(schema, nil)
+	if err != nil {
+		t.Fatalf("GetOldModuleContent failed: %v", err)
+	}
+	if content.Attributes["required_version"] == nil {
+		t.Error("expected required_version attribute")
+	}
+
+	if _, err := runner.GetNewModuleContent(schema, nil); err == nil {
+		t.Error("GetNewModuleContent should fail when a required attribute is missing from all files")
+	}
+}
+
+func TestRunner_LeadingComments(t *testing.T) {
+	runner := TestRunner(t,
+		map[string]string{"main.tf": `
+# tfbreak:ignore test_rule
+resource "azurerm_resource_group" "ignored" {
+  name = "ignored"
+}
+
+resource "azurerm_resource_group" "checked" {
+  name = "checked"
+}
+`},
+		map[string]string{},
+	)
+
+	content, err := runner.GetOldResourceContent("azurerm_resource_group", &hclext.BodySchema{}, nil)
+	if err != nil {
+		t.Fatalf("GetOldResourceContent failed: %v", err)
+	}
+	if len(content.Blocks) != 2 {
+		t.Fatalf("expected 2 blocks, got %d", len(content.Blocks))
+	}
+
+	var checked []string
+	for _, block := range content.Blocks {
+		if slices.Contains(block.LeadingComments, "# tfbreak:ignore test_rule") {
+			continue
+		}
+		checked = append(checked, block.Labels[1])
+	}
+	if !reflect.DeepEqual(checked, []string{"checked"}) {
+		t.Errorf("checked blocks = %v, want [checked]", checked)
+	}
+}
+
+func TestRunner_GetOldModuleContent(t *testing.T) {
+	runner := TestRunner(t,
+		map[string]string{
+			"main.tf": `
+variable "location" {
+  default = "westeurope"
+}`,
+		},
+		map[string]string{},
+	)
+
+	schema := &hclext.BodySchema{
+		Blocks: []hclext.BlockSchema{
+			{
+				Type:       "variable",
+				LabelNames: []string{"name"},
+			},
+		},
+	}
+
+	content, err := runner.GetOldModuleContent(schema, nil)
+	if err != nil {
+		t.Fatalf("GetOldModuleContent failed: %v", err)
+	}
+
+	if len(content.Blocks) != 1 {
+		t.Fatalf("expected 1 variable block, got %d", len(content.Blocks))
+	}
+
+	if content.Blocks[0].Labels[0] != "location" {
+		t.Errorf("variable name = %q, want %q", content.Blocks[0].Labels[0], "location")
+	}
+}
+
+func TestRunner_SourceBytes(t *testing.T) {
+	runner := TestRunner(t,
+		map[string]string{},
+		map[string]string{
+			"main.tf": `
+resource "azurerm_resource_group" "rg" {
+  name     = "${var.prefix}-rg"
+  location = "westeurope"
+
+  timeouts {
+    create = var.timeout
+  }
+}`,
+		},
+	)
+
+	content, err := runner.GetNewResourceContent("azurerm_resource_group", &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{{Name: "name"}, {Name: "location"}},
+		Blocks: []hclext.BlockSchema{
+			{
+				Type: "timeouts",
+				Body: &hclext.BodySchema{
+					Attributes: []hclext.AttributeSchema{{Name: "create"}},
+				},
+			},
+		},
+	}, nil)
+	if err != nil {
+		t.Fatalf("GetNewResourceContent failed: %v", err)
+	}
+
+	body := content.Blocks[0].Body
+	if got := string(body.Attributes["name"].SourceBytes); got != `"${var.prefix}-rg"` {
+		t.Errorf("name SourceBytes = %q, want %q", got, `"${var.prefix}-rg"`)
+	}
+	if got := string(body.Attributes["location"].SourceBytes); got != `"westeurope"` {
+		t.Errorf("location SourceBytes = %q, want %q", got, `"westeurope"`)
+	}
+	if got := string(body.Blocks[0].Body.Attributes["create"].SourceBytes); got != "var.timeout" {
+		t.Errorf("create SourceBytes = %q, want %q", got, "var.timeout")
+	}
+}
+
+func TestRunner_ItemRanges(t *testing.T) {
+	runner := TestRunner(t,
+		map[string]string{},
+		map[string]string{
+			"main.tf": `
+resource "azurerm_resource_group" "rg" {
+  tags = {
+    env  = "prod"
+    team = "platform"
+  }
+}`,
+		},
+	)
+
+	content, err := runner.GetNewResourceContent("azurerm_resource_group", &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{{Name: "tags"}},
+	}, nil)
+	if err != nil {
+		t.Fatalf("GetNewResourceContent failed: %v", err)
+	}
+
+	tags := content.Blocks[0].Body.Attributes["tags"]
+	env, team := tags.ItemRanges["env"], tags.ItemRanges["team"]
+	if env.Start.Line != 4 || team.Start.Line != 5 {
+		t.Errorf("item lines = env %d, team %d, want 4 and 5", env.Start.Line, team.Start.Line)
+	}
+
+	// An issue can point at a single map entry
+	rule := &testRule{name: "tags_rule"}
+	if err := runner.EmitIssue(rule, "team tag changed", team); err != nil {
+		t.Fatalf("EmitIssue failed: %v", err)
+	}
+	if got := runner.GetIssues()[0].Range; got != team {
+		t.Errorf("issue range = %v, want %v", got, team)
+	}
+}
+
+func TestRunner_GetModuleDiff(t *testing.T) {
+	runner := TestRunner(t,
+		map[string]string{
+			"main.tf": `
+resource "azurerm_resource_group" "kept" {
+  location = "westeurope"
+}
+resource "azurerm_resource_group" "changed" {
+  location = "westeurope"
+}
+resource "azurerm_resource_group" "removed" {
+  location = "westeurope"
+}`,
+		},
+		map[string]string{
+			"main.tf": `
+resource "azurerm_resource_group" "kept" {
+  location = "westeurope"
+}
+resource "azurerm_resource_group" "changed" {
+  location = "eastus"
+}
+resource "azurerm_resource_group" "added" {
+  location = "westeurope"
+}`,
+		},
+	)
+
+	schema := &hclext.BodySchema{
+		Blocks: []hclext.BlockSchema{
+			{
+				Type:       "resource",
+				LabelNames: []string{"type", "name"},
+				Body: &hclext.BodySchema{
+					Attributes: []hclext.AttributeSchema{{Name: "location"}},
+				},
+			},
+		},
+	}
+
+	diff, err := runner.GetModuleDiff(schema, nil)
+	if err != nil {
+		t.Fatalf("GetModuleDiff failed: %v", err)
+	}
+
+	if len(diff.Added) != 1 || diff.Added[0].Labels[1] != "added" {
+		t.Errorf("expected only 'added' in Added, got %d blocks", len(diff.Added))
+	}
+	if len(diff.Removed) != 1 || diff.Removed[0].Labels[1] != "removed" {
+		t.Errorf("expected only 'removed' in Removed, got %d blocks", len(diff.Removed))
+	}
+	if len(diff.Changed) != 1 {
+		t.Fatalf("expected 1 changed block, got %d", len(diff.Changed))
+	}
+	if diff.Changed[0].Address != "azurerm_resource_group.changed" {
+		t.Errorf("changed address = %q, want %q", diff.Changed[0].Address, "azurerm_resource_group.changed")
+	}
+}
+
+func TestRunner_ModuleCtxAll(t *testing.T) {
+	files := map[string]string{
+		"main.tf": `
+module "app" {
+  source = "./modules/instance"
+}
+module "worker" {
+  source = "./modules/instance"
+}
+resource "aws_instance" "web" {
+  instance_type = "t3.micro"
+}`,
+		"modules/instance/main.tf": `
+resource "aws_instance" "web" {
+  instance_type = "t3.large"
+}`,
+	}
+	runner := TestRunner(t, files, files)
+	schema := &hclext.BodySchema{Attributes: []hclext.AttributeSchema{{Name: "instance_type"}}}
+
+	content, err := runner.GetNewResourceContent("aws_instance", schema, nil)
+	if err != nil {
+		t.Fatalf("GetNewResourceContent failed: %v", err)
+	}
+	if len(content.Blocks) != 1 || content.Blocks[0].FullAddress() != "aws_instance.web" {
+		t.Errorf("expected only the root module's aws_instance.web, got %d blocks", len(content.Blocks))
+	}
+
+	content, err = runner.GetNewResourceContent("aws_instance", schema, &tflint.GetModuleContentOption{ModuleCtx: tflint.ModuleCtxAll})
+	if err != nil {
+		t.Fatalf("GetNewResourceContent failed: %v", err)
+	}
+	var got []string
+	for _, block := range content.Blocks {
+		if block.Address() != "aws_instance.web" {
+			t.Errorf("Address() = %q, want aws_instance.web", block.Address())
+		}
+		got = append(got, block.FullAddress())
+	}
+	want := []string{"aws_instance.web", "module.app.aws_instance.web", "module.worker.aws_instance.web"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("FullAddress() = %v, want %v", got, want)
+	}
+}
+
+func TestTestRunnerWithModules_ModuleCtxAll(t *testing.T) {
+	runner := TestRunnerWithModules(t,
+		map[string]string{
+			"main.tf": `
+module "app" {
+  source  = "example/app/aws"
+  version = "1.0.0"
+}
+resource "aws_instance" "web" {
+  instance_type = "t3.micro"
+}`,
+		},
+		map[string]map[string]string{
+			"app":    {"main.tf": `resource "aws_instance" "app" { instance_type = "t3.large" }`},
+			"app.db": {"main.tf": `resource "aws_db_instance" "main" {}`},
+		},
+	)
+	schema := &hclext.BodySchema{Attributes: []hclext.AttributeSchema{{Name: "instance_type"}}}
+
+	content, err := runner.GetOldResourceContent("aws_instance", schema, nil)
+	if err != nil {
+		t.Fatalf("GetOldResourceContent failed: %v", err)
+	}
+	if len(content.Blocks) != 1 || content.Blocks[0].FullAddress() != "aws_instance.web" {
+		t.Errorf("expected only the root module's aws_instance.web, got %d blocks", len(content.Blocks))
+	}
+
+	content, err = runner.GetOldResourceContent("aws_instance", schema, &tflint.GetModuleContentOption{ModuleCtx: tflint.ModuleCtxAll})
+	if err != nil {
+		t.Fatalf("GetOldResourceContent failed: %v", err)
+	}
+	var got []string
+	for _, block := range content.Blocks {
+		got = append(got, block.FullAddress())
+	}
+	if want := []string{"aws_instance.web", "module.app.aws_instance.app"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("FullAddress() = %v, want %v", got, want)
+	}
+	if content.Blocks[1].DefRange.Filename != "modules/app/main.tf" {
+		t.Errorf("module block filename = %q, want %q", content.Blocks[1].DefRange.Filename, "modules/app/main.tf")
+	}
+
+	content, err = runner.GetNewResourceContent("aws_db_instance", nil, &tflint.GetModuleContentOption{ModuleCtx: tflint.ModuleCtxAll})
+	if err != nil {
+		t.Fatalf("GetNewResourceContent failed: %v", err)
+	}
+	if len(content.Blocks) != 1 || !reflect.DeepEqual(content.Blocks[0].ModulePath, []string{"app", "db"}) {
+		t.Errorf("expected aws_db_instance.main in module app.db, got %d blocks", len(content.Blocks))
+	}
+
+	resources, err := runner.GetAllNewResources()
+	if err != nil {
+		t.Fatalf("GetAllNewResources failed: %v", err)
+	}
+	if len(resources) != 1 {
+		t.Errorf("GetAllNewResources returned %d resources, want the root module's only", len(resources))
+	}
+}
+
+func TestRunner_GetModuleDiff_ModuleCtxAll(t *testing.T) {
+	oldFiles := map[string]string{
+		"main.tf": `
+module "app" {
+  source = "./modules/app"
+}
+module "worker" {
+  source = "./modules/worker"
+}`,
+		"modules/app/main.tf":    `resource "aws_instance" "web" { instance_type = "t3.micro" }`,
+		"modules/worker/main.tf": `resource "aws_instance" "web" { instance_type = "t3.micro" }`,
+	}
+	newFiles := maps.Clone(oldFiles)
+	newFiles["modules/worker/main.tf"] = `resource "aws_instance" "web" { instance_type = "t3.large" }`
+	runner := TestRunner(t, oldFiles, newFiles)
+
+	schema := &hclext.BodySchema{
+		Blocks: []hclext.BlockSchema{{
+			Type:       "resource",
+			LabelNames: []string{"type", "name"},
+			Body:       &hclext.BodySchema{Attributes: []hclext.AttributeSchema{{Name: "instance_type"}}},
+		}},
+	}
+	diff, err := runner.GetModuleDiff(schema, &tflint.GetModuleContentOption{ModuleCtx: tflint.ModuleCtxAll})
+	if err != nil {
+		t.Fatalf("GetModuleDiff failed: %v", err)
+	}
+
+	if len(diff.Added) != 0 || len(diff.Removed) != 0 {
+		t.Errorf("expected no added or removed blocks, got %d added, %d removed", len(diff.Added), len(diff.Removed))
+	}
+	if len(diff.Changed) != 1 || diff.Changed[0].Address != "module.worker.aws_instance.web" {
+		t.Fatalf("expected only module.worker.aws_instance.web to change, got %d changes", len(diff.Changed))
+	}
+}
+
+func TestRunner_GetModuleDiff_RenamedFile(t *testing.T) {
+	runner := TestRunner(t,
+		map[string]string{
+			"main.tf": `
+resource "azurerm_resource_group" "rg" {
+  name     = "example"
+  location = "westeurope"
+
+  timeouts {
+    create = "30m"
+  }
+}`,
+		},
+		map[string]string{
+			"variables.tf": `variable "unused" {}`,
+			"resources.tf": `
+# moved here from main.tf
+resource "azurerm_resource_group" "rg" {
+  location = "westeurope"
+  name     = "example"
+
+  timeouts {
+    create = "30m"
+  }
+}`,
+		},
+	)
+
+	schema := &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{{Name: "name"}, {Name: "location"}},
+		Blocks: []hclext.BlockSchema{
+			{Type: "timeouts", Body: &hclext.BodySchema{Attributes: []hclext.AttributeSchema{{Name: "create"}}}},
+		},
+	}
+
+	oldContent, err := runner.GetOldResourceContent("azurerm_resource_group", schema, nil)
+	if err != nil {
+		t.Fatalf("GetOldResourceContent failed: %v", err)
+	}
+	newContent, err := runner.GetNewResourceContent("azurerm_resource_group", schema, nil)
+	if err != nil {
+		t.Fatalf("GetNewResourceContent failed: %v", err)
+	}
+	if len(oldContent.Blocks) != 1 || len(newContent.Blocks) != 1 {
+		t.Fatalf("expected 1 block on each side, got %d old and %d new", len(oldContent.Blocks), len(newContent.Blocks))
+	}
+	oldBlock, newBlock := oldContent.Blocks[0], newContent.Blocks[0]
+	if oldBlock.DefRange.Filename != "main.tf" || newBlock.DefRange.Filename != "resources.tf" {
+		t.Fatalf("blocks come from %s and %s, want main.tf and resources.tf", oldBlock.DefRange.Filename, newBlock.DefRange.Filename)
+	}
+	if diff := hclext.DiffBodyContent(oldContent, newContent); !diff.IsEmpty() {
+		t.Errorf("expected no content differences across files, got %+v", diff)
+	}
+
+	diff, err := runner.GetModuleDiff(&hclext.BodySchema{
+		Blocks: []hclext.BlockSchema{
+			{Type: "resource", LabelNames: []string{"type", "name"}, Body: schema},
+		},
+	}, nil)
+	if err != nil {
+		t.Fatalf("GetModuleDiff failed: %v", err)
+	}
+	if len(diff.Added) != 0 || len(diff.Removed) != 0 || len(diff.Changed) != 0 {
+		t.Errorf("resource moved between files should be unchanged, got added %d, removed %d, changed %d",
+			len(diff.Added), len(diff.Removed), len(diff.Changed))
+	}
+}
+
+func TestRunner_GetResourceContent_Count(t *testing.T) {
+	runner := TestRunner(t,
+		map[string]string{
+			"main.tf": `
+resource "azurerm_resource_group" "rg" {
+  name     = "example"
+  location = "westeurope"
+}
+
+resource "azurerm_storage_account" "sa" {
+  for_each = toset(["a", "b"])
+  name     = each.key
+}`,
+		},
+		map[string]string{
+			"main.tf": `
+resource "azurerm_resource_group" "rg" {
+  count    = 2
+  name     = "example"
+  location = "westeurope"
+}`,
+		},
+	)
+
+	schema := &hclext.BodySchema{
+		Attributes: append(tflint.MetaArgumentsSchema(), hclext.AttributeSchema{Name: "name"}),
+	}
+	oldContent, err := runner.GetOldResourceContent("azurerm_resource_group", schema, nil)
+	if err != nil {
+		t.Fatalf("GetOldResourceContent failed: %v", err)
+	}
+	newContent, err := runner.GetNewResourceContent("azurerm_resource_group", schema, nil)
+	if err != nil {
+		t.Fatalf("GetNewResourceContent failed: %v", err)
+	}
+	oldBlock, newBlock := oldContent.Blocks[0], newContent.Blocks[0]
+	if oldBlock.HasCount() {
+		t.Error("old resource should not have count")
+	}
+	if !newBlock.HasCount() || newBlock.HasForEach() {
+		t.Errorf("new resource HasCount() = %v, HasForEach() = %v, want true, false", newBlock.HasCount(), newBlock.HasForEach())
+	}
+	if val, ok := newBlock.Body.Attributes["count"].AsNumber(); !ok || val != 2 {
+		t.Errorf("count = %v (ok %v), want 2", val, ok)
+	}
+
+	saContent, err := runner.GetOldResourceContent("azurerm_storage_account", schema, nil)
+	if err != nil {
+		t.Fatalf("GetOldResourceContent failed: %v", err)
+	}
+	if sa := saContent.Blocks[0]; !sa.HasForEach() || sa.HasCount() {
+		t.Errorf("storage account HasForEach() = %v, HasCount() = %v, want true, false", sa.HasForEach(), sa.HasCount())
+	}
+
+	// Without the meta-arguments in the schema, count is not extracted
+	withoutCount, err := runner.GetNewResourceContent("azurerm_resource_group", &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{{Name: "name"}},
+	}, nil)
+	if err != nil {
+		t.Fatalf("GetNewResourceContent failed: %v", err)
+	}
+	if withoutCount.Blocks[0].HasCount() {
+		t.Error("count should not be extracted unless the schema declares it")
+	}
+}
+
+func TestRunner_GetResourceContent_IgnoreChanges(t *testing.T) {
+	runner := TestRunner(t,
+		map[string]string{
+			"main.tf": `
+resource "azurerm_resource_group" "rg" {
+  name     = "example"
+  location = "westeurope"
+}`,
+		},
+		map[string]string{
+			"main.tf": `
+resource "azurerm_resource_group" "rg" {
+  name     = "example"
+  location = "northeurope"
+
+  lifecycle {
+    ignore_changes = [location]
+  }
+}`,
+		},
+	)
+
+	schema := &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{{Name: "name"}, {Name: "location"}},
+		Blocks:     []hclext.BlockSchema{tflint.LifecycleBlockSchema()},
+	}
+	oldContent, err := runner.GetOldResourceContent("azurerm_resource_group", schema, nil)
+	if err != nil {
+		t.Fatalf("GetOldResourceContent failed: %v", err)
+	}
+	newContent, err := runner.GetNewResourceContent("azurerm_resource_group", schema, nil)
+	if err != nil {
+		t.Fatalf("GetNewResourceContent failed: %v", err)
+	}
+	oldBlock, newBlock := oldContent.Blocks[0], newContent.Blocks[0]
+	if got := tflint.IgnoredChanges(newBlock); !reflect.DeepEqual(got, []string{"location"}) {
+		t.Errorf("IgnoredChanges() = %q, want [location]", got)
+	}
+	if got := tflint.IgnoredChanges(oldBlock); got != nil {
+		t.Errorf("IgnoredChanges() without lifecycle = %q, want nil", got)
+	}
+
+	// A rule comparing attributes can skip the ignored location change
+	var changed []string
+	for _, name := range []string{"name", "location"} {
+		if tflint.IgnoresChange(newBlock, name) {
+			continue
+		}
+		if !hclext.AttributesEqual(oldBlock.Body.Attributes[name], newBlock.Body.Attributes[name]) {
+			changed = append(changed, name)
+		}
+	}
+	if len(changed) != 0 {
+		t.Errorf("changed attributes = %v, want none", changed)
+	}
+
+	// Without LifecycleBlockSchema in the schema, the lifecycle block is not extracted
+	withoutLifecycle, err := runner.GetNewResourceContent("azurerm_resource_group", &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{{Name: "location"}},
+	}, nil)
+	if err != nil {
+		t.Fatalf("GetNewResourceContent failed: %v", err)
+	}
+	if got := tflint.IgnoredChanges(withoutLifecycle.Blocks[0]); got != nil {
+		t.Errorf("IgnoredChanges() without lifecycle in schema = %q, want nil", got)
+	}
+}
+
+func TestRunner_GetModuleContent_ExpandDynamicBlocks(t *testing.T) {
+	src := `
+variable "rules" {
+  default = ["http", "https"]
+}
+
+resource "azurerm_network_security_group" "main" {
+  dynamic "security_rule" {
+    for_each = ["ssh", "rdp", "winrm"]
+    content {
+      name = security_rule.value
+    }
+  }
+  dynamic "security_rule" {
+    for_each = var.rules
+    iterator = rule
+    content {
+      name = rule.value
+    }
+  }
+  dynamic "security_rule" {
+    for_each = var.unknown
+    content {
+      name = security_rule.value
+    }
+  }
+  dynamic "security_rule" {
+    for_each = local.rules
+    content {
+      name = security_rule.value
+    }
+  }
+  security_rule {
+    name = "static"
+  }
+}
+
+variable "unknown" {}`
+	runner := TestRunner(t, map[string]string{}, map[string]string{"main.tf": src})
+
+	schema := &hclext.BodySchema{
+		Blocks: []hclext.BlockSchema{
+			{
+				Type:       "resource",
+				LabelNames: []string{"type", "name"},
+				Body: &hclext.BodySchema{
+					Blocks: []hclext.BlockSchema{
+						{
+							Type: "security_rule",
+							Body: &hclext.BodySchema{
+								Attributes: []hclext.AttributeSchema{{Name: "name", Required: true}},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	content, err := runner.GetNewModuleContent(schema, &tflint.GetModuleContentOption{ExpandMode: tflint.ExpandModeExpand})
+	if err != nil {
+		t.Fatalf("GetNewModuleContent failed: %v", err)
+	}
+	if len(content.Blocks) != 1 {
+		t.Fatalf("expected 1 resource, got %d", len(content.Blocks))
+	}
+
+	// Dynamic blocks with an unknown for_each (no default, local) are skipped
+	var names []string
+	for _, block := range content.Blocks[0].Body.Blocks {
+		val, diags := block.Body.Attributes["name"].Expr.Value(nil)
+		if diags.HasErrors() {
+			t.Fatalf("failed to evaluate name: %s", diags)
+		}
+		names = append(names, val.AsString())
+	}
+	want := []string{"ssh", "rdp", "winrm", "http", "https", "static"}
+	if !reflect.DeepEqual(names, want) {
+		t.Errorf("security_rule names = %v, want %v", names, want)
+	}
+
+	// Without ExpandModeExpand, only the static block is extracted
+	content, err = runner.GetNewModuleContent(schema, nil)
+	if err != nil {
+		t.Fatalf("GetNewModuleContent failed: %v", err)
+	}
+	if got := len(content.Blocks[0].Body.Blocks); got != 1 {
+		t.Errorf("expected 1 security_rule without expansion, got %d", got)
+	}
+}
+
+func TestRunner_GetResourceContent_ExpandDynamicBlocksWithEvalContext(t *testing.T) {
+	runner := TestRunner(t, map[string]string{
+		"main.tf": `
+resource "azurerm_storage_account" "main" {
+  dynamic "network_rules" {
+    for_each = toset(local.networks)
+    content {
+      name = network_rules.key
+    }
+  }
+}`,
+	}, map[string]string{})
+	runner.EvalContext = &hcl.EvalContext{
+		Variables: map[string]cty.Value{
+			"local": cty.ObjectVal(map[string]cty.Value{
+				"networks": cty.TupleVal([]cty.Value{cty.StringVal("a"), cty.StringVal("b")}),
+			}),
+		},
+	}
+
+	content, err := runner.GetOldResourceContent("azurerm_storage_account", &hclext.BodySchema{
+		Blocks: []hclext.BlockSchema{{Type: "network_rules", Body: &hclext.BodySchema{}}},
+	}, &tflint.GetModuleContentOption{ExpandMode: tflint.ExpandModeExpand})
+	if err != nil {
+		t.Fatalf("GetOldResourceContent failed: %v", err)
+	}
+	if got := len(content.Blocks[0].Body.Blocks); got != 2 {
+		t.Errorf("expected 2 expanded network_rules blocks, got %d", got)
+	}
+}
+
+func TestRunner_WalkResources(t *testing.T) {
+	runner := TestRunner(t,
+		map[string]string{
+			"main.tf": `
+resource "azurerm_resource_group" "main" {
+  lifecycle {
+    prevent_destroy = true
+  }
+}
+data "azurerm_client_config" "current" {}
+resource "azurerm_storage_account" "main" {}
+variable "location" {}`,
+		},
+		map[string]string{
+			"main.tf": `
+resource "azurerm_virtual_network" "main" {}`,
+		},
+	)
+
+	schema := &hclext.BodySchema{
+		Blocks: []hclext.BlockSchema{{Type: "lifecycle"}},
+	}
+
+	var visited []string
+	err := runner.WalkOldResources(schema, func(resourceType string, block *hclext.Block) error {
+		visited = append(visited, resourceType+"/"+block.Address())
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("WalkOldResources failed: %v", err)
+	}
+	want := []string{
+		"azurerm_resource_group/azurerm_resource_group.main",
+		"azurerm_storage_account/azurerm_storage_account.main",
+	}
+	if !reflect.DeepEqual(visited, want) {
+		t.Errorf("visited = %v, want %v", visited, want)
+	}
+
+	visited = nil
+	err = runner.WalkNewResources(schema, func(resourceType string, block *hclext.Block) error {
+		visited = append(visited, resourceType)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("WalkNewResources failed: %v", err)
+	}
+	if !reflect.DeepEqual(visited, []string{"azurerm_virtual_network"}) {
+		t.Errorf("visited = %v, want [azurerm_virtual_network]", visited)
+	}
+}
+
+func TestRunner_WalkResources_StopsOnError(t *testing.T) {
+	runner := TestRunner(t,
+		map[string]string{
+			"main.tf": `
+resource "azurerm_resource_group" "main" {}
+resource "azurerm_storage_account" "main" {}
+resource "azurerm_virtual_network" "main" {}`,
+		},
+		map[string]string{},
+	)
+
+	stop := errors.New("stop")
+	calls := 0
+	err := runner.WalkOldResources(&hclext.BodySchema{}, func(resourceType string, block *hclext.Block) error {
+		calls++
+		if resourceType == "azurerm_storage_account" {
+			return stop
+		}
+		return nil
+	})
+	if !errors.Is(err, stop) {
+		t.Errorf("err = %v, want %v", err, stop)
+	}
+	if calls != 2 {
+		t.Errorf("callback called %d times, want 2", calls)
+	}
+}
+
+func TestRunner_GetFile(t *testing.T) {
+	runner := TestRunner(t,
+		map[string]string{"main.tf": `resource "azurerm_resource_group" "old" {}`},
+		map[string]string{
+			"main.tf":      `resource "azurerm_resource_group" "new" {}`,
+			"variables.tf": `variable "location" {}`,
+		},
+	)
+
+	oldFile, err := runner.GetOldFile("main.tf")
+	if err != nil {
+		t.Fatalf("GetOldFile failed: %v", err)
+	}
+	if !strings.Contains(string(oldFile.Bytes), `"old"`) {
+		t.Errorf("old file bytes = %q, want old resource", oldFile.Bytes)
+	}
+
+	newFile, err := runner.GetNewFile("variables.tf")
+	if err != nil {
+		t.Fatalf("GetNewFile failed: %v", err)
+	}
+	if !strings.Contains(string(newFile.Bytes), "location") {
+		t.Errorf("new file bytes = %q, want variable", newFile.Bytes)
+	}
+}
+
+func TestRunner_GetFile_NotFound(t *testing.T) {
+	runner := TestRunner(t, map[string]string{}, map[string]string{})
+
+	if _, err := runner.GetOldFile("missing.tf"); err == nil {
+		t.Error("GetOldFile expected error for missing file")
+	}
+	if _, err := runner.GetNewFile("missing.tf"); err == nil {
+		t.Error("GetNewFile expected error for missing file")
+	}
+}
+
+func TestRunner_GetFileSource(t *testing.T) {
+	oldSrc := `resource "azurerm_resource_group" "rg" { location = "westus" }`
+	newSrc := `resource "azurerm_resource_group" "rg" { location = "eastus" }`
+	runner := TestRunner(t,
+		map[string]string{"main.tf": oldSrc},
+		map[string]string{"main.tf": newSrc},
+	)
+
+	for side, want := range map[tflint.Side]string{tflint.SideOld: oldSrc, tflint.SideNew: newSrc} {
+		src, err := runner.GetFileSource("main.tf", side)
+		if err != nil {
+			t.Fatalf("GetFileSource(%s) error: %v", side, err)
+		}
+		if string(src) != want {
+			t.Errorf("GetFileSource(%s) = %q, want %q", side, src, want)
+		}
+	}
+
+	if _, err := runner.GetFileSource("missing.tf", tflint.SideNew); err == nil {
+		t.Error("expected error for unknown file, got nil")
+	}
+}
+
+func TestRunner_ListFiles(t *testing.T) {
+	runner := TestRunner(t,
+		map[string]string{"main.tf": ``},
+		map[string]string{"variables.tf": ``, "main.tf": ``},
+	)
+
+	if got := runner.ListOldFiles(); !reflect.DeepEqual(got, []string{"main.tf"}) {
+		t.Errorf("ListOldFiles() = %v, want [main.tf]", got)
+	}
+	if got := runner.ListNewFiles(); !reflect.DeepEqual(got, []string{"main.tf", "variables.tf"}) {
+		t.Errorf("ListNewFiles() = %v, want [main.tf variables.tf]", got)
+	}
+}
+
+func TestRunner_FileChanges(t *testing.T) {
+	runner := TestRunner(t,
+		map[string]string{"main.tf": ``, "outputs.tf": ``},
+		map[string]string{"main.tf": ``, "variables.tf": ``},
+	)
+
+	added, removed, common := runner.FileChanges()
+	if !reflect.DeepEqual(added, []string{"variables.tf"}) {
+		t.Errorf("added = %v, want [variables.tf]", added)
+	}
+	if !reflect.DeepEqual(removed, []string{"outputs.tf"}) {
+		t.Errorf("removed = %v, want [outputs.tf]", removed)
+	}
+	if !reflect.DeepEqual(common, []string{"main.tf"}) {
+		t.Errorf("common = %v, want [main.tf]", common)
+	}
+}
+
+func TestRunner_GetProviderRequirements(t *testing.T) {
+	runner := TestRunner(t,
+		map[string]string{
+			"versions.tf": `
+terraform {
+  required_providers {
+    azurerm = {
+      source  = "hashicorp/azurerm"
+      version = "~> 3.0"
+    }
+    random = {
+      source = "hashicorp/random"
+    }
+  }
+}`,
+		},
+		map[string]string{
+			"versions.tf": `
+terraform {
+  required_version = ">= 1.5"
+
+  required_providers {
+    azurerm = {
+      source                = "hashicorp/azurerm"
+      version               = ">= 4.0"
+      configuration_aliases = [azurerm.secondary]
+    }
+    legacy = "1.2.3"
+  }
+}`,
+			"providers.tf.json": `{"terraform": {"required_providers": {"random": {"source": "hashicorp/random", "version": "3.6.0"}}}}`,
+		},
+	)
+
+	oldReqs, err := runner.GetOldProviderRequirements()
+	if err != nil {
+		t.Fatalf("GetOldProviderRequirements error: %v", err)
+	}
+	wantOld := map[string]tflint.ProviderRequirement{
+		"azurerm": {Source: "hashicorp/azurerm", VersionConstraint: "~> 3.0"},
+		"random":  {Source: "hashicorp/random"},
+	}
+	if !reflect.DeepEqual(oldReqs, wantOld) {
+		t.Errorf("old requirements = %v, want %v", oldReqs, wantOld)
+	}
+
+	newReqs, err := runner.GetNewProviderRequirements()
+	if err != nil {
+		t.Fatalf("GetNewProviderRequirements error: %v", err)
+	}
+	wantNew := map[string]tflint.ProviderRequirement{
+		"azurerm": {Source: "hashicorp/azurerm", VersionConstraint: ">= 4.0"},
+		"legacy":  {VersionConstraint: "1.2.3"},
+		"random":  {Source: "hashicorp/random", VersionConstraint: "3.6.0"},
+	}
+	if !reflect.DeepEqual(newReqs, wantNew) {
+		t.Errorf("new requirements = %v, want %v", newReqs, wantNew)
+	}
+}
+
+func TestRunner_GetProviderRequirements_NoTerraformBlock(t *testing.T) {
+	runner := TestRunner(t,
+		map[string]string{},
+		map[string]string{
+			"main.tf": `resource "azurerm_resource_group" "rg" { location = "westus" }`,
+		},
+	)
+
+	reqs, err := runner.GetNewProviderRequirements()
+	if err != nil {
+		t.Fatalf("GetNewProviderRequirements error: %v", err)
+	}
+	if reqs == nil || len(reqs) != 0 {
+		t.Errorf("expected empty map, got %#v", reqs)
+	}
+}
+
+func TestRunner_GetProviderRequirements_Invalid(t *testing.T) {
+	runner := TestRunner(t,
+		map[string]string{},
+		map[string]string{
+			"versions.tf": `
+terraform {
+  required_providers {
+    azurerm = {
+      source = var.source
+    }
+  }
+}`,
+		},
+	)
+
+	if _, err := runner.GetNewProviderRequirements(); err == nil {
+		t.Error("expected error for non-literal source, got nil")
+	}
+}
+
+func TestRunner_GetTerraformSettings(t *testing.T) {
+	runner := TestRunner(t,
+		map[string]string{
+			"main.tf": `resource "azurerm_resource_group" "rg" { location = "westus" }`,
+		},
+		map[string]string{
+			"versions.tf": `
+terraform {
+  required_version = ">= 1.5"
+
+  required_providers {
+    azurerm = {
+      source  = "hashicorp/azurerm"
+      version = "~> 4.0"
+    }
+  }
+}`,
+			"backend.tf": `
+terraform {
+  backend "azurerm" {
+    resource_group_name = "tfstate"
+    key                 = "prod.tfstate"
+  }
+}`,
+		},
+	)
+
+	oldSettings, err := runner.GetOldTerraformSettings()
+	if err != nil {
+		t.Fatalf("GetOldTerraformSettings error: %v", err)
+	}
+	if oldSettings.RequiredVersion != "" || oldSettings.Backend != nil || len(oldSettings.RequiredProviders) != 0 {
+		t.Errorf("expected empty settings without a terraform block, got %+v", oldSettings)
+	}
+
+	newSettings, err := runner.GetNewTerraformSettings()
+	if err != nil {
+		t.Fatalf("GetNewTerraformSettings error: %v", err)
+	}
+	if newSettings.RequiredVersion != ">= 1.5" {
+		t.Errorf("RequiredVersion = %q, want %q", newSettings.RequiredVersion, ">= 1.5")
+	}
+	if newSettings.RequiredVersionRange.Filename != "versions.tf" || newSettings.RequiredVersionRange.Start.Line != 3 {
+		t.Errorf("RequiredVersionRange = %s, want versions.tf line 3", newSettings.RequiredVersionRange)
+	}
+	want := map[string]tflint.ProviderRequirement{
+		"azurerm": {Source: "hashicorp/azurerm", VersionConstraint: "~> 4.0"},
+	}
+	if !reflect.DeepEqual(newSettings.RequiredProviders, want) {
+		t.Errorf("RequiredProviders = %#v, want %#v", newSettings.RequiredProviders, want)
+	}
+
+	backend := newSettings.Backend
+	if backend == nil {
+		t.Fatal("expected a backend")
+	}
+	if backend.Type != "azurerm" || backend.DeclRange.Filename != "backend.tf" {
+		t.Errorf("Backend = %s at %s, want azurerm in backend.tf", backend.Type, backend.DeclRange)
+	}
+	if attr := backend.Body.Attributes["key"]; attr == nil || string(attr.SourceBytes) != `"prod.tfstate"` {
+		t.Errorf("backend key = %+v, want \"prod.tfstate\"", attr)
+	}
+}
+
+func TestRunner_GetTerraformSettings_Invalid(t *testing.T) {
+	tests := map[string]string{
+		"duplicate backend": `
+terraform {
+  backend "local" {}
+}
+terraform {
+  backend "azurerm" {}
+}`,
+		"non-literal required_version": `
+terraform {
+  required_version = var.version
+}`,
+	}
+
+	for name, content := range tests {
+		runner := TestRunner(t, map[string]string{}, map[string]string{"main.tf": content})
+		if _, err := runner.GetNewTerraformSettings(); err == nil {
+			t.Errorf("%s: expected an error, got nil", name)
+		}
+	}
+}
+
+func TestRunner_GetProviderConfig(t *testing.T) {
+	runner := TestRunner(t,
+		map[string]string{
+			"providers.tf": `
+provider "azurerm" {
+  features {}
+}`,
+		},
+		map[string]string{
+			"providers.tf": `
+provider "azurerm" {
+  features {
+    key_vault {
+      purge_soft_delete_on_destroy = false
+    }
+  }
+}
+
+provider "azurerm" {
+  alias           = "west"
+  subscription_id = "00000000-0000-0000-0000-000000000000"
+  features {}
+}
+
+provider "random" {}`,
+		},
+	)
+
+	schema := hclext.NewSchema().
+		Attrs("subscription_id").
+		Block("features", nil).
+		Build()
+
+	oldProvider, err := runner.GetOldProviderConfig("azurerm", schema)
+	if err != nil {
+		t.Fatalf("GetOldProviderConfig error: %v", err)
+	}
+	if oldProvider == nil || len(oldProvider.Body.Blocks) != 1 {
+		t.Fatalf("expected the old default azurerm provider with a features block, got %+v", oldProvider)
+	}
+
+	newProvider, err := runner.GetNewProviderConfig("azurerm", schema)
+	if err != nil {
+		t.Fatalf("GetNewProviderConfig error: %v", err)
+	}
+	if newProvider == nil || newProvider.Body.Attributes["alias"] != nil || newProvider.Body.Attributes["subscription_id"] != nil {
+		t.Fatalf("expected the new default azurerm provider, got %+v", newProvider)
+	}
+
+	west, err := runner.GetNewProviderConfig("azurerm.west", schema)
+	if err != nil {
+		t.Fatalf("GetNewProviderConfig error: %v", err)
+	}
+	if west == nil || west.Body.Attributes["subscription_id"] == nil {
+		t.Fatalf("expected the aliased azurerm provider, got %+v", west)
+	}
+	if val, ok := hclext.AttributeValue(west.Body.Attributes["alias"]); !ok || val.AsString() != "west" {
+		t.Errorf("alias = %#v, want \"west\"", val)
+	}
+	if len(schema.Attributes) != 1 {
+		t.Errorf("schema was modified: %+v", schema.Attributes)
+	}
+
+	for _, name := range []string{"azurerm.east", "aws"} {
+		block, err := runner.GetNewProviderConfig(name, nil)
+		if err != nil {
+			t.Fatalf("GetNewProviderConfig(%q) error: %v", name, err)
+		}
+		if block != nil {
+			t.Errorf("GetNewProviderConfig(%q) = %+v, want nil", name, block)
+		}
+	}
+	if block, err := runner.GetOldProviderConfig("azurerm.west", nil); err != nil || block != nil {
+		t.Errorf("GetOldProviderConfig(\"azurerm.west\") = %+v, %v, want nil", block, err)
+	}
+}
+
+func TestRunner_GetVariables(t *testing.T) {
+	runner := TestRunner(t,
+		map[string]string{
+			"variables.tf": `
+variable "location" {
+  type    = string
+  default = "westus"
+}
+
+variable "name" {}`,
+		},
+		map[string]string{
+			"variables.tf": `
+variable "location" {
+  type = string
+}
+
+variable "name" {
+  default = null
+}
+
+variable "zones" {
+  type    = list(number)
+  default = ["1", 2]
+}
+
+variable "settings" {
+  type = object({
+    tier     = string
+    replicas = optional(number, 1)
+  })
+  default = { tier = "standard" }
+}`,
+			"json.tf.json": `{"variable": {"tags": {"type": "map(string)", "default": {"env": "prod"}}}}`,
+		},
+	)
+
+	oldVars, err := runner.GetOldVariables()
+	if err != nil {
+		t.Fatalf("GetOldVariables error: %v", err)
+	}
+	if len(oldVars) != 2 {
+		t.Fatalf("got %d old variables, want 2", len(oldVars))
+	}
+	if v := oldVars[0]; v.Name != "location" || !v.HasDefault || v.Required || !v.Default.RawEquals(cty.StringVal("westus")) || v.Type != cty.String {
+		t.Errorf("old location = %+v", v)
+	}
+	if v := oldVars[1]; v.Name != "name" || v.HasDefault || !v.Required || v.Default != cty.NilVal || v.Type != cty.DynamicPseudoType {
+		t.Errorf("old name = %+v", v)
+	}
+	if oldVars[0].DeclRange.Filename != "variables.tf" || oldVars[0].DeclRange.Start.Line != 2 {
+		t.Errorf("old location DeclRange = %v", oldVars[0].DeclRange)
+	}
+
+	newVars, err := runner.GetNewVariables()
+	if err != nil {
+		t.Fatalf("GetNewVariables error: %v", err)
+	}
+	var names []string
+	for _, v := range newVars {
+		names = append(names, v.Name)
+	}
+	if want := []string{"tags", "location", "name", "zones", "settings"}; !reflect.DeepEqual(names, want) {
+		t.Fatalf("new variables = %v, want %v", names, want)
+	}
+
+	tags, location, name, zones, settings := newVars[0], newVars[1], newVars[2], newVars[3], newVars[4]
+	if tags.Type != cty.Map(cty.String) || !tags.Default.RawEquals(cty.MapVal(map[string]cty.Value{"env": cty.StringVal("prod")})) {
+		t.Errorf("tags = %+v", tags)
+	}
+	if location.HasDefault || !location.Required || location.Type != cty.String {
+		t.Errorf("location lost its default and should be required: %+v", location)
+	}
+	if !name.HasDefault || name.Required || !name.Default.IsNull() {
+		t.Errorf("name with null default should be optional: %+v", name)
+	}
+	wantZones := cty.ListVal([]cty.Value{cty.NumberIntVal(1), cty.NumberIntVal(2)})
+	if zones.Type != cty.List(cty.Number) || !zones.Default.RawEquals(wantZones) {
+		t.Errorf("zones = %+v, want default converted to %#v", zones, wantZones)
+	}
+	if got := settings.Default.GetAttr("replicas"); !got.RawEquals(cty.NumberIntVal(1)) {
+		t.Errorf("settings.replicas = %#v, want optional default 1", got)
+	}
+}
+
+func TestRunner_GetVariables_InvalidDefault(t *testing.T) {
+	runner := TestRunner(t,
+		map[string]string{},
+		map[string]string{
+			"variables.tf": `
+variable "instances" {
+  type    = number
+  default = "many"
+}`,
+		},
+	)
+
+	if _, err := runner.GetNewVariables(); err == nil {
+		t.Error("expected error for default incompatible with its type, got nil")
+	}
+}
+
+func TestRunner_GetOutputs(t *testing.T) {
+	runner := TestRunner(t,
+		map[string]string{
+			"outputs.tf": `
+output "id" {
+  value       = azurerm_resource_group.rg.id
+  description = "The resource group ID."
+}
+
+output "key" {
+  value     = azurerm_storage_account.sa.primary_access_key
+  sensitive = true
+}`,
+		},
+		map[string]string{
+			"outputs.tf": `
+output "id" {
+  value = azurerm_resource_group.rg.id
+}`,
+		},
+	)
+
+	oldOutputs, err := runner.GetOldOutputs()
+	if err != nil {
+		t.Fatalf("GetOldOutputs error: %v", err)
+	}
+	want := []tflint.OutputDef{
+		{Name: "id", Description: "The resource group ID."},
+		{Name: "key", Sensitive: true},
+	}
+	if len(oldOutputs) != len(want) {
+		t.Fatalf("got %d old outputs, want %d", len(oldOutputs), len(want))
+	}
+	for i, w := range want {
+		got := oldOutputs[i]
+		if got.Name != w.Name || got.Description != w.Description || got.Sensitive != w.Sensitive {
+			t.Errorf("outputs[%d] = %+v, want %+v", i, got, w)
+		}
+		if got.DeclRange.Filename != "outputs.tf" {
+			t.Errorf("outputs[%d] DeclRange = %v", i, got.DeclRange)
+		}
+	}
+
+	newOutputs, err := runner.GetNewOutputs()
+	if err != nil {
+		t.Fatalf("GetNewOutputs error: %v", err)
+	}
+	if len(newOutputs) != 1 || newOutputs[0].Name != "id" {
+		t.Errorf("new outputs = %+v, want only id", newOutputs)
+	}
+
+	empty := TestRunner(t, map[string]string{}, map[string]string{})
+	if outputs, err := empty.GetNewOutputs(); err != nil || outputs == nil || len(outputs) != 0 {
+		t.Errorf("expected empty outputs, got %#v (err %v)", outputs, err)
+	}
+}
+
+func TestRunner_GetModuleCalls(t *testing.T) {
+	runner := TestRunner(t,
+		map[string]string{
+			"main.tf": `
+module "network" {
+  source  = "Azure/network/azurerm"
+  version = "~> 5.0"
+
+  resource_group_name = var.resource_group_name
+}
+
+module "storage" {
+  source = "./modules/storage"
+}`,
+		},
+		map[string]string{
+			"main.tf": `
+module "network" {
+  source  = "Azure/network/azurerm"
+  version = "~> 4.0"
+}`,
+		},
+	)
+
+	oldCalls, err := runner.GetOldModuleCalls()
+	if err != nil {
+		t.Fatalf("GetOldModuleCalls error: %v", err)
+	}
+	want := []tflint.ModuleCall{
+		{Name: "network", Source: "Azure/network/azurerm", Version: "~> 5.0"},
+		{Name: "storage", Source: "./modules/storage"},
+	}
+	if len(oldCalls) != len(want) {
+		t.Fatalf("got %d old module calls, want %d", len(oldCalls), len(want))
+	}
+	for i, w := range want {
+		got := oldCalls[i]
+		if got.Name != w.Name || got.Source != w.Source || got.Version != w.Version {
+			t.Errorf("module calls[%d] = %+v, want %+v", i, got, w)
+		}
+		if got.DeclRange.Filename != "main.tf" {
+			t.Errorf("module calls[%d] DeclRange = %v", i, got.DeclRange)
+		}
+	}
+
+	newCalls, err := runner.GetNewModuleCalls()
+	if err != nil {
+		t.Fatalf("GetNewModuleCalls error: %v", err)
+	}
+	if len(newCalls) != 1 || newCalls[0].Version != "~> 4.0" {
+		t.Errorf("new module calls = %+v, want only network at ~> 4.0", newCalls)
+	}
+
+	empty := TestRunner(t, map[string]string{}, map[string]string{})
+	if calls, err := empty.GetNewModuleCalls(); err != nil || calls == nil || len(calls) != 0 {
+		t.Errorf("expected empty module calls, got %#v (err %v)", calls, err)
+	}
+}
+
+func TestRunner_GetModuleCalls_Invalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+	}{
+		{"missing source", `module "network" {}`},
+		{"non-literal source", `module "network" { source = var.source }`},
+		{"non-literal version", `module "network" {
+  source  = "Azure/network/azurerm"
+  version = local.version
+}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			runner := TestRunner(t, map[string]string{}, map[string]string{"main.tf": tt.content})
+			if _, err := runner.GetNewModuleCalls(); err == nil {
+				t.Error("expected error, got nil")
+			}
+		})
+	}
+}
+
+func TestRunner_GetLocals(t *testing.T) {
+	runner := TestRunner(t,
+		map[string]string{
+			"main.tf": `
+locals {
+  location = "westeurope"
+}
+
+resource "azurerm_resource_group" "main" {
+  location = local.location
+}`,
+		},
+		map[string]string{
+			"locals.tf": `
+locals {
+  region   = "north"
+  location = "${local.region}europe"
+}`,
+			"main.tf": `
+resource "azurerm_resource_group" "main" {
+  location = local.location
+}`,
+		},
+	)
+
+	schema := &hclext.BodySchema{Attributes: []hclext.AttributeSchema{{Name: "location"}}}
+	resolve := func(locals map[string]cty.Value, content *hclext.BodyContent) cty.Value {
+		t.Helper()
+		attr := content.Blocks[0].Body.Attributes["location"]
+		ctx := &hcl.EvalContext{Variables: map[string]cty.Value{"local": cty.ObjectVal(locals)}}
+		val, diags := attr.Expr.Value(ctx)
+		if diags.HasErrors() {
+			t.Fatalf("evaluating location: %v", diags)
+		}
+		return val
+	}
+
+	oldLocals, err := runner.GetOldLocals()
+	if err != nil {
+		t.Fatalf("GetOldLocals error: %v", err)
+	}
+	oldContent, err := runner.GetOldResourceContent("azurerm_resource_group", schema, nil)
+	if err != nil {
+		t.Fatalf("GetOldResourceContent error: %v", err)
+	}
+	newLocals, err := runner.GetNewLocals()
+	if err != nil {
+		t.Fatalf("GetNewLocals error: %v", err)
+	}
+	newContent, err := runner.GetNewResourceContent("azurerm_resource_group", schema, nil)
+	if err != nil {
+		t.Fatalf("GetNewResourceContent error: %v", err)
+	}
+
+	oldVal, newVal := resolve(oldLocals, oldContent), resolve(newLocals, newContent)
+	if !oldVal.RawEquals(cty.StringVal("westeurope")) {
+		t.Errorf("old location = %#v, want westeurope", oldVal)
+	}
+	if !newVal.RawEquals(cty.StringVal("northeurope")) {
+		t.Errorf("new location = %#v, want northeurope", newVal)
+	}
+
+	empty := TestRunner(t, map[string]string{}, map[string]string{})
+	if locals, err := empty.GetNewLocals(); err != nil || locals == nil || len(locals) != 0 {
+		t.Errorf("expected empty locals, got %#v (err %v)", locals, err)
+	}
+}
+
+func TestRunner_GetLocals_Unknown(t *testing.T) {
+	runner := TestRunner(t, map[string]string{}, map[string]string{
+		"main.tf": `
+variable "env" {
+  default = "prod"
+}
+
+locals {
+  tags   = merge({ team = "core" }, { env = "prod" })
+  env    = var.env
+  name   = "app-${local.env}"
+  id     = azurerm_resource_group.main.id
+  ping   = local.pong
+  pong   = local.ping
+  broken = 1 + "a"
+  names  = [for n in ["a", "b"] : upper(n)]
+}`,
+	})
+
+	locals, err := runner.GetNewLocals()
+	if err != nil {
+		t.Fatalf("GetNewLocals error: %v", err)
+	}
+
+	wantTags := cty.ObjectVal(map[string]cty.Value{"team": cty.StringVal("core"), "env": cty.StringVal("prod")})
+	if !locals["tags"].RawEquals(wantTags) {
+		t.Errorf("local.tags = %#v, want %#v", locals["tags"], wantTags)
+	}
+	for _, name := range []string{"env", "name", "id", "ping", "pong", "broken", "names"} {
+		val, ok := locals[name]
+		if !ok {
+			t.Errorf("local.%s missing", name)
+			continue
+		}
+		if val.IsKnown() {
+			t.Errorf("local.%s = %#v, want unknown", name, val)
+		}
+	}
+}
+
+func TestRunner_GetLocals_Duplicate(t *testing.T) {
+	runner := TestRunner(t, map[string]string{}, map[string]string{
+		"a.tf": `locals { name = "a" }`,
+		"b.tf": `locals { name = "b" }`,
+	})
+	if _, err := runner.GetNewLocals(); err == nil {
+		t.Error("expected error for duplicate local, got nil")
+	}
+}
+
+func TestRunner_GetAllResources(t *testing.T) {
+	runner := TestRunner(t, map[string]string{}, map[string]string{
+		"network.tf": `
+resource "azurerm_virtual_network" "main" {
+  name          = "vnet"
+  address_space = ["10.0.0.0/16"]
+}`,
+		"main.tf": `
+# The web server.
+resource "aws_instance" "web" {
+  ami = "ami-123"
+
+  ebs_block_device {
+    volume_size = 10
+
+    tags {
+      name = "data"
+    }
+  }
+}
+
+data "aws_ami" "ubuntu" {
+  most_recent = true
+}`,
+	})
+
+	resources, err := runner.GetAllNewResources()
+	if err != nil {
+		t.Fatalf("GetAllNewResources error: %v", err)
+	}
+	var addrs []string
+	for _, resource := range resources {
+		addrs = append(addrs, resource.Address())
+	}
+	if want := []string{"aws_instance.web", "azurerm_virtual_network.main"}; !slices.Equal(addrs, want) {
+		t.Fatalf("resources = %v, want %v", addrs, want)
+	}
+
+	web := resources[0]
+	if want := []string{"# The web server."}; !slices.Equal(web.LeadingComments, want) {
+		t.Errorf("LeadingComments = %q, want %q", web.LeadingComments, want)
+	}
+	if attr := web.Body.Attributes["ami"]; attr == nil || string(attr.SourceBytes) != `"ami-123"` {
+		t.Errorf("ami = %+v, want source %q", attr, `"ami-123"`)
+	}
+	if len(web.Body.Blocks) != 1 || web.Body.Blocks[0].Type != "ebs_block_device" {
+		t.Fatalf("nested blocks = %+v, want ebs_block_device", web.Body.Blocks)
+	}
+	device := web.Body.Blocks[0].Body
+	if attr := device.Attributes["volume_size"]; attr == nil || string(attr.SourceBytes) != "10" {
+		t.Errorf("volume_size = %+v, want source 10", attr)
+	}
+	if len(device.Blocks) != 1 || device.Blocks[0].Body.Attributes["name"] == nil {
+		t.Errorf("ebs_block_device blocks = %+v, want tags with name", device.Blocks)
+	}
+	if _, ok := resources[1].Body.Attributes["address_space"]; !ok {
+		t.Error("expected address_space attribute on azurerm_virtual_network.main")
+	}
+
+	old, err := runner.GetAllOldResources()
+	if err != nil {
+		t.Fatalf("GetAllOldResources error: %v", err)
+	}
+	if len(old) != 0 {
+		t.Errorf("expected no old resources, got %d", len(old))
+	}
+}
+
+func TestRunner_ResourceAddresses(t *testing.T) {
+	runner := TestRunner(t, map[string]string{
+		"storage.tf": `
+resource "azurerm_storage_account" "main" {}
+resource "azurerm_key_vault" "main" {}
+`,
+		"network.tf": `
+resource "azurerm_virtual_network" "main" {}
+
+data "azurerm_client_config" "current" {}
+
+module "app" {
+  source = "./modules/app"
+}
+`,
+	}, map[string]string{
+		"main.tf": `resource "azurerm_storage_account" "main" {}`,
+	})
+
+	want := []string{"azurerm_key_vault.main", "azurerm_storage_account.main", "azurerm_virtual_network.main"}
+	// The order does not depend on the file or source order, and is the same
+	// on every call
+	for range 2 {
+		got, err := runner.OldResourceAddresses()
+		if err != nil {
+			t.Fatalf("OldResourceAddresses error: %v", err)
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("OldResourceAddresses() = %v, want %v", got, want)
+		}
+	}
+
+	got, err := runner.NewResourceAddresses()
+	if err != nil {
+		t.Fatalf("NewResourceAddresses error: %v", err)
+	}
+	if want := []string{"azurerm_storage_account.main"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("NewResourceAddresses() = %v, want %v", got, want)
+	}
+}
+
+func TestRunner_DiffNestedAttributePaths(t *testing.T) {
+	config := func(days int) map[string]string {
+		return map[string]string{"main.tf": fmt.Sprintf(`
+resource "azurerm_storage_account" "main" {
+  name = "examplesa"
+
+  blob_properties {
+    versioning_enabled = true
+
+    delete_retention_policy {
+      days = %d
+    }
+  }
+}`, days)}
+	}
+	runner := TestRunner(t, config(7), config(30))
+
+	schema := hclext.NewSchema().
+		Attr("name", false).
+		Block("blob_properties", func(b *hclext.SchemaBuilder) {
+			b.Attr("versioning_enabled", false).
+				Block("delete_retention_policy", func(b *hclext.SchemaBuilder) {
+					b.Attr("days", false)
+				})
+		}).
+		Build()
+
+	oldContent, err := runner.GetOldResourceContent("azurerm_storage_account", schema, nil)
+	if err != nil {
+		t.Fatalf("GetOldResourceContent error: %v", err)
+	}
+	newContent, err := runner.GetNewResourceContent("azurerm_storage_account", schema, nil)
+	if err != nil {
+		t.Fatalf("GetNewResourceContent error: %v", err)
+	}
+
+	changes := hclext.DiffBodyContent(oldContent.Blocks[0].Body, newContent.Blocks[0].Body).AttributePaths()
+	if len(changes) != 1 {
+		t.Fatalf("got %d changed attributes, want 1: %+v", len(changes), changes)
+	}
+	change := changes[0]
+	if change.Path != "blob_properties.delete_retention_policy.days" {
+		t.Errorf("Path = %q, want blob_properties.delete_retention_policy.days", change.Path)
+	}
+	oldVal, _ := hclext.AttributeValue(change.Old)
+	newVal, _ := hclext.AttributeValue(change.New)
+	if !oldVal.RawEquals(cty.NumberIntVal(7)) || !newVal.RawEquals(cty.NumberIntVal(30)) {
+		t.Errorf("values = %#v -> %#v, want 7 -> 30", oldVal, newVal)
+	}
+}
+
+func TestRunner_GetMovedBlocks_Rename(t *testing.T) {
+	runner := TestRunner(t,
+		map[string]string{"main.tf": `
+resource "aws_instance" "old" {
+  ami = "ami-123"
+}
+resource "aws_instance" "deleted" {}
+`},
+		map[string]string{"main.tf": `
+resource "aws_instance" "new" {
+  ami = "ami-123"
+}
+
+moved {
+  from = aws_instance.old
+  to   = aws_instance.new
+}
+`},
+	)
+
+	moved := runner.GetMovedBlocks()
+	if len(moved) != 1 {
+		t.Fatalf("expected 1 moved block, got %d", len(moved))
+	}
+	if moved[0].From != "aws_instance.old" || moved[0].To != "aws_instance.new" {
+		t.Errorf("moved = %s -> %s, want aws_instance.old -> aws_instance.new", moved[0].From, moved[0].To)
+	}
+	if moved[0].DeclRange.Start.Line != 6 {
+		t.Errorf("DeclRange line = %d, want 6", moved[0].DeclRange.Start.Line)
+	}
+
+	diff, err := runner.GetModuleDiff(&hclext.BodySchema{
+		Blocks: []hclext.BlockSchema{
+			{
+				Type:       "resource",
+				LabelNames: []string{"type", "name"},
+				Body:       &hclext.BodySchema{Attributes: []hclext.AttributeSchema{{Name: "ami"}}},
+			},
+		},
+	}, nil)
+	if err != nil {
+		t.Fatalf("GetModuleDiff failed: %v", err)
+	}
+	diff.ApplyMovedBlocks(moved)
+
+	if len(diff.Added) != 0 {
+		t.Errorf("expected no added blocks, got %d", len(diff.Added))
+	}
+	if len(diff.Removed) != 1 || diff.Removed[0].Labels[1] != "deleted" {
+		t.Errorf("expected only 'deleted' in Removed, got %d blocks", len(diff.Removed))
+	}
+	if len(diff.Changed) != 1 || diff.Changed[0].Address != "aws_instance.new" {
+		t.Fatalf("expected aws_instance.new in Changed, got %d blocks", len(diff.Changed))
+	}
+	if diff.Changed[0].Old.Labels[1] != "old" {
+		t.Errorf("changed old block = %v, want aws_instance.old", diff.Changed[0].Old.Labels)
+	}
+}
+
+func TestRunner_GetMovedBlocks_None(t *testing.T) {
+	runner := TestRunner(t,
+		map[string]string{},
+		map[string]string{"main.tf": `resource "aws_instance" "web" {}`},
+	)
+
+	if moved := runner.GetMovedBlocks(); len(moved) != 0 {
+		t.Errorf("expected no moved blocks, got %d", len(moved))
+	}
+}
+
+func TestRunner_EmitIssue(t *testing.T) {
+	runner := TestRunner(t, map[string]string{}, map[string]string{})
+
+	rule := &testRule{name: "test_rule"}
+	issueRange := hcl.Range{
+		Filename: "main.tf",
+		Start:    hcl.Pos{Line: 3, Column: 3},
+		End:      hcl.Pos{Line: 3, Column: 20},
+	}
+
+	err := runner.EmitIssue(rule, "test message", issueRange)
+	if err != nil {
+		t.Fatalf("EmitIssue failed: %v", err)
+	}
+
+	if len(runner.Issues) != 1 {
+		t.Fatalf("expected 1 issue, got %d", len(runner.Issues))
+	}
+
+	issue := runner.Issues[0]
+	if issue.Rule.Name() != "test_rule" {
+		t.Errorf("issue rule name = %q, want %q", issue.Rule.Name(), "test_rule")
+	}
+	if issue.Message != "test message" {
+		t.Errorf("issue message = %q, want %q", issue.Message, "test message")
+	}
+	if issue.Range.Filename != "main.tf" {
+		t.Errorf("issue range filename = %q, want %q", issue.Range.Filename, "main.tf")
+	}
+}
+
+func TestRunner_EmitIssue_IgnoreDirective(t *testing.T) {
+	runner := TestRunner(t, map[string]string{}, map[string]string{
+		"main.tf": `# tfbreak:ignore=ignored_rule
+resource "azurerm_resource_group" "main" {
+  location = "westus"
+}`,
+	})
+
+	issueRange := hcl.Range{
+		Filename: "main.tf",
+		Start:    hcl.Pos{Line: 3, Column: 3},
+		End:      hcl.Pos{Line: 3, Column: 22},
+	}
+	_ = runner.EmitIssue(&testRule{name: "ignored_rule"}, "ignored", issueRange)
+	_ = runner.EmitIssueWithFix(&testRule{name: "ignored_rule"}, "ignored", issueRange, &tflint.Fix{})
+	_ = runner.EmitIssue(&testRule{name: "other_rule"}, "reported", issueRange)
+
+	if len(runner.Issues) != 1 {
+		t.Fatalf("expected 1 issue, got %d", len(runner.Issues))
+	}
+	if runner.Issues[0].Rule.Name() != "other_rule" {
+		t.Errorf("issue rule name = %q, want %q", runner.Issues[0].Rule.Name(), "other_rule")
+	}
+}
+
+func TestRunner_EmitIssue_NilRule(t *testing.T) {
+	runner := TestRunner(t, map[string]string{}, map[string]string{})
+
+	errs := []error{
+		runner.EmitIssue(nil, "no rule", hcl.Range{}),
+		runner.EmitIssueWithFix(nil, "no rule", hcl.Range{}, &tflint.Fix{}),
+		runner.EmitIssueWithSeverity(nil, tflint.WARNING, "no rule", hcl.Range{}),
+		runner.EmitIssueWithOptions(nil, "no rule", hcl.Range{}, tflint.IssueOptions{Kind: tflint.KindBreaking}),
+	}
+	for i, err := range errs {
+		if !errors.Is(err, tflint.ErrNilRule) {
+			t.Errorf("emit %d error = %v, want ErrNilRule", i, err)
+		}
+	}
+	AssertNoIssues(t, runner.Issues)
+}
+
+func TestRunner_EmitIssue_Concurrent(t *testing.T) {
+	runner := TestRunner(t, map[string]string{}, map[string]string{})
+	rule := &testRule{name: "test_rule"}
+
+	// Run with -race to detect unsynchronized access
+	const goroutines, perGoroutine = 8, 25
+	var wg sync.WaitGroup
+	for i := 0; i < goroutines; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < perGoroutine; j++ {
+				_ = runner.EmitIssue(rule, "concurrent issue", hcl.Range{Filename: "main.tf"})
+				_ = runner.GetIssues()
+			}
+		}()
+	}
+	wg.Wait()
+
+	if got := len(runner.GetIssues()); got != goroutines*perGoroutine {
+		t.Errorf("expected %d issues, got %d", goroutines*perGoroutine, got)
+	}
+}
+
+func TestRunner_GetIssues_ReturnsCopy(t *testing.T) {
+	runner := TestRunner(t, map[string]string{}, map[string]string{})
+	rule := &testRule{name: "test_rule"}
+	_ = runner.EmitIssue(rule, "first", hcl.Range{})
+
+	issues := runner.GetIssues()
+	issues[0].Message = "modified"
+	_ = runner.EmitIssue(rule, "second", hcl.Range{})
+
+	if len(issues) != 1 {
+		t.Errorf("expected copy to keep 1 issue, got %d", len(issues))
+	}
+	if runner.Issues[0].Message != "first" {
+		t.Errorf("modifying the copy changed the runner: %q", runner.Issues[0].Message)
+	}
+}
+
+func TestRunner_EmitIssue_Multiple(t *testing.T) {
+	runner := TestRunner(t, map[string]string{}, map[string]string{})
+
+	rule := &testRule{name: "test_rule"}
+
+	_ = runner.EmitIssue(rule, "issue 1", hcl.Range{})
+	_ = runner.EmitIssue(rule, "issue 2", hcl.Range{})
+	_ = runner.EmitIssue(rule, "issue 3", hcl.Range{})
+
+	if len(runner.Issues) != 3 {
+		t.Errorf("expected 3 issues, got %d", len(runner.Issues))
+	}
+}
+
+func TestRunner_EmitIssueWithFix(t *testing.T) {
+	runner := TestRunner(t, map[string]string{}, map[string]string{})
+
+	rule := &testRule{name: "test_rule"}
+	fix := &tflint.Fix{
+		Edits: []tflint.TextEdit{
+			{Range: hcl.Range{Filename: "main.tf"}, NewText: "location"},
+		},
+	}
+
+	if err := runner.EmitIssueWithFix(rule, "argument renamed", hcl.Range{Filename: "main.tf"}, fix); err != nil {
+		t.Fatalf("EmitIssueWithFix failed: %v", err)
+	}
+
+	if len(runner.Issues) != 1 {
+		t.Fatalf("expected 1 issue, got %d", len(runner.Issues))
+	}
+	if runner.Issues[0].Fix != fix {
+		t.Error("expected issue to carry the fix")
+	}
+}
+
+func TestRunner_EmitIssueWithSeverity(t *testing.T) {
+	runner := TestRunner(t, map[string]string{}, map[string]string{})
+
+	rule := &testRule{name: "test_rule"}
+	_ = runner.EmitIssueWithSeverity(rule, tflint.WARNING, "default changed", hcl.Range{})
+	_ = runner.EmitIssue(rule, "attribute removed", hcl.Range{})
+
+	if len(runner.Issues) != 2 {
+		t.Fatalf("expected 2 issues, got %d", len(runner.Issues))
+	}
+	if got := runner.Issues[0].Severity; got != tflint.WARNING {
+		t.Errorf("per-issue severity = %v, want WARNING", got)
+	}
+	if got := runner.Issues[1].Severity; got != rule.Severity() {
+		t.Errorf("rule severity = %v, want %v", got, rule.Severity())
+	}
+}
+
+func TestRunner_EmitIssueWithOptions(t *testing.T) {
+	runner := TestRunner(t, map[string]string{
+		"main.tf": `resource "azurerm_storage_account" "removed" {
+  name = "removed"
+}
+
+resource "azurerm_storage_account" "ignored" { # tfbreak:ignore=test_rule
+  name = "ignored"
+}`,
+	}, map[string]string{
+		"main.tf": `# tfbreak:ignore=test_rule
+variable "ignored" {}`,
+	})
+
+	content, err := runner.GetOldResourceContent("azurerm_storage_account", &hclext.BodySchema{}, nil)
+	if err != nil {
+		t.Fatalf("GetOldResourceContent error: %v", err)
+	}
+	rule := &testRule{name: "test_rule"}
+	fix := &tflint.Fix{Edits: []tflint.TextEdit{{NewText: "moved {}"}}}
+	for _, block := range content.Blocks {
+		address := tflint.BlockAddress(block)
+		_ = runner.EmitIssueWithOptions(rule, address+" was removed", block.DefRange, tflint.IssueOptions{
+			Severity:        tflint.WARNING,
+			Kind:            tflint.KindBreaking,
+			ResourceAddress: address,
+			Side:            tflint.SideOld,
+			Fix:             fix,
+		})
+	}
+	// Ranges without a side refer to the NEW configuration
+	_ = runner.EmitIssueWithOptions(rule, "ignored", hcl.Range{
+		Filename: "main.tf",
+		Start:    hcl.Pos{Line: 2, Column: 1},
+		End:      hcl.Pos{Line: 2, Column: 20},
+	}, tflint.IssueOptions{Kind: tflint.KindBreaking})
+	_ = runner.EmitIssueWithOptions(rule, "plain", hcl.Range{}, tflint.IssueOptions{})
+
+	AssertIssuesWithSeverity(t, Issues{
+		{
+			Rule:            rule,
+			Message:         "azurerm_storage_account.removed was removed",
+			Range:           content.Blocks[0].DefRange,
+			Fix:             fix,
+			Severity:        tflint.WARNING,
+			Kind:            tflint.KindBreaking,
+			ResourceAddress: "azurerm_storage_account.removed",
+			Side:            tflint.SideOld,
+		},
+		{Rule: rule, Message: "plain"},
+	}, runner.Issues)
+}
+
+func TestRunner_DecodeRuleConfig(t *testing.T) {
+	runner := TestRunner(t, map[string]string{}, map[string]string{})
+
+	var target struct{ Value string }
+	err := runner.DecodeRuleConfig("test_rule", &target)
+	if err != nil {
+		t.Errorf("DecodeRuleConfig failed: %v", err)
+	}
+}
+
+func TestTestRunnerWithConfig_DecodeRuleConfig(t *testing.T) {
+	runner := TestRunnerWithConfig(t,
+		map[string]string{},
+		map[string]string{},
+		map[string]string{
+			"test_rule": `
+threshold       = 10
+ignore_patterns = ["^legacy_"]
+`,
+		},
+	)
+
+	var config struct {
+		Threshold      int      `hcl:"threshold"`
+		IgnorePatterns []string `hcl:"ignore_patterns,optional"`
+	}
+	if err := runner.DecodeRuleConfig("test_rule", &config); err != nil {
+		t.Fatalf("DecodeRuleConfig failed: %v", err)
+	}
+
+	if config.Threshold != 10 {
+		t.Errorf("Threshold = %d, want 10", config.Threshold)
+	}
+	if len(config.IgnorePatterns) != 1 || config.IgnorePatterns[0] != "^legacy_" {
+		t.Errorf("IgnorePatterns = %v, want [^legacy_]", config.IgnorePatterns)
 	}
 }
 
-func TestRunner_GetResourceContent_FiltersByType(t *testing.T) {
-	runner := TestRunner(t,
+func TestTestRunnerWithConfig_DecodeRuleConfigWithDefaults(t *testing.T) {
+	runner := TestRunnerWithConfig(t,
+		map[string]string{},
+		map[string]string{},
 		map[string]string{
-			"main.tf": `
-resource "azurerm_resource_group" "rg" {
-  name = "my-rg"
-}
-resource "azurerm_virtual_network" "vnet" {
-  name = "my-vnet"
+			"test_rule": `
+threshold = 0
+limits {
+  max = 50
 }
-resource "azurerm_resource_group" "rg2" {
-  name = "my-rg-2"
-}`,
+`,
 		},
-		map[string]string{},
 	)
 
-	schema := &hclext.BodySchema{
-		Attributes: []hclext.AttributeSchema{
-			{Name: "name"},
-		},
+	type limits struct {
+		Max int `hcl:"max,optional" tfbreak:"default=100"`
+		Min int `hcl:"min,optional" tfbreak:"default=1"`
 	}
-
-	content, err := runner.GetOldResourceContent("azurerm_resource_group", schema, nil)
-	if err != nil {
-		t.Fatalf("GetOldResourceContent failed: %v", err)
+	var config struct {
+		Threshold      int      `hcl:"threshold,optional" tfbreak:"default=10"`
+		IgnorePatterns []string `hcl:"ignore_patterns,optional" tfbreak:"default=^legacy_"`
+		Limits         limits   `hcl:"limits,block"`
 	}
-
-	if len(content.Blocks) != 2 {
-		t.Errorf("expected 2 resource_group blocks, got %d", len(content.Blocks))
+	if err := tflint.DecodeRuleConfigWithDefaults(runner, "test_rule", &config); err != nil {
+		t.Fatalf("DecodeRuleConfigWithDefaults failed: %v", err)
 	}
 
-	for _, block := range content.Blocks {
-		if block.Labels[0] != "azurerm_resource_group" {
-			t.Errorf("unexpected resource type: %s", block.Labels[0])
-		}
+	if config.Threshold != 0 {
+		t.Errorf("Threshold = %d, want 0 from config", config.Threshold)
+	}
+	if len(config.IgnorePatterns) != 1 || config.IgnorePatterns[0] != "^legacy_" {
+		t.Errorf("IgnorePatterns = %v, want default [^legacy_]", config.IgnorePatterns)
+	}
+	if config.Limits.Max != 50 || config.Limits.Min != 1 {
+		t.Errorf("Limits = %+v, want {Max:50 Min:1}", config.Limits)
 	}
 }
 
-func TestRunner_GetOldModuleContent(t *testing.T) {
-	runner := TestRunner(t,
+func TestTestRunnerWithConfig_DecodeRuleConfigWithEnv(t *testing.T) {
+	runner := TestRunnerWithConfig(t,
+		map[string]string{},
+		map[string]string{},
 		map[string]string{
-			"main.tf": `
-variable "location" {
-  default = "westeurope"
-}`,
+			"test_rule": `
+threshold = 10
+mode      = "warn"
+`,
 		},
-		map[string]string{},
 	)
+	t.Setenv("TFBREAK_RULE_test_rule_THRESHOLD", "5")
 
-	schema := &hclext.BodySchema{
-		Blocks: []hclext.BlockSchema{
-			{
-				Type:       "variable",
-				LabelNames: []string{"name"},
-			},
-		},
+	var config struct {
+		Threshold int    `hcl:"threshold,optional"`
+		Mode      string `hcl:"mode,optional"`
 	}
-
-	content, err := runner.GetOldModuleContent(schema, nil)
-	if err != nil {
-		t.Fatalf("GetOldModuleContent failed: %v", err)
+	if err := tflint.DecodeRuleConfigWithEnv(runner, "test_rule", &config); err != nil {
+		t.Fatalf("DecodeRuleConfigWithEnv failed: %v", err)
 	}
 
-	if len(content.Blocks) != 1 {
-		t.Fatalf("expected 1 variable block, got %d", len(content.Blocks))
+	if config.Threshold != 5 {
+		t.Errorf("Threshold = %d, want 5 from env", config.Threshold)
 	}
-
-	if content.Blocks[0].Labels[0] != "location" {
-		t.Errorf("variable name = %q, want %q", content.Blocks[0].Labels[0], "location")
+	if config.Mode != "warn" {
+		t.Errorf("Mode = %q, want warn from config", config.Mode)
 	}
 }
 
-func TestRunner_EmitIssue(t *testing.T) {
-	runner := TestRunner(t, map[string]string{}, map[string]string{})
+func TestTestRunnerWithConfig_DecodeRuleConfigExists(t *testing.T) {
+	runner := TestRunnerWithConfig(t, map[string]string{}, map[string]string{}, map[string]string{
+		"configured": `ignore_patterns = []`,
+	})
 
-	rule := &testRule{name: "test_rule"}
-	issueRange := hcl.Range{
-		Filename: "main.tf",
-		Start:    hcl.Pos{Line: 3, Column: 3},
-		End:      hcl.Pos{Line: 3, Column: 20},
+	var config struct {
+		IgnorePatterns []string `hcl:"ignore_patterns,optional"`
 	}
-
-	err := runner.EmitIssue(rule, "test message", issueRange)
+	exists, err := runner.DecodeRuleConfigExists("configured", &config)
 	if err != nil {
-		t.Fatalf("EmitIssue failed: %v", err)
+		t.Fatalf("DecodeRuleConfigExists error: %v", err)
 	}
-
-	if len(runner.Issues) != 1 {
-		t.Fatalf("expected 1 issue, got %d", len(runner.Issues))
+	if !exists {
+		t.Error("expected configuration to exist for configured")
 	}
 
-	issue := runner.Issues[0]
-	if issue.Rule.Name() != "test_rule" {
-		t.Errorf("issue rule name = %q, want %q", issue.Rule.Name(), "test_rule")
+	exists, err = runner.DecodeRuleConfigExists("unconfigured", &config)
+	if err != nil {
+		t.Fatalf("DecodeRuleConfigExists error: %v", err)
 	}
-	if issue.Message != "test message" {
-		t.Errorf("issue message = %q, want %q", issue.Message, "test message")
+	if exists {
+		t.Error("expected no configuration for unconfigured")
 	}
-	if issue.Range.Filename != "main.tf" {
-		t.Errorf("issue range filename = %q, want %q", issue.Range.Filename, "main.tf")
+
+	exists, err = TestRunner(t, map[string]string{}, map[string]string{}).DecodeRuleConfigExists("configured", &config)
+	if err != nil || exists {
+		t.Errorf("TestRunner DecodeRuleConfigExists = %v, %v, want false, nil", exists, err)
 	}
 }
 
-func TestRunner_EmitIssue_Multiple(t *testing.T) {
-	runner := TestRunner(t, map[string]string{}, map[string]string{})
-
-	rule := &testRule{name: "test_rule"}
-
-	_ = runner.EmitIssue(rule, "issue 1", hcl.Range{})
-	_ = runner.EmitIssue(rule, "issue 2", hcl.Range{})
-	_ = runner.EmitIssue(rule, "issue 3", hcl.Range{})
+func TestTestRunnerWithConfig_UnknownRule(t *testing.T) {
+	runner := TestRunnerWithConfig(t,
+		map[string]string{},
+		map[string]string{},
+		map[string]string{"test_rule": `threshold = 10`},
+	)
 
-	if len(runner.Issues) != 3 {
-		t.Errorf("expected 3 issues, got %d", len(runner.Issues))
+	config := struct {
+		Threshold int `hcl:"threshold"`
+	}{Threshold: 5}
+	if err := runner.DecodeRuleConfig("other_rule", &config); err != nil {
+		t.Fatalf("DecodeRuleConfig failed: %v", err)
+	}
+	if config.Threshold != 5 {
+		t.Errorf("Threshold = %d, want unchanged 5", config.Threshold)
 	}
 }
 
-func TestRunner_DecodeRuleConfig(t *testing.T) {
-	runner := TestRunner(t, map[string]string{}, map[string]string{})
+func TestTestRunnerWithConfig_DecodeError(t *testing.T) {
+	runner := TestRunnerWithConfig(t,
+		map[string]string{},
+		map[string]string{},
+		map[string]string{"test_rule": `threshold = "high"`},
+	)
 
-	var target struct{ Value string }
-	err := runner.DecodeRuleConfig("test_rule", &target)
-	if err != nil {
-		t.Errorf("DecodeRuleConfig failed: %v", err)
+	var config struct {
+		Threshold int `hcl:"threshold"`
+	}
+	if err := runner.DecodeRuleConfig("test_rule", &config); err == nil {
+		t.Error("expected error decoding string into int")
 	}
 }
 
@@ -405,6 +2665,191 @@ resource "azurerm_storage_account" "example" {
 	}
 }
 
+func TestRunner_GetResourceContent_JustAttributes(t *testing.T) {
+	runner := TestRunner(t,
+		map[string]string{
+			"main.tf": `
+resource "azurerm_linux_web_app" "example" {
+  name = "webapp"
+
+  app_settings {
+    WEBSITE_RUN_FROM_PACKAGE = "1"
+    FEATURE_FLAG             = "on"
+  }
+
+  site_config {
+    always_on = true
+  }
+}`,
+		},
+		map[string]string{},
+	)
+
+	schema := &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{{Name: "name"}},
+		Blocks: []hclext.BlockSchema{
+			{
+				Type: "app_settings",
+				Body: &hclext.BodySchema{
+					Mode:       hclext.SchemaJustAttributesMode,
+					Attributes: []hclext.AttributeSchema{{Name: "FEATURE_FLAG", Required: true}},
+				},
+			},
+			{
+				Type: "site_config",
+				Body: &hclext.BodySchema{Attributes: []hclext.AttributeSchema{{Name: "always_on"}}},
+			},
+		},
+	}
+
+	content, err := runner.GetOldResourceContent("azurerm_linux_web_app", schema, nil)
+	if err != nil {
+		t.Fatalf("GetOldResourceContent() error = %v", err)
+	}
+
+	settings, ok := content.Blocks[0].Body.GetBlock("app_settings")
+	if !ok {
+		t.Fatal("app_settings block not found")
+	}
+	var names []string
+	for name := range settings.Body.Attributes {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	if want := []string{"FEATURE_FLAG", "WEBSITE_RUN_FROM_PACKAGE"}; !reflect.DeepEqual(names, want) {
+		t.Errorf("app_settings attributes = %v, want %v", names, want)
+	}
+	if got := string(settings.Body.Attributes["WEBSITE_RUN_FROM_PACKAGE"].SourceBytes); got != `"1"` {
+		t.Errorf("SourceBytes = %q, want %q", got, `"1"`)
+	}
+
+	// Sibling blocks still use the declared schema
+	if _, ok := content.Blocks[0].Body.GetAttribute("site_config", "always_on"); !ok {
+		t.Error("site_config.always_on not found")
+	}
+}
+
+func TestRunner_GetResourceContent_JustAttributesErrors(t *testing.T) {
+	runner := TestRunner(t,
+		map[string]string{
+			"main.tf": `
+resource "azurerm_linux_web_app" "example" {
+  app_settings {
+    FEATURE_FLAG = "on"
+
+    nested {
+      value = 1
+    }
+  }
+}`,
+		},
+		map[string]string{},
+	)
+
+	tests := []struct {
+		name   string
+		schema *hclext.BodySchema
+	}{
+		{
+			name:   "body contains blocks",
+			schema: &hclext.BodySchema{Mode: hclext.SchemaJustAttributesMode},
+		},
+		{
+			name: "schema declares blocks",
+			schema: &hclext.BodySchema{
+				Mode:   hclext.SchemaJustAttributesMode,
+				Blocks: []hclext.BlockSchema{{Type: "nested"}},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := runner.GetOldResourceContent("azurerm_linux_web_app", &hclext.BodySchema{
+				Blocks: []hclext.BlockSchema{{Type: "app_settings", Body: tt.schema}},
+			}, nil)
+			if err == nil {
+				t.Fatal("expected error")
+			}
+		})
+	}
+}
+
+func TestRunner_GetResourceContent_ExactMode(t *testing.T) {
+	runner := TestRunner(t,
+		map[string]string{
+			"main.tf": `
+resource "azurerm_storage_account" "example" {
+  account_tier = "Standard"
+  account_kind = "StorageV2"
+
+  network_rules {
+    default_action = "Deny"
+  }
+}`,
+		},
+		map[string]string{},
+	)
+
+	tests := []struct {
+		name    string
+		schema  *hclext.BodySchema
+		wantErr string
+	}{
+		{
+			name: "default mode ignores undeclared attribute",
+			schema: &hclext.BodySchema{
+				Attributes: []hclext.AttributeSchema{{Name: "account_tier"}},
+				Blocks:     []hclext.BlockSchema{{Type: "network_rules", Body: &hclext.BodySchema{}}},
+			},
+		},
+		{
+			name: "exact mode reports undeclared attribute",
+			schema: &hclext.BodySchema{
+				Attributes: []hclext.AttributeSchema{{Name: "account_tier"}},
+				Blocks:     []hclext.BlockSchema{{Type: "network_rules", Body: &hclext.BodySchema{}}},
+				Mode:       hclext.SchemaExactMode,
+			},
+			wantErr: `An argument named "account_kind" is not expected here.`,
+		},
+		{
+			name: "exact mode reports undeclared block",
+			schema: &hclext.BodySchema{
+				Attributes: []hclext.AttributeSchema{{Name: "account_tier"}, {Name: "account_kind"}},
+				Mode:       hclext.SchemaExactMode,
+			},
+			wantErr: `Blocks of type "network_rules" are not expected here.`,
+		},
+		{
+			name: "exact mode in nested block schema",
+			schema: &hclext.BodySchema{
+				Attributes: []hclext.AttributeSchema{{Name: "account_tier"}, {Name: "account_kind"}},
+				Blocks:     []hclext.BlockSchema{{Type: "network_rules", Body: &hclext.BodySchema{Mode: hclext.SchemaExactMode}}},
+				Mode:       hclext.SchemaExactMode,
+			},
+			wantErr: `An argument named "default_action" is not expected here.`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			content, err := runner.GetOldResourceContent("azurerm_storage_account", tt.schema, nil)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("GetOldResourceContent() error = %v", err)
+				}
+				if len(content.Blocks) != 1 {
+					t.Errorf("got %d blocks, want 1", len(content.Blocks))
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("GetOldResourceContent() error = %v, want it to contain %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
 func TestLabelsMatch(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -431,3 +2876,180 @@ func TestLabelsMatch(t *testing.T) {
 		})
 	}
 }
+
+// resourceCountRule emits one issue per resource block in the new
+// configuration, or fails with err if set.
+type resourceCountRule struct {
+	testRule
+	enabled bool
+	err     error
+}
+
+func (r *resourceCountRule) Enabled() bool { return r.enabled }
+
+func (r *resourceCountRule) Check(_ context.Context, runner tflint.Runner) error {
+	if r.err != nil {
+		return r.err
+	}
+	content, err := runner.GetNewModuleContent(&hclext.BodySchema{
+		Blocks: []hclext.BlockSchema{{Type: "resource", LabelNames: []string{"type", "name"}}},
+	}, nil)
+	if err != nil {
+		return err
+	}
+	for _, block := range content.Blocks {
+		if err := runner.EmitIssue(r, r.name+": "+block.Address(), block.DefRange); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func TestRunRuleSet(t *testing.T) {
+	enabled := &resourceCountRule{testRule: testRule{name: "enabled_rule"}, enabled: true}
+	disabled := &resourceCountRule{testRule: testRule{name: "disabled_rule"}}
+	ruleset := &tflint.BuiltinRuleSet{
+		Name:    "test",
+		Version: "0.1.0",
+		Rules:   []tflint.Rule{enabled, disabled},
+	}
+	files := map[string]string{"main.tf": `resource "azurerm_resource_group" "rg" {}`}
+
+	runner := TestRunner(t, files, files)
+	if err := RunRuleSet(t, ruleset, runner); err != nil {
+		t.Fatalf("RunRuleSet() error = %v", err)
+	}
+	AssertIssuesWithoutRange(t, Issues{
+		{Rule: enabled, Message: "enabled_rule: azurerm_resource_group.rg"},
+	}, runner.Issues)
+
+	// The global config enables the disabled rule and overrides its severity
+	notice := tflint.NOTICE
+	runner = TestRunner(t, files, files)
+	runner.Config = &tflint.Config{
+		Rules: map[string]*tflint.RuleConfig{
+			"disabled_rule": {Name: "disabled_rule", Enabled: true, Severity: &notice},
+		},
+	}
+	if err := RunRuleSet(t, ruleset, runner); err != nil {
+		t.Fatalf("RunRuleSet() error = %v", err)
+	}
+	AssertIssueCount(t, runner.Issues, 2)
+	for _, issue := range runner.Issues {
+		if issue.Rule.Name() == "disabled_rule" && issue.Severity != tflint.NOTICE {
+			t.Errorf("disabled_rule severity = %v, want NOTICE", issue.Severity)
+		}
+	}
+}
+
+func TestRunRuleSet_CollectsErrors(t *testing.T) {
+	failing := &resourceCountRule{testRule: testRule{name: "failing_rule"}, enabled: true, err: errors.New("boom")}
+	passing := &resourceCountRule{testRule: testRule{name: "passing_rule"}, enabled: true}
+	ruleset := &tflint.BuiltinRuleSet{Rules: []tflint.Rule{failing, passing}}
+	files := map[string]string{"main.tf": `resource "azurerm_resource_group" "rg" {}`}
+
+	runner := TestRunner(t, files, files)
+	err := RunRuleSet(t, ruleset, runner)
+
+	var ruleErr *tflint.RuleError
+	if !errors.As(err, &ruleErr) || ruleErr.Rule != "failing_rule" {
+		t.Fatalf("RunRuleSet() error = %v, want a RuleError for failing_rule", err)
+	}
+	// Later rules still run
+	AssertIssueCount(t, runner.Issues, 1)
+}
+
+// preparedRule counts its Prepare calls and fails Check unless prepared.
+type preparedRule struct {
+	resourceCountRule
+	prepared int
+}
+
+func (r *preparedRule) Prepare(_ tflint.Runner) error {
+	r.prepared++
+	return nil
+}
+
+func (r *preparedRule) Check(ctx context.Context, runner tflint.Runner) error {
+	if r.prepared != 1 {
+		return fmt.Errorf("prepared %d times before Check, want 1", r.prepared)
+	}
+	return r.resourceCountRule.Check(ctx, runner)
+}
+
+func TestRunRuleSet_PreparesRules(t *testing.T) {
+	rule := &preparedRule{resourceCountRule: resourceCountRule{testRule: testRule{name: "prepared_rule"}, enabled: true}}
+	ruleset := &tflint.BuiltinRuleSet{Rules: []tflint.Rule{rule}}
+	files := map[string]string{"main.tf": `resource "azurerm_resource_group" "rg" {}`}
+
+	runner := TestRunner(t, files, files)
+	if err := RunRuleSet(t, ruleset, runner); err != nil {
+		t.Fatalf("RunRuleSet() error = %v", err)
+	}
+	if rule.prepared != 1 {
+		t.Errorf("Prepare called %d times, want 1", rule.prepared)
+	}
+	AssertIssueCount(t, runner.Issues, 1)
+}
+
+func TestRunner_ContextCancelled(t *testing.T) {
+	files := map[string]string{"main.tf": `
+resource "azurerm_resource_group" "rg" {}
+
+variable "location" {}
+`}
+	runner := TestRunner(t, files, files)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	runner.Context = ctx
+
+	if _, err := runner.GetNewResourceContent("azurerm_resource_group", &hclext.BodySchema{}, nil); err != nil {
+		t.Fatalf("GetNewResourceContent() before cancel error = %v", err)
+	}
+
+	cancel()
+
+	calls := map[string]func() error{
+		"GetOldModuleContent": func() error {
+			_, err := runner.GetOldModuleContent(&hclext.BodySchema{}, nil)
+			return err
+		},
+		"GetNewResourceContent": func() error {
+			_, err := runner.GetNewResourceContent("azurerm_resource_group", &hclext.BodySchema{}, nil)
+			return err
+		},
+		"GetResourceContentPair": func() error {
+			_, _, err := runner.GetResourceContentPair("azurerm_resource_group", &hclext.BodySchema{}, nil)
+			return err
+		},
+		"GetOldVariables": func() error {
+			_, err := runner.GetOldVariables()
+			return err
+		},
+		"GetNewFile": func() error {
+			_, err := runner.GetNewFile("main.tf")
+			return err
+		},
+		"GetFileSource": func() error {
+			_, err := runner.GetFileSource("main.tf", tflint.SideNew)
+			return err
+		},
+		"GetModuleDiff": func() error {
+			_, err := runner.GetModuleDiff(&hclext.BodySchema{}, nil)
+			return err
+		},
+	}
+	for name, call := range calls {
+		if err := call(); !errors.Is(err, context.Canceled) {
+			t.Errorf("%s() error = %v, want context.Canceled", name, err)
+		}
+	}
+
+	// The rule's own callbacks fail, so RunRuleSet reports it
+	rule := &resourceCountRule{testRule: testRule{name: "count_rule"}, enabled: true}
+	err := RunRuleSet(t, &tflint.BuiltinRuleSet{Rules: []tflint.Rule{rule}}, runner)
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("RunRuleSet() error = %v, want context.Canceled", err)
+	}
+	AssertNoIssues(t, runner.Issues)
+}
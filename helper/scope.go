@@ -0,0 +1,43 @@
+package helper
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/jokarl/tfbreak-plugin-sdk/tflint"
+)
+
+// AssertRuleScope checks that rule implements tflint.ScopedRule and that
+// its ResourceTypes are wantTypes, in any order. It guards against a rule
+// silently becoming unscoped, which makes the host run it on every
+// configuration.
+//
+// Example:
+//
+//	helper.AssertRuleScope(t, NewStorageAccountRule(), []string{"azurerm_storage_account"})
+func AssertRuleScope(t *testing.T, rule tflint.Rule, wantTypes []string) {
+	t.Helper()
+
+	if msg := ruleScopeMismatch(rule, wantTypes); msg != "" {
+		t.Error(msg)
+	}
+}
+
+// ruleScopeMismatch describes how the scope of rule differs from
+// wantTypes, or returns an empty string if it matches.
+func ruleScopeMismatch(rule tflint.Rule, wantTypes []string) string {
+	scoped, ok := rule.(tflint.ScopedRule)
+	if !ok {
+		return fmt.Sprintf("rule %s does not implement tflint.ScopedRule, want resource types %q", rule.Name(), wantTypes)
+	}
+
+	got := slices.Clone(scoped.ResourceTypes())
+	want := slices.Clone(wantTypes)
+	slices.Sort(got)
+	slices.Sort(want)
+	if !slices.Equal(got, want) {
+		return fmt.Sprintf("rule %s is scoped to resource types %q, want %q", rule.Name(), got, want)
+	}
+	return ""
+}
@@ -0,0 +1,40 @@
+package helper
+
+import (
+	"strings"
+	"testing"
+)
+
+// scopedRuleForTest is a rule scoped to a fixed set of resource types.
+type scopedRuleForTest struct {
+	testRuleForIssue
+	types []string
+}
+
+func (r *scopedRuleForTest) ResourceTypes() []string { return r.types }
+
+func TestAssertRuleScope(t *testing.T) {
+	rule := &scopedRuleForTest{
+		testRuleForIssue: testRuleForIssue{name: "scoped_rule"},
+		types:            []string{"azurerm_storage_account", "azurerm_resource_group"},
+	}
+
+	AssertRuleScope(t, rule, []string{"azurerm_resource_group", "azurerm_storage_account"})
+
+	msg := ruleScopeMismatch(rule, []string{"azurerm_storage_account"})
+	if msg == "" {
+		t.Fatal("expected scope mismatch to be detected")
+	}
+	if !strings.Contains(msg, "scoped_rule") || !strings.Contains(msg, "azurerm_resource_group") {
+		t.Errorf("mismatch message should name the rule and its resource types, got %q", msg)
+	}
+}
+
+func TestAssertRuleScope_Unscoped(t *testing.T) {
+	rule := &testRuleForIssue{name: "unscoped_rule"}
+
+	msg := ruleScopeMismatch(rule, []string{"azurerm_storage_account"})
+	if !strings.Contains(msg, "does not implement tflint.ScopedRule") {
+		t.Errorf("expected unscoped rule to be reported, got %q", msg)
+	}
+}
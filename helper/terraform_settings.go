@@ -0,0 +1,98 @@
+package helper
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/jokarl/tfbreak-plugin-sdk/tflint"
+)
+
+// GetOldTerraformSettings parses the terraform blocks of the old files.
+func (r *Runner) GetOldTerraformSettings() (*tflint.TerraformSettings, error) {
+	if err := r.contextErr(); err != nil {
+		return nil, err
+	}
+	return terraformSettings(moduleInstances(r.oldFiles, r.modules)[0].files)
+}
+
+// GetNewTerraformSettings parses the terraform blocks of the new files.
+func (r *Runner) GetNewTerraformSettings() (*tflint.TerraformSettings, error) {
+	if err := r.contextErr(); err != nil {
+		return nil, err
+	}
+	return terraformSettings(moduleInstances(r.newFiles, r.modules)[0].files)
+}
+
+// terraformSettings collects the settings of all `terraform` blocks in files.
+// A required_version declared more than once keeps the last declaration, in
+// file name order, as required_providers entries do. More than one backend
+// block is an error, as it is for Terraform.
+func terraformSettings(files map[string]*hcl.File) (*tflint.TerraformSettings, error) {
+	reqs, err := providerRequirements(files)
+	if err != nil {
+		return nil, err
+	}
+	settings := &tflint.TerraformSettings{RequiredProviders: reqs}
+
+	terraformSchema := &hcl.BodySchema{
+		Blocks: []hcl.BlockHeaderSchema{{Type: "terraform"}},
+	}
+	settingsSchema := &hcl.BodySchema{
+		Attributes: []hcl.AttributeSchema{{Name: "required_version"}},
+		Blocks:     []hcl.BlockHeaderSchema{{Type: "backend", LabelNames: []string{"type"}}},
+	}
+
+	var diags hcl.Diagnostics
+	for _, name := range listFiles(files) {
+		file := files[name]
+		content, _, fileDiags := file.Body.PartialContent(terraformSchema)
+		diags = append(diags, fileDiags...)
+		if fileDiags.HasErrors() {
+			continue
+		}
+
+		for _, terraform := range content.Blocks {
+			tfContent, _, tfDiags := terraform.Body.PartialContent(settingsSchema)
+			diags = append(diags, tfDiags...)
+			if tfDiags.HasErrors() {
+				continue
+			}
+
+			if attr, ok := tfContent.Attributes["required_version"]; ok {
+				val, valDiags := literalValue(attr, cty.String)
+				diags = append(diags, valDiags...)
+				if !valDiags.HasErrors() {
+					settings.RequiredVersion = val.AsString()
+					settings.RequiredVersionRange = attr.Range
+				}
+			}
+
+			for _, backend := range tfContent.Blocks {
+				if settings.Backend != nil {
+					diags = append(diags, &hcl.Diagnostic{
+						Severity: hcl.DiagError,
+						Summary:  "Duplicate backend configuration",
+						Detail:   fmt.Sprintf("A backend was already declared at %s. A module may have only one backend.", settings.Backend.DeclRange),
+						Subject:  backend.DefRange.Ptr(),
+					})
+					continue
+				}
+				body, bodyDiags := rawContent(backend.Body)
+				diags = append(diags, bodyDiags...)
+				fillSourceBytes(body, file.Bytes)
+				settings.Backend = &tflint.TerraformBackend{
+					Type:      backend.Labels[0],
+					Body:      body,
+					DeclRange: backend.DefRange,
+				}
+			}
+		}
+	}
+
+	if diags.HasErrors() {
+		return nil, diags
+	}
+	return settings, nil
+}
@@ -0,0 +1,3 @@
+variable "address_space" {
+  default = "10.0.0.0/16"
+}
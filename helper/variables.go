@@ -0,0 +1,223 @@
+package helper
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/ext/typeexpr"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	hcljson "github.com/hashicorp/hcl/v2/json"
+	"github.com/zclconf/go-cty/cty"
+	"github.com/zclconf/go-cty/cty/convert"
+
+	"github.com/jokarl/tfbreak-plugin-sdk/tflint"
+)
+
+// GetOldVariables parses the variable blocks of the old files.
+func (r *Runner) GetOldVariables() ([]tflint.VariableDef, error) {
+	if err := r.contextErr(); err != nil {
+		return nil, err
+	}
+	return variables(r.oldFiles)
+}
+
+// GetNewVariables parses the variable blocks of the new files.
+func (r *Runner) GetNewVariables() ([]tflint.VariableDef, error) {
+	if err := r.contextErr(); err != nil {
+		return nil, err
+	}
+	return variables(r.newFiles)
+}
+
+// GetOldOutputs parses the output blocks of the old files.
+func (r *Runner) GetOldOutputs() ([]tflint.OutputDef, error) {
+	if err := r.contextErr(); err != nil {
+		return nil, err
+	}
+	return outputs(r.oldFiles)
+}
+
+// GetNewOutputs parses the output blocks of the new files.
+func (r *Runner) GetNewOutputs() ([]tflint.OutputDef, error) {
+	if err := r.contextErr(); err != nil {
+		return nil, err
+	}
+	return outputs(r.newFiles)
+}
+
+// variables collects the variable blocks in files, in file name order.
+func variables(files map[string]*hcl.File) ([]tflint.VariableDef, error) {
+	fileSchema := &hcl.BodySchema{
+		Blocks: []hcl.BlockHeaderSchema{{Type: "variable", LabelNames: []string{"name"}}},
+	}
+	variableSchema := &hcl.BodySchema{
+		Attributes: []hcl.AttributeSchema{{Name: "type"}, {Name: "default"}},
+	}
+
+	vars := []tflint.VariableDef{}
+	var diags hcl.Diagnostics
+	for _, name := range listFiles(files) {
+		content, _, fileDiags := files[name].Body.PartialContent(fileSchema)
+		diags = append(diags, fileDiags...)
+		if fileDiags.HasErrors() {
+			continue
+		}
+
+		for _, block := range content.Blocks {
+			varContent, _, varDiags := block.Body.PartialContent(variableSchema)
+			diags = append(diags, varDiags...)
+			if varDiags.HasErrors() {
+				continue
+			}
+			v, varDiags := decodeVariable(block, varContent)
+			diags = append(diags, varDiags...)
+			if !varDiags.HasErrors() {
+				vars = append(vars, v)
+			}
+		}
+	}
+
+	if diags.HasErrors() {
+		return nil, diags
+	}
+	return vars, nil
+}
+
+// decodeVariable decodes the type and default of a variable block.
+// Like Terraform, the default is converted to the type constraint, with
+// optional object attribute defaults applied first.
+func decodeVariable(block *hcl.Block, content *hcl.BodyContent) (tflint.VariableDef, hcl.Diagnostics) {
+	v := tflint.VariableDef{
+		Name:      block.Labels[0],
+		Type:      cty.DynamicPseudoType,
+		DeclRange: block.DefRange,
+	}
+
+	var defaults *typeexpr.Defaults
+	if attr, ok := content.Attributes["type"]; ok {
+		expr, diags := typeExpr(attr.Expr)
+		if diags.HasErrors() {
+			return v, diags
+		}
+		ty, tyDefaults, diags := typeexpr.TypeConstraintWithDefaults(expr)
+		if diags.HasErrors() {
+			return v, diags
+		}
+		v.Type, defaults = ty, tyDefaults
+	}
+
+	attr, ok := content.Attributes["default"]
+	if !ok {
+		v.Required = true
+		return v, nil
+	}
+
+	val, diags := attr.Expr.Value(nil)
+	if diags.HasErrors() {
+		return v, diags
+	}
+	if defaults != nil {
+		val = defaults.Apply(val)
+	}
+	val, err := convert.Convert(val, v.Type)
+	if err != nil {
+		return v, hcl.Diagnostics{{
+			Severity: hcl.DiagError,
+			Summary:  "Invalid default value for variable",
+			Detail:   fmt.Sprintf("The default value of variable %q is not compatible with its type constraint: %s.", v.Name, err),
+			Subject:  attr.Expr.Range().Ptr(),
+		}}
+	}
+	v.Default, v.HasDefault = val, true
+	return v, nil
+}
+
+// typeExpr returns the type constraint expression of a variable. In JSON
+// files the type is a string containing native syntax, e.g. "list(string)".
+func typeExpr(expr hcl.Expression) (hcl.Expression, hcl.Diagnostics) {
+	if !hcljson.IsJSONExpression(expr) {
+		return expr, nil
+	}
+	val, diags := expr.Value(nil)
+	if diags.HasErrors() {
+		return nil, diags
+	}
+	if !val.IsKnown() || val.IsNull() || val.Type() != cty.String {
+		return nil, hcl.Diagnostics{{
+			Severity: hcl.DiagError,
+			Summary:  "Invalid type specification",
+			Detail:   "A type constraint in JSON syntax must be a string.",
+			Subject:  expr.Range().Ptr(),
+		}}
+	}
+	return hclsyntax.ParseExpression([]byte(val.AsString()), expr.Range().Filename, expr.Range().Start)
+}
+
+// outputs collects the output blocks in files, in file name order.
+func outputs(files map[string]*hcl.File) ([]tflint.OutputDef, error) {
+	fileSchema := &hcl.BodySchema{
+		Blocks: []hcl.BlockHeaderSchema{{Type: "output", LabelNames: []string{"name"}}},
+	}
+	outputSchema := &hcl.BodySchema{
+		Attributes: []hcl.AttributeSchema{{Name: "description"}, {Name: "sensitive"}},
+	}
+
+	outs := []tflint.OutputDef{}
+	var diags hcl.Diagnostics
+	for _, name := range listFiles(files) {
+		content, _, fileDiags := files[name].Body.PartialContent(fileSchema)
+		diags = append(diags, fileDiags...)
+		if fileDiags.HasErrors() {
+			continue
+		}
+
+		for _, block := range content.Blocks {
+			outContent, _, outDiags := block.Body.PartialContent(outputSchema)
+			diags = append(diags, outDiags...)
+			if outDiags.HasErrors() {
+				continue
+			}
+
+			out := tflint.OutputDef{Name: block.Labels[0], DeclRange: block.DefRange}
+			if attr, ok := outContent.Attributes["description"]; ok {
+				val, valDiags := literalValue(attr, cty.String)
+				diags = append(diags, valDiags...)
+				if !valDiags.HasErrors() {
+					out.Description = val.AsString()
+				}
+			}
+			if attr, ok := outContent.Attributes["sensitive"]; ok {
+				val, valDiags := literalValue(attr, cty.Bool)
+				diags = append(diags, valDiags...)
+				if !valDiags.HasErrors() {
+					out.Sensitive = val.True()
+				}
+			}
+			outs = append(outs, out)
+		}
+	}
+
+	if diags.HasErrors() {
+		return nil, diags
+	}
+	return outs, nil
+}
+
+// literalValue evaluates attr without context and converts it to ty.
+func literalValue(attr *hcl.Attribute, ty cty.Type) (cty.Value, hcl.Diagnostics) {
+	val, diags := attr.Expr.Value(nil)
+	if diags.HasErrors() {
+		return cty.NilVal, diags
+	}
+	if val.IsKnown() && !val.IsNull() {
+		if converted, err := convert.Convert(val, ty); err == nil {
+			return converted, nil
+		}
+	}
+	return cty.NilVal, hcl.Diagnostics{{
+		Severity: hcl.DiagError,
+		Summary:  fmt.Sprintf("Invalid %s argument", attr.Name),
+		Detail:   fmt.Sprintf("The %s argument must be a literal %s.", attr.Name, ty.FriendlyName()),
+		Subject:  attr.Expr.Range().Ptr(),
+	}}
+}
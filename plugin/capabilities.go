@@ -0,0 +1,70 @@
+// Package plugin provides gRPC-based plugin communication for tfbreak.
+//
+// This file implements capability negotiation. Hosts and plugins built with
+// different SDK versions use the capabilities a plugin reports to avoid
+// optional features the other side does not support.
+
+package plugin
+
+import (
+	"slices"
+
+	"github.com/jokarl/tfbreak-plugin-sdk/tflint"
+)
+
+// Capabilities a plugin can report through GRPCRuleSetClient.Capabilities.
+const (
+	// CapabilityCheckStream means the plugin implements the CheckStream RPC.
+	CapabilityCheckStream = "check_stream"
+	// CapabilityEmitFix means at least one rule implements
+	// tflint.FixableRule and may emit issues with fixes.
+	CapabilityEmitFix = "emit_fix"
+	// CapabilityRuleMetadata means at least one rule implements
+	// tflint.RuleMetadata, so GetRuleMetadata reports descriptions and tags.
+	CapabilityRuleMetadata = "rule_metadata"
+	// CapabilityScopedRules means at least one rule implements
+	// tflint.ScopedRule, so the host can skip rules by resource type.
+	CapabilityScopedRules = "scoped_rules"
+	// CapabilityDeprecatedRules means at least one rule implements
+	// tflint.DeprecatedRule.
+	CapabilityDeprecatedRules = "deprecated_rules"
+)
+
+// Capabilities is the set of capabilities a plugin supports.
+type Capabilities map[string]bool
+
+// Has reports whether the plugin supports the named capability.
+func (c Capabilities) Has(name string) bool {
+	return c[name]
+}
+
+// ruleSetCapabilities returns the sorted capabilities of rs: those every
+// plugin built with this SDK supports, plus those derived from the optional
+// interfaces its rules implement.
+func ruleSetCapabilities(rs tflint.RuleSet) []string {
+	capabilities := []string{CapabilityCheckStream}
+	add := func(name string) {
+		if !slices.Contains(capabilities, name) {
+			capabilities = append(capabilities, name)
+		}
+	}
+
+	if builtin := rs.BuiltinImpl(); builtin != nil {
+		for _, rule := range builtin.Rules {
+			if fixable, ok := rule.(tflint.FixableRule); ok && fixable.Fixable() {
+				add(CapabilityEmitFix)
+			}
+			if _, ok := rule.(tflint.RuleMetadata); ok {
+				add(CapabilityRuleMetadata)
+			}
+			if _, ok := rule.(tflint.ScopedRule); ok {
+				add(CapabilityScopedRules)
+			}
+			if _, ok := rule.(tflint.DeprecatedRule); ok {
+				add(CapabilityDeprecatedRules)
+			}
+		}
+	}
+	slices.Sort(capabilities)
+	return capabilities
+}
@@ -0,0 +1,101 @@
+package plugin
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/jokarl/tfbreak-plugin-sdk/plugin/proto"
+	"github.com/jokarl/tfbreak-plugin-sdk/tflint"
+)
+
+type fixableTestRule struct {
+	testRule
+	fixable bool
+}
+
+func (r *fixableTestRule) Fixable() bool { return r.fixable }
+
+func TestRuleSetCapabilities(t *testing.T) {
+	tests := []struct {
+		name  string
+		rules []tflint.Rule
+		want  []string
+	}{
+		{
+			name:  "plain rules",
+			rules: []tflint.Rule{&testRule{name: "plain"}},
+			want:  []string{CapabilityCheckStream},
+		},
+		{
+			name:  "fixable rule",
+			rules: []tflint.Rule{&testRule{name: "plain"}, &fixableTestRule{testRule: testRule{name: "fixable"}, fixable: true}},
+			want:  []string{CapabilityCheckStream, CapabilityEmitFix},
+		},
+		{
+			name:  "rule that does not fix",
+			rules: []tflint.Rule{&fixableTestRule{testRule: testRule{name: "unfixable"}}},
+			want:  []string{CapabilityCheckStream},
+		},
+		{
+			name: "optional interfaces",
+			rules: []tflint.Rule{
+				&metadataTestRule{scopedTestRule{testRule: testRule{name: "metadata"}}},
+				&deprecatedTestRule{testRule: testRule{name: "deprecated"}},
+			},
+			want: []string{CapabilityCheckStream, CapabilityDeprecatedRules, CapabilityRuleMetadata, CapabilityScopedRules},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rs := &tflint.BuiltinRuleSet{Name: "test", Version: "0.1.0", Rules: tt.rules}
+			if got := ruleSetCapabilities(rs); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ruleSetCapabilities() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGRPCRuleSetClient_Capabilities(t *testing.T) {
+	ruleset := dispenseRuleSet(t, &tflint.BuiltinRuleSet{
+		Name:    "test",
+		Version: "0.1.0",
+		Rules:   []tflint.Rule{&fixableTestRule{testRule: testRule{name: "fixable"}, fixable: true}},
+	}, RuleSetPlugin{})
+
+	caps, err := ruleset.Capabilities()
+	if err != nil {
+		t.Fatalf("Capabilities error: %v", err)
+	}
+	if !caps.Has(CapabilityEmitFix) || !caps.Has(CapabilityCheckStream) {
+		t.Errorf("Capabilities() = %v, want %s and %s", caps, CapabilityEmitFix, CapabilityCheckStream)
+	}
+	if caps.Has(CapabilityScopedRules) {
+		t.Errorf("Capabilities() = %v, want no %s", caps, CapabilityScopedRules)
+	}
+}
+
+// legacyRuleSetClient answers like a plugin built before GetCapabilities existed.
+type legacyRuleSetClient struct {
+	pb.RuleSetClient
+}
+
+func (legacyRuleSetClient) GetCapabilities(context.Context, *pb.GetCapabilities_Request, ...grpc.CallOption) (*pb.GetCapabilities_Response, error) {
+	return nil, status.Error(codes.Unimplemented, "unknown method GetCapabilities")
+}
+
+func TestGRPCRuleSetClient_CapabilitiesUnimplemented(t *testing.T) {
+	c := &GRPCRuleSetClient{client: legacyRuleSetClient{}}
+
+	caps, err := c.Capabilities()
+	if err != nil {
+		t.Fatalf("Capabilities error: %v", err)
+	}
+	if len(caps) != 0 {
+		t.Errorf("Capabilities() = %v, want none", caps)
+	}
+}
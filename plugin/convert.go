@@ -6,7 +6,11 @@
 package plugin
 
 import (
+	"errors"
+	"fmt"
+
 	"github.com/hashicorp/hcl/v2"
+	hcljson "github.com/hashicorp/hcl/v2/json"
 	"github.com/zclconf/go-cty/cty"
 	ctyjson "github.com/zclconf/go-cty/cty/json"
 
@@ -27,18 +31,25 @@ func toProtoConfig(config *tflint.Config) *pb.Config {
 
 	protoRules := make(map[string]*pb.RuleConfig)
 	for name, rc := range config.Rules {
-		protoRules[name] = &pb.RuleConfig{
-			Name:    rc.Name,
-			Enabled: rc.Enabled,
-			// Note: Body is not serialized over gRPC; use DecodeRuleConfig instead
+		protoRule := &pb.RuleConfig{
+			Name:        rc.Name,
+			Enabled:     rc.Enabled,
+			ConfigBytes: toProtoRuleConfigBody(rc.Body),
+		}
+		if rc.Severity != nil {
+			protoRule.Severity = toProtoSeverity(*rc.Severity)
 		}
+		protoRules[name] = protoRule
 	}
 
 	return &pb.Config{
 		Rules:             protoRules,
 		DisabledByDefault: config.DisabledByDefault,
 		Only:              config.Only,
+		Ignore:            config.Ignore,
 		PluginDir:         config.PluginDir,
+		MinSeverity:       toProtoSeverity(config.MinSeverity),
+		Variables:         toProtoVariables(config.Variables),
 	}
 }
 
@@ -50,21 +61,122 @@ func fromProtoConfig(config *pb.Config) *tflint.Config {
 
 	rules := make(map[string]*tflint.RuleConfig)
 	for name, rc := range config.GetRules() {
-		rules[name] = &tflint.RuleConfig{
+		ruleConfig := &tflint.RuleConfig{
 			Name:    rc.GetName(),
 			Enabled: rc.GetEnabled(),
-			// Note: Body is not deserialized; use DecodeRuleConfig instead
+			Body:    fromProtoRuleConfigBody(name, rc.GetConfigBytes()),
+		}
+		if rc.GetSeverity() != pb.Severity_SEVERITY_UNSPECIFIED {
+			severity := fromProtoSeverity(rc.GetSeverity())
+			ruleConfig.Severity = &severity
 		}
+		rules[name] = ruleConfig
+	}
+
+	var minSeverity tflint.Severity
+	if config.GetMinSeverity() != pb.Severity_SEVERITY_UNSPECIFIED {
+		minSeverity = fromProtoSeverity(config.GetMinSeverity())
 	}
 
 	return &tflint.Config{
 		Rules:             rules,
 		DisabledByDefault: config.GetDisabledByDefault(),
 		Only:              config.GetOnly(),
+		Ignore:            config.GetIgnore(),
 		PluginDir:         config.GetPluginDir(),
+		MinSeverity:       minSeverity,
+		Variables:         fromProtoVariables(config.GetVariables()),
 	}
 }
 
+// toProtoRuleConfigBody encodes a rule configuration body as a JSON object.
+// It returns nil if the body is unset, has nested blocks, or has an attribute
+// that cannot be evaluated without context; the plugin then falls back to
+// the DecodeRuleConfig callback.
+func toProtoRuleConfigBody(body hcl.Body) []byte {
+	if body == nil {
+		return nil
+	}
+	attrs, diags := body.JustAttributes()
+	if diags.HasErrors() {
+		return nil
+	}
+
+	vals := make(map[string]cty.Value, len(attrs))
+	for name, attr := range attrs {
+		val, diags := attr.Expr.Value(nil)
+		if diags.HasErrors() || !val.IsWhollyKnown() {
+			return nil
+		}
+		vals[name] = val
+	}
+	obj := cty.ObjectVal(vals)
+	configBytes, err := ctyjson.Marshal(obj, obj.Type())
+	if err != nil {
+		return nil
+	}
+	return configBytes
+}
+
+// fromProtoRuleConfigBody parses JSON-encoded rule configuration into a body.
+// It returns nil if there is no configuration or it cannot be parsed.
+func fromProtoRuleConfigBody(ruleName string, configBytes []byte) hcl.Body {
+	if len(configBytes) == 0 {
+		return nil
+	}
+	file, diags := hcljson.Parse(configBytes, ruleName+".json")
+	if diags.HasErrors() {
+		return nil
+	}
+	return file.Body
+}
+
+// toProtoVariables converts config variables to proto.Value entries.
+// Values that are null, unknown, or cannot be serialized are dropped.
+func toProtoVariables(vars map[string]cty.Value) map[string]*pb.Value {
+	if len(vars) == 0 {
+		return nil
+	}
+
+	protoVars := make(map[string]*pb.Value, len(vars))
+	for name, val := range vars {
+		if val == cty.NilVal || val.IsNull() || !val.IsWhollyKnown() {
+			continue
+		}
+		valueBytes, err := ctyjson.Marshal(val, val.Type())
+		if err != nil {
+			continue
+		}
+		typeBytes, err := ctyjson.MarshalType(val.Type())
+		if err != nil {
+			continue
+		}
+		protoVars[name] = &pb.Value{Value: valueBytes, Type: typeBytes}
+	}
+	return protoVars
+}
+
+// fromProtoVariables converts proto.Value entries to config variables.
+// Entries that cannot be decoded are dropped.
+func fromProtoVariables(vars map[string]*pb.Value) map[string]cty.Value {
+	if len(vars) == 0 {
+		return nil
+	}
+
+	result := make(map[string]cty.Value, len(vars))
+	for name, v := range vars {
+		if len(v.GetValue()) == 0 {
+			continue
+		}
+		val := decodeExprValue(v.GetValue(), v.GetType())
+		if val == cty.NilVal {
+			continue
+		}
+		result[name] = val
+	}
+	return result
+}
+
 // =============================================================================
 // Schema Conversion
 // =============================================================================
@@ -95,7 +207,7 @@ func toProtoBodySchema(schema *hclext.BodySchema) *pb.BodySchema {
 	return &pb.BodySchema{
 		Attributes: protoAttrs,
 		Blocks:     protoBlocks,
-		Mode:       pb.SchemaMode(schema.Mode),
+		Mode:       toProtoSchemaMode(schema.Mode),
 	}
 }
 
@@ -125,7 +237,33 @@ func fromProtoBodySchema(schema *pb.BodySchema) *hclext.BodySchema {
 	return &hclext.BodySchema{
 		Attributes: attrs,
 		Blocks:     blocks,
-		Mode:       hclext.SchemaMode(schema.GetMode()),
+		Mode:       fromProtoSchemaMode(schema.GetMode()),
+	}
+}
+
+// toProtoSchemaMode converts hclext.SchemaMode to proto.SchemaMode.
+// The enums are mapped explicitly so that adding a mode to either side
+// cannot silently misalign them.
+func toProtoSchemaMode(mode hclext.SchemaMode) pb.SchemaMode {
+	switch mode {
+	case hclext.SchemaJustAttributesMode:
+		return pb.SchemaMode_SCHEMA_MODE_JUST_ATTRIBUTES
+	case hclext.SchemaExactMode:
+		return pb.SchemaMode_SCHEMA_MODE_EXACT
+	default:
+		return pb.SchemaMode_SCHEMA_MODE_DEFAULT
+	}
+}
+
+// fromProtoSchemaMode converts proto.SchemaMode to hclext.SchemaMode.
+func fromProtoSchemaMode(mode pb.SchemaMode) hclext.SchemaMode {
+	switch mode {
+	case pb.SchemaMode_SCHEMA_MODE_JUST_ATTRIBUTES:
+		return hclext.SchemaJustAttributesMode
+	case pb.SchemaMode_SCHEMA_MODE_EXACT:
+		return hclext.SchemaExactMode
+	default:
+		return hclext.SchemaDefaultMode
 	}
 }
 
@@ -184,31 +322,48 @@ func toProtoAttribute(attr *hclext.Attribute) *pb.Attribute {
 	}
 
 	protoAttr := &pb.Attribute{
-		Name:      attr.Name,
-		Range:     toProtoRange(attr.Range),
-		NameRange: toProtoRange(attr.NameRange),
+		Name:       attr.Name,
+		ExprBytes:  attr.SourceBytes,
+		Range:      toProtoRange(attr.Range),
+		NameRange:  toProtoRange(attr.NameRange),
+		ItemRanges: toProtoItemRanges(attr.ItemRanges),
+		References: attr.References(),
 	}
 
 	// Serialize value - prefer pre-evaluated Value, fall back to Expr evaluation.
 	// This handles both fresh attributes (with Expr) and roundtrip attributes (with Value).
-	var val cty.Value
-
-	// First check if we have a pre-evaluated Value (for roundtrip scenarios)
-	if attr.Value != cty.NilVal && !attr.Value.IsNull() && attr.Value.IsKnown() {
-		val = attr.Value
-	} else if attr.Expr != nil {
-		// Try to evaluate the expression
+	// Expressions that cannot be evaluated without context, such as references
+	// to variables, are unknown.
+	val := attr.Value
+	if val == cty.NilVal && attr.Expr != nil {
 		evaluated, diags := attr.Expr.Value(nil)
-		if !diags.HasErrors() && evaluated.IsKnown() && !evaluated.IsNull() {
+		if diags.HasErrors() {
+			val = cty.DynamicVal
+		} else {
 			val = evaluated
 		}
 	}
 
-	// Serialize if we got a valid value
-	if val != cty.NilVal && !val.IsNull() && val.IsKnown() {
+	// Serialize the value along with its type so that the exact type
+	// (e.g., list vs set vs tuple) survives the roundtrip. Null and unknown
+	// values are sent as markers with their type only.
+	switch {
+	case val == cty.NilVal:
+		// No value available; only the source bytes are sent
+	case !val.IsWhollyKnown():
+		protoAttr.IsUnknown = true
+		protoAttr.ExprType, _ = ctyjson.MarshalType(val.Type())
+	case val.IsNull():
+		protoAttr.IsNull = true
+		protoAttr.ExprType, _ = ctyjson.MarshalType(val.Type())
+	default:
 		jsonBytes, err := ctyjson.Marshal(val, val.Type())
 		if err == nil {
-			protoAttr.ExprValue = jsonBytes
+			typeBytes, err := ctyjson.MarshalType(val.Type())
+			if err == nil {
+				protoAttr.ExprValue = jsonBytes
+				protoAttr.ExprType = typeBytes
+			}
 		}
 	}
 
@@ -222,23 +377,86 @@ func fromProtoAttribute(attr *pb.Attribute) *hclext.Attribute {
 	}
 
 	hclAttr := &hclext.Attribute{
-		Name:      attr.GetName(),
-		Range:     fromProtoRange(attr.GetRange()),
-		NameRange: fromProtoRange(attr.GetNameRange()),
-		// Expr cannot be reconstructed from proto; use Value instead
+		Name:        attr.GetName(),
+		SourceBytes: attr.GetExprBytes(),
+		Range:       fromProtoRange(attr.GetRange()),
+		NameRange:   fromProtoRange(attr.GetNameRange()),
+		ItemRanges:  fromProtoItemRanges(attr.GetItemRanges()),
+		Refs:        attr.GetReferences(),
+		// Expr cannot be reconstructed from proto; use Value or SourceBytes instead
 	}
 
-	// Reconstruct the Value from the serialized JSON
-	if len(attr.GetExprValue()) > 0 {
-		var simpleType ctyjson.SimpleJSONValue
-		if err := simpleType.UnmarshalJSON(attr.GetExprValue()); err == nil {
-			hclAttr.Value = simpleType.Value
-		}
+	// Reconstruct the Value from the markers or the serialized JSON
+	switch {
+	case attr.GetIsUnknown():
+		hclAttr.Value = cty.UnknownVal(decodeExprType(attr.GetExprType()))
+	case attr.GetIsNull():
+		hclAttr.Value = cty.NullVal(decodeExprType(attr.GetExprType()))
+	case len(attr.GetExprValue()) > 0:
+		hclAttr.Value = decodeExprValue(attr.GetExprValue(), attr.GetExprType())
 	}
 
 	return hclAttr
 }
 
+// toProtoItemRanges converts attribute item ranges to proto.Range values.
+func toProtoItemRanges(ranges map[string]hcl.Range) map[string]*pb.Range {
+	if len(ranges) == 0 {
+		return nil
+	}
+
+	result := make(map[string]*pb.Range, len(ranges))
+	for key, r := range ranges {
+		result[key] = toProtoRange(r)
+	}
+	return result
+}
+
+// fromProtoItemRanges converts proto.Range values to attribute item ranges.
+func fromProtoItemRanges(ranges map[string]*pb.Range) map[string]hcl.Range {
+	if len(ranges) == 0 {
+		return nil
+	}
+
+	result := make(map[string]hcl.Range, len(ranges))
+	for key, r := range ranges {
+		result[key] = fromProtoRange(r)
+	}
+	return result
+}
+
+// decodeExprValue decodes a JSON-encoded value using its serialized type.
+// If no type is available (e.g., from an older host), the value is decoded
+// with an inferred type via SimpleJSONValue.
+func decodeExprValue(valueBytes, typeBytes []byte) cty.Value {
+	if len(typeBytes) > 0 {
+		typ, err := ctyjson.UnmarshalType(typeBytes)
+		if err == nil {
+			val, err := ctyjson.Unmarshal(valueBytes, typ)
+			if err == nil {
+				return val
+			}
+		}
+	}
+
+	var simpleType ctyjson.SimpleJSONValue
+	if err := simpleType.UnmarshalJSON(valueBytes); err != nil {
+		return cty.NilVal
+	}
+	return simpleType.Value
+}
+
+// decodeExprType decodes a serialized type, falling back to
+// cty.DynamicPseudoType if it is missing or invalid.
+func decodeExprType(typeBytes []byte) cty.Type {
+	if len(typeBytes) > 0 {
+		if typ, err := ctyjson.UnmarshalType(typeBytes); err == nil {
+			return typ
+		}
+	}
+	return cty.DynamicPseudoType
+}
+
 // toProtoBlock converts hclext.Block to proto.Block.
 func toProtoBlock(block *hclext.Block) *pb.Block {
 	if block == nil {
@@ -251,12 +469,14 @@ func toProtoBlock(block *hclext.Block) *pb.Block {
 	}
 
 	return &pb.Block{
-		Type:        block.Type,
-		Labels:      block.Labels,
-		Body:        toProtoBodyContent(block.Body),
-		DefRange:    toProtoRange(block.DefRange),
-		TypeRange:   toProtoRange(block.TypeRange),
-		LabelRanges: labelRanges,
+		Type:            block.Type,
+		Labels:          block.Labels,
+		Body:            toProtoBodyContent(block.Body),
+		DefRange:        toProtoRange(block.DefRange),
+		TypeRange:       toProtoRange(block.TypeRange),
+		LabelRanges:     labelRanges,
+		LeadingComments: block.LeadingComments,
+		ModulePath:      block.ModulePath,
 	}
 }
 
@@ -272,15 +492,35 @@ func fromProtoBlock(block *pb.Block) *hclext.Block {
 	}
 
 	return &hclext.Block{
-		Type:        block.GetType(),
-		Labels:      block.GetLabels(),
-		Body:        fromProtoBodyContent(block.GetBody()),
-		DefRange:    fromProtoRange(block.GetDefRange()),
-		TypeRange:   fromProtoRange(block.GetTypeRange()),
-		LabelRanges: labelRanges,
+		Type:            block.GetType(),
+		Labels:          block.GetLabels(),
+		Body:            fromProtoBodyContent(block.GetBody()),
+		DefRange:        fromProtoRange(block.GetDefRange()),
+		TypeRange:       fromProtoRange(block.GetTypeRange()),
+		LabelRanges:     labelRanges,
+		LeadingComments: block.GetLeadingComments(),
+		ModulePath:      block.GetModulePath(),
 	}
 }
 
+// toProtoBlocks converts []*hclext.Block to []*proto.Block.
+func toProtoBlocks(blocks []*hclext.Block) []*pb.Block {
+	result := make([]*pb.Block, len(blocks))
+	for i, block := range blocks {
+		result[i] = toProtoBlock(block)
+	}
+	return result
+}
+
+// fromProtoBlocks converts []*proto.Block to []*hclext.Block.
+func fromProtoBlocks(blocks []*pb.Block) []*hclext.Block {
+	result := make([]*hclext.Block, len(blocks))
+	for i, block := range blocks {
+		result[i] = fromProtoBlock(block)
+	}
+	return result
+}
+
 // =============================================================================
 // Range Conversion
 // =============================================================================
@@ -344,6 +584,309 @@ func toProtoRule(rule tflint.Rule) *pb.Rule {
 	}
 }
 
+// fromProtoRuleDefault converts proto.Rule to RuleDefault.
+func fromProtoRuleDefault(rule *pb.Rule) RuleDefault {
+	return RuleDefault{
+		Name:     rule.GetName(),
+		Enabled:  rule.GetEnabled(),
+		Severity: fromProtoSeverity(rule.GetSeverity()),
+		Link:     rule.GetLink(),
+	}
+}
+
+// toProtoRuleMetadata extracts the optional metadata declared by a rule.
+// Rules without metadata produce an empty message.
+func toProtoRuleMetadata(ruleset *tflint.BuiltinRuleSet, rule tflint.Rule) *pb.RuleMetadata {
+	metadata := &pb.RuleMetadata{
+		Description: ruleset.RuleDescription(rule),
+		Tags:        ruleset.RuleTags(rule),
+	}
+	if scoped, ok := rule.(tflint.ScopedRule); ok {
+		metadata.ResourceTypes = scoped.ResourceTypes()
+	}
+	metadata.ReplacedBy, metadata.Deprecated = ruleset.RuleDeprecation(rule)
+	return metadata
+}
+
+// fromProtoRuleMetadata converts proto.RuleMetadata to RuleMetadata.
+func fromProtoRuleMetadata(metadata *pb.RuleMetadata) *RuleMetadata {
+	result := &RuleMetadata{
+		Description:   metadata.GetDescription(),
+		Tags:          metadata.GetTags(),
+		ResourceTypes: metadata.GetResourceTypes(),
+		Deprecated:    metadata.GetDeprecated(),
+		ReplacedBy:    metadata.GetReplacedBy(),
+	}
+	if result.Tags == nil {
+		result.Tags = []string{}
+	}
+	return result
+}
+
+// toProtoFix converts a tflint.Fix to proto.Fix.
+func toProtoFix(fix *tflint.Fix) *pb.Fix {
+	if fix == nil {
+		return nil
+	}
+
+	edits := make([]*pb.TextEdit, len(fix.Edits))
+	for i, edit := range fix.Edits {
+		edits[i] = &pb.TextEdit{
+			Range:   toProtoRange(edit.Range),
+			NewText: edit.NewText,
+		}
+	}
+	return &pb.Fix{Edits: edits}
+}
+
+// fromProtoFix converts proto.Fix to tflint.Fix.
+func fromProtoFix(fix *pb.Fix) *tflint.Fix {
+	if fix == nil {
+		return nil
+	}
+
+	edits := make([]tflint.TextEdit, len(fix.GetEdits()))
+	for i, edit := range fix.GetEdits() {
+		edits[i] = tflint.TextEdit{
+			Range:   fromProtoRange(edit.GetRange()),
+			NewText: edit.GetNewText(),
+		}
+	}
+	return &tflint.Fix{Edits: edits}
+}
+
+// toProtoMovedBlocks converts []tflint.MovedBlock to []*proto.MovedBlock.
+func toProtoMovedBlocks(moved []tflint.MovedBlock) []*pb.MovedBlock {
+	result := make([]*pb.MovedBlock, len(moved))
+	for i, m := range moved {
+		result[i] = &pb.MovedBlock{
+			From:      m.From,
+			To:        m.To,
+			DeclRange: toProtoRange(m.DeclRange),
+		}
+	}
+	return result
+}
+
+// fromProtoMovedBlocks converts []*proto.MovedBlock to []tflint.MovedBlock.
+func fromProtoMovedBlocks(moved []*pb.MovedBlock) []tflint.MovedBlock {
+	result := make([]tflint.MovedBlock, len(moved))
+	for i, m := range moved {
+		result[i] = tflint.MovedBlock{
+			From:      m.GetFrom(),
+			To:        m.GetTo(),
+			DeclRange: fromProtoRange(m.GetDeclRange()),
+		}
+	}
+	return result
+}
+
+// toProtoProviderRequirements converts provider requirements to proto.
+func toProtoProviderRequirements(reqs map[string]tflint.ProviderRequirement) map[string]*pb.ProviderRequirement {
+	result := make(map[string]*pb.ProviderRequirement, len(reqs))
+	for name, req := range reqs {
+		result[name] = &pb.ProviderRequirement{
+			Source:            req.Source,
+			VersionConstraint: req.VersionConstraint,
+		}
+	}
+	return result
+}
+
+// fromProtoProviderRequirements converts proto provider requirements to tflint.
+func fromProtoProviderRequirements(reqs map[string]*pb.ProviderRequirement) map[string]tflint.ProviderRequirement {
+	result := make(map[string]tflint.ProviderRequirement, len(reqs))
+	for name, req := range reqs {
+		result[name] = tflint.ProviderRequirement{
+			Source:            req.GetSource(),
+			VersionConstraint: req.GetVersionConstraint(),
+		}
+	}
+	return result
+}
+
+// toProtoTerraformSettings converts terraform block settings to proto.
+func toProtoTerraformSettings(settings *tflint.TerraformSettings) *pb.TerraformSettings {
+	if settings == nil {
+		return nil
+	}
+	result := &pb.TerraformSettings{
+		RequiredVersion:      settings.RequiredVersion,
+		RequiredVersionRange: toProtoRange(settings.RequiredVersionRange),
+		RequiredProviders:    toProtoProviderRequirements(settings.RequiredProviders),
+	}
+	if backend := settings.Backend; backend != nil {
+		result.Backend = &pb.TerraformBackend{
+			Type:      backend.Type,
+			Body:      toProtoBodyContent(backend.Body),
+			DeclRange: toProtoRange(backend.DeclRange),
+		}
+	}
+	return result
+}
+
+// fromProtoTerraformSettings converts proto terraform block settings to tflint.
+func fromProtoTerraformSettings(settings *pb.TerraformSettings) *tflint.TerraformSettings {
+	if settings == nil {
+		return nil
+	}
+	result := &tflint.TerraformSettings{
+		RequiredVersion:      settings.GetRequiredVersion(),
+		RequiredVersionRange: fromProtoRange(settings.GetRequiredVersionRange()),
+		RequiredProviders:    fromProtoProviderRequirements(settings.GetRequiredProviders()),
+	}
+	if backend := settings.GetBackend(); backend != nil {
+		result.Backend = &tflint.TerraformBackend{
+			Type:      backend.GetType(),
+			Body:      fromProtoBodyContent(backend.GetBody()),
+			DeclRange: fromProtoRange(backend.GetDeclRange()),
+		}
+	}
+	return result
+}
+
+// toProtoVariableDefs converts variable definitions to proto.
+// Types and defaults that cannot be serialized are left unset.
+func toProtoVariableDefs(vars []tflint.VariableDef) []*pb.VariableDef {
+	result := make([]*pb.VariableDef, len(vars))
+	for i, v := range vars {
+		protoVar := &pb.VariableDef{
+			Name:      v.Name,
+			Required:  v.Required,
+			DeclRange: toProtoRange(v.DeclRange),
+		}
+		if typeBytes, err := ctyjson.MarshalType(v.Type); err == nil {
+			protoVar.Type = typeBytes
+		}
+		if v.HasDefault {
+			protoVar.Default = toProtoValue(v.Default)
+		}
+		result[i] = protoVar
+	}
+	return result
+}
+
+// fromProtoVariableDefs converts proto variable definitions to tflint.
+func fromProtoVariableDefs(vars []*pb.VariableDef) []tflint.VariableDef {
+	result := make([]tflint.VariableDef, len(vars))
+	for i, v := range vars {
+		def := tflint.VariableDef{
+			Name:      v.GetName(),
+			Type:      cty.DynamicPseudoType,
+			Required:  v.GetRequired(),
+			DeclRange: fromProtoRange(v.GetDeclRange()),
+		}
+		if typ, err := ctyjson.UnmarshalType(v.GetType()); err == nil {
+			def.Type = typ
+		}
+		if v.GetDefault() != nil {
+			def.Default = decodeExprValue(v.GetDefault().GetValue(), v.GetDefault().GetType())
+			def.HasDefault = true
+		}
+		result[i] = def
+	}
+	return result
+}
+
+// toProtoValue converts a known value, which may be null, to proto.Value.
+// It returns an empty proto.Value if the value cannot be serialized.
+func toProtoValue(val cty.Value) *pb.Value {
+	if val == cty.NilVal || !val.IsWhollyKnown() {
+		return &pb.Value{}
+	}
+	valueBytes, err := ctyjson.Marshal(val, val.Type())
+	if err != nil {
+		return &pb.Value{}
+	}
+	typeBytes, err := ctyjson.MarshalType(val.Type())
+	if err != nil {
+		return &pb.Value{}
+	}
+	return &pb.Value{Value: valueBytes, Type: typeBytes}
+}
+
+// toProtoOutputDefs converts output definitions to proto.
+func toProtoOutputDefs(outputs []tflint.OutputDef) []*pb.OutputDef {
+	result := make([]*pb.OutputDef, len(outputs))
+	for i, o := range outputs {
+		result[i] = &pb.OutputDef{
+			Name:        o.Name,
+			Description: o.Description,
+			Sensitive:   o.Sensitive,
+			DeclRange:   toProtoRange(o.DeclRange),
+		}
+	}
+	return result
+}
+
+// fromProtoOutputDefs converts proto output definitions to tflint.
+func fromProtoOutputDefs(outputs []*pb.OutputDef) []tflint.OutputDef {
+	result := make([]tflint.OutputDef, len(outputs))
+	for i, o := range outputs {
+		result[i] = tflint.OutputDef{
+			Name:        o.GetName(),
+			Description: o.GetDescription(),
+			Sensitive:   o.GetSensitive(),
+			DeclRange:   fromProtoRange(o.GetDeclRange()),
+		}
+	}
+	return result
+}
+
+// toProtoModuleCalls converts module calls to proto.
+func toProtoModuleCalls(calls []tflint.ModuleCall) []*pb.ModuleCall {
+	result := make([]*pb.ModuleCall, len(calls))
+	for i, c := range calls {
+		result[i] = &pb.ModuleCall{
+			Name:      c.Name,
+			Source:    c.Source,
+			Version:   c.Version,
+			DeclRange: toProtoRange(c.DeclRange),
+		}
+	}
+	return result
+}
+
+// fromProtoModuleCalls converts proto module calls to tflint.
+func fromProtoModuleCalls(calls []*pb.ModuleCall) []tflint.ModuleCall {
+	result := make([]tflint.ModuleCall, len(calls))
+	for i, c := range calls {
+		result[i] = tflint.ModuleCall{
+			Name:      c.GetName(),
+			Source:    c.GetSource(),
+			Version:   c.GetVersion(),
+			DeclRange: fromProtoRange(c.GetDeclRange()),
+		}
+	}
+	return result
+}
+
+// toProtoLocals converts local values to proto. Values that are not wholly
+// known are sent as an empty proto.Value.
+func toProtoLocals(locals map[string]cty.Value) map[string]*pb.Value {
+	result := make(map[string]*pb.Value, len(locals))
+	for name, val := range locals {
+		result[name] = toProtoValue(val)
+	}
+	return result
+}
+
+// fromProtoLocals converts proto local values to cty. Empty values are
+// decoded as unknown.
+func fromProtoLocals(locals map[string]*pb.Value) map[string]cty.Value {
+	result := make(map[string]cty.Value, len(locals))
+	for name, v := range locals {
+		val := cty.DynamicVal
+		if len(v.GetValue()) > 0 {
+			if decoded := decodeExprValue(v.GetValue(), v.GetType()); decoded != cty.NilVal {
+				val = decoded
+			}
+		}
+		result[name] = val
+	}
+	return result
+}
+
 // toProtoSeverity converts tflint.Severity to proto.Severity.
 func toProtoSeverity(s tflint.Severity) pb.Severity {
 	switch s {
@@ -372,6 +915,189 @@ func fromProtoSeverity(s pb.Severity) tflint.Severity {
 	}
 }
 
+// toProtoIssueKind converts tflint.IssueKind to proto.IssueKind.
+func toProtoIssueKind(k tflint.IssueKind) pb.IssueKind {
+	switch k {
+	case tflint.KindBreaking:
+		return pb.IssueKind_ISSUE_KIND_BREAKING
+	case tflint.KindWarning:
+		return pb.IssueKind_ISSUE_KIND_WARNING
+	case tflint.KindInfo:
+		return pb.IssueKind_ISSUE_KIND_INFO
+	default:
+		return pb.IssueKind_ISSUE_KIND_UNSPECIFIED
+	}
+}
+
+// fromProtoIssueKind converts proto.IssueKind to tflint.IssueKind.
+func fromProtoIssueKind(k pb.IssueKind) tflint.IssueKind {
+	switch k {
+	case pb.IssueKind_ISSUE_KIND_BREAKING:
+		return tflint.KindBreaking
+	case pb.IssueKind_ISSUE_KIND_WARNING:
+		return tflint.KindWarning
+	case pb.IssueKind_ISSUE_KIND_INFO:
+		return tflint.KindInfo
+	default:
+		return tflint.KindUnspecified
+	}
+}
+
+// =============================================================================
+// Error Conversion
+// =============================================================================
+
+// toProtoRuleErrors converts tflint.RuleError values to proto.RuleError.
+func toProtoRuleErrors(errs []*tflint.RuleError) []*pb.RuleError {
+	if len(errs) == 0 {
+		return nil
+	}
+
+	result := make([]*pb.RuleError, len(errs))
+	for i, err := range errs {
+		result[i] = &pb.RuleError{
+			Rule:        err.Rule,
+			Category:    toProtoErrorCategory(err.Category),
+			Message:     err.Err.Error(),
+			Diagnostics: toProtoDiagnostics(errorDiagnostics(err.Err)),
+		}
+	}
+	return result
+}
+
+// fromProtoRuleErrors converts proto.RuleError values to tflint.RuleError.
+// The underlying error only carries the message, or is a
+// *tflint.DiagnosticsError if diagnostics were sent; its original type is lost.
+func fromProtoRuleErrors(errs []*pb.RuleError) []*tflint.RuleError {
+	if len(errs) == 0 {
+		return nil
+	}
+
+	result := make([]*tflint.RuleError, len(errs))
+	for i, err := range errs {
+		var ruleErr error = errors.New(err.GetMessage())
+		if diags := fromProtoDiagnostics(err.GetDiagnostics()); len(diags) > 0 {
+			ruleErr = &tflint.DiagnosticsError{Message: err.GetMessage(), Diagnostics: diags}
+		}
+		result[i] = &tflint.RuleError{
+			Rule:     err.GetRule(),
+			Category: fromProtoErrorCategory(err.GetCategory()),
+			Err:      ruleErr,
+		}
+	}
+	return result
+}
+
+// errorDiagnostics returns the HCL diagnostics err wraps, if any.
+func errorDiagnostics(err error) hcl.Diagnostics {
+	var diags hcl.Diagnostics
+	if errors.As(err, &diags) {
+		return diags
+	}
+	return nil
+}
+
+// toProtoDiagnostics converts hcl.Diagnostics to proto.Diagnostic values.
+func toProtoDiagnostics(diags hcl.Diagnostics) []*pb.Diagnostic {
+	if len(diags) == 0 {
+		return nil
+	}
+
+	result := make([]*pb.Diagnostic, 0, len(diags))
+	for _, diag := range diags {
+		if diag == nil {
+			continue
+		}
+		d := &pb.Diagnostic{
+			Severity: toProtoDiagnosticSeverity(diag.Severity),
+			Summary:  diag.Summary,
+			Detail:   diag.Detail,
+		}
+		if diag.Subject != nil {
+			d.Subject = toProtoRange(*diag.Subject)
+		}
+		if diag.Context != nil {
+			d.Context = toProtoRange(*diag.Context)
+		}
+		result = append(result, d)
+	}
+	return result
+}
+
+// fromProtoDiagnostics converts proto.Diagnostic values to hcl.Diagnostics.
+func fromProtoDiagnostics(diags []*pb.Diagnostic) hcl.Diagnostics {
+	if len(diags) == 0 {
+		return nil
+	}
+
+	result := make(hcl.Diagnostics, len(diags))
+	for i, diag := range diags {
+		d := &hcl.Diagnostic{
+			Severity: fromProtoDiagnosticSeverity(diag.GetSeverity()),
+			Summary:  diag.GetSummary(),
+			Detail:   diag.GetDetail(),
+		}
+		if diag.GetSubject() != nil {
+			d.Subject = fromProtoRange(diag.GetSubject()).Ptr()
+		}
+		if diag.GetContext() != nil {
+			d.Context = fromProtoRange(diag.GetContext()).Ptr()
+		}
+		result[i] = d
+	}
+	return result
+}
+
+// toProtoDiagnosticSeverity converts hcl.DiagnosticSeverity to proto.DiagnosticSeverity.
+func toProtoDiagnosticSeverity(s hcl.DiagnosticSeverity) pb.DiagnosticSeverity {
+	switch s {
+	case hcl.DiagError:
+		return pb.DiagnosticSeverity_DIAGNOSTIC_SEVERITY_ERROR
+	case hcl.DiagWarning:
+		return pb.DiagnosticSeverity_DIAGNOSTIC_SEVERITY_WARNING
+	default:
+		return pb.DiagnosticSeverity_DIAGNOSTIC_SEVERITY_UNSPECIFIED
+	}
+}
+
+// fromProtoDiagnosticSeverity converts proto.DiagnosticSeverity to hcl.DiagnosticSeverity.
+func fromProtoDiagnosticSeverity(s pb.DiagnosticSeverity) hcl.DiagnosticSeverity {
+	switch s {
+	case pb.DiagnosticSeverity_DIAGNOSTIC_SEVERITY_ERROR:
+		return hcl.DiagError
+	case pb.DiagnosticSeverity_DIAGNOSTIC_SEVERITY_WARNING:
+		return hcl.DiagWarning
+	default:
+		return hcl.DiagInvalid
+	}
+}
+
+// toProtoErrorCategory converts tflint.ErrorCategory to proto.ErrorCategory.
+func toProtoErrorCategory(c tflint.ErrorCategory) pb.ErrorCategory {
+	switch c {
+	case tflint.ErrorCategoryInternal:
+		return pb.ErrorCategory_ERROR_CATEGORY_INTERNAL
+	case tflint.ErrorCategoryPanic:
+		return pb.ErrorCategory_ERROR_CATEGORY_PANIC
+	case tflint.ErrorCategoryConfig:
+		return pb.ErrorCategory_ERROR_CATEGORY_CONFIG
+	default:
+		return pb.ErrorCategory_ERROR_CATEGORY_UNSPECIFIED
+	}
+}
+
+// fromProtoErrorCategory converts proto.ErrorCategory to tflint.ErrorCategory.
+func fromProtoErrorCategory(c pb.ErrorCategory) tflint.ErrorCategory {
+	switch c {
+	case pb.ErrorCategory_ERROR_CATEGORY_PANIC:
+		return tflint.ErrorCategoryPanic
+	case pb.ErrorCategory_ERROR_CATEGORY_CONFIG:
+		return tflint.ErrorCategoryConfig
+	default:
+		return tflint.ErrorCategoryInternal
+	}
+}
+
 // =============================================================================
 // Option Conversion
 // =============================================================================
@@ -401,3 +1127,69 @@ func fromProtoGetModuleContentOption(opt *pb.GetModuleContentOption) *tflint.Get
 		},
 	}
 }
+
+// toProtoSide converts tflint.Side to proto.Side.
+func toProtoSide(side tflint.Side) pb.Side {
+	switch side {
+	case tflint.SideOld:
+		return pb.Side_SIDE_OLD
+	case tflint.SideNew:
+		return pb.Side_SIDE_NEW
+	default:
+		return pb.Side_SIDE_UNSPECIFIED
+	}
+}
+
+// fromProtoIssueSide converts the proto.Side of an issue to tflint.Side.
+// Issues default to the NEW configuration, so SIDE_UNSPECIFIED is SideNew.
+func fromProtoIssueSide(side pb.Side) tflint.Side {
+	if side == pb.Side_SIDE_OLD {
+		return tflint.SideOld
+	}
+	return tflint.SideNew
+}
+
+// fromProtoSide converts proto.Side to tflint.Side.
+// Returns an error for SIDE_UNSPECIFIED, since there is no sensible default.
+func fromProtoSide(side pb.Side) (tflint.Side, error) {
+	switch side {
+	case pb.Side_SIDE_OLD:
+		return tflint.SideOld, nil
+	case pb.Side_SIDE_NEW:
+		return tflint.SideNew, nil
+	default:
+		return 0, fmt.Errorf("unknown side: %s", side)
+	}
+}
+
+// toProtoIssue converts an emitted issue and its options to proto.Issue.
+func toProtoIssue(rule tflint.Rule, message string, issueRange hcl.Range, opts tflint.IssueOptions) *pb.Issue {
+	return &pb.Issue{
+		Rule:            toProtoRule(rule),
+		Message:         message,
+		Range:           toProtoRange(issueRange),
+		Fix:             toProtoFix(opts.Fix),
+		Severity:        toProtoSeverity(opts.Severity),
+		Kind:            toProtoIssueKind(opts.Kind),
+		ResourceAddress: opts.ResourceAddress,
+		Side:            toProtoSide(opts.Side),
+	}
+}
+
+// fromProtoIssueOptions converts the optional attributes of proto.Issue to
+// tflint.IssueOptions. Attributes the issue does not set are left at their
+// zero value.
+func fromProtoIssueOptions(issue *pb.Issue) tflint.IssueOptions {
+	opts := tflint.IssueOptions{
+		Kind:            fromProtoIssueKind(issue.GetKind()),
+		ResourceAddress: issue.GetResourceAddress(),
+		Fix:             fromProtoFix(issue.GetFix()),
+	}
+	if severity := issue.GetSeverity(); severity != pb.Severity_SEVERITY_UNSPECIFIED {
+		opts.Severity = fromProtoSeverity(severity)
+	}
+	if side := issue.GetSide(); side != pb.Side_SIDE_UNSPECIFIED {
+		opts.Side = fromProtoIssueSide(side)
+	}
+	return opts
+}
@@ -1,10 +1,14 @@
 package plugin
 
 import (
+	"errors"
+	"fmt"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/gohcl"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
 	"github.com/zclconf/go-cty/cty"
 
 	"github.com/jokarl/tfbreak-plugin-sdk/hclext"
@@ -24,6 +28,7 @@ func TestToProtoConfig(t *testing.T) {
 		config := &tflint.Config{
 			DisabledByDefault: true,
 			Only:              []string{"rule1", "rule2"},
+			Ignore:            []string{"azurerm_*"},
 			PluginDir:         "/path/to/plugins",
 			Rules: map[string]*tflint.RuleConfig{
 				"test_rule": {
@@ -41,6 +46,9 @@ func TestToProtoConfig(t *testing.T) {
 		if len(result.Only) != 2 {
 			t.Errorf("Only should have 2 items, got %d", len(result.Only))
 		}
+		if len(result.Ignore) != 1 || result.Ignore[0] != "azurerm_*" {
+			t.Errorf("Ignore = %v, want [azurerm_*]", result.Ignore)
+		}
 		if result.PluginDir != "/path/to/plugins" {
 			t.Errorf("PluginDir = %q, want %q", result.PluginDir, "/path/to/plugins")
 		}
@@ -64,6 +72,7 @@ func TestFromProtoConfig(t *testing.T) {
 		config := &pb.Config{
 			DisabledByDefault: true,
 			Only:              []string{"rule1"},
+			Ignore:            []string{"/azurerm_.*/"},
 			PluginDir:         "/plugins",
 			Rules: map[string]*pb.RuleConfig{
 				"my_rule": {
@@ -81,6 +90,9 @@ func TestFromProtoConfig(t *testing.T) {
 		if len(result.Only) != 1 {
 			t.Errorf("Only should have 1 item, got %d", len(result.Only))
 		}
+		if len(result.Ignore) != 1 || result.Ignore[0] != "/azurerm_.*/" {
+			t.Errorf("Ignore = %v, want [/azurerm_.*/]", result.Ignore)
+		}
 		if rc, ok := result.Rules["my_rule"]; !ok {
 			t.Error("Rules should contain my_rule")
 		} else if rc.Enabled {
@@ -89,6 +101,96 @@ func TestFromProtoConfig(t *testing.T) {
 	})
 }
 
+func TestConfigConversion_MinSeverity(t *testing.T) {
+	proto := toProtoConfig(&tflint.Config{MinSeverity: tflint.WARNING})
+	if proto.MinSeverity != pb.Severity_SEVERITY_WARNING {
+		t.Errorf("proto MinSeverity = %v, want SEVERITY_WARNING", proto.MinSeverity)
+	}
+	if got := fromProtoConfig(proto).MinSeverity; got != tflint.WARNING {
+		t.Errorf("MinSeverity = %v, want WARNING", got)
+	}
+
+	unset := fromProtoConfig(toProtoConfig(&tflint.Config{}))
+	if unset.MinSeverity != 0 {
+		t.Errorf("MinSeverity = %v, want 0 (unset)", unset.MinSeverity)
+	}
+}
+
+func TestConfigConversion_SeverityOverride(t *testing.T) {
+	warning := tflint.WARNING
+	config := &tflint.Config{
+		Rules: map[string]*tflint.RuleConfig{
+			"overridden": {Name: "overridden", Enabled: true, Severity: &warning},
+			"default":    {Name: "default", Enabled: true},
+		},
+	}
+
+	proto := toProtoConfig(config)
+	if proto.Rules["overridden"].Severity != pb.Severity_SEVERITY_WARNING {
+		t.Errorf("proto severity = %v, want SEVERITY_WARNING", proto.Rules["overridden"].Severity)
+	}
+	if proto.Rules["default"].Severity != pb.Severity_SEVERITY_UNSPECIFIED {
+		t.Errorf("proto severity = %v, want SEVERITY_UNSPECIFIED", proto.Rules["default"].Severity)
+	}
+
+	result := fromProtoConfig(proto)
+	if result.Rules["overridden"].Severity == nil || *result.Rules["overridden"].Severity != tflint.WARNING {
+		t.Errorf("Severity = %v, want WARNING", result.Rules["overridden"].Severity)
+	}
+	if result.Rules["default"].Severity != nil {
+		t.Errorf("Severity = %v, want nil", *result.Rules["default"].Severity)
+	}
+}
+
+func TestConfigConversion_Variables(t *testing.T) {
+	config := &tflint.Config{
+		Variables: map[string]cty.Value{
+			"environment": cty.StringVal("production"),
+			"threshold":   cty.NumberIntVal(42),
+			"severity_map": cty.ObjectVal(map[string]cty.Value{
+				"tfbreak_example": cty.StringVal("WARNING"),
+			}),
+			"unknown": cty.UnknownVal(cty.String),
+			"null":    cty.NullVal(cty.String),
+		},
+	}
+
+	proto := toProtoConfig(config)
+	if len(proto.Variables) != 3 {
+		t.Errorf("proto Variables has %d entries, want 3 (null and unknown dropped)", len(proto.Variables))
+	}
+	if got := string(proto.Variables["environment"].GetValue()); got != `"production"` {
+		t.Errorf("proto environment = %s, want %q", got, `"production"`)
+	}
+
+	result := fromProtoConfig(proto)
+	want := map[string]cty.Value{
+		"environment": cty.StringVal("production"),
+		"threshold":   cty.NumberIntVal(42),
+		"severity_map": cty.ObjectVal(map[string]cty.Value{
+			"tfbreak_example": cty.StringVal("WARNING"),
+		}),
+	}
+	if len(result.Variables) != len(want) {
+		t.Fatalf("Variables has %d entries, want %d", len(result.Variables), len(want))
+	}
+	for name, w := range want {
+		got, ok := result.Variables[name]
+		if !ok {
+			t.Errorf("Variables missing %q", name)
+			continue
+		}
+		if !got.RawEquals(w) {
+			t.Errorf("Variables[%q] = %#v, want %#v", name, got, w)
+		}
+	}
+
+	unset := fromProtoConfig(toProtoConfig(&tflint.Config{}))
+	if unset.Variables != nil {
+		t.Errorf("Variables = %v, want nil", unset.Variables)
+	}
+}
+
 func TestToProtoBodySchema(t *testing.T) {
 	t.Run("nil schema", func(t *testing.T) {
 		result := toProtoBodySchema(nil)
@@ -314,6 +416,89 @@ func TestSeverityConversion(t *testing.T) {
 	}
 }
 
+func TestSchemaModeConversion(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    hclext.SchemaMode
+		expected pb.SchemaMode
+	}{
+		{"default", hclext.SchemaDefaultMode, pb.SchemaMode_SCHEMA_MODE_DEFAULT},
+		{"just attributes", hclext.SchemaJustAttributesMode, pb.SchemaMode_SCHEMA_MODE_JUST_ATTRIBUTES},
+		{"exact", hclext.SchemaExactMode, pb.SchemaMode_SCHEMA_MODE_EXACT},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			proto := toProtoSchemaMode(tt.input)
+			if proto != tt.expected {
+				t.Errorf("toProtoSchemaMode(%v) = %v, want %v", tt.input, proto, tt.expected)
+			}
+			if _, ok := pb.SchemaMode_name[int32(proto)]; !ok {
+				t.Errorf("toProtoSchemaMode(%v) = %d, which is not a named proto value", tt.input, proto)
+			}
+
+			back := fromProtoSchemaMode(proto)
+			if back != tt.input {
+				t.Errorf("fromProtoSchemaMode(%v) = %v, want %v", proto, back, tt.input)
+			}
+		})
+	}
+}
+
+func TestSchemaModeConversion_AllProtoValues(t *testing.T) {
+	// Every named proto value must map to a distinct hclext value and back,
+	// so a mode added to the proto without a mapping fails this test.
+	for value, name := range pb.SchemaMode_name {
+		proto := pb.SchemaMode(value)
+		if back := toProtoSchemaMode(fromProtoSchemaMode(proto)); back != proto {
+			t.Errorf("%s does not roundtrip, got %v", name, back)
+		}
+	}
+}
+
+func TestSideConversion(t *testing.T) {
+	for _, side := range []tflint.Side{tflint.SideOld, tflint.SideNew} {
+		back, err := fromProtoSide(toProtoSide(side))
+		if err != nil {
+			t.Fatalf("fromProtoSide(toProtoSide(%s)) error: %v", side, err)
+		}
+		if back != side {
+			t.Errorf("side %s roundtripped to %s", side, back)
+		}
+	}
+
+	if _, err := fromProtoSide(pb.Side_SIDE_UNSPECIFIED); err == nil {
+		t.Error("expected error for SIDE_UNSPECIFIED, got nil")
+	}
+}
+
+func TestIssueOptionsConversion(t *testing.T) {
+	rule := &testRule{name: "test_rule"}
+	tests := []struct {
+		name string
+		opts tflint.IssueOptions
+	}{
+		{"empty", tflint.IssueOptions{}},
+		{"all", tflint.IssueOptions{
+			Severity:        tflint.WARNING,
+			Kind:            tflint.KindBreaking,
+			ResourceAddress: "azurerm_storage_account.main",
+			Side:            tflint.SideOld,
+			Fix:             &tflint.Fix{Edits: []tflint.TextEdit{{NewText: "moved {}"}}},
+		}},
+		{"new side", tflint.IssueOptions{Side: tflint.SideNew}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			issue := toProtoIssue(rule, "message", hcl.Range{Filename: "main.tf"}, tt.opts)
+			if diff := cmp.Diff(tt.opts, fromProtoIssueOptions(issue)); diff != "" {
+				t.Errorf("options mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
 func TestToProtoRule(t *testing.T) {
 	t.Run("nil rule", func(t *testing.T) {
 		result := toProtoRule(nil)
@@ -342,6 +527,41 @@ func TestToProtoRule(t *testing.T) {
 	})
 }
 
+func TestFixConversion(t *testing.T) {
+	t.Run("nil fix", func(t *testing.T) {
+		if toProtoFix(nil) != nil {
+			t.Error("toProtoFix(nil) should return nil")
+		}
+		if fromProtoFix(nil) != nil {
+			t.Error("fromProtoFix(nil) should return nil")
+		}
+	})
+
+	t.Run("roundtrip", func(t *testing.T) {
+		original := &tflint.Fix{
+			Edits: []tflint.TextEdit{
+				{
+					Range: hcl.Range{
+						Filename: "main.tf",
+						Start:    hcl.Pos{Line: 2, Column: 3, Byte: 10},
+						End:      hcl.Pos{Line: 2, Column: 11, Byte: 18},
+					},
+					NewText: "location",
+				},
+				{
+					Range:   hcl.Range{Filename: "moved.tf"},
+					NewText: "moved {\n  from = a.b\n  to   = a.c\n}\n",
+				},
+			},
+		}
+
+		result := fromProtoFix(toProtoFix(original))
+		if diff := cmp.Diff(original, result); diff != "" {
+			t.Errorf("fix mismatch (-want +got):\n%s", diff)
+		}
+	})
+}
+
 func TestGetModuleContentOptionConversion(t *testing.T) {
 	t.Run("nil option", func(t *testing.T) {
 		proto := toProtoGetModuleContentOption(nil)
@@ -453,6 +673,57 @@ func TestToProtoSeverity_UnknownSeverity(t *testing.T) {
 	}
 }
 
+func TestConfigConversion_RuleConfigBody(t *testing.T) {
+	parse := func(src string) hcl.Body {
+		file, diags := hclsyntax.ParseConfig([]byte(src), "config.hcl", hcl.InitialPos)
+		if diags.HasErrors() {
+			t.Fatalf("parse error: %v", diags)
+		}
+		return file.Body
+	}
+	config := &tflint.Config{
+		Rules: map[string]*tflint.RuleConfig{
+			"static":  {Name: "static", Enabled: true, Body: parse("level = \"strict\"\nthreshold = 5\nnames = [\"a\", \"b\"]\n")},
+			"dynamic": {Name: "dynamic", Enabled: true, Body: parse("level = var.level\n")},
+			"nobody":  {Name: "nobody", Enabled: true},
+		},
+	}
+
+	proto := toProtoConfig(config)
+	want := `{"level":"strict","names":["a","b"],"threshold":5}`
+	if got := string(proto.Rules["static"].GetConfigBytes()); got != want {
+		t.Errorf("static config bytes = %s, want %s", got, want)
+	}
+	// Bodies that cannot be evaluated statically are left to DecodeRuleConfig
+	if got := proto.Rules["dynamic"].GetConfigBytes(); got != nil {
+		t.Errorf("dynamic config bytes = %s, want none", got)
+	}
+	if got := proto.Rules["nobody"].GetConfigBytes(); got != nil {
+		t.Errorf("nobody config bytes = %s, want none", got)
+	}
+
+	result := fromProtoConfig(proto)
+	if result.Rules["dynamic"].Body != nil || result.Rules["nobody"].Body != nil {
+		t.Error("rules without config bytes have a Body, want nil")
+	}
+	var decoded struct {
+		Level     string   `hcl:"level"`
+		Threshold int      `hcl:"threshold"`
+		Names     []string `hcl:"names"`
+	}
+	if diags := gohcl.DecodeBody(result.Rules["static"].Body, nil, &decoded); diags.HasErrors() {
+		t.Fatalf("DecodeBody error: %v", diags)
+	}
+	if decoded.Level != "strict" || decoded.Threshold != 5 || !cmp.Equal(decoded.Names, []string{"a", "b"}) {
+		t.Errorf("decoded = %+v, want level strict, threshold 5, names [a b]", decoded)
+	}
+
+	// The decoded body encodes to the same bytes
+	if got := string(toProtoConfig(result).Rules["static"].GetConfigBytes()); got != want {
+		t.Errorf("round trip config bytes = %s, want %s", got, want)
+	}
+}
+
 func TestToProtoConfig_EmptyRules(t *testing.T) {
 	config := &tflint.Config{
 		Rules: map[string]*tflint.RuleConfig{},
@@ -600,6 +871,37 @@ func TestBlockConversion_WithLabelRanges(t *testing.T) {
 	}
 }
 
+func TestBlockConversion_WithLeadingComments(t *testing.T) {
+	original := &hclext.Block{
+		Type:            "resource",
+		Labels:          []string{"aws_instance", "example"},
+		LeadingComments: []string{"# tfbreak:ignore my_rule", "// legacy"},
+	}
+
+	result := fromProtoBlock(toProtoBlock(original))
+
+	if diff := cmp.Diff(original.LeadingComments, result.LeadingComments); diff != "" {
+		t.Errorf("LeadingComments mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestBlockConversion_WithModulePath(t *testing.T) {
+	original := &hclext.Block{
+		Type:       "resource",
+		Labels:     []string{"aws_instance", "web"},
+		ModulePath: []string{"app", "compute"},
+	}
+
+	result := fromProtoBlock(toProtoBlock(original))
+
+	if got := result.FullAddress(); got != "module.app.module.compute.aws_instance.web" {
+		t.Errorf("FullAddress() = %q, want %q", got, "module.app.module.compute.aws_instance.web")
+	}
+	if root := fromProtoBlock(toProtoBlock(&hclext.Block{Type: "resource"})); len(root.ModulePath) != 0 {
+		t.Errorf("ModulePath = %v, want empty for the root module", root.ModulePath)
+	}
+}
+
 // =============================================================================
 // Value serialization tests
 // =============================================================================
@@ -608,29 +910,26 @@ func TestAttributeConversion_WithValue(t *testing.T) {
 	// Test that an attribute with a pre-evaluated Value (no Expr) roundtrips correctly.
 	// This is important for gRPC communication where Expr can't be serialized.
 	//
-	// Note: cty JSON serialization uses SimpleJSONValue which converts:
-	// - Lists to Tuples
-	// - Maps to Objects
-	// This is expected behavior and values are still semantically equivalent for comparisons.
+	// The cty.Type is serialized alongside the value, so collection types
+	// (list, set, tuple, map, object) keep their exact type after the roundtrip.
 	tests := []struct {
-		name     string
-		value    cty.Value
-		expected cty.Value // expected after roundtrip (may differ due to JSON type conversion)
+		name  string
+		value cty.Value
 	}{
-		{"string value", cty.StringVal("hello"), cty.StringVal("hello")},
-		{"number value", cty.NumberIntVal(42), cty.NumberIntVal(42)},
-		{"bool value", cty.BoolVal(true), cty.BoolVal(true)},
-		// Lists become Tuples after JSON roundtrip
-		{
-			"list value",
-			cty.ListVal([]cty.Value{cty.StringVal("a"), cty.StringVal("b")}),
-			cty.TupleVal([]cty.Value{cty.StringVal("a"), cty.StringVal("b")}),
-		},
-		// Maps become Objects after JSON roundtrip
+		{"string value", cty.StringVal("hello")},
+		{"number value", cty.NumberIntVal(42)},
+		{"fractional number value", cty.NumberFloatVal(3.25)},
+		{"bool value", cty.BoolVal(true)},
+		{"list value", cty.ListVal([]cty.Value{cty.StringVal("a"), cty.StringVal("b")})},
+		{"set value", cty.SetVal([]cty.Value{cty.StringVal("a"), cty.StringVal("b")})},
+		{"tuple value", cty.TupleVal([]cty.Value{cty.StringVal("a"), cty.NumberIntVal(1)})},
+		{"map value", cty.MapVal(map[string]cty.Value{"key": cty.StringVal("val")})},
 		{
-			"map value",
-			cty.MapVal(map[string]cty.Value{"key": cty.StringVal("val")}),
-			cty.ObjectVal(map[string]cty.Value{"key": cty.StringVal("val")}),
+			"object value",
+			cty.ObjectVal(map[string]cty.Value{
+				"name": cty.StringVal("example"),
+				"tags": cty.ListVal([]cty.Value{cty.StringVal("a")}),
+			}),
 		},
 	}
 
@@ -653,6 +952,9 @@ func TestAttributeConversion_WithValue(t *testing.T) {
 			if len(proto.ExprValue) == 0 {
 				t.Fatal("ExprValue should be populated from Value")
 			}
+			if len(proto.ExprType) == 0 {
+				t.Fatal("ExprType should be populated from Value")
+			}
 
 			result := fromProtoAttribute(proto)
 			if result == nil {
@@ -663,13 +965,78 @@ func TestAttributeConversion_WithValue(t *testing.T) {
 				t.Fatal("result.Value should not be NilVal")
 			}
 
-			if !result.Value.RawEquals(tt.expected) {
-				t.Errorf("Value mismatch: got %#v, want %#v", result.Value, tt.expected)
+			if !result.Value.Type().Equals(tt.value.Type()) {
+				t.Errorf("Type mismatch: got %#v, want %#v", result.Value.Type(), tt.value.Type())
+			}
+			if !result.Value.RawEquals(tt.value) {
+				t.Errorf("Value mismatch: got %#v, want %#v", result.Value, tt.value)
 			}
 		})
 	}
 }
 
+func TestAttributeConversion_WithoutType(t *testing.T) {
+	// Values sent without a serialized type (e.g., by an older host) are
+	// decoded with an inferred type: lists become tuples, maps become objects.
+	result := fromProtoAttribute(&pb.Attribute{
+		Name:      "test_attr",
+		ExprValue: []byte(`["a","b"]`),
+	})
+
+	expected := cty.TupleVal([]cty.Value{cty.StringVal("a"), cty.StringVal("b")})
+	if !result.Value.RawEquals(expected) {
+		t.Errorf("Value mismatch: got %#v, want %#v", result.Value, expected)
+	}
+}
+
+func TestAttributeConversion_SourceBytes(t *testing.T) {
+	original := &hclext.Attribute{
+		Name:        "name",
+		Value:       cty.UnknownVal(cty.String),
+		SourceBytes: []byte(`"${var.prefix}-rg"`),
+	}
+
+	proto := toProtoAttribute(original)
+	if string(proto.ExprBytes) != `"${var.prefix}-rg"` {
+		t.Errorf("ExprBytes = %q, want %q", proto.ExprBytes, `"${var.prefix}-rg"`)
+	}
+
+	result := fromProtoAttribute(proto)
+	if string(result.SourceBytes) != `"${var.prefix}-rg"` {
+		t.Errorf("SourceBytes = %q, want %q", result.SourceBytes, `"${var.prefix}-rg"`)
+	}
+	if !result.Value.RawEquals(cty.UnknownVal(cty.String)) {
+		t.Errorf("Value = %#v, want unknown string", result.Value)
+	}
+}
+
+func TestAttributeConversion_ItemRanges(t *testing.T) {
+	envRange := hcl.Range{
+		Filename: "main.tf",
+		Start:    hcl.Pos{Line: 2, Column: 3, Byte: 10},
+		End:      hcl.Pos{Line: 2, Column: 15, Byte: 22},
+	}
+	teamRange := hcl.Range{
+		Filename: "main.tf",
+		Start:    hcl.Pos{Line: 3, Column: 3, Byte: 25},
+		End:      hcl.Pos{Line: 3, Column: 21, Byte: 43},
+	}
+	original := &hclext.Attribute{
+		Name:       "tags",
+		ItemRanges: map[string]hcl.Range{"env": envRange, "team": teamRange},
+	}
+
+	result := fromProtoAttribute(toProtoAttribute(original))
+	if diff := cmp.Diff(original.ItemRanges, result.ItemRanges); diff != "" {
+		t.Errorf("ItemRanges mismatch (-want +got):\n%s", diff)
+	}
+
+	plain := fromProtoAttribute(toProtoAttribute(&hclext.Attribute{Name: "name"}))
+	if plain.ItemRanges != nil {
+		t.Errorf("ItemRanges = %v, want nil", plain.ItemRanges)
+	}
+}
+
 func TestAttributeConversion_NilAndUnknownValues(t *testing.T) {
 	t.Run("nil value", func(t *testing.T) {
 		attr := &hclext.Attribute{
@@ -688,7 +1055,7 @@ func TestAttributeConversion_NilAndUnknownValues(t *testing.T) {
 			Value: cty.NullVal(cty.String),
 		}
 		proto := toProtoAttribute(attr)
-		// Null values are not serialized (per the implementation)
+		// Null values are sent as a marker, without a JSON value
 		if len(proto.ExprValue) != 0 {
 			t.Error("ExprValue should be empty for null value")
 		}
@@ -700,9 +1067,209 @@ func TestAttributeConversion_NilAndUnknownValues(t *testing.T) {
 			Value: cty.UnknownVal(cty.String),
 		}
 		proto := toProtoAttribute(attr)
-		// Unknown values are not serialized
+		// Unknown values are sent as a marker, without a JSON value
 		if len(proto.ExprValue) != 0 {
 			t.Error("ExprValue should be empty for unknown value")
 		}
 	})
 }
+
+func TestAttributeConversion_PresenceStates(t *testing.T) {
+	parse := func(t *testing.T, src string) *hclext.BodyContent {
+		t.Helper()
+		file, diags := hclsyntax.ParseConfig([]byte(src), "main.tf", hcl.InitialPos)
+		if diags.HasErrors() {
+			t.Fatalf("parse error: %v", diags)
+		}
+		content, diags := file.Body.Content(&hcl.BodySchema{
+			Attributes: []hcl.AttributeSchema{{Name: "sku"}},
+		})
+		if diags.HasErrors() {
+			t.Fatalf("content error: %v", diags)
+		}
+		return hclext.FromHCLBodyContent(content)
+	}
+
+	tests := []struct {
+		name        string
+		src         string
+		wantPresent bool
+		wantNull    bool
+		wantUnknown bool
+		want        cty.Value
+	}{
+		{name: "absent", src: ``},
+		{name: "null", src: `sku = null`, wantPresent: true, wantNull: true, want: cty.NullVal(cty.DynamicPseudoType)},
+		{name: "unknown", src: `sku = var.sku`, wantPresent: true, wantUnknown: true, want: cty.DynamicVal},
+		{name: "known", src: `sku = "Standard"`, wantPresent: true, want: cty.StringVal("Standard")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := fromProtoBodyContent(toProtoBodyContent(parse(t, tt.src)))
+
+			attr, ok := result.Attributes["sku"]
+			if ok != tt.wantPresent {
+				t.Fatalf("attribute present = %t, want %t", ok, tt.wantPresent)
+			}
+			if !ok {
+				return
+			}
+			if !attr.Value.RawEquals(tt.want) {
+				t.Errorf("Value = %#v, want %#v", attr.Value, tt.want)
+			}
+			if got := !attr.Value.IsKnown(); got != tt.wantUnknown {
+				t.Errorf("unknown = %t, want %t", got, tt.wantUnknown)
+			}
+			if got := attr.Value.IsKnown() && attr.Value.IsNull(); got != tt.wantNull {
+				t.Errorf("null = %t, want %t", got, tt.wantNull)
+			}
+		})
+	}
+
+	t.Run("typed values", func(t *testing.T) {
+		for _, val := range []cty.Value{cty.NullVal(cty.List(cty.String)), cty.UnknownVal(cty.Map(cty.Number))} {
+			result := fromProtoAttribute(toProtoAttribute(&hclext.Attribute{Name: "sku", Value: val}))
+			if !result.Value.RawEquals(val) {
+				t.Errorf("Value = %#v, want %#v", result.Value, val)
+			}
+		}
+	})
+}
+
+func TestAttributeConversion_References(t *testing.T) {
+	file, diags := hclsyntax.ParseConfig([]byte(`location = "${var.region}-${local.suffix}"
+sku      = "Standard"
+`), "main.tf", hcl.InitialPos)
+	if diags.HasErrors() {
+		t.Fatalf("parse error: %v", diags)
+	}
+	content, diags := file.Body.Content(&hcl.BodySchema{
+		Attributes: []hcl.AttributeSchema{{Name: "location"}, {Name: "sku"}},
+	})
+	if diags.HasErrors() {
+		t.Fatalf("content error: %v", diags)
+	}
+
+	result := fromProtoBodyContent(toProtoBodyContent(hclext.FromHCLBodyContent(content)))
+
+	location := result.Attributes["location"]
+	if location.Expr != nil {
+		t.Fatal("Expr should not survive the roundtrip")
+	}
+	if diff := cmp.Diff([]string{"var.region", "local.suffix"}, location.References()); diff != "" {
+		t.Errorf("References() mismatch (-want +got):\n%s", diff)
+	}
+	if got := result.Attributes["sku"].References(); got != nil {
+		t.Errorf("References() = %v, want nil for a literal", got)
+	}
+
+	// A roundtrip attribute keeps its references when sent again
+	again := fromProtoAttribute(toProtoAttribute(location))
+	if diff := cmp.Diff(location.References(), again.References()); diff != "" {
+		t.Errorf("References() mismatch after a second roundtrip (-want +got):\n%s", diff)
+	}
+}
+
+func TestRuleMetadataConversion(t *testing.T) {
+	ruleset := &tflint.BuiltinRuleSet{}
+
+	t.Run("with metadata", func(t *testing.T) {
+		rule := &metadataTestRule{
+			scopedTestRule: scopedTestRule{
+				testRule:      testRule{name: "documented"},
+				resourceTypes: []string{"azurerm_storage_account"},
+			},
+		}
+
+		got := fromProtoRuleMetadata(toProtoRuleMetadata(ruleset, rule))
+		want := &RuleMetadata{
+			Description:   "Detects ForceNew changes",
+			Tags:          []string{"force-new", "azurerm"},
+			ResourceTypes: []string{"azurerm_storage_account"},
+		}
+		if diff := cmp.Diff(want, got); diff != "" {
+			t.Errorf("metadata mismatch (-want +got):\n%s", diff)
+		}
+	})
+
+	t.Run("deprecated", func(t *testing.T) {
+		rule := &deprecatedTestRule{testRule: testRule{name: "old"}, replacedBy: "new"}
+		got := fromProtoRuleMetadata(toProtoRuleMetadata(ruleset, rule))
+		want := &RuleMetadata{Tags: []string{}, Deprecated: true, ReplacedBy: "new"}
+		if diff := cmp.Diff(want, got); diff != "" {
+			t.Errorf("metadata mismatch (-want +got):\n%s", diff)
+		}
+	})
+
+	t.Run("without metadata", func(t *testing.T) {
+		got := fromProtoRuleMetadata(toProtoRuleMetadata(ruleset, &testRule{name: "plain"}))
+		want := &RuleMetadata{Tags: []string{}}
+		if diff := cmp.Diff(want, got); diff != "" {
+			t.Errorf("metadata mismatch (-want +got):\n%s", diff)
+		}
+	})
+}
+
+func TestDiagnosticsConversion(t *testing.T) {
+	diags := hcl.Diagnostics{
+		{
+			Severity: hcl.DiagError,
+			Summary:  "Unsupported argument",
+			Detail:   `An argument named "foo" is not expected here.`,
+			Subject:  &hcl.Range{Filename: "main.tf", Start: hcl.Pos{Line: 2, Column: 3, Byte: 10}, End: hcl.Pos{Line: 2, Column: 6, Byte: 13}},
+			Context:  &hcl.Range{Filename: "main.tf", Start: hcl.Pos{Line: 1, Column: 1}, End: hcl.Pos{Line: 3, Column: 2, Byte: 30}},
+		},
+		{Severity: hcl.DiagWarning, Summary: "No range"},
+	}
+
+	result := fromProtoDiagnostics(toProtoDiagnostics(diags))
+	if diff := cmp.Diff(diags, result); diff != "" {
+		t.Errorf("roundtrip mismatch (-want +got):\n%s", diff)
+	}
+	if fromProtoDiagnostics(toProtoDiagnostics(nil)) != nil {
+		t.Error("expected nil for no diagnostics")
+	}
+}
+
+func TestRuleErrorConversion(t *testing.T) {
+	errs := []*tflint.RuleError{
+		{Rule: "rule_a", Category: tflint.ErrorCategoryInternal, Err: errors.New("boom")},
+		{Rule: "rule_b", Category: tflint.ErrorCategoryPanic, Err: errors.New("nil map")},
+		{Rule: "rule_c", Category: tflint.ErrorCategoryConfig, Err: errors.New("bad threshold")},
+	}
+
+	result := fromProtoRuleErrors(toProtoRuleErrors(errs))
+	if len(result) != len(errs) {
+		t.Fatalf("got %d errors, want %d", len(result), len(errs))
+	}
+	for i, want := range errs {
+		got := result[i]
+		if got.Rule != want.Rule || got.Category != want.Category || got.Error() != want.Error() {
+			t.Errorf("errors[%d] = %q (%v), want %q (%v)", i, got.Error(), got.Category, want.Error(), want.Category)
+		}
+	}
+
+	if fromProtoRuleErrors(toProtoRuleErrors(nil)) != nil {
+		t.Error("expected nil for no errors")
+	}
+
+	// Diagnostics wrapped by a rule error survive the conversion
+	diags := hcl.Diagnostics{
+		{Severity: hcl.DiagError, Summary: "first", Subject: &hcl.Range{Filename: "main.tf", Start: hcl.Pos{Line: 1, Column: 1}, End: hcl.Pos{Line: 1, Column: 5, Byte: 4}}},
+		{Severity: hcl.DiagError, Summary: "second", Subject: &hcl.Range{Filename: "main.tf", Start: hcl.Pos{Line: 2, Column: 1, Byte: 5}, End: hcl.Pos{Line: 2, Column: 5, Byte: 9}}},
+	}
+	result = fromProtoRuleErrors(toProtoRuleErrors([]*tflint.RuleError{
+		{Rule: "rule_d", Category: tflint.ErrorCategoryInternal, Err: fmt.Errorf("decode: %w", diags)},
+	}))
+	var got hcl.Diagnostics
+	if !errors.As(result[0], &got) {
+		t.Fatalf("errors.As should extract hcl.Diagnostics from %v", result[0])
+	}
+	if diff := cmp.Diff(diags, got); diff != "" {
+		t.Errorf("diagnostics mismatch (-want +got):\n%s", diff)
+	}
+	if want := "rule rule_d: decode: " + diags.Error(); result[0].Error() != want {
+		t.Errorf("Error() = %q, want %q", result[0].Error(), want)
+	}
+}
@@ -0,0 +1,88 @@
+// Package plugin provides gRPC-based plugin communication for tfbreak.
+//
+// This file carries HCL diagnostics across gRPC errors. A gRPC error only
+// has a message, so errors wrapping hcl.Diagnostics are sent with the
+// diagnostics attached as a status detail and decoded on the other side
+// into a *tflint.DiagnosticsError, keeping each severity and range.
+
+package plugin
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/jokarl/tfbreak-plugin-sdk/plugin/proto"
+	"github.com/jokarl/tfbreak-plugin-sdk/tflint"
+)
+
+// toStatusError returns err as a gRPC status error with its diagnostics
+// attached. Errors without diagnostics, and errors that already carry a
+// status, are returned unchanged.
+func toStatusError(err error) error {
+	diags := errorDiagnostics(err)
+	if len(diags) == 0 {
+		return err
+	}
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+
+	st, detailErr := status.New(codes.Unknown, err.Error()).WithDetails(&pb.Diagnostics{
+		Diagnostics: toProtoDiagnostics(diags),
+	})
+	if detailErr != nil {
+		return err
+	}
+	return st.Err()
+}
+
+// fromStatusError returns a *tflint.DiagnosticsError for a gRPC status
+// error with diagnostics attached, and err unchanged otherwise.
+func fromStatusError(err error) error {
+	st, ok := status.FromError(err)
+	if !ok {
+		return err
+	}
+	for _, detail := range st.Details() {
+		if diags, ok := detail.(*pb.Diagnostics); ok {
+			return &tflint.DiagnosticsError{
+				Message:     st.Message(),
+				Diagnostics: fromProtoDiagnostics(diags.GetDiagnostics()),
+			}
+		}
+	}
+	return err
+}
+
+// diagnosticsInterceptor attaches diagnostics to errors returned by the
+// handler. It is installed on the host's Runner server.
+func diagnosticsInterceptor(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	resp, err := handler(ctx, req)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return resp, nil
+}
+
+// diagnosticsConn is a gRPC connection that decodes diagnostics attached
+// to the errors of unary calls.
+type diagnosticsConn struct {
+	grpc.ClientConnInterface
+}
+
+// withDiagnostics wraps conn so that errors carrying diagnostics are
+// returned as *tflint.DiagnosticsError.
+func withDiagnostics(conn grpc.ClientConnInterface) grpc.ClientConnInterface {
+	return &diagnosticsConn{ClientConnInterface: conn}
+}
+
+// Invoke performs the unary call, decoding diagnostics from its error.
+func (c *diagnosticsConn) Invoke(ctx context.Context, method string, args, reply any, opts ...grpc.CallOption) error {
+	if err := c.ClientConnInterface.Invoke(ctx, method, args, reply, opts...); err != nil {
+		return fromStatusError(err)
+	}
+	return nil
+}
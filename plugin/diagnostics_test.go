@@ -0,0 +1,114 @@
+package plugin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/jokarl/tfbreak-plugin-sdk/hclext"
+	"github.com/jokarl/tfbreak-plugin-sdk/tflint"
+)
+
+// testDiagnostics returns an error and a warning with source ranges.
+func testDiagnostics() hcl.Diagnostics {
+	return hcl.Diagnostics{
+		{
+			Severity: hcl.DiagError,
+			Summary:  "Missing required argument",
+			Detail:   `The argument "location" is required, but no definition was found.`,
+			Subject:  &hcl.Range{Filename: "main.tf", Start: hcl.Pos{Line: 1, Column: 40, Byte: 39}, End: hcl.Pos{Line: 1, Column: 41, Byte: 40}},
+			Context:  &hcl.Range{Filename: "main.tf", Start: hcl.Pos{Line: 1, Column: 1, Byte: 0}, End: hcl.Pos{Line: 3, Column: 2, Byte: 60}},
+		},
+		{
+			Severity: hcl.DiagWarning,
+			Summary:  "Deprecated attribute",
+			Subject:  &hcl.Range{Filename: "network.tf", Start: hcl.Pos{Line: 5, Column: 3, Byte: 80}, End: hcl.Pos{Line: 5, Column: 10, Byte: 87}},
+		},
+	}
+}
+
+func TestStatusErrorRoundtrip(t *testing.T) {
+	diags := testDiagnostics()
+	err := fromStatusError(toStatusError(fmt.Errorf("failed to get content: %w", diags)))
+
+	var diagErr *tflint.DiagnosticsError
+	if !errors.As(err, &diagErr) {
+		t.Fatalf("expected *tflint.DiagnosticsError, got %T: %v", err, err)
+	}
+	if want := "failed to get content: " + diags.Error(); diagErr.Error() != want {
+		t.Errorf("Error() = %q, want %q", diagErr.Error(), want)
+	}
+	var got hcl.Diagnostics
+	if !errors.As(err, &got) {
+		t.Fatal("errors.As should extract hcl.Diagnostics")
+	}
+	if !reflect.DeepEqual(got, diags) {
+		t.Errorf("diagnostics = %v, want %v", got, diags)
+	}
+}
+
+func TestStatusError_WithoutDiagnostics(t *testing.T) {
+	plain := errors.New("boom")
+	if got := toStatusError(plain); got != plain {
+		t.Errorf("toStatusError() = %v, want the error unchanged", got)
+	}
+
+	unavailable := status.Error(codes.Unavailable, "host busy")
+	if got := fromStatusError(unavailable); got != unavailable {
+		t.Errorf("fromStatusError() = %v, want the error unchanged", got)
+	}
+	if got := fromStatusError(plain); got != plain {
+		t.Errorf("fromStatusError() = %v, want the error unchanged", got)
+	}
+}
+
+// contentErrorTestRule returns the error of a module content callback.
+type contentErrorTestRule struct {
+	testRule
+}
+
+func (r *contentErrorTestRule) Check(_ context.Context, runner tflint.Runner) error {
+	if _, err := runner.GetOldModuleContent(&hclext.BodySchema{}, nil); err != nil {
+		return fmt.Errorf("reading old content: %w", err)
+	}
+	return nil
+}
+
+func TestGRPCRuleSetClient_CheckDiagnostics(t *testing.T) {
+	ruleset := dispenseRuleSet(t, &tflint.BuiltinRuleSet{
+		Name:    "test",
+		Version: "0.1.0",
+		Rules:   []tflint.Rule{&contentErrorTestRule{testRule: testRule{name: "content_rule"}}},
+	}, RuleSetPlugin{})
+
+	// The host's diagnostics travel to the plugin as a callback error and
+	// back to the host as a rule error
+	diags := testDiagnostics()
+	runner := &recordingRunner{
+		onGetOldModuleContent: func(*hclext.BodySchema, *tflint.GetModuleContentOption) (*hclext.BodyContent, error) {
+			return nil, diags
+		},
+	}
+	err := ruleset.Check(runner)
+
+	var ruleErr *tflint.RuleError
+	if !errors.As(err, &ruleErr) || ruleErr.Rule != "content_rule" {
+		t.Fatalf("expected a RuleError for content_rule, got %T: %v", err, err)
+	}
+	var got hcl.Diagnostics
+	if !errors.As(err, &got) {
+		t.Fatalf("errors.As should extract hcl.Diagnostics from %v", err)
+	}
+	if !reflect.DeepEqual(got, diags) {
+		t.Errorf("diagnostics = %v, want %v", got, diags)
+	}
+	if want := "reading old content: " + diags.Error(); ruleErr.Err.Error() != want {
+		t.Errorf("message = %q, want %q", ruleErr.Err.Error(), want)
+	}
+}
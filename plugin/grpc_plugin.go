@@ -7,12 +7,19 @@ package plugin
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"io"
+	"runtime/debug"
 	"sync"
 	"time"
 
+	"github.com/hashicorp/go-hclog"
 	"github.com/hashicorp/go-plugin"
+	"github.com/hashicorp/hcl/v2"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/jokarl/tfbreak-plugin-sdk/hclext"
 	pb "github.com/jokarl/tfbreak-plugin-sdk/plugin/proto"
@@ -36,14 +43,43 @@ type RuleSetPlugin struct {
 	// Impl is the concrete implementation of the RuleSet interface.
 	// Only used when serving (plugin side).
 	Impl tflint.RuleSet
+	// BufferIssues returns emitted issues in the Check response instead of
+	// sending each one through the EmitIssue callback.
+	// Only used when serving (plugin side).
+	BufferIssues bool
+	// Parallelism is the maximum number of rules run concurrently during Check.
+	// Values below 2 run rules sequentially.
+	// Only used when serving (plugin side).
+	Parallelism int
+	// CombineErrors returns rule failures from Check as a single RPC error
+	// instead of structured errors in the Check response.
+	// Only used when serving (plugin side).
+	CombineErrors bool
+	// RunnerRetry controls how Runner callbacks to the host are retried
+	// on transient failures. The zero value disables retries.
+	// Only used when serving (plugin side).
+	RunnerRetry RetryPolicy
+	// Logger is made available to rules via tflint.LoggerFromContext.
+	// Only used when serving (plugin side).
+	Logger hclog.Logger
+	// MaxMessageSize is the largest gRPC message, in bytes, the Runner
+	// callback server accepts from the plugin. Zero keeps gRPC's default
+	// of 4MB. Plugins set their own limit with ServeOpts.MaxMessageSize.
+	// Only used by the host.
+	MaxMessageSize int
 }
 
 // GRPCServer is called by the plugin to register the gRPC server.
 // This is called on the plugin side.
 func (p *RuleSetPlugin) GRPCServer(broker *plugin.GRPCBroker, s *grpc.Server) error {
 	pb.RegisterRuleSetServer(s, &GRPCRuleSetServer{
-		impl:   p.Impl,
-		broker: broker,
+		impl:          p.Impl,
+		broker:        broker,
+		bufferIssues:  p.BufferIssues,
+		parallelism:   p.Parallelism,
+		combineErrors: p.CombineErrors,
+		runnerRetry:   p.RunnerRetry,
+		logger:        p.Logger,
 	})
 	return nil
 }
@@ -52,8 +88,9 @@ func (p *RuleSetPlugin) GRPCServer(broker *plugin.GRPCBroker, s *grpc.Server) er
 // This is called on the host side (tfbreak-core).
 func (p *RuleSetPlugin) GRPCClient(ctx context.Context, broker *plugin.GRPCBroker, c *grpc.ClientConn) (interface{}, error) {
 	return &GRPCRuleSetClient{
-		client: pb.NewRuleSetClient(c),
-		broker: broker,
+		client:         pb.NewRuleSetClient(withDiagnostics(c)),
+		broker:         broker,
+		maxMessageSize: p.MaxMessageSize,
 	}, nil
 }
 
@@ -67,6 +104,19 @@ type GRPCRuleSetServer struct {
 	pb.UnimplementedRuleSetServer
 	impl   tflint.RuleSet
 	broker *plugin.GRPCBroker
+	// bufferIssues collects issues into the Check response.
+	bufferIssues bool
+	// parallelism is the maximum number of rules run concurrently.
+	parallelism int
+	// combineErrors returns rule failures as a single RPC error.
+	combineErrors bool
+	// runnerRetry is the retry policy for Runner callbacks.
+	runnerRetry RetryPolicy
+	// logger is passed to rules through the Check context.
+	logger hclog.Logger
+	// ruleConfigs holds the JSON-encoded rule configuration bodies sent with
+	// ApplyGlobalConfig, keyed by rule name, so runners decode them locally.
+	ruleConfigs map[string][]byte
 }
 
 // GetRuleSetName returns the name of the ruleset.
@@ -90,6 +140,34 @@ func (s *GRPCRuleSetServer) GetRuleNames(ctx context.Context, req *pb.GetRuleNam
 	}, nil
 }
 
+// GetRuleMetadata returns metadata for all rules in this ruleset.
+func (s *GRPCRuleSetServer) GetRuleMetadata(ctx context.Context, req *pb.GetRuleMetadata_Request) (*pb.GetRuleMetadata_Response, error) {
+	rules := make(map[string]*pb.RuleMetadata)
+	if builtin := s.impl.BuiltinImpl(); builtin != nil {
+		for _, rule := range builtin.Rules {
+			rules[rule.Name()] = toProtoRuleMetadata(builtin, rule)
+		}
+	}
+	return &pb.GetRuleMetadata_Response{
+		Rules: rules,
+	}, nil
+}
+
+// GetRuleDefaults returns the declared defaults of all rules in this ruleset.
+// Rule.Enabled and Rule.Severity are reported as implemented by each rule,
+// ignoring any configuration applied to the ruleset.
+func (s *GRPCRuleSetServer) GetRuleDefaults(ctx context.Context, req *pb.GetRuleDefaults_Request) (*pb.GetRuleDefaults_Response, error) {
+	var rules []*pb.Rule
+	if builtin := s.impl.BuiltinImpl(); builtin != nil {
+		for _, rule := range builtin.Rules {
+			rules = append(rules, toProtoRule(rule))
+		}
+	}
+	return &pb.GetRuleDefaults_Response{
+		Rules: rules,
+	}, nil
+}
+
 // GetVersionConstraint returns the tfbreak version constraint.
 func (s *GRPCRuleSetServer) GetVersionConstraint(ctx context.Context, req *pb.GetVersionConstraint_Request) (*pb.GetVersionConstraint_Response, error) {
 	return &pb.GetVersionConstraint_Response{
@@ -97,6 +175,22 @@ func (s *GRPCRuleSetServer) GetVersionConstraint(ctx context.Context, req *pb.Ge
 	}, nil
 }
 
+// GetSDKVersion returns the SDK version and supported protocol versions.
+func (s *GRPCRuleSetServer) GetSDKVersion(ctx context.Context, req *pb.GetSDKVersion_Request) (*pb.GetSDKVersion_Response, error) {
+	return &pb.GetSDKVersion_Response{
+		Version:            sdkVersion(),
+		ProtocolVersion:    ProtocolVersion,
+		MinProtocolVersion: MinProtocolVersion,
+	}, nil
+}
+
+// GetCapabilities returns the optional features this plugin supports.
+func (s *GRPCRuleSetServer) GetCapabilities(ctx context.Context, req *pb.GetCapabilities_Request) (*pb.GetCapabilities_Response, error) {
+	return &pb.GetCapabilities_Response{
+		Capabilities: ruleSetCapabilities(s.impl),
+	}, nil
+}
+
 // GetConfigSchema returns the schema for plugin-specific configuration.
 func (s *GRPCRuleSetServer) GetConfigSchema(ctx context.Context, req *pb.GetConfigSchema_Request) (*pb.GetConfigSchema_Response, error) {
 	schema := s.impl.ConfigSchema()
@@ -109,8 +203,16 @@ func (s *GRPCRuleSetServer) GetConfigSchema(ctx context.Context, req *pb.GetConf
 func (s *GRPCRuleSetServer) ApplyGlobalConfig(ctx context.Context, req *pb.ApplyGlobalConfig_Request) (*pb.ApplyGlobalConfig_Response, error) {
 	config := fromProtoConfig(req.GetConfig())
 	if err := s.impl.ApplyGlobalConfig(config); err != nil {
-		return nil, err
+		return nil, toStatusError(err)
+	}
+
+	ruleConfigs := make(map[string][]byte)
+	for name, rc := range req.GetConfig().GetRules() {
+		if len(rc.GetConfigBytes()) > 0 {
+			ruleConfigs[name] = rc.GetConfigBytes()
+		}
 	}
+	s.ruleConfigs = ruleConfigs
 	return &pb.ApplyGlobalConfig_Response{}, nil
 }
 
@@ -118,13 +220,15 @@ func (s *GRPCRuleSetServer) ApplyGlobalConfig(ctx context.Context, req *pb.Apply
 func (s *GRPCRuleSetServer) ApplyConfig(ctx context.Context, req *pb.ApplyConfig_Request) (*pb.ApplyConfig_Response, error) {
 	content := fromProtoBodyContent(req.GetContent())
 	if err := s.impl.ApplyConfig(content); err != nil {
-		return nil, err
+		return nil, toStatusError(err)
 	}
 	return &pb.ApplyConfig_Response{}, nil
 }
 
 // Check executes all enabled rules.
 // All rules are executed even if some fail - errors are collected and returned together.
+// The request context is passed to each rule and to runner callbacks, so host
+// cancellation is observed while a rule is running, not only between rules.
 func (s *GRPCRuleSetServer) Check(ctx context.Context, req *pb.Check_Request) (*pb.Check_Response, error) {
 	// The broker provides a unique ID for this call.
 	// The host starts a Runner server and tells us the ID.
@@ -142,8 +246,101 @@ func (s *GRPCRuleSetServer) Check(ctx context.Context, req *pb.Check_Request) (*
 	}
 	defer conn.Close()
 
-	runnerClient := pb.NewRunnerClient(conn)
-	runner := &GRPCRunnerClient{client: runnerClient}
+	var runner tflint.Runner = s.newRunnerClient(ctx, conn)
+
+	// Collect issues locally instead of sending a callback per issue
+	var buffer *bufferingRunner
+	if s.bufferIssues {
+		buffer = &bufferingRunner{Runner: runner}
+		runner = buffer
+	}
+
+	ruleErrors, err := s.runRules(ctx, runner)
+	if err != nil {
+		return nil, err
+	}
+
+	// In legacy mode, combine errors into a single RPC error.
+	// A failed RPC carries no response, so buffered issues are sent
+	// through the callback first to avoid losing them.
+	if s.combineErrors && len(ruleErrors) > 0 {
+		errs := make([]error, 0, len(ruleErrors)+1)
+		for _, err := range ruleErrors {
+			errs = append(errs, err)
+		}
+		if buffer != nil {
+			if err := buffer.flush(); err != nil {
+				errs = append(errs, err)
+			}
+		}
+		return nil, combineErrors(errs)
+	}
+
+	resp := &pb.Check_Response{Errors: toProtoRuleErrors(ruleErrors)}
+	if buffer != nil {
+		resp.Issues = buffer.drain()
+	}
+	return resp, nil
+}
+
+// newRunnerClient returns the Runner client for a Check call, calling back
+// to the host on conn. Rule configuration sent with ApplyGlobalConfig is
+// decoded locally; other configuration is requested from the host.
+func (s *GRPCRuleSetServer) newRunnerClient(ctx context.Context, conn *grpc.ClientConn) *GRPCRunnerClient {
+	return &GRPCRunnerClient{
+		client:      pb.NewRunnerClient(withDiagnostics(withRetry(conn, s.runnerRetry))),
+		ctx:         ctx,
+		ruleConfigs: s.ruleConfigs,
+	}
+}
+
+// CheckStream executes all enabled rules like Check, but sends each issue to
+// the host on the stream as soon as it is emitted. A completion message
+// carrying the structured rule errors is sent once every rule has finished.
+// In legacy mode, rule failures end the stream with the combined error
+// instead.
+func (s *GRPCRuleSetServer) CheckStream(req *pb.CheckStream_Request, stream pb.RuleSet_CheckStreamServer) error {
+	ctx := stream.Context()
+
+	// The Runner client is still needed for content callbacks
+	conn, err := s.broker.Dial(RunnerBrokerID)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	runner := &streamingRunner{
+		Runner: s.newRunnerClient(ctx, conn),
+		stream: stream,
+	}
+	ruleErrors, err := s.runRules(ctx, runner)
+	if err != nil {
+		return err
+	}
+	if s.combineErrors && len(ruleErrors) > 0 {
+		errs := make([]error, 0, len(ruleErrors))
+		for _, err := range ruleErrors {
+			errs = append(errs, err)
+		}
+		return combineErrors(errs)
+	}
+
+	return stream.Send(&pb.CheckStream_Response{
+		Event: &pb.CheckStream_Response_Complete{Complete: &pb.CheckStream_Complete{
+			Errors: toProtoRuleErrors(ruleErrors),
+		}},
+	})
+}
+
+// runRules executes all enabled rules against runner, collecting rule errors
+// rather than failing fast. This ensures all rules run even if some fail,
+// giving users a complete picture. The returned error is set only if the
+// rules could not be run to completion (e.g., the context was cancelled).
+func (s *GRPCRuleSetServer) runRules(ctx context.Context, runner tflint.Runner) ([]*tflint.RuleError, error) {
+	// Make the plugin logger available to rules
+	if s.logger != nil {
+		ctx = tflint.ContextWithLogger(ctx, s.logger)
+	}
 
 	// Let the ruleset optionally wrap the runner
 	wrappedRunner, err := s.impl.NewRunner(runner)
@@ -151,32 +348,114 @@ func (s *GRPCRuleSetServer) Check(ctx context.Context, req *pb.Check_Request) (*
 		return nil, err
 	}
 
-	// Execute all enabled rules, collecting errors rather than failing fast.
-	// This ensures all rules run even if some fail, giving users a complete picture.
+	// Apply configured severity overrides to emitted issues
 	builtin := s.impl.BuiltinImpl()
-	var ruleErrors []error
-	for _, rule := range builtin.EnabledRules() {
+	wrappedRunner = builtin.ApplySeverityOverrides(wrappedRunner)
+
+	// Run rules in a bounded worker pool. Errors are stored by rule index
+	// so they are reported in rule order regardless of completion order.
+	rules := builtin.EnabledRules()
+	s.warnDeprecated(builtin, rules)
+	errs := make([]*tflint.RuleError, len(rules))
+
+	// Prepare rules once before any rule is checked, so they can share
+	// cached lookups. A rule that fails to prepare is not checked.
+	for i, rule := range rules {
+		errs[i] = prepareRule(rule, wrappedRunner)
+	}
+
+	sem := make(chan struct{}, max(s.parallelism, 1))
+	var wg sync.WaitGroup
+	for i, rule := range rules {
+		if errs[i] != nil {
+			continue
+		}
+		sem <- struct{}{}
+
 		// Check for context cancellation between rules
-		select {
-		case <-ctx.Done():
-			return nil, ctx.Err()
-		default:
+		if err := ctx.Err(); err != nil {
+			wg.Wait()
+			return nil, err
 		}
 
-		if err := rule.Check(wrappedRunner); err != nil {
-			ruleErrors = append(ruleErrors, fmt.Errorf("rule %s: %w", rule.Name(), err))
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer func() { <-sem }()
+			errs[i] = checkRule(ctx, rule, wrappedRunner)
+		}()
+	}
+	wg.Wait()
+
+	var ruleErrors []*tflint.RuleError
+	for _, err := range errs {
+		if err != nil {
+			ruleErrors = append(ruleErrors, err)
 		}
 	}
+	return ruleErrors, nil
+}
 
-	// If any rules failed, combine errors into a single error
-	if len(ruleErrors) > 0 {
-		return nil, combineErrors(ruleErrors)
+// warnDeprecated logs a warning for each deprecated rule among the enabled
+// rules, so users running the plugin learn about the replacement even if
+// the host does not read the rule metadata.
+func (s *GRPCRuleSetServer) warnDeprecated(builtin *tflint.BuiltinRuleSet, rules []tflint.Rule) {
+	if s.logger == nil {
+		return
 	}
+	for _, rule := range rules {
+		if replacedBy, ok := builtin.RuleDeprecation(rule); ok {
+			s.logger.Warn("enabled rule is deprecated", "rule", rule.Name(), "replaced_by", replacedBy)
+		}
+	}
+}
 
-	return &pb.Check_Response{}, nil
+// checkRule runs a single rule, returning nil if it succeeds. A panic in
+// the rule is recovered and returned as an error including the stack trace,
+// so one faulty rule does not crash the plugin process.
+func checkRule(ctx context.Context, rule tflint.Rule, runner tflint.Runner) (ruleErr *tflint.RuleError) {
+	defer func() {
+		if r := recover(); r != nil {
+			ruleErr = &tflint.RuleError{
+				Rule:     rule.Name(),
+				Category: tflint.ErrorCategoryPanic,
+				Err:      fmt.Errorf("%v\n%s", r, debug.Stack()),
+			}
+		}
+	}()
+
+	if err := rule.Check(ctx, runner); err != nil {
+		return tflint.NewRuleError(rule.Name(), err)
+	}
+	return nil
+}
+
+// prepareRule calls Prepare on a rule implementing tflint.PreparableRule,
+// returning nil if it succeeds or the rule does not implement it. A panic
+// is recovered as in checkRule.
+func prepareRule(rule tflint.Rule, runner tflint.Runner) (ruleErr *tflint.RuleError) {
+	preparable, ok := rule.(tflint.PreparableRule)
+	if !ok {
+		return nil
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			ruleErr = &tflint.RuleError{
+				Rule:     rule.Name(),
+				Category: tflint.ErrorCategoryPanic,
+				Err:      fmt.Errorf("%v\n%s", r, debug.Stack()),
+			}
+		}
+	}()
+
+	if err := preparable.Prepare(runner); err != nil {
+		return tflint.NewRuleError(rule.Name(), err)
+	}
+	return nil
 }
 
 // combineErrors combines multiple errors into a single error.
+// It is used instead of structured errors when ServeOpts.CombineErrors is set.
 func combineErrors(errs []error) error {
 	if len(errs) == 0 {
 		return nil
@@ -208,6 +487,8 @@ const RunnerBrokerID uint32 = 1
 type GRPCRuleSetClient struct {
 	client pb.RuleSetClient
 	broker *plugin.GRPCBroker
+	// maxMessageSize limits messages on the Runner callback server.
+	maxMessageSize int
 }
 
 // RuleSetName returns the name of the ruleset.
@@ -249,6 +530,92 @@ func (c *GRPCRuleSetClient) RuleNames() []string {
 	return resp.GetNames()
 }
 
+// RuleMetadata is the metadata a plugin reports for one of its rules.
+type RuleMetadata struct {
+	// Description is the rule description, or empty if not provided.
+	Description string
+	// Tags are labels used to group the rule. Empty if not provided.
+	Tags []string
+	// ResourceTypes are the resource types a scoped rule inspects.
+	// Empty if the rule is not scoped.
+	ResourceTypes []string
+	// Deprecated reports whether the rule is superseded and will be
+	// removed. Hosts should warn when a deprecated rule is enabled.
+	Deprecated bool
+	// ReplacedBy is the name of the rule replacing a deprecated rule, or
+	// empty if there is none.
+	ReplacedBy string
+}
+
+// RuleMetadata returns the metadata of every rule, keyed by rule name.
+// Every rule has an entry; rules that do not implement tflint.RuleMetadata,
+// tflint.ScopedRule or tflint.DeprecatedRule have empty fields.
+func (c *GRPCRuleSetClient) RuleMetadata() (map[string]*RuleMetadata, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), defaultGRPCTimeout)
+	defer cancel()
+
+	resp, err := c.client.GetRuleMetadata(ctx, &pb.GetRuleMetadata_Request{})
+	if err != nil {
+		return nil, err
+	}
+
+	rules := make(map[string]*RuleMetadata, len(resp.GetRules()))
+	for name, metadata := range resp.GetRules() {
+		rules[name] = fromProtoRuleMetadata(metadata)
+	}
+	return rules, nil
+}
+
+// RuleDefault describes a rule as declared by the plugin, before any
+// configuration is applied.
+type RuleDefault struct {
+	// Name is the rule name.
+	Name string
+	// Enabled reports whether the rule is enabled by default.
+	Enabled bool
+	// Severity is the rule's default severity.
+	Severity tflint.Severity
+	// Link is the rule's documentation URL, or empty if not provided.
+	Link string
+}
+
+// RuleDefaults returns the default enabled state and severity of every rule,
+// in the order the ruleset declares them. Hosts can use this to render a rule
+// catalog or validate configuration without running the plugin.
+func (c *GRPCRuleSetClient) RuleDefaults() ([]RuleDefault, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), defaultGRPCTimeout)
+	defer cancel()
+
+	resp, err := c.client.GetRuleDefaults(ctx, &pb.GetRuleDefaults_Request{})
+	if err != nil {
+		return nil, err
+	}
+
+	rules := make([]RuleDefault, len(resp.GetRules()))
+	for i, rule := range resp.GetRules() {
+		rules[i] = fromProtoRuleDefault(rule)
+	}
+	return rules, nil
+}
+
+// RuleResourceTypes returns the resource types declared by each scoped rule,
+// keyed by rule name. Rules that do not implement tflint.ScopedRule are
+// omitted and must always be run.
+func (c *GRPCRuleSetClient) RuleResourceTypes() (map[string][]string, error) {
+	rules, err := c.RuleMetadata()
+	if err != nil {
+		return nil, err
+	}
+
+	types := make(map[string][]string)
+	for name, metadata := range rules {
+		if len(metadata.ResourceTypes) > 0 {
+			types[name] = metadata.ResourceTypes
+		}
+	}
+	return types, nil
+}
+
 // VersionConstraint returns the tfbreak version constraint.
 func (c *GRPCRuleSetClient) VersionConstraint() string {
 	ctx, cancel := context.WithTimeout(context.Background(), defaultGRPCTimeout)
@@ -262,6 +629,59 @@ func (c *GRPCRuleSetClient) VersionConstraint() string {
 	return resp.GetConstraint()
 }
 
+// SDKInfo returns the SDK version the plugin was built with and the protocol
+// versions it supports. Hosts can use SDKInfo.CheckProtocol to report a clear
+// error for an incompatible plugin. Plugins built before this RPC existed
+// return a codes.Unimplemented error.
+func (c *GRPCRuleSetClient) SDKInfo() (*SDKInfo, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), defaultGRPCTimeout)
+	defer cancel()
+
+	resp, err := c.client.GetSDKVersion(ctx, &pb.GetSDKVersion_Request{})
+	if err != nil {
+		return nil, err
+	}
+	return &SDKInfo{
+		Version:            resp.GetVersion(),
+		ProtocolVersion:    int(resp.GetProtocolVersion()),
+		MinProtocolVersion: int(resp.GetMinProtocolVersion()),
+	}, nil
+}
+
+// Capabilities returns the optional features the plugin supports. Plugins
+// built before this RPC existed report no capabilities rather than an
+// error, so hosts can treat every feature missing from the set as
+// unsupported.
+//
+// Example:
+//
+//	caps, err := ruleset.Capabilities()
+//	if err != nil {
+//	    return err
+//	}
+//	if caps.Has(plugin.CapabilityCheckStream) {
+//	    return ruleset.CheckStream(runner, report)
+//	}
+//	return ruleset.Check(runner)
+func (c *GRPCRuleSetClient) Capabilities() (Capabilities, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), defaultGRPCTimeout)
+	defer cancel()
+
+	resp, err := c.client.GetCapabilities(ctx, &pb.GetCapabilities_Request{})
+	if status.Code(err) == codes.Unimplemented {
+		return Capabilities{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	capabilities := make(Capabilities, len(resp.GetCapabilities()))
+	for _, name := range resp.GetCapabilities() {
+		capabilities[name] = true
+	}
+	return capabilities, nil
+}
+
 // ConfigSchema returns the schema for plugin-specific configuration.
 func (c *GRPCRuleSetClient) ConfigSchema() *hclext.BodySchema {
 	ctx, cancel := context.WithTimeout(context.Background(), defaultGRPCTimeout)
@@ -311,8 +731,136 @@ func (c *GRPCRuleSetClient) BuiltinImpl() *tflint.BuiltinRuleSet {
 
 // Check executes all enabled rules via the plugin.
 // The host must provide a Runner implementation that the plugin can call back to.
+// If any rules fail, the returned error is a *tflint.MultiRuleError, from which
+// each *tflint.RuleError can be extracted with errors.As.
 func (c *GRPCRuleSetClient) Check(runner tflint.Runner) error {
-	// Start a Runner server that the plugin can call back to
+	_, err := c.CheckMaxSeverity(runner)
+	return err
+}
+
+// CheckMaxSeverity executes all enabled rules like Check and also returns the
+// highest severity among the issues the plugin emitted, so the host can choose
+// an exit code (e.g., fail on ERROR, warn on WARNING). It returns 0 if no issue
+// was emitted.
+//
+// The severity is tracked on the host from the issues passed to runner, so
+// issues dropped because of a tfbreak:ignore directive do not count.
+func (c *GRPCRuleSetClient) CheckMaxSeverity(runner tflint.Runner) (tflint.Severity, error) {
+	tracker := &severityTracker{Runner: runner}
+	stop := c.serveRunner(tracker)
+	defer stop()
+
+	// Call the plugin's Check method with a timeout
+	ctx, cancel := context.WithTimeout(context.Background(), checkTimeout)
+	defer cancel()
+
+	resp, err := c.client.Check(ctx, &pb.Check_Request{})
+	if err != nil {
+		return 0, err
+	}
+
+	// Report issues buffered by the plugin, if any
+	for _, issue := range resp.GetIssues() {
+		if err := emitProtoIssue(tracker, issue); err != nil {
+			return tracker.maxSeverity(), err
+		}
+	}
+
+	// Report rule failures after their issues have been delivered
+	if ruleErrors := fromProtoRuleErrors(resp.GetErrors()); len(ruleErrors) > 0 {
+		return tracker.maxSeverity(), &tflint.MultiRuleError{Errors: ruleErrors}
+	}
+	return tracker.maxSeverity(), nil
+}
+
+// Issue is a finding streamed from the plugin by CheckStream.
+type Issue struct {
+	// Rule is the rule that reported the issue. Only its name, enabled
+	// state, severity, and link are available on the host side.
+	Rule tflint.Rule
+	// Message describes the finding.
+	Message string
+	// Range is the source location of the finding.
+	Range hcl.Range
+	// Fix is the suggested remediation, or nil if none was provided.
+	Fix *tflint.Fix
+	// Severity is the severity of this issue: the per-issue severity if the
+	// rule set one, otherwise the rule's severity.
+	Severity tflint.Severity
+	// Kind is the classification passed to EmitIssueWithOptions, or
+	// tflint.KindUnspecified.
+	Kind tflint.IssueKind
+	// ResourceAddress is the address passed to EmitIssueWithOptions, or
+	// empty.
+	ResourceAddress string
+	// Side is the configuration Range refers to: the side passed to
+	// EmitIssueWithOptions, otherwise tflint.SideNew.
+	Side tflint.Side
+}
+
+// CheckStream executes all enabled rules via the plugin, calling onIssue for
+// each issue as soon as the plugin emits it. Issues are not sent to the
+// runner's EmitIssue; the runner is only used for content callbacks and
+// tfbreak:ignore directives. It returns once the plugin reports completion.
+// If any rule failed, the error is a *tflint.MultiRuleError, as for Check;
+// plugins served with ServeOpts.CombineErrors return a single combined error
+// instead.
+func (c *GRPCRuleSetClient) CheckStream(runner tflint.Runner, onIssue func(Issue)) error {
+	stop := c.serveRunner(runner)
+	defer stop()
+
+	ctx, cancel := context.WithTimeout(context.Background(), checkTimeout)
+	defer cancel()
+
+	stream, err := c.client.CheckStream(ctx, &pb.CheckStream_Request{})
+	if err != nil {
+		return err
+	}
+
+	for {
+		resp, err := stream.Recv()
+		if err != nil {
+			if err == io.EOF {
+				return errors.New("check stream ended without completion")
+			}
+			return err
+		}
+
+		switch event := resp.GetEvent().(type) {
+		case *pb.CheckStream_Response_Issue:
+			issue := event.Issue
+			rule := fromProtoRule(issue.GetRule())
+			issueRange := fromProtoRange(issue.GetRange())
+			side := fromProtoIssueSide(issue.GetSide())
+			if isIgnoredIssue(runner, rule.Name(), side, issueRange) {
+				continue
+			}
+			severity := rule.Severity()
+			if issue.GetSeverity() != pb.Severity_SEVERITY_UNSPECIFIED {
+				severity = fromProtoSeverity(issue.GetSeverity())
+			}
+			onIssue(Issue{
+				Rule:            rule,
+				Message:         issue.GetMessage(),
+				Range:           issueRange,
+				Fix:             fromProtoFix(issue.GetFix()),
+				Severity:        severity,
+				Kind:            fromProtoIssueKind(issue.GetKind()),
+				ResourceAddress: issue.GetResourceAddress(),
+				Side:            side,
+			})
+		case *pb.CheckStream_Response_Complete:
+			if ruleErrors := fromProtoRuleErrors(event.Complete.GetErrors()); len(ruleErrors) > 0 {
+				return &tflint.MultiRuleError{Errors: ruleErrors}
+			}
+			return nil
+		}
+	}
+}
+
+// serveRunner starts a Runner server that the plugin can call back to during
+// a check. The returned function stops the server.
+func (c *GRPCRuleSetClient) serveRunner(runner tflint.Runner) func() {
 	runnerServer := &GRPCRunnerServer{impl: runner}
 
 	// Use a WaitGroup to ensure the server is ready before calling Check
@@ -326,7 +874,10 @@ func (c *GRPCRuleSetClient) Check(runner tflint.Runner) error {
 	// Use the broker to start a server the plugin can connect to
 	serverFunc := func(opts []grpc.ServerOption) *grpc.Server {
 		serverMu.Lock()
-		grpcServer = grpc.NewServer(opts...)
+		// Accept messages up to the configured size from the plugin
+		opts = append(opts, messageSizeServerOptions(c.maxMessageSize)...)
+		// Attach diagnostics to errors so they reach the plugin intact
+		grpcServer = grpc.NewServer(append(opts, grpc.ChainUnaryInterceptor(diagnosticsInterceptor))...)
 		serverMu.Unlock()
 		pb.RegisterRunnerServer(grpcServer, runnerServer)
 		serverReady.Done()
@@ -350,20 +901,12 @@ func (c *GRPCRuleSetClient) Check(runner tflint.Runner) error {
 		// Server startup timeout - proceed anyway, plugin may still connect
 	}
 
-	// Ensure cleanup of the gRPC server when done
-	defer func() {
+	return func() {
 		serverMu.Lock()
 		if grpcServer != nil {
 			// Use GracefulStop to allow pending RPCs to complete
 			grpcServer.GracefulStop()
 		}
 		serverMu.Unlock()
-	}()
-
-	// Call the plugin's Check method with a timeout
-	ctx, cancel := context.WithTimeout(context.Background(), checkTimeout)
-	defer cancel()
-
-	_, err := c.client.Check(ctx, &pb.Check_Request{})
-	return err
+	}
 }
@@ -1,13 +1,25 @@
 package plugin
 
 import (
+	"bytes"
+	"context"
+	"errors"
 	"fmt"
+	"reflect"
+	"sort"
 	"strings"
+	"sync"
 	"testing"
+	"time"
 
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/go-plugin"
 	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/zclconf/go-cty/cty"
 
 	"github.com/jokarl/tfbreak-plugin-sdk/hclext"
+	pb "github.com/jokarl/tfbreak-plugin-sdk/plugin/proto"
 	"github.com/jokarl/tfbreak-plugin-sdk/tflint"
 )
 
@@ -16,6 +28,22 @@ type mockRuleSet struct {
 	tflint.BuiltinRuleSet
 }
 
+// dispenseRuleSet serves rs over an in-process go-plugin connection
+// configured by opts and returns the host-side client. The connection is
+// closed when the test ends.
+func dispenseRuleSet(tb testing.TB, rs tflint.RuleSet, opts RuleSetPlugin) *GRPCRuleSetClient {
+	tb.Helper()
+	opts.Impl = rs
+	client, _ := plugin.TestPluginGRPCConn(tb, false, map[string]plugin.Plugin{PluginName: &opts})
+	tb.Cleanup(func() { client.Close() })
+
+	raw, err := client.Dispense(PluginName)
+	if err != nil {
+		tb.Fatalf("Dispense error: %v", err)
+	}
+	return raw.(*GRPCRuleSetClient)
+}
+
 func TestGRPCRuleSetClientImplementsRuleSet(t *testing.T) {
 	// This is a compile-time check that GRPCRuleSetClient implements tflint.RuleSet.
 	// If this doesn't compile, the interface isn't properly implemented.
@@ -61,10 +89,166 @@ func TestGRPCRuleSetClientMethods(t *testing.T) {
 	}
 }
 
+// scopedTestRule is a rule that declares the resource types it inspects.
+type scopedTestRule struct {
+	testRule
+	resourceTypes []string
+}
+
+func (r *scopedTestRule) ResourceTypes() []string { return r.resourceTypes }
+
+// metadataTestRule is a scoped rule that also provides a description and tags.
+type metadataTestRule struct {
+	scopedTestRule
+}
+
+func (r *metadataTestRule) Description() string { return "Detects ForceNew changes" }
+func (r *metadataTestRule) Tags() []string      { return []string{"force-new", "azurerm"} }
+
+// deprecatedTestRule is a rule superseded by another rule.
+type deprecatedTestRule struct {
+	testRule
+	replacedBy string
+}
+
+func (r *deprecatedTestRule) Deprecated() (string, bool) { return r.replacedBy, true }
+
+func TestGRPCRuleSetServer_GetRuleMetadata(t *testing.T) {
+	server := &GRPCRuleSetServer{
+		impl: &tflint.BuiltinRuleSet{
+			Rules: []tflint.Rule{
+				&scopedTestRule{
+					testRule:      testRule{name: "scoped_rule"},
+					resourceTypes: []string{"azurerm_storage_account"},
+				},
+				&testRule{name: "unscoped_rule"},
+			},
+		},
+	}
+
+	resp, err := server.GetRuleMetadata(context.Background(), &pb.GetRuleMetadata_Request{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(resp.Rules) != 2 {
+		t.Fatalf("expected metadata for 2 rules, got %d", len(resp.Rules))
+	}
+	scoped := resp.Rules["scoped_rule"].GetResourceTypes()
+	if len(scoped) != 1 || scoped[0] != "azurerm_storage_account" {
+		t.Errorf("scoped_rule resource types = %v, want [azurerm_storage_account]", scoped)
+	}
+	if unscoped := resp.Rules["unscoped_rule"].GetResourceTypes(); len(unscoped) != 0 {
+		t.Errorf("unscoped_rule resource types = %v, want empty", unscoped)
+	}
+}
+
+func TestGRPCRuleSetClient_RuleMetadata(t *testing.T) {
+	ruleset := dispenseRuleSet(t, &tflint.BuiltinRuleSet{
+		Name:    "test",
+		Version: "0.1.0",
+		Rules: []tflint.Rule{
+			&metadataTestRule{scopedTestRule: scopedTestRule{
+				testRule:      testRule{name: "documented_rule"},
+				resourceTypes: []string{"azurerm_storage_account"},
+			}},
+			&testRule{name: "plain_rule"},
+			&deprecatedTestRule{testRule: testRule{name: "old_rule"}, replacedBy: "new_rule"},
+		},
+	}, RuleSetPlugin{})
+
+	metadata, err := ruleset.RuleMetadata()
+	if err != nil {
+		t.Fatalf("RuleMetadata error: %v", err)
+	}
+
+	documented := metadata["documented_rule"]
+	if documented == nil {
+		t.Fatal("missing metadata for documented_rule")
+	}
+	if documented.Description != "Detects ForceNew changes" {
+		t.Errorf("Description = %q, want %q", documented.Description, "Detects ForceNew changes")
+	}
+	if len(documented.Tags) != 2 || documented.Tags[0] != "force-new" {
+		t.Errorf("Tags = %v, want [force-new azurerm]", documented.Tags)
+	}
+
+	plain, ok := metadata["plain_rule"]
+	if !ok || plain == nil {
+		t.Fatal("rules without metadata should still have an entry")
+	}
+	if plain.Description != "" || len(plain.Tags) != 0 || len(plain.ResourceTypes) != 0 || plain.Deprecated || plain.ReplacedBy != "" {
+		t.Errorf("plain_rule metadata = %+v, want empty", plain)
+	}
+
+	if old := metadata["old_rule"]; old == nil || !old.Deprecated || old.ReplacedBy != "new_rule" {
+		t.Errorf("old_rule metadata = %+v, want deprecated and replaced by new_rule", old)
+	}
+
+	types, err := ruleset.RuleResourceTypes()
+	if err != nil {
+		t.Fatalf("RuleResourceTypes error: %v", err)
+	}
+	if _, ok := types["plain_rule"]; ok || len(types["documented_rule"]) != 1 {
+		t.Errorf("RuleResourceTypes = %v, want only documented_rule", types)
+	}
+}
+
 // Note: TestGRPCRuleSetClientConfigSchema and TestGRPCRuleSetClientApplyConfig
 // are not included because they would require a full gRPC server setup.
 // The actual gRPC communication is tested via integration tests.
 
+// disabledTestRule is a rule that is disabled by default with WARNING severity.
+type disabledTestRule struct {
+	testRule
+}
+
+func (r *disabledTestRule) Enabled() bool             { return false }
+func (r *disabledTestRule) Severity() tflint.Severity { return tflint.WARNING }
+
+func TestGRPCRuleSetClient_RuleDefaults(t *testing.T) {
+	ruleset := dispenseRuleSet(t, &tflint.BuiltinRuleSet{
+		Name:    "test",
+		Version: "0.1.0",
+		Rules: []tflint.Rule{
+			&testRule{name: "enabled_rule"},
+			&disabledTestRule{testRule: testRule{name: "disabled_rule"}},
+		},
+	}, RuleSetPlugin{})
+
+	defaults, err := ruleset.RuleDefaults()
+	if err != nil {
+		t.Fatalf("RuleDefaults error: %v", err)
+	}
+
+	want := []RuleDefault{
+		{Name: "enabled_rule", Enabled: true, Severity: tflint.ERROR},
+		{Name: "disabled_rule", Enabled: false, Severity: tflint.WARNING},
+	}
+	if !reflect.DeepEqual(defaults, want) {
+		t.Errorf("RuleDefaults() = %+v, want %+v", defaults, want)
+	}
+}
+
+func TestGRPCRuleSetClient_SDKInfo(t *testing.T) {
+	ruleset := dispenseRuleSet(t, &tflint.BuiltinRuleSet{Name: "test", Version: "0.1.0"}, RuleSetPlugin{})
+
+	info, err := ruleset.SDKInfo()
+	if err != nil {
+		t.Fatalf("SDKInfo error: %v", err)
+	}
+	if info.Version == "" {
+		t.Error("SDKInfo().Version should not be empty")
+	}
+	if info.ProtocolVersion != ProtocolVersion || info.MinProtocolVersion != MinProtocolVersion {
+		t.Errorf("SDKInfo() protocol range = %d to %d, want %d to %d",
+			info.MinProtocolVersion, info.ProtocolVersion, MinProtocolVersion, ProtocolVersion)
+	}
+	if err := info.CheckProtocol(ProtocolVersion); err != nil {
+		t.Errorf("CheckProtocol(ProtocolVersion) error = %v", err)
+	}
+}
+
 func TestRunnerBrokerID(t *testing.T) {
 	// Verify the broker ID is a reasonable value
 	if RunnerBrokerID == 0 {
@@ -140,10 +324,534 @@ func (r *mockRunner) GetNewResourceContent(resourceType string, schema *hclext.B
 	return &hclext.BodyContent{}, nil
 }
 
+func (r *mockRunner) GetResourceContentPair(resourceType string, schema *hclext.BodySchema, opts *tflint.GetModuleContentOption) (*hclext.BodyContent, *hclext.BodyContent, error) {
+	return &hclext.BodyContent{}, &hclext.BodyContent{}, nil
+}
+
+func (r *mockRunner) GetOldDataSourceContent(dataSourceType string, schema *hclext.BodySchema, opts *tflint.GetModuleContentOption) (*hclext.BodyContent, error) {
+	return &hclext.BodyContent{}, nil
+}
+
+func (r *mockRunner) GetNewDataSourceContent(dataSourceType string, schema *hclext.BodySchema, opts *tflint.GetModuleContentOption) (*hclext.BodyContent, error) {
+	return &hclext.BodyContent{}, nil
+}
+
+func (r *mockRunner) GetOldFile(filename string) (*hcl.File, error) {
+	return nil, fmt.Errorf("file not found: %s", filename)
+}
+
+func (r *mockRunner) GetNewFile(filename string) (*hcl.File, error) {
+	return nil, fmt.Errorf("file not found: %s", filename)
+}
+
+func (r *mockRunner) GetFileSource(filename string, side tflint.Side) ([]byte, error) {
+	return nil, fmt.Errorf("file not found: %s", filename)
+}
+
+func (r *mockRunner) ListOldFiles() []string {
+	return nil
+}
+
+func (r *mockRunner) ListNewFiles() []string {
+	return nil
+}
+
+func (r *mockRunner) FileChanges() (added, removed, common []string) {
+	return nil, nil, nil
+}
+
+func (r *mockRunner) GetOldProviderRequirements() (map[string]tflint.ProviderRequirement, error) {
+	return map[string]tflint.ProviderRequirement{}, nil
+}
+
+func (r *mockRunner) GetNewProviderRequirements() (map[string]tflint.ProviderRequirement, error) {
+	return map[string]tflint.ProviderRequirement{}, nil
+}
+
+func (r *mockRunner) GetOldTerraformSettings() (*tflint.TerraformSettings, error) {
+	return &tflint.TerraformSettings{}, nil
+}
+
+func (r *mockRunner) GetNewTerraformSettings() (*tflint.TerraformSettings, error) {
+	return &tflint.TerraformSettings{}, nil
+}
+
+func (r *mockRunner) GetOldProviderConfig(name string, schema *hclext.BodySchema) (*hclext.Block, error) {
+	return nil, nil
+}
+
+func (r *mockRunner) GetNewProviderConfig(name string, schema *hclext.BodySchema) (*hclext.Block, error) {
+	return nil, nil
+}
+
+func (r *mockRunner) GetOldVariables() ([]tflint.VariableDef, error) {
+	return nil, nil
+}
+
+func (r *mockRunner) GetNewVariables() ([]tflint.VariableDef, error) {
+	return nil, nil
+}
+
+func (r *mockRunner) GetOldOutputs() ([]tflint.OutputDef, error) {
+	return nil, nil
+}
+
+func (r *mockRunner) GetNewOutputs() ([]tflint.OutputDef, error) {
+	return nil, nil
+}
+
+func (r *mockRunner) GetOldModuleCalls() ([]tflint.ModuleCall, error) {
+	return nil, nil
+}
+
+func (r *mockRunner) GetNewModuleCalls() ([]tflint.ModuleCall, error) {
+	return nil, nil
+}
+
+func (r *mockRunner) GetOldLocals() (map[string]cty.Value, error) {
+	return nil, nil
+}
+
+func (r *mockRunner) GetNewLocals() (map[string]cty.Value, error) {
+	return nil, nil
+}
+
+func (r *mockRunner) GetAllOldResources() ([]*hclext.Block, error) {
+	return nil, nil
+}
+
+func (r *mockRunner) GetAllNewResources() ([]*hclext.Block, error) {
+	return nil, nil
+}
+
+func (r *mockRunner) OldResourceAddresses() ([]string, error) {
+	return nil, nil
+}
+
+func (r *mockRunner) NewResourceAddresses() ([]string, error) {
+	return nil, nil
+}
+
+func (r *mockRunner) GetMovedBlocks() []tflint.MovedBlock {
+	return nil
+}
+
+func (r *mockRunner) GetModuleDiff(schema *hclext.BodySchema, opts *tflint.GetModuleContentOption) (*tflint.ModuleDiff, error) {
+	return tflint.GetModuleDiff(r, schema, opts)
+}
+
+func (r *mockRunner) WalkOldResources(schema *hclext.BodySchema, fn tflint.WalkResourcesFunc) error {
+	return tflint.WalkOldResources(r, schema, fn)
+}
+
+func (r *mockRunner) WalkNewResources(schema *hclext.BodySchema, fn tflint.WalkResourcesFunc) error {
+	return tflint.WalkNewResources(r, schema, fn)
+}
+
 func (r *mockRunner) EmitIssue(rule tflint.Rule, message string, issueRange hcl.Range) error {
 	return nil
 }
 
+func (r *mockRunner) EmitIssueWithFix(rule tflint.Rule, message string, issueRange hcl.Range, fix *tflint.Fix) error {
+	return nil
+}
+
+func (r *mockRunner) EmitIssueWithSeverity(rule tflint.Rule, severity tflint.Severity, message string, issueRange hcl.Range) error {
+	return nil
+}
+
+func (r *mockRunner) EmitIssueWithOptions(rule tflint.Rule, message string, issueRange hcl.Range, opts tflint.IssueOptions) error {
+	return nil
+}
+
 func (r *mockRunner) DecodeRuleConfig(ruleName string, target any) error {
 	return nil
 }
+
+func (r *mockRunner) DecodeRuleConfigExists(ruleName string, target any) (bool, error) {
+	return false, nil
+}
+
+// loggerTestRule records the logger it receives through the Check context.
+type loggerTestRule struct {
+	testRule
+	logger hclog.Logger
+}
+
+func (r *loggerTestRule) Check(ctx context.Context, _ tflint.Runner) error {
+	r.logger = tflint.LoggerFromContext(ctx)
+	return nil
+}
+
+func TestGRPCRuleSetServer_CheckPassesLogger(t *testing.T) {
+	logger := hclog.New(&hclog.LoggerOptions{Name: "test"})
+	rule := &loggerTestRule{testRule: testRule{name: "logger_rule"}}
+
+	ruleset := dispenseRuleSet(t, &tflint.BuiltinRuleSet{Name: "test", Version: "0.1.0", Rules: []tflint.Rule{rule}}, RuleSetPlugin{Logger: logger})
+	if err := ruleset.Check(&recordingRunner{}); err != nil {
+		t.Fatalf("Check error: %v", err)
+	}
+
+	if rule.logger != logger {
+		t.Error("rule did not receive the plugin logger")
+	}
+}
+
+// configTestRule records whether configuration exists for each rule name
+// it decodes during Check.
+type configTestRule struct {
+	testRule
+	names   []string
+	exists  map[string]bool
+	configs map[string]map[string]any
+}
+
+func (r *configTestRule) Check(_ context.Context, runner tflint.Runner) error {
+	r.exists = make(map[string]bool)
+	r.configs = make(map[string]map[string]any)
+	for _, name := range r.names {
+		var config map[string]any
+		exists, err := runner.DecodeRuleConfigExists(name, &config)
+		if err != nil {
+			return err
+		}
+		r.exists[name] = exists
+		r.configs[name] = config
+	}
+	return nil
+}
+
+func TestGRPCRunnerClient_DecodeRuleConfigExists(t *testing.T) {
+	rule := &configTestRule{testRule: testRule{name: "config_rule"}, names: []string{"configured", "unconfigured"}}
+
+	ruleset := dispenseRuleSet(t, &tflint.BuiltinRuleSet{Name: "test", Version: "0.1.0", Rules: []tflint.Rule{rule}}, RuleSetPlugin{})
+	runner := &recordingRunner{
+		onDecodeRuleConfig: func(ruleName string, target any) error {
+			if ruleName == "configured" {
+				*target.(*map[string]any) = map[string]any{"level": "strict"}
+			}
+			return nil
+		},
+	}
+	if err := ruleset.Check(runner); err != nil {
+		t.Fatalf("Check error: %v", err)
+	}
+
+	if want := map[string]bool{"configured": true, "unconfigured": false}; !reflect.DeepEqual(rule.exists, want) {
+		t.Errorf("exists = %v, want %v", rule.exists, want)
+	}
+	if got := rule.configs["configured"]["level"]; got != "strict" {
+		t.Errorf("configured level = %v, want strict", got)
+	}
+	if rule.configs["unconfigured"] != nil {
+		t.Errorf("unconfigured config = %v, want nil", rule.configs["unconfigured"])
+	}
+}
+
+func TestGRPCRunnerClient_DecodeRuleConfigLocal(t *testing.T) {
+	const src = "level = \"strict\"\nthreshold = 5\nnames = [\"a\", \"b\"]\n"
+	file, diags := hclsyntax.ParseConfig([]byte(src), "config.hcl", hcl.InitialPos)
+	if diags.HasErrors() {
+		t.Fatalf("parse error: %v", diags)
+	}
+
+	// decode runs a Check that decodes the configuration of "configured",
+	// with the host answering DecodeRuleConfig callbacks with callbackConfig
+	decode := func(t *testing.T, config *tflint.Config, callbackConfig map[string]any) (map[string]any, int) {
+		t.Helper()
+
+		rule := &configTestRule{testRule: testRule{name: "config_rule"}, names: []string{"configured"}}
+		ruleset := dispenseRuleSet(t, &tflint.BuiltinRuleSet{Name: "test", Version: "0.1.0", Rules: []tflint.Rule{rule}}, RuleSetPlugin{})
+		if err := ruleset.ApplyGlobalConfig(config); err != nil {
+			t.Fatalf("ApplyGlobalConfig error: %v", err)
+		}
+
+		callbacks := 0
+		runner := &recordingRunner{
+			onDecodeRuleConfig: func(ruleName string, target any) error {
+				callbacks++
+				*target.(*map[string]any) = callbackConfig
+				return nil
+			},
+		}
+		if err := ruleset.Check(runner); err != nil {
+			t.Fatalf("Check error: %v", err)
+		}
+		if !rule.exists["configured"] {
+			t.Error("configured has no configuration")
+		}
+		return rule.configs["configured"], callbacks
+	}
+
+	local, callbacks := decode(t, &tflint.Config{
+		Rules: map[string]*tflint.RuleConfig{
+			"configured": {Name: "configured", Enabled: true, Body: file.Body},
+		},
+	}, nil)
+	if callbacks != 0 {
+		t.Errorf("local decode made %d DecodeRuleConfig callbacks, want 0", callbacks)
+	}
+
+	// Without a body, the same configuration comes from the host
+	remote, callbacks := decode(t, &tflint.Config{
+		Rules: map[string]*tflint.RuleConfig{
+			"configured": {Name: "configured", Enabled: true},
+		},
+	}, map[string]any{"level": "strict", "threshold": 5, "names": []string{"a", "b"}})
+	if callbacks != 1 {
+		t.Errorf("callback decode made %d DecodeRuleConfig callbacks, want 1", callbacks)
+	}
+
+	if !reflect.DeepEqual(local, remote) {
+		t.Errorf("local config = %v, callback config = %v", local, remote)
+	}
+}
+
+func TestGRPCRuleSetServer_CheckWarnsDeprecated(t *testing.T) {
+	var buf bytes.Buffer
+	logger := hclog.New(&hclog.LoggerOptions{Name: "test", Output: &buf})
+
+	ruleset := dispenseRuleSet(t, &tflint.BuiltinRuleSet{Name: "test", Version: "0.1.0", Rules: []tflint.Rule{
+		&deprecatedTestRule{testRule: testRule{name: "old_rule"}, replacedBy: "new_rule"},
+		&testRule{name: "new_rule"},
+	}}, RuleSetPlugin{Logger: logger})
+	if err := ruleset.Check(&recordingRunner{}); err != nil {
+		t.Fatalf("Check error: %v", err)
+	}
+
+	log := buf.String()
+	if !strings.Contains(log, "rule=old_rule") || !strings.Contains(log, "replaced_by=new_rule") {
+		t.Errorf("log = %q, want a warning for old_rule", log)
+	}
+	if strings.Contains(log, "rule=new_rule") {
+		t.Errorf("log = %q, want no warning for new_rule", log)
+	}
+}
+
+// panickingTestRule panics with a nil map write during Check.
+type panickingTestRule struct {
+	testRule
+}
+
+func (r *panickingTestRule) Check(_ context.Context, _ tflint.Runner) error {
+	var attrs map[string]string
+	attrs["location"] = "westus"
+	return nil
+}
+
+// barrierTestRule waits until all rules sharing its barrier have started,
+// then emits a fixed number of issues. It fails if the rules are not run
+// concurrently.
+type barrierTestRule struct {
+	testRule
+	barrier *sync.WaitGroup
+	count   int
+}
+
+func (r *barrierTestRule) Check(_ context.Context, runner tflint.Runner) error {
+	r.barrier.Done()
+	done := make(chan struct{})
+	go func() {
+		r.barrier.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		return errors.New("rules did not run concurrently")
+	}
+
+	for i := 0; i < r.count; i++ {
+		if err := runner.EmitIssue(r, fmt.Sprintf("%s issue %d", r.name, i), hcl.Range{Filename: "main.tf"}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func TestGRPCRuleSetServer_CheckParallel(t *testing.T) {
+	const ruleCount, issueCount = 4, 5
+
+	var barrier sync.WaitGroup
+	barrier.Add(ruleCount)
+	var rules []tflint.Rule
+	for i := 0; i < ruleCount; i++ {
+		rules = append(rules, &barrierTestRule{
+			testRule: testRule{name: fmt.Sprintf("rule_%d", i)},
+			barrier:  &barrier,
+			count:    issueCount,
+		})
+	}
+
+	ruleset := dispenseRuleSet(t, &tflint.BuiltinRuleSet{Name: "test", Version: "0.1.0", Rules: rules}, RuleSetPlugin{Parallelism: ruleCount})
+
+	// EmitIssue callbacks arrive concurrently on the host side
+	var mu sync.Mutex
+	var messages []string
+	runner := &recordingRunner{
+		onEmitIssue: func(rule tflint.Rule, message string, issueRange hcl.Range) error {
+			mu.Lock()
+			defer mu.Unlock()
+			messages = append(messages, message)
+			return nil
+		},
+	}
+	if err := ruleset.Check(runner); err != nil {
+		t.Fatalf("Check error: %v", err)
+	}
+
+	var want []string
+	for i := 0; i < ruleCount; i++ {
+		for j := 0; j < issueCount; j++ {
+			want = append(want, fmt.Sprintf("rule_%d issue %d", i, j))
+		}
+	}
+	sort.Strings(messages)
+	sort.Strings(want)
+	if !reflect.DeepEqual(messages, want) {
+		t.Errorf("messages = %v, want %v", messages, want)
+	}
+}
+
+func TestGRPCRuleSetServer_CheckParallel_ErrorOrder(t *testing.T) {
+	// Errors are reported in rule order, regardless of completion order
+	rules := []tflint.Rule{
+		&failingTestRule{testRule: testRule{name: "rule_a"}},
+		&failingTestRule{testRule: testRule{name: "rule_b"}},
+		&failingTestRule{testRule: testRule{name: "rule_c"}},
+	}
+	server := &GRPCRuleSetServer{
+		impl:        &tflint.BuiltinRuleSet{Name: "test", Version: "0.1.0", Rules: rules},
+		parallelism: len(rules),
+	}
+
+	errs, err := server.runRules(context.Background(), &recordingRunner{})
+	if err != nil {
+		t.Fatalf("runRules error: %v", err)
+	}
+	var got []string
+	for _, err := range errs {
+		got = append(got, err.Error())
+	}
+	want := []string{"rule rule_a: rule failed", "rule rule_b: rule failed", "rule rule_c: rule failed"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("errors = %v, want %v", got, want)
+	}
+}
+
+func TestGRPCRuleSetServer_CheckRecoversPanic(t *testing.T) {
+	panicking := &panickingTestRule{testRule: testRule{name: "panicking_rule"}}
+	emitting := &emittingTestRule{testRule: testRule{name: "emitting_rule"}, count: 2}
+
+	ruleset := dispenseRuleSet(t, &tflint.BuiltinRuleSet{Name: "test", Version: "0.1.0", Rules: []tflint.Rule{panicking, emitting}}, RuleSetPlugin{})
+
+	var messages []string
+	runner := &recordingRunner{
+		onEmitIssue: func(rule tflint.Rule, message string, issueRange hcl.Range) error {
+			messages = append(messages, message)
+			return nil
+		},
+	}
+	err := ruleset.Check(runner)
+	if err == nil {
+		t.Fatal("expected error from panicking rule, got nil")
+	}
+	if !strings.Contains(err.Error(), "rule panicking_rule panicked: assignment to entry in nil map") {
+		t.Errorf("error does not mention the panicking rule: %v", err)
+	}
+	if !strings.Contains(err.Error(), "panickingTestRule).Check") {
+		t.Errorf("error does not include the stack trace: %v", err)
+	}
+	if len(messages) != 2 {
+		t.Errorf("received %d issues from the other rule, want 2", len(messages))
+	}
+}
+
+// configErrorTestRule fails with an invalid configuration error.
+type configErrorTestRule struct {
+	testRule
+}
+
+func (r *configErrorTestRule) Check(_ context.Context, _ tflint.Runner) error {
+	return fmt.Errorf("%w: threshold must be positive", tflint.ErrInvalidConfig)
+}
+
+func TestGRPCRuleSetClient_CheckRuleErrors(t *testing.T) {
+	rules := []tflint.Rule{
+		&failingTestRule{testRule: testRule{name: "failing_rule"}},
+		&configErrorTestRule{testRule: testRule{name: "config_rule"}},
+		&panickingTestRule{testRule: testRule{name: "panicking_rule"}},
+	}
+
+	for _, bufferIssues := range []bool{false, true} {
+		t.Run(fmt.Sprintf("buffer issues %v", bufferIssues), func(t *testing.T) {
+			ruleset := dispenseRuleSet(t, &tflint.BuiltinRuleSet{Name: "test", Version: "0.1.0", Rules: rules}, RuleSetPlugin{BufferIssues: bufferIssues})
+
+			var messages []string
+			runner := &recordingRunner{
+				onEmitIssue: func(rule tflint.Rule, message string, issueRange hcl.Range) error {
+					messages = append(messages, message)
+					return nil
+				},
+			}
+			err := ruleset.Check(runner)
+
+			var multi *tflint.MultiRuleError
+			if !errors.As(err, &multi) {
+				t.Fatalf("expected *tflint.MultiRuleError, got %T: %v", err, err)
+			}
+			if len(multi.Errors) != 3 {
+				t.Fatalf("got %d rule errors, want 3", len(multi.Errors))
+			}
+
+			want := []struct {
+				rule     string
+				category tflint.ErrorCategory
+				message  string
+			}{
+				{"failing_rule", tflint.ErrorCategoryInternal, "rule failed"},
+				{"config_rule", tflint.ErrorCategoryConfig, "invalid config: threshold must be positive"},
+				{"panicking_rule", tflint.ErrorCategoryPanic, "assignment to entry in nil map"},
+			}
+			for i, w := range want {
+				got := multi.Errors[i]
+				if got.Rule != w.rule || got.Category != w.category {
+					t.Errorf("errors[%d] = %s/%v, want %s/%v", i, got.Rule, got.Category, w.rule, w.category)
+				}
+				if !strings.Contains(got.Err.Error(), w.message) {
+					t.Errorf("errors[%d] message = %q, want it to contain %q", i, got.Err.Error(), w.message)
+				}
+			}
+
+			var ruleErr *tflint.RuleError
+			if !errors.As(err, &ruleErr) || ruleErr.Rule != "failing_rule" {
+				t.Errorf("errors.As extracted %v, want failing_rule", ruleErr)
+			}
+
+			if len(messages) != 1 || messages[0] != "before failure" {
+				t.Errorf("issues = %v, want the issue emitted before the failure", messages)
+			}
+		})
+	}
+}
+
+func TestGRPCRuleSetClient_CheckCombineErrors(t *testing.T) {
+	rules := []tflint.Rule{
+		&failingTestRule{testRule: testRule{name: "rule_a"}},
+		&failingTestRule{testRule: testRule{name: "rule_b"}},
+	}
+
+	ruleset := dispenseRuleSet(t, &tflint.BuiltinRuleSet{Name: "test", Version: "0.1.0", Rules: rules}, RuleSetPlugin{CombineErrors: true})
+
+	err := ruleset.Check(&recordingRunner{})
+	if err == nil {
+		t.Fatal("expected error from failing rules, got nil")
+	}
+	if !strings.Contains(err.Error(), "2 rules failed: rule rule_a: rule failed; rule rule_b: rule failed") {
+		t.Errorf("error = %v, want combined message", err)
+	}
+	var ruleErr *tflint.RuleError
+	if errors.As(err, &ruleErr) {
+		t.Error("combined errors should not carry structured rule errors")
+	}
+}
@@ -9,9 +9,15 @@ package plugin
 import (
 	"context"
 	"encoding/json"
+	"fmt"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclparse"
+	"github.com/zclconf/go-cty/cty"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/jokarl/tfbreak-plugin-sdk/hclext"
 	pb "github.com/jokarl/tfbreak-plugin-sdk/plugin/proto"
@@ -20,6 +26,7 @@ import (
 
 // runnerCallTimeout is the timeout for individual runner callback calls.
 // These should be fast since they're just data retrieval from the host.
+// Calls are also cancelled when the context of the enclosing Check is cancelled.
 const runnerCallTimeout = 30 * time.Second
 
 // =============================================================================
@@ -28,16 +35,43 @@ const runnerCallTimeout = 30 * time.Second
 
 // GRPCRunnerClient implements tflint.Runner by calling back to the host.
 // This runs in the plugin process and makes gRPC calls to the host's Runner server.
+//
+// The tflint.Runner methods take no context, like those of tflint's Runner,
+// so that rules and custom Runner implementations written against that
+// interface keep working. Instead, the plugin creates one client per Check
+// or CheckStream call and stores that call's context in it; every callback,
+// including those made from PreparableRule.Prepare, is derived from it and
+// cancelled with the call. The client never outlives the call it serves.
+// A client created without a context, outside of Check, derives its
+// callbacks from context.Background(), so they are only bounded by the
+// per-callback timeout.
 type GRPCRunnerClient struct {
 	client pb.RunnerClient
+	// ctx is the context of the Check call this runner serves, or nil for
+	// context.Background(). Callbacks are cancelled when the host cancels
+	// Check.
+	ctx context.Context
+	// ruleConfigs holds the JSON-encoded rule configuration received with
+	// ApplyGlobalConfig, keyed by rule name. Rules without an entry are
+	// decoded through the DecodeRuleConfig callback.
+	ruleConfigs map[string][]byte
 }
 
 // Ensure GRPCRunnerClient implements tflint.Runner.
 var _ tflint.Runner = (*GRPCRunnerClient)(nil)
 
+// context returns the context callbacks are derived from: the context of the
+// Check call, or context.Background() if the client has none.
+func (r *GRPCRunnerClient) context() context.Context {
+	if r.ctx == nil {
+		return context.Background()
+	}
+	return r.ctx
+}
+
 // GetOldModuleContent retrieves module content from the OLD (baseline) configuration.
 func (r *GRPCRunnerClient) GetOldModuleContent(schema *hclext.BodySchema, opts *tflint.GetModuleContentOption) (*hclext.BodyContent, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), runnerCallTimeout)
+	ctx, cancel := context.WithTimeout(r.context(), runnerCallTimeout)
 	defer cancel()
 
 	resp, err := r.client.GetOldModuleContent(ctx, &pb.GetModuleContent_Request{
@@ -52,7 +86,7 @@ func (r *GRPCRunnerClient) GetOldModuleContent(schema *hclext.BodySchema, opts *
 
 // GetNewModuleContent retrieves module content from the NEW configuration.
 func (r *GRPCRunnerClient) GetNewModuleContent(schema *hclext.BodySchema, opts *tflint.GetModuleContentOption) (*hclext.BodyContent, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), runnerCallTimeout)
+	ctx, cancel := context.WithTimeout(r.context(), runnerCallTimeout)
 	defer cancel()
 
 	resp, err := r.client.GetNewModuleContent(ctx, &pb.GetModuleContent_Request{
@@ -67,7 +101,7 @@ func (r *GRPCRunnerClient) GetNewModuleContent(schema *hclext.BodySchema, opts *
 
 // GetOldResourceContent retrieves resources of a specific type from the OLD configuration.
 func (r *GRPCRunnerClient) GetOldResourceContent(resourceType string, schema *hclext.BodySchema, opts *tflint.GetModuleContentOption) (*hclext.BodyContent, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), runnerCallTimeout)
+	ctx, cancel := context.WithTimeout(r.context(), runnerCallTimeout)
 	defer cancel()
 
 	resp, err := r.client.GetOldResourceContent(ctx, &pb.GetResourceContent_Request{
@@ -83,7 +117,7 @@ func (r *GRPCRunnerClient) GetOldResourceContent(resourceType string, schema *hc
 
 // GetNewResourceContent retrieves resources of a specific type from the NEW configuration.
 func (r *GRPCRunnerClient) GetNewResourceContent(resourceType string, schema *hclext.BodySchema, opts *tflint.GetModuleContentOption) (*hclext.BodyContent, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), runnerCallTimeout)
+	ctx, cancel := context.WithTimeout(r.context(), runnerCallTimeout)
 	defer cancel()
 
 	resp, err := r.client.GetNewResourceContent(ctx, &pb.GetResourceContent_Request{
@@ -97,38 +131,516 @@ func (r *GRPCRunnerClient) GetNewResourceContent(resourceType string, schema *hc
 	return fromProtoBodyContent(resp.GetContent()), nil
 }
 
+// GetResourceContentPair retrieves resources of a specific type from both
+// configurations in one callback. Hosts built before the RPC existed are
+// asked for each configuration separately.
+func (r *GRPCRunnerClient) GetResourceContentPair(resourceType string, schema *hclext.BodySchema, opts *tflint.GetModuleContentOption) (*hclext.BodyContent, *hclext.BodyContent, error) {
+	ctx, cancel := context.WithTimeout(r.context(), runnerCallTimeout)
+	defer cancel()
+
+	resp, err := r.client.GetResourceContentPair(ctx, &pb.GetResourceContentPair_Request{
+		ResourceType: resourceType,
+		Schema:       toProtoBodySchema(schema),
+		Option:       toProtoGetModuleContentOption(opts),
+	})
+	if status.Code(err) == codes.Unimplemented {
+		oldContent, err := r.GetOldResourceContent(resourceType, schema, opts)
+		if err != nil {
+			return nil, nil, err
+		}
+		newContent, err := r.GetNewResourceContent(resourceType, schema, opts)
+		if err != nil {
+			return nil, nil, err
+		}
+		return oldContent, newContent, nil
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	return fromProtoBodyContent(resp.GetOldContent()), fromProtoBodyContent(resp.GetNewContent()), nil
+}
+
+// GetOldDataSourceContent retrieves data sources of a specific type from the OLD configuration.
+func (r *GRPCRunnerClient) GetOldDataSourceContent(dataSourceType string, schema *hclext.BodySchema, opts *tflint.GetModuleContentOption) (*hclext.BodyContent, error) {
+	ctx, cancel := context.WithTimeout(r.context(), runnerCallTimeout)
+	defer cancel()
+
+	resp, err := r.client.GetOldDataSourceContent(ctx, &pb.GetResourceContent_Request{
+		ResourceType: dataSourceType,
+		Schema:       toProtoBodySchema(schema),
+		Option:       toProtoGetModuleContentOption(opts),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return fromProtoBodyContent(resp.GetContent()), nil
+}
+
+// GetNewDataSourceContent retrieves data sources of a specific type from the NEW configuration.
+func (r *GRPCRunnerClient) GetNewDataSourceContent(dataSourceType string, schema *hclext.BodySchema, opts *tflint.GetModuleContentOption) (*hclext.BodyContent, error) {
+	ctx, cancel := context.WithTimeout(r.context(), runnerCallTimeout)
+	defer cancel()
+
+	resp, err := r.client.GetNewDataSourceContent(ctx, &pb.GetResourceContent_Request{
+		ResourceType: dataSourceType,
+		Schema:       toProtoBodySchema(schema),
+		Option:       toProtoGetModuleContentOption(opts),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return fromProtoBodyContent(resp.GetContent()), nil
+}
+
+// GetOldFile retrieves the source of a file from the OLD configuration and parses it.
+func (r *GRPCRunnerClient) GetOldFile(filename string) (*hcl.File, error) {
+	ctx, cancel := context.WithTimeout(r.context(), runnerCallTimeout)
+	defer cancel()
+
+	resp, err := r.client.GetOldFile(ctx, &pb.GetFile_Request{Filename: filename})
+	if err != nil {
+		return nil, err
+	}
+	return parseFile(resp.GetBytes(), filename)
+}
+
+// GetNewFile retrieves the source of a file from the NEW configuration and parses it.
+func (r *GRPCRunnerClient) GetNewFile(filename string) (*hcl.File, error) {
+	ctx, cancel := context.WithTimeout(r.context(), runnerCallTimeout)
+	defer cancel()
+
+	resp, err := r.client.GetNewFile(ctx, &pb.GetFile_Request{Filename: filename})
+	if err != nil {
+		return nil, err
+	}
+	return parseFile(resp.GetBytes(), filename)
+}
+
+// GetFileSource retrieves the raw source of a file from the OLD or NEW configuration.
+func (r *GRPCRunnerClient) GetFileSource(filename string, side tflint.Side) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(r.context(), runnerCallTimeout)
+	defer cancel()
+
+	resp, err := r.client.GetFileSource(ctx, &pb.GetFileSource_Request{
+		Filename: filename,
+		Side:     toProtoSide(side),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.GetBytes(), nil
+}
+
+// ListOldFiles lists the files in the OLD configuration.
+// Returns nil if the host cannot be reached.
+func (r *GRPCRunnerClient) ListOldFiles() []string {
+	ctx, cancel := context.WithTimeout(r.context(), runnerCallTimeout)
+	defer cancel()
+
+	resp, err := r.client.ListOldFiles(ctx, &pb.ListFiles_Request{})
+	if err != nil {
+		return nil
+	}
+	return resp.GetFilenames()
+}
+
+// ListNewFiles lists the files in the NEW configuration.
+// Returns nil if the host cannot be reached.
+func (r *GRPCRunnerClient) ListNewFiles() []string {
+	ctx, cancel := context.WithTimeout(r.context(), runnerCallTimeout)
+	defer cancel()
+
+	resp, err := r.client.ListNewFiles(ctx, &pb.ListFiles_Request{})
+	if err != nil {
+		return nil
+	}
+	return resp.GetFilenames()
+}
+
+// FileChanges compares the files of the OLD and NEW configurations.
+// Returns nil slices if the host cannot be reached.
+func (r *GRPCRunnerClient) FileChanges() (added, removed, common []string) {
+	ctx, cancel := context.WithTimeout(r.context(), runnerCallTimeout)
+	defer cancel()
+
+	resp, err := r.client.FileChanges(ctx, &pb.FileChanges_Request{})
+	if err != nil {
+		return nil, nil, nil
+	}
+	return resp.GetAdded(), resp.GetRemoved(), resp.GetCommon()
+}
+
+// GetOldProviderRequirements retrieves the required providers of the OLD configuration.
+func (r *GRPCRunnerClient) GetOldProviderRequirements() (map[string]tflint.ProviderRequirement, error) {
+	ctx, cancel := context.WithTimeout(r.context(), runnerCallTimeout)
+	defer cancel()
+
+	resp, err := r.client.GetOldProviderRequirements(ctx, &pb.GetProviderRequirements_Request{})
+	if err != nil {
+		return nil, err
+	}
+	return fromProtoProviderRequirements(resp.GetRequirements()), nil
+}
+
+// GetNewProviderRequirements retrieves the required providers of the NEW configuration.
+func (r *GRPCRunnerClient) GetNewProviderRequirements() (map[string]tflint.ProviderRequirement, error) {
+	ctx, cancel := context.WithTimeout(r.context(), runnerCallTimeout)
+	defer cancel()
+
+	resp, err := r.client.GetNewProviderRequirements(ctx, &pb.GetProviderRequirements_Request{})
+	if err != nil {
+		return nil, err
+	}
+	return fromProtoProviderRequirements(resp.GetRequirements()), nil
+}
+
+// GetOldTerraformSettings retrieves the terraform block settings of the OLD configuration.
+func (r *GRPCRunnerClient) GetOldTerraformSettings() (*tflint.TerraformSettings, error) {
+	ctx, cancel := context.WithTimeout(r.context(), runnerCallTimeout)
+	defer cancel()
+
+	resp, err := r.client.GetOldTerraformSettings(ctx, &pb.GetTerraformSettings_Request{})
+	if err != nil {
+		return nil, err
+	}
+	return fromProtoTerraformSettings(resp.GetSettings()), nil
+}
+
+// GetNewTerraformSettings retrieves the terraform block settings of the NEW configuration.
+func (r *GRPCRunnerClient) GetNewTerraformSettings() (*tflint.TerraformSettings, error) {
+	ctx, cancel := context.WithTimeout(r.context(), runnerCallTimeout)
+	defer cancel()
+
+	resp, err := r.client.GetNewTerraformSettings(ctx, &pb.GetTerraformSettings_Request{})
+	if err != nil {
+		return nil, err
+	}
+	return fromProtoTerraformSettings(resp.GetSettings()), nil
+}
+
+// GetOldProviderConfig retrieves a provider block of the OLD configuration.
+func (r *GRPCRunnerClient) GetOldProviderConfig(name string, schema *hclext.BodySchema) (*hclext.Block, error) {
+	ctx, cancel := context.WithTimeout(r.context(), runnerCallTimeout)
+	defer cancel()
+
+	resp, err := r.client.GetOldProviderConfig(ctx, &pb.GetProviderConfig_Request{
+		Name:   name,
+		Schema: toProtoBodySchema(schema),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return fromProtoBlock(resp.GetBlock()), nil
+}
+
+// GetNewProviderConfig retrieves a provider block of the NEW configuration.
+func (r *GRPCRunnerClient) GetNewProviderConfig(name string, schema *hclext.BodySchema) (*hclext.Block, error) {
+	ctx, cancel := context.WithTimeout(r.context(), runnerCallTimeout)
+	defer cancel()
+
+	resp, err := r.client.GetNewProviderConfig(ctx, &pb.GetProviderConfig_Request{
+		Name:   name,
+		Schema: toProtoBodySchema(schema),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return fromProtoBlock(resp.GetBlock()), nil
+}
+
+// GetOldVariables retrieves the variable blocks of the OLD configuration.
+func (r *GRPCRunnerClient) GetOldVariables() ([]tflint.VariableDef, error) {
+	ctx, cancel := context.WithTimeout(r.context(), runnerCallTimeout)
+	defer cancel()
+
+	resp, err := r.client.GetOldVariables(ctx, &pb.GetVariables_Request{})
+	if err != nil {
+		return nil, err
+	}
+	return fromProtoVariableDefs(resp.GetVariables()), nil
+}
+
+// GetNewVariables retrieves the variable blocks of the NEW configuration.
+func (r *GRPCRunnerClient) GetNewVariables() ([]tflint.VariableDef, error) {
+	ctx, cancel := context.WithTimeout(r.context(), runnerCallTimeout)
+	defer cancel()
+
+	resp, err := r.client.GetNewVariables(ctx, &pb.GetVariables_Request{})
+	if err != nil {
+		return nil, err
+	}
+	return fromProtoVariableDefs(resp.GetVariables()), nil
+}
+
+// GetOldOutputs retrieves the output blocks of the OLD configuration.
+func (r *GRPCRunnerClient) GetOldOutputs() ([]tflint.OutputDef, error) {
+	ctx, cancel := context.WithTimeout(r.context(), runnerCallTimeout)
+	defer cancel()
+
+	resp, err := r.client.GetOldOutputs(ctx, &pb.GetOutputs_Request{})
+	if err != nil {
+		return nil, err
+	}
+	return fromProtoOutputDefs(resp.GetOutputs()), nil
+}
+
+// GetNewOutputs retrieves the output blocks of the NEW configuration.
+func (r *GRPCRunnerClient) GetNewOutputs() ([]tflint.OutputDef, error) {
+	ctx, cancel := context.WithTimeout(r.context(), runnerCallTimeout)
+	defer cancel()
+
+	resp, err := r.client.GetNewOutputs(ctx, &pb.GetOutputs_Request{})
+	if err != nil {
+		return nil, err
+	}
+	return fromProtoOutputDefs(resp.GetOutputs()), nil
+}
+
+// GetOldModuleCalls retrieves the module blocks of the OLD configuration.
+func (r *GRPCRunnerClient) GetOldModuleCalls() ([]tflint.ModuleCall, error) {
+	ctx, cancel := context.WithTimeout(r.context(), runnerCallTimeout)
+	defer cancel()
+
+	resp, err := r.client.GetOldModuleCalls(ctx, &pb.GetModuleCalls_Request{})
+	if err != nil {
+		return nil, err
+	}
+	return fromProtoModuleCalls(resp.GetModuleCalls()), nil
+}
+
+// GetNewModuleCalls retrieves the module blocks of the NEW configuration.
+func (r *GRPCRunnerClient) GetNewModuleCalls() ([]tflint.ModuleCall, error) {
+	ctx, cancel := context.WithTimeout(r.context(), runnerCallTimeout)
+	defer cancel()
+
+	resp, err := r.client.GetNewModuleCalls(ctx, &pb.GetModuleCalls_Request{})
+	if err != nil {
+		return nil, err
+	}
+	return fromProtoModuleCalls(resp.GetModuleCalls()), nil
+}
+
+// GetOldLocals evaluates the locals blocks of the OLD configuration.
+func (r *GRPCRunnerClient) GetOldLocals() (map[string]cty.Value, error) {
+	ctx, cancel := context.WithTimeout(r.context(), runnerCallTimeout)
+	defer cancel()
+
+	resp, err := r.client.GetOldLocals(ctx, &pb.GetLocals_Request{})
+	if err != nil {
+		return nil, err
+	}
+	return fromProtoLocals(resp.GetLocals()), nil
+}
+
+// GetNewLocals evaluates the locals blocks of the NEW configuration.
+func (r *GRPCRunnerClient) GetNewLocals() (map[string]cty.Value, error) {
+	ctx, cancel := context.WithTimeout(r.context(), runnerCallTimeout)
+	defer cancel()
+
+	resp, err := r.client.GetNewLocals(ctx, &pb.GetLocals_Request{})
+	if err != nil {
+		return nil, err
+	}
+	return fromProtoLocals(resp.GetLocals()), nil
+}
+
+// GetAllOldResources retrieves every resource block of the OLD configuration.
+func (r *GRPCRunnerClient) GetAllOldResources() ([]*hclext.Block, error) {
+	ctx, cancel := context.WithTimeout(r.context(), runnerCallTimeout)
+	defer cancel()
+
+	resp, err := r.client.GetAllOldResources(ctx, &pb.GetAllResources_Request{})
+	if err != nil {
+		return nil, err
+	}
+	return fromProtoBlocks(resp.GetResources()), nil
+}
+
+// GetAllNewResources retrieves every resource block of the NEW configuration.
+func (r *GRPCRunnerClient) GetAllNewResources() ([]*hclext.Block, error) {
+	ctx, cancel := context.WithTimeout(r.context(), runnerCallTimeout)
+	defer cancel()
+
+	resp, err := r.client.GetAllNewResources(ctx, &pb.GetAllResources_Request{})
+	if err != nil {
+		return nil, err
+	}
+	return fromProtoBlocks(resp.GetResources()), nil
+}
+
+// OldResourceAddresses lists the resource addresses of the OLD
+// configuration. Hosts built before the RPC existed are asked for the
+// resource blocks instead.
+func (r *GRPCRunnerClient) OldResourceAddresses() ([]string, error) {
+	ctx, cancel := context.WithTimeout(r.context(), runnerCallTimeout)
+	defer cancel()
+
+	resp, err := r.client.OldResourceAddresses(ctx, &pb.ResourceAddresses_Request{})
+	if status.Code(err) == codes.Unimplemented {
+		return tflint.OldResourceAddresses(r)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return resp.GetAddresses(), nil
+}
+
+// NewResourceAddresses lists the resource addresses of the NEW
+// configuration. See OldResourceAddresses.
+func (r *GRPCRunnerClient) NewResourceAddresses() ([]string, error) {
+	ctx, cancel := context.WithTimeout(r.context(), runnerCallTimeout)
+	defer cancel()
+
+	resp, err := r.client.NewResourceAddresses(ctx, &pb.ResourceAddresses_Request{})
+	if status.Code(err) == codes.Unimplemented {
+		return tflint.NewResourceAddresses(r)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return resp.GetAddresses(), nil
+}
+
+// GetMovedBlocks retrieves the moved blocks declared in the NEW configuration.
+// Returns nil if the host cannot be reached.
+func (r *GRPCRunnerClient) GetMovedBlocks() []tflint.MovedBlock {
+	ctx, cancel := context.WithTimeout(r.context(), runnerCallTimeout)
+	defer cancel()
+
+	resp, err := r.client.GetMovedBlocks(ctx, &pb.GetMovedBlocks_Request{})
+	if err != nil {
+		return nil
+	}
+	return fromProtoMovedBlocks(resp.GetMovedBlocks())
+}
+
+// parseFile parses raw file source received from the host.
+// Files ending in ".json" are parsed as HCL JSON, all others as native HCL.
+func parseFile(src []byte, filename string) (*hcl.File, error) {
+	parser := hclparse.NewParser()
+	var file *hcl.File
+	var diags hcl.Diagnostics
+	if strings.HasSuffix(filename, ".json") {
+		file, diags = parser.ParseJSON(src, filename)
+	} else {
+		file, diags = parser.ParseHCL(src, filename)
+	}
+	if diags.HasErrors() {
+		return nil, diags
+	}
+	return file, nil
+}
+
+// GetModuleDiff retrieves module content from both configurations and pairs blocks by address.
+// This is composed from the existing GetOldModuleContent and GetNewModuleContent calls,
+// so no additional RPC is required.
+func (r *GRPCRunnerClient) GetModuleDiff(schema *hclext.BodySchema, opts *tflint.GetModuleContentOption) (*tflint.ModuleDiff, error) {
+	return tflint.GetModuleDiff(r, schema, opts)
+}
+
+// WalkOldResources calls fn for every resource block in the OLD configuration.
+// This is composed from GetOldModuleContent, so no additional RPC is required.
+func (r *GRPCRunnerClient) WalkOldResources(schema *hclext.BodySchema, fn tflint.WalkResourcesFunc) error {
+	return tflint.WalkOldResources(r, schema, fn)
+}
+
+// WalkNewResources calls fn for every resource block in the NEW configuration.
+// This is composed from GetNewModuleContent, so no additional RPC is required.
+func (r *GRPCRunnerClient) WalkNewResources(schema *hclext.BodySchema, fn tflint.WalkResourcesFunc) error {
+	return tflint.WalkNewResources(r, schema, fn)
+}
+
 // EmitIssue reports a finding from the rule.
 func (r *GRPCRunnerClient) EmitIssue(rule tflint.Rule, message string, issueRange hcl.Range) error {
-	ctx, cancel := context.WithTimeout(context.Background(), runnerCallTimeout)
-	defer cancel()
+	return r.emitIssue(&pb.EmitIssue_Request{
+		Rule:    toProtoRule(rule),
+		Message: message,
+		Range:   toProtoRange(issueRange),
+	})
+}
 
-	_, err := r.client.EmitIssue(ctx, &pb.EmitIssue_Request{
+// EmitIssueWithFix reports a finding along with a suggested fix.
+func (r *GRPCRunnerClient) EmitIssueWithFix(rule tflint.Rule, message string, issueRange hcl.Range, fix *tflint.Fix) error {
+	return r.emitIssue(&pb.EmitIssue_Request{
 		Rule:    toProtoRule(rule),
 		Message: message,
 		Range:   toProtoRange(issueRange),
+		Fix:     toProtoFix(fix),
+	})
+}
+
+// EmitIssueWithSeverity reports a finding with a per-issue severity.
+func (r *GRPCRunnerClient) EmitIssueWithSeverity(rule tflint.Rule, severity tflint.Severity, message string, issueRange hcl.Range) error {
+	return r.emitIssue(&pb.EmitIssue_Request{
+		Rule:     toProtoRule(rule),
+		Message:  message,
+		Range:    toProtoRange(issueRange),
+		Severity: toProtoSeverity(severity),
 	})
+}
+
+// EmitIssueWithOptions reports a finding with the attributes in opts.
+func (r *GRPCRunnerClient) EmitIssueWithOptions(rule tflint.Rule, message string, issueRange hcl.Range, opts tflint.IssueOptions) error {
+	return r.emitIssue(&pb.EmitIssue_Request{
+		Rule:            toProtoRule(rule),
+		Message:         message,
+		Range:           toProtoRange(issueRange),
+		Fix:             toProtoFix(opts.Fix),
+		Severity:        toProtoSeverity(opts.Severity),
+		Kind:            toProtoIssueKind(opts.Kind),
+		ResourceAddress: opts.ResourceAddress,
+		Side:            toProtoSide(opts.Side),
+	})
+}
+
+// emitIssue sends an issue to the host. Issues without a rule are rejected,
+// since the host cannot attribute or suppress them.
+func (r *GRPCRunnerClient) emitIssue(req *pb.EmitIssue_Request) error {
+	if req.GetRule() == nil {
+		return nilRuleError(req.GetMessage())
+	}
+
+	ctx, cancel := context.WithTimeout(r.context(), runnerCallTimeout)
+	defer cancel()
+
+	_, err := r.client.EmitIssue(ctx, req)
 	return err
 }
 
 // DecodeRuleConfig retrieves and decodes the rule's configuration.
 func (r *GRPCRunnerClient) DecodeRuleConfig(ruleName string, target any) error {
-	ctx, cancel := context.WithTimeout(context.Background(), runnerCallTimeout)
+	_, err := r.DecodeRuleConfigExists(ruleName, target)
+	return err
+}
+
+// DecodeRuleConfigExists retrieves and decodes the rule's configuration,
+// reporting whether the host had configuration for the rule. Configuration
+// received with ApplyGlobalConfig is decoded without calling the host.
+func (r *GRPCRunnerClient) DecodeRuleConfigExists(ruleName string, target any) (bool, error) {
+	if configBytes, ok := r.ruleConfigs[ruleName]; ok {
+		return true, json.Unmarshal(configBytes, target)
+	}
+
+	ctx, cancel := context.WithTimeout(r.context(), runnerCallTimeout)
 	defer cancel()
 
 	resp, err := r.client.DecodeRuleConfig(ctx, &pb.DecodeRuleConfig_Request{
 		RuleName: ruleName,
 	})
 	if err != nil {
-		return err
+		return false, err
 	}
 
-	// If no config was provided, return nil
+	// If no config was provided, leave target untouched
 	if !resp.GetHasConfig() || len(resp.GetConfigBytes()) == 0 {
-		return nil
+		return resp.GetHasConfig(), nil
 	}
 
 	// Decode the JSON-encoded config into the target
-	return json.Unmarshal(resp.GetConfigBytes(), target)
+	if err := json.Unmarshal(resp.GetConfigBytes(), target); err != nil {
+		return true, err
+	}
+	return true, nil
 }
 
 // =============================================================================
@@ -200,23 +712,325 @@ func (s *GRPCRunnerServer) GetNewResourceContent(ctx context.Context, req *pb.Ge
 	}, nil
 }
 
-// EmitIssue handles the gRPC call to emit an issue.
-func (s *GRPCRunnerServer) EmitIssue(ctx context.Context, req *pb.EmitIssue_Request) (*pb.EmitIssue_Response, error) {
-	// Create a minimal rule implementation for the callback
-	rule := &protoRule{
-		name:     req.GetRule().GetName(),
-		enabled:  req.GetRule().GetEnabled(),
-		severity: fromProtoSeverity(req.GetRule().GetSeverity()),
-		link:     req.GetRule().GetLink(),
+// GetResourceContentPair handles the gRPC call for old and new resource content.
+func (s *GRPCRunnerServer) GetResourceContentPair(ctx context.Context, req *pb.GetResourceContentPair_Request) (*pb.GetResourceContentPair_Response, error) {
+	oldContent, newContent, err := s.impl.GetResourceContentPair(
+		req.GetResourceType(),
+		fromProtoBodySchema(req.GetSchema()),
+		fromProtoGetModuleContentOption(req.GetOption()),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GetResourceContentPair_Response{
+		OldContent: toProtoBodyContent(oldContent),
+		NewContent: toProtoBodyContent(newContent),
+	}, nil
+}
+
+// GetOldDataSourceContent handles the gRPC call for old data source content.
+func (s *GRPCRunnerServer) GetOldDataSourceContent(ctx context.Context, req *pb.GetResourceContent_Request) (*pb.GetResourceContent_Response, error) {
+	content, err := s.impl.GetOldDataSourceContent(
+		req.GetResourceType(),
+		fromProtoBodySchema(req.GetSchema()),
+		fromProtoGetModuleContentOption(req.GetOption()),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GetResourceContent_Response{
+		Content: toProtoBodyContent(content),
+	}, nil
+}
+
+// GetNewDataSourceContent handles the gRPC call for new data source content.
+func (s *GRPCRunnerServer) GetNewDataSourceContent(ctx context.Context, req *pb.GetResourceContent_Request) (*pb.GetResourceContent_Response, error) {
+	content, err := s.impl.GetNewDataSourceContent(
+		req.GetResourceType(),
+		fromProtoBodySchema(req.GetSchema()),
+		fromProtoGetModuleContentOption(req.GetOption()),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GetResourceContent_Response{
+		Content: toProtoBodyContent(content),
+	}, nil
+}
+
+// GetOldFile handles the gRPC call for an old file.
+func (s *GRPCRunnerServer) GetOldFile(ctx context.Context, req *pb.GetFile_Request) (*pb.GetFile_Response, error) {
+	file, err := s.impl.GetOldFile(req.GetFilename())
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GetFile_Response{Bytes: file.Bytes}, nil
+}
+
+// GetNewFile handles the gRPC call for a new file.
+func (s *GRPCRunnerServer) GetNewFile(ctx context.Context, req *pb.GetFile_Request) (*pb.GetFile_Response, error) {
+	file, err := s.impl.GetNewFile(req.GetFilename())
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GetFile_Response{Bytes: file.Bytes}, nil
+}
+
+// GetFileSource handles the gRPC call for a file's source.
+func (s *GRPCRunnerServer) GetFileSource(ctx context.Context, req *pb.GetFileSource_Request) (*pb.GetFileSource_Response, error) {
+	side, err := fromProtoSide(req.GetSide())
+	if err != nil {
+		return nil, err
+	}
+	src, err := s.impl.GetFileSource(req.GetFilename(), side)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GetFileSource_Response{Bytes: src}, nil
+}
+
+// ListOldFiles handles the gRPC call to list old files.
+func (s *GRPCRunnerServer) ListOldFiles(ctx context.Context, req *pb.ListFiles_Request) (*pb.ListFiles_Response, error) {
+	return &pb.ListFiles_Response{Filenames: s.impl.ListOldFiles()}, nil
+}
+
+// ListNewFiles handles the gRPC call to list new files.
+func (s *GRPCRunnerServer) ListNewFiles(ctx context.Context, req *pb.ListFiles_Request) (*pb.ListFiles_Response, error) {
+	return &pb.ListFiles_Response{Filenames: s.impl.ListNewFiles()}, nil
+}
+
+// FileChanges handles the gRPC call to compare files.
+func (s *GRPCRunnerServer) FileChanges(ctx context.Context, req *pb.FileChanges_Request) (*pb.FileChanges_Response, error) {
+	added, removed, common := s.impl.FileChanges()
+	return &pb.FileChanges_Response{Added: added, Removed: removed, Common: common}, nil
+}
+
+// GetOldProviderRequirements handles the gRPC call for old provider requirements.
+func (s *GRPCRunnerServer) GetOldProviderRequirements(ctx context.Context, req *pb.GetProviderRequirements_Request) (*pb.GetProviderRequirements_Response, error) {
+	reqs, err := s.impl.GetOldProviderRequirements()
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GetProviderRequirements_Response{Requirements: toProtoProviderRequirements(reqs)}, nil
+}
+
+// GetNewProviderRequirements handles the gRPC call for new provider requirements.
+func (s *GRPCRunnerServer) GetNewProviderRequirements(ctx context.Context, req *pb.GetProviderRequirements_Request) (*pb.GetProviderRequirements_Response, error) {
+	reqs, err := s.impl.GetNewProviderRequirements()
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GetProviderRequirements_Response{Requirements: toProtoProviderRequirements(reqs)}, nil
+}
+
+// GetOldTerraformSettings handles the gRPC call for old terraform block settings.
+func (s *GRPCRunnerServer) GetOldTerraformSettings(ctx context.Context, req *pb.GetTerraformSettings_Request) (*pb.GetTerraformSettings_Response, error) {
+	settings, err := s.impl.GetOldTerraformSettings()
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GetTerraformSettings_Response{Settings: toProtoTerraformSettings(settings)}, nil
+}
+
+// GetNewTerraformSettings handles the gRPC call for new terraform block settings.
+func (s *GRPCRunnerServer) GetNewTerraformSettings(ctx context.Context, req *pb.GetTerraformSettings_Request) (*pb.GetTerraformSettings_Response, error) {
+	settings, err := s.impl.GetNewTerraformSettings()
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GetTerraformSettings_Response{Settings: toProtoTerraformSettings(settings)}, nil
+}
+
+// GetOldProviderConfig handles the gRPC call for an old provider block.
+func (s *GRPCRunnerServer) GetOldProviderConfig(ctx context.Context, req *pb.GetProviderConfig_Request) (*pb.GetProviderConfig_Response, error) {
+	block, err := s.impl.GetOldProviderConfig(req.GetName(), fromProtoBodySchema(req.GetSchema()))
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GetProviderConfig_Response{Block: toProtoBlock(block)}, nil
+}
+
+// GetNewProviderConfig handles the gRPC call for a new provider block.
+func (s *GRPCRunnerServer) GetNewProviderConfig(ctx context.Context, req *pb.GetProviderConfig_Request) (*pb.GetProviderConfig_Response, error) {
+	block, err := s.impl.GetNewProviderConfig(req.GetName(), fromProtoBodySchema(req.GetSchema()))
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GetProviderConfig_Response{Block: toProtoBlock(block)}, nil
+}
+
+// GetOldVariables handles the gRPC call for old variables.
+func (s *GRPCRunnerServer) GetOldVariables(ctx context.Context, req *pb.GetVariables_Request) (*pb.GetVariables_Response, error) {
+	vars, err := s.impl.GetOldVariables()
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GetVariables_Response{Variables: toProtoVariableDefs(vars)}, nil
+}
+
+// GetNewVariables handles the gRPC call for new variables.
+func (s *GRPCRunnerServer) GetNewVariables(ctx context.Context, req *pb.GetVariables_Request) (*pb.GetVariables_Response, error) {
+	vars, err := s.impl.GetNewVariables()
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GetVariables_Response{Variables: toProtoVariableDefs(vars)}, nil
+}
+
+// GetOldOutputs handles the gRPC call for old outputs.
+func (s *GRPCRunnerServer) GetOldOutputs(ctx context.Context, req *pb.GetOutputs_Request) (*pb.GetOutputs_Response, error) {
+	outputs, err := s.impl.GetOldOutputs()
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GetOutputs_Response{Outputs: toProtoOutputDefs(outputs)}, nil
+}
+
+// GetNewOutputs handles the gRPC call for new outputs.
+func (s *GRPCRunnerServer) GetNewOutputs(ctx context.Context, req *pb.GetOutputs_Request) (*pb.GetOutputs_Response, error) {
+	outputs, err := s.impl.GetNewOutputs()
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GetOutputs_Response{Outputs: toProtoOutputDefs(outputs)}, nil
+}
+
+// GetOldModuleCalls handles the gRPC call for old module calls.
+func (s *GRPCRunnerServer) GetOldModuleCalls(ctx context.Context, req *pb.GetModuleCalls_Request) (*pb.GetModuleCalls_Response, error) {
+	calls, err := s.impl.GetOldModuleCalls()
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GetModuleCalls_Response{ModuleCalls: toProtoModuleCalls(calls)}, nil
+}
+
+// GetNewModuleCalls handles the gRPC call for new module calls.
+func (s *GRPCRunnerServer) GetNewModuleCalls(ctx context.Context, req *pb.GetModuleCalls_Request) (*pb.GetModuleCalls_Response, error) {
+	calls, err := s.impl.GetNewModuleCalls()
+	if err != nil {
+		return nil, err
 	}
+	return &pb.GetModuleCalls_Response{ModuleCalls: toProtoModuleCalls(calls)}, nil
+}
+
+// GetOldLocals handles the gRPC call for old local values.
+func (s *GRPCRunnerServer) GetOldLocals(ctx context.Context, req *pb.GetLocals_Request) (*pb.GetLocals_Response, error) {
+	locals, err := s.impl.GetOldLocals()
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GetLocals_Response{Locals: toProtoLocals(locals)}, nil
+}
+
+// GetNewLocals handles the gRPC call for new local values.
+func (s *GRPCRunnerServer) GetNewLocals(ctx context.Context, req *pb.GetLocals_Request) (*pb.GetLocals_Response, error) {
+	locals, err := s.impl.GetNewLocals()
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GetLocals_Response{Locals: toProtoLocals(locals)}, nil
+}
 
-	err := s.impl.EmitIssue(rule, req.GetMessage(), fromProtoRange(req.GetRange()))
+// GetAllOldResources handles the gRPC call for all old resources.
+func (s *GRPCRunnerServer) GetAllOldResources(ctx context.Context, req *pb.GetAllResources_Request) (*pb.GetAllResources_Response, error) {
+	resources, err := s.impl.GetAllOldResources()
 	if err != nil {
 		return nil, err
 	}
+	return &pb.GetAllResources_Response{Resources: toProtoBlocks(resources)}, nil
+}
+
+// GetAllNewResources handles the gRPC call for all new resources.
+func (s *GRPCRunnerServer) GetAllNewResources(ctx context.Context, req *pb.GetAllResources_Request) (*pb.GetAllResources_Response, error) {
+	resources, err := s.impl.GetAllNewResources()
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GetAllResources_Response{Resources: toProtoBlocks(resources)}, nil
+}
+
+// OldResourceAddresses handles the gRPC call for old resource addresses.
+func (s *GRPCRunnerServer) OldResourceAddresses(ctx context.Context, req *pb.ResourceAddresses_Request) (*pb.ResourceAddresses_Response, error) {
+	addresses, err := s.impl.OldResourceAddresses()
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ResourceAddresses_Response{Addresses: addresses}, nil
+}
+
+// NewResourceAddresses handles the gRPC call for new resource addresses.
+func (s *GRPCRunnerServer) NewResourceAddresses(ctx context.Context, req *pb.ResourceAddresses_Request) (*pb.ResourceAddresses_Response, error) {
+	addresses, err := s.impl.NewResourceAddresses()
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ResourceAddresses_Response{Addresses: addresses}, nil
+}
+
+// GetMovedBlocks handles the gRPC call for moved blocks.
+func (s *GRPCRunnerServer) GetMovedBlocks(ctx context.Context, req *pb.GetMovedBlocks_Request) (*pb.GetMovedBlocks_Response, error) {
+	return &pb.GetMovedBlocks_Response{MovedBlocks: toProtoMovedBlocks(s.impl.GetMovedBlocks())}, nil
+}
+
+// EmitIssue handles the gRPC call to emit an issue.
+func (s *GRPCRunnerServer) EmitIssue(ctx context.Context, req *pb.EmitIssue_Request) (*pb.EmitIssue_Response, error) {
+	issue := &pb.Issue{
+		Rule:            req.GetRule(),
+		Message:         req.GetMessage(),
+		Range:           req.GetRange(),
+		Fix:             req.GetFix(),
+		Severity:        req.GetSeverity(),
+		Kind:            req.GetKind(),
+		ResourceAddress: req.GetResourceAddress(),
+		Side:            req.GetSide(),
+	}
+	if err := emitProtoIssue(s.impl, issue); err != nil {
+		return nil, err
+	}
 	return &pb.EmitIssue_Response{}, nil
 }
 
+// emitProtoIssue reports an issue received from the plugin to the runner.
+// It is shared by the EmitIssue callback and buffered issues returned from Check.
+func emitProtoIssue(runner tflint.Runner, issue *pb.Issue) error {
+	// Create a minimal rule implementation for the callback
+	r := fromProtoRule(issue.GetRule())
+
+	rng := fromProtoRange(issue.GetRange())
+	side := fromProtoIssueSide(issue.GetSide())
+	if isIgnoredIssue(runner, r.name, side, rng) {
+		return nil
+	}
+
+	opts := fromProtoIssueOptions(issue)
+	if opts == (tflint.IssueOptions{}) {
+		return runner.EmitIssue(r, issue.GetMessage(), rng)
+	}
+	return runner.EmitIssueWithOptions(r, issue.GetMessage(), rng, opts)
+}
+
+// nilRuleError returns the error for an issue emitted with a nil rule.
+func nilRuleError(message string) error {
+	return fmt.Errorf("%w: %q", tflint.ErrNilRule, message)
+}
+
+// isIgnoredIssue reports whether the issue is suppressed by a tfbreak:ignore
+// directive in the file the range points to, in the configuration of side.
+func isIgnoredIssue(runner tflint.Runner, ruleName string, side tflint.Side, issueRange hcl.Range) bool {
+	if issueRange.Filename == "" {
+		return false
+	}
+	getFile := runner.GetNewFile
+	if side == tflint.SideOld {
+		getFile = runner.GetOldFile
+	}
+	file, err := getFile(issueRange.Filename)
+	if err != nil || file == nil {
+		return false
+	}
+	return tflint.IsIgnored(file.Bytes, ruleName, issueRange)
+}
+
 // DecodeRuleConfig handles the gRPC call to decode rule configuration.
 func (s *GRPCRunnerServer) DecodeRuleConfig(ctx context.Context, req *pb.DecodeRuleConfig_Request) (*pb.DecodeRuleConfig_Response, error) {
 	// Create a temporary target to capture the config
@@ -258,4 +1072,14 @@ func (r *protoRule) Name() string          { return r.name }
 func (r *protoRule) Enabled() bool         { return r.enabled }
 func (r *protoRule) Severity() tflint.Severity { return r.severity }
 func (r *protoRule) Link() string          { return r.link }
-func (r *protoRule) Check(tflint.Runner) error { return nil }
+func (r *protoRule) Check(context.Context, tflint.Runner) error { return nil }
+
+// fromProtoRule converts a proto Rule into a minimal Rule implementation.
+func fromProtoRule(rule *pb.Rule) *protoRule {
+	return &protoRule{
+		name:     rule.GetName(),
+		enabled:  rule.GetEnabled(),
+		severity: fromProtoSeverity(rule.GetSeverity()),
+		link:     rule.GetLink(),
+	}
+}
@@ -1,10 +1,16 @@
 package plugin
 
 import (
+	"context"
 	"fmt"
+	"reflect"
 	"testing"
 
 	"github.com/hashicorp/hcl/v2"
+	"github.com/zclconf/go-cty/cty"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/jokarl/tfbreak-plugin-sdk/hclext"
 	pb "github.com/jokarl/tfbreak-plugin-sdk/plugin/proto"
@@ -37,7 +43,7 @@ func TestProtoRuleImplementsRule(t *testing.T) {
 	if rule.Link() != "https://example.com" {
 		t.Errorf("Link() = %q, want %q", rule.Link(), "https://example.com")
 	}
-	if err := rule.Check(nil); err != nil {
+	if err := rule.Check(context.Background(), nil); err != nil {
 		t.Errorf("Check() returned error: %v", err)
 	}
 }
@@ -88,12 +94,40 @@ func TestGRPCRunnerServerEmitIssue(t *testing.T) {
 
 // recordingRunner records calls for testing
 type recordingRunner struct {
-	onGetOldModuleContent   func(*hclext.BodySchema, *tflint.GetModuleContentOption) (*hclext.BodyContent, error)
-	onGetNewModuleContent   func(*hclext.BodySchema, *tflint.GetModuleContentOption) (*hclext.BodyContent, error)
-	onGetOldResourceContent func(string, *hclext.BodySchema, *tflint.GetModuleContentOption) (*hclext.BodyContent, error)
-	onGetNewResourceContent func(string, *hclext.BodySchema, *tflint.GetModuleContentOption) (*hclext.BodyContent, error)
-	onEmitIssue             func(tflint.Rule, string, hcl.Range) error
-	onDecodeRuleConfig      func(string, any) error
+	onGetOldModuleContent        func(*hclext.BodySchema, *tflint.GetModuleContentOption) (*hclext.BodyContent, error)
+	onGetNewModuleContent        func(*hclext.BodySchema, *tflint.GetModuleContentOption) (*hclext.BodyContent, error)
+	onGetOldResourceContent      func(string, *hclext.BodySchema, *tflint.GetModuleContentOption) (*hclext.BodyContent, error)
+	onGetNewResourceContent      func(string, *hclext.BodySchema, *tflint.GetModuleContentOption) (*hclext.BodyContent, error)
+	onGetResourceContentPair     func(string, *hclext.BodySchema, *tflint.GetModuleContentOption) (*hclext.BodyContent, *hclext.BodyContent, error)
+	onGetOldDataSourceContent    func(string, *hclext.BodySchema, *tflint.GetModuleContentOption) (*hclext.BodyContent, error)
+	onGetNewDataSourceContent    func(string, *hclext.BodySchema, *tflint.GetModuleContentOption) (*hclext.BodyContent, error)
+	onGetOldFile                 func(string) (*hcl.File, error)
+	onGetNewFile                 func(string) (*hcl.File, error)
+	onGetFileSource              func(string, tflint.Side) ([]byte, error)
+	onListOldFiles               func() []string
+	onListNewFiles               func() []string
+	onGetOldProviderRequirements func() (map[string]tflint.ProviderRequirement, error)
+	onGetNewProviderRequirements func() (map[string]tflint.ProviderRequirement, error)
+	onGetOldTerraformSettings    func() (*tflint.TerraformSettings, error)
+	onGetNewTerraformSettings    func() (*tflint.TerraformSettings, error)
+	onGetOldProviderConfig       func(string, *hclext.BodySchema) (*hclext.Block, error)
+	onGetNewProviderConfig       func(string, *hclext.BodySchema) (*hclext.Block, error)
+	onGetOldVariables            func() ([]tflint.VariableDef, error)
+	onGetNewVariables            func() ([]tflint.VariableDef, error)
+	onGetOldOutputs              func() ([]tflint.OutputDef, error)
+	onGetNewOutputs              func() ([]tflint.OutputDef, error)
+	onGetOldModuleCalls          func() ([]tflint.ModuleCall, error)
+	onGetNewModuleCalls          func() ([]tflint.ModuleCall, error)
+	onGetOldLocals               func() (map[string]cty.Value, error)
+	onGetNewLocals               func() (map[string]cty.Value, error)
+	onGetAllOldResources         func() ([]*hclext.Block, error)
+	onGetAllNewResources         func() ([]*hclext.Block, error)
+	onOldResourceAddresses       func() ([]string, error)
+	onNewResourceAddresses       func() ([]string, error)
+	onGetMovedBlocks             func() []tflint.MovedBlock
+	onEmitIssue                  func(tflint.Rule, string, hcl.Range) error
+	onEmitIssueWithOptions       func(tflint.Rule, string, hcl.Range, tflint.IssueOptions) error
+	onDecodeRuleConfig           func(string, any) error
 }
 
 func (r *recordingRunner) GetOldModuleContent(schema *hclext.BodySchema, opts *tflint.GetModuleContentOption) (*hclext.BodyContent, error) {
@@ -124,6 +158,219 @@ func (r *recordingRunner) GetNewResourceContent(resourceType string, schema *hcl
 	return &hclext.BodyContent{Attributes: map[string]*hclext.Attribute{}, Blocks: []*hclext.Block{}}, nil
 }
 
+func (r *recordingRunner) GetResourceContentPair(resourceType string, schema *hclext.BodySchema, opts *tflint.GetModuleContentOption) (*hclext.BodyContent, *hclext.BodyContent, error) {
+	if r.onGetResourceContentPair != nil {
+		return r.onGetResourceContentPair(resourceType, schema, opts)
+	}
+	oldContent, err := r.GetOldResourceContent(resourceType, schema, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+	newContent, err := r.GetNewResourceContent(resourceType, schema, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+	return oldContent, newContent, nil
+}
+
+func (r *recordingRunner) GetOldDataSourceContent(dataSourceType string, schema *hclext.BodySchema, opts *tflint.GetModuleContentOption) (*hclext.BodyContent, error) {
+	if r.onGetOldDataSourceContent != nil {
+		return r.onGetOldDataSourceContent(dataSourceType, schema, opts)
+	}
+	return &hclext.BodyContent{Attributes: map[string]*hclext.Attribute{}, Blocks: []*hclext.Block{}}, nil
+}
+
+func (r *recordingRunner) GetNewDataSourceContent(dataSourceType string, schema *hclext.BodySchema, opts *tflint.GetModuleContentOption) (*hclext.BodyContent, error) {
+	if r.onGetNewDataSourceContent != nil {
+		return r.onGetNewDataSourceContent(dataSourceType, schema, opts)
+	}
+	return &hclext.BodyContent{Attributes: map[string]*hclext.Attribute{}, Blocks: []*hclext.Block{}}, nil
+}
+
+func (r *recordingRunner) GetOldFile(filename string) (*hcl.File, error) {
+	if r.onGetOldFile != nil {
+		return r.onGetOldFile(filename)
+	}
+	return nil, fmt.Errorf("file not found: %s", filename)
+}
+
+func (r *recordingRunner) GetNewFile(filename string) (*hcl.File, error) {
+	if r.onGetNewFile != nil {
+		return r.onGetNewFile(filename)
+	}
+	return nil, fmt.Errorf("file not found: %s", filename)
+}
+
+func (r *recordingRunner) GetFileSource(filename string, side tflint.Side) ([]byte, error) {
+	if r.onGetFileSource != nil {
+		return r.onGetFileSource(filename, side)
+	}
+	return nil, fmt.Errorf("file not found: %s", filename)
+}
+
+func (r *recordingRunner) ListOldFiles() []string {
+	if r.onListOldFiles != nil {
+		return r.onListOldFiles()
+	}
+	return nil
+}
+
+func (r *recordingRunner) ListNewFiles() []string {
+	if r.onListNewFiles != nil {
+		return r.onListNewFiles()
+	}
+	return nil
+}
+
+func (r *recordingRunner) FileChanges() (added, removed, common []string) {
+	return tflint.FileChanges(r)
+}
+
+func (r *recordingRunner) GetOldProviderRequirements() (map[string]tflint.ProviderRequirement, error) {
+	if r.onGetOldProviderRequirements != nil {
+		return r.onGetOldProviderRequirements()
+	}
+	return map[string]tflint.ProviderRequirement{}, nil
+}
+
+func (r *recordingRunner) GetNewProviderRequirements() (map[string]tflint.ProviderRequirement, error) {
+	if r.onGetNewProviderRequirements != nil {
+		return r.onGetNewProviderRequirements()
+	}
+	return map[string]tflint.ProviderRequirement{}, nil
+}
+
+func (r *recordingRunner) GetOldTerraformSettings() (*tflint.TerraformSettings, error) {
+	if r.onGetOldTerraformSettings != nil {
+		return r.onGetOldTerraformSettings()
+	}
+	return &tflint.TerraformSettings{}, nil
+}
+
+func (r *recordingRunner) GetNewTerraformSettings() (*tflint.TerraformSettings, error) {
+	if r.onGetNewTerraformSettings != nil {
+		return r.onGetNewTerraformSettings()
+	}
+	return &tflint.TerraformSettings{}, nil
+}
+
+func (r *recordingRunner) GetOldProviderConfig(name string, schema *hclext.BodySchema) (*hclext.Block, error) {
+	if r.onGetOldProviderConfig != nil {
+		return r.onGetOldProviderConfig(name, schema)
+	}
+	return nil, nil
+}
+
+func (r *recordingRunner) GetNewProviderConfig(name string, schema *hclext.BodySchema) (*hclext.Block, error) {
+	if r.onGetNewProviderConfig != nil {
+		return r.onGetNewProviderConfig(name, schema)
+	}
+	return nil, nil
+}
+
+func (r *recordingRunner) GetOldVariables() ([]tflint.VariableDef, error) {
+	if r.onGetOldVariables != nil {
+		return r.onGetOldVariables()
+	}
+	return nil, nil
+}
+
+func (r *recordingRunner) GetNewVariables() ([]tflint.VariableDef, error) {
+	if r.onGetNewVariables != nil {
+		return r.onGetNewVariables()
+	}
+	return nil, nil
+}
+
+func (r *recordingRunner) GetOldOutputs() ([]tflint.OutputDef, error) {
+	if r.onGetOldOutputs != nil {
+		return r.onGetOldOutputs()
+	}
+	return nil, nil
+}
+
+func (r *recordingRunner) GetNewOutputs() ([]tflint.OutputDef, error) {
+	if r.onGetNewOutputs != nil {
+		return r.onGetNewOutputs()
+	}
+	return nil, nil
+}
+
+func (r *recordingRunner) GetOldModuleCalls() ([]tflint.ModuleCall, error) {
+	if r.onGetOldModuleCalls != nil {
+		return r.onGetOldModuleCalls()
+	}
+	return nil, nil
+}
+
+func (r *recordingRunner) GetNewModuleCalls() ([]tflint.ModuleCall, error) {
+	if r.onGetNewModuleCalls != nil {
+		return r.onGetNewModuleCalls()
+	}
+	return nil, nil
+}
+
+func (r *recordingRunner) GetOldLocals() (map[string]cty.Value, error) {
+	if r.onGetOldLocals != nil {
+		return r.onGetOldLocals()
+	}
+	return nil, nil
+}
+
+func (r *recordingRunner) GetNewLocals() (map[string]cty.Value, error) {
+	if r.onGetNewLocals != nil {
+		return r.onGetNewLocals()
+	}
+	return nil, nil
+}
+
+func (r *recordingRunner) GetAllOldResources() ([]*hclext.Block, error) {
+	if r.onGetAllOldResources != nil {
+		return r.onGetAllOldResources()
+	}
+	return nil, nil
+}
+
+func (r *recordingRunner) GetAllNewResources() ([]*hclext.Block, error) {
+	if r.onGetAllNewResources != nil {
+		return r.onGetAllNewResources()
+	}
+	return nil, nil
+}
+
+func (r *recordingRunner) OldResourceAddresses() ([]string, error) {
+	if r.onOldResourceAddresses != nil {
+		return r.onOldResourceAddresses()
+	}
+	return nil, nil
+}
+
+func (r *recordingRunner) NewResourceAddresses() ([]string, error) {
+	if r.onNewResourceAddresses != nil {
+		return r.onNewResourceAddresses()
+	}
+	return nil, nil
+}
+
+func (r *recordingRunner) GetMovedBlocks() []tflint.MovedBlock {
+	if r.onGetMovedBlocks != nil {
+		return r.onGetMovedBlocks()
+	}
+	return nil
+}
+
+func (r *recordingRunner) GetModuleDiff(schema *hclext.BodySchema, opts *tflint.GetModuleContentOption) (*tflint.ModuleDiff, error) {
+	return tflint.GetModuleDiff(r, schema, opts)
+}
+
+func (r *recordingRunner) WalkOldResources(schema *hclext.BodySchema, fn tflint.WalkResourcesFunc) error {
+	return tflint.WalkOldResources(r, schema, fn)
+}
+
+func (r *recordingRunner) WalkNewResources(schema *hclext.BodySchema, fn tflint.WalkResourcesFunc) error {
+	return tflint.WalkNewResources(r, schema, fn)
+}
+
 func (r *recordingRunner) EmitIssue(rule tflint.Rule, message string, issueRange hcl.Range) error {
 	if r.onEmitIssue != nil {
 		return r.onEmitIssue(rule, message, issueRange)
@@ -131,6 +378,21 @@ func (r *recordingRunner) EmitIssue(rule tflint.Rule, message string, issueRange
 	return nil
 }
 
+func (r *recordingRunner) EmitIssueWithFix(rule tflint.Rule, message string, issueRange hcl.Range, fix *tflint.Fix) error {
+	return r.EmitIssueWithOptions(rule, message, issueRange, tflint.IssueOptions{Fix: fix})
+}
+
+func (r *recordingRunner) EmitIssueWithSeverity(rule tflint.Rule, severity tflint.Severity, message string, issueRange hcl.Range) error {
+	return r.EmitIssueWithOptions(rule, message, issueRange, tflint.IssueOptions{Severity: severity})
+}
+
+func (r *recordingRunner) EmitIssueWithOptions(rule tflint.Rule, message string, issueRange hcl.Range, opts tflint.IssueOptions) error {
+	if r.onEmitIssueWithOptions != nil {
+		return r.onEmitIssueWithOptions(rule, message, issueRange, opts)
+	}
+	return nil
+}
+
 func (r *recordingRunner) DecodeRuleConfig(ruleName string, target any) error {
 	if r.onDecodeRuleConfig != nil {
 		return r.onDecodeRuleConfig(ruleName, target)
@@ -138,6 +400,10 @@ func (r *recordingRunner) DecodeRuleConfig(ruleName string, target any) error {
 	return nil
 }
 
+func (r *recordingRunner) DecodeRuleConfigExists(ruleName string, target any) (bool, error) {
+	return false, r.DecodeRuleConfig(ruleName, target)
+}
+
 // =============================================================================
 // GRPCRunnerServer method tests
 // =============================================================================
@@ -171,6 +437,35 @@ func TestGRPCRunnerServer_GetOldModuleContent(t *testing.T) {
 	}
 }
 
+func TestGRPCRunnerServer_GetOldModuleContent_SchemaMode(t *testing.T) {
+	var captured *hclext.BodySchema
+	runner := &recordingRunner{
+		onGetOldModuleContent: func(schema *hclext.BodySchema, opts *tflint.GetModuleContentOption) (*hclext.BodyContent, error) {
+			captured = schema
+			return &hclext.BodyContent{}, nil
+		},
+	}
+	server := &GRPCRunnerServer{impl: runner}
+
+	schema := &hclext.BodySchema{
+		Blocks: []hclext.BlockSchema{
+			{Type: "locals", Body: &hclext.BodySchema{Mode: hclext.SchemaJustAttributesMode}},
+		},
+	}
+	_, err := server.GetOldModuleContent(context.Background(), &pb.GetModuleContent_Request{
+		Schema: toProtoBodySchema(schema),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if captured == nil || len(captured.Blocks) != 1 {
+		t.Fatalf("runner received schema %+v, want one block", captured)
+	}
+	if got := captured.Blocks[0].Body.Mode; got != hclext.SchemaJustAttributesMode {
+		t.Errorf("nested schema mode = %v, want SchemaJustAttributesMode", got)
+	}
+}
+
 func TestGRPCRunnerServer_GetNewModuleContent(t *testing.T) {
 	expectedContent := &hclext.BodyContent{
 		Attributes: map[string]*hclext.Attribute{
@@ -262,6 +557,654 @@ func TestGRPCRunnerServer_GetNewResourceContent(t *testing.T) {
 	}
 }
 
+func TestGRPCRunnerServer_GetResourceContentPair(t *testing.T) {
+	runner := &recordingRunner{
+		onGetOldResourceContent: func(resourceType string, schema *hclext.BodySchema, opts *tflint.GetModuleContentOption) (*hclext.BodyContent, error) {
+			return &hclext.BodyContent{Blocks: []*hclext.Block{{Type: "resource", Labels: []string{resourceType, "old"}}}}, nil
+		},
+		onGetNewResourceContent: func(resourceType string, schema *hclext.BodySchema, opts *tflint.GetModuleContentOption) (*hclext.BodyContent, error) {
+			return &hclext.BodyContent{Blocks: []*hclext.Block{{Type: "resource", Labels: []string{resourceType, "new"}}}}, nil
+		},
+	}
+	server := &GRPCRunnerServer{impl: runner}
+
+	resp, err := server.GetResourceContentPair(nil, &pb.GetResourceContentPair_Request{
+		ResourceType: "azurerm_storage_account",
+		Schema:       &pb.BodySchema{},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := resp.GetOldContent().GetBlocks(); len(got) != 1 || got[0].GetLabels()[1] != "old" {
+		t.Errorf("old content blocks = %v, want the old resource", got)
+	}
+	if got := resp.GetNewContent().GetBlocks(); len(got) != 1 || got[0].GetLabels()[1] != "new" {
+		t.Errorf("new content blocks = %v, want the new resource", got)
+	}
+}
+
+// legacyRunnerClient answers Runner callbacks like a host built before the
+// GetResourceContentPair and resource address RPCs existed.
+type legacyRunnerClient struct {
+	pb.RunnerClient
+	server *GRPCRunnerServer
+	calls  []string
+}
+
+func (c *legacyRunnerClient) GetResourceContentPair(_ context.Context, _ *pb.GetResourceContentPair_Request, _ ...grpc.CallOption) (*pb.GetResourceContentPair_Response, error) {
+	c.calls = append(c.calls, "GetResourceContentPair")
+	return nil, status.Error(codes.Unimplemented, "unknown method GetResourceContentPair")
+}
+
+func (c *legacyRunnerClient) GetOldResourceContent(ctx context.Context, req *pb.GetResourceContent_Request, _ ...grpc.CallOption) (*pb.GetResourceContent_Response, error) {
+	c.calls = append(c.calls, "GetOldResourceContent")
+	return c.server.GetOldResourceContent(ctx, req)
+}
+
+func (c *legacyRunnerClient) GetNewResourceContent(ctx context.Context, req *pb.GetResourceContent_Request, _ ...grpc.CallOption) (*pb.GetResourceContent_Response, error) {
+	c.calls = append(c.calls, "GetNewResourceContent")
+	return c.server.GetNewResourceContent(ctx, req)
+}
+
+func (c *legacyRunnerClient) OldResourceAddresses(_ context.Context, _ *pb.ResourceAddresses_Request, _ ...grpc.CallOption) (*pb.ResourceAddresses_Response, error) {
+	c.calls = append(c.calls, "OldResourceAddresses")
+	return nil, status.Error(codes.Unimplemented, "unknown method OldResourceAddresses")
+}
+
+func (c *legacyRunnerClient) GetOldModuleContent(ctx context.Context, req *pb.GetModuleContent_Request, _ ...grpc.CallOption) (*pb.GetModuleContent_Response, error) {
+	c.calls = append(c.calls, "GetOldModuleContent")
+	return c.server.GetOldModuleContent(ctx, req)
+}
+
+func TestGRPCRunnerClient_GetResourceContentPair_LegacyHost(t *testing.T) {
+	host := &recordingRunner{
+		onGetOldResourceContent: func(resourceType string, schema *hclext.BodySchema, opts *tflint.GetModuleContentOption) (*hclext.BodyContent, error) {
+			return &hclext.BodyContent{Blocks: []*hclext.Block{{Type: "resource", Labels: []string{resourceType, "old"}}}}, nil
+		},
+	}
+	client := &legacyRunnerClient{server: &GRPCRunnerServer{impl: host}}
+	runner := &GRPCRunnerClient{client: client}
+
+	oldContent, newContent, err := runner.GetResourceContentPair("azurerm_storage_account", &hclext.BodySchema{}, nil)
+	if err != nil {
+		t.Fatalf("GetResourceContentPair() error = %v", err)
+	}
+	if len(oldContent.Blocks) != 1 || len(newContent.Blocks) != 0 {
+		t.Errorf("got %d old and %d new blocks, want 1 and 0", len(oldContent.Blocks), len(newContent.Blocks))
+	}
+	want := []string{"GetResourceContentPair", "GetOldResourceContent", "GetNewResourceContent"}
+	if !reflect.DeepEqual(client.calls, want) {
+		t.Errorf("calls = %v, want %v", client.calls, want)
+	}
+}
+
+func TestGRPCRunnerServer_ResourceAddresses(t *testing.T) {
+	runner := &recordingRunner{
+		onOldResourceAddresses: func() ([]string, error) {
+			return []string{"azurerm_key_vault.main", "azurerm_storage_account.main"}, nil
+		},
+		onNewResourceAddresses: func() ([]string, error) {
+			return []string{"azurerm_storage_account.main"}, nil
+		},
+	}
+	server := &GRPCRunnerServer{impl: runner}
+
+	oldResp, err := server.OldResourceAddresses(context.Background(), &pb.ResourceAddresses_Request{})
+	if err != nil {
+		t.Fatalf("OldResourceAddresses error: %v", err)
+	}
+	if want := []string{"azurerm_key_vault.main", "azurerm_storage_account.main"}; !reflect.DeepEqual(oldResp.GetAddresses(), want) {
+		t.Errorf("old addresses = %v, want %v", oldResp.GetAddresses(), want)
+	}
+	newResp, err := server.NewResourceAddresses(context.Background(), &pb.ResourceAddresses_Request{})
+	if err != nil {
+		t.Fatalf("NewResourceAddresses error: %v", err)
+	}
+	if want := []string{"azurerm_storage_account.main"}; !reflect.DeepEqual(newResp.GetAddresses(), want) {
+		t.Errorf("new addresses = %v, want %v", newResp.GetAddresses(), want)
+	}
+}
+
+func TestGRPCRunnerClient_ResourceAddresses_LegacyHost(t *testing.T) {
+	host := &recordingRunner{
+		onGetOldModuleContent: func(schema *hclext.BodySchema, opts *tflint.GetModuleContentOption) (*hclext.BodyContent, error) {
+			return &hclext.BodyContent{Blocks: []*hclext.Block{
+				{Type: "resource", Labels: []string{"azurerm_storage_account", "main"}},
+				{Type: "resource", Labels: []string{"azurerm_key_vault", "main"}},
+			}}, nil
+		},
+	}
+	client := &legacyRunnerClient{server: &GRPCRunnerServer{impl: host}}
+	runner := &GRPCRunnerClient{client: client}
+
+	addresses, err := runner.OldResourceAddresses()
+	if err != nil {
+		t.Fatalf("OldResourceAddresses() error = %v", err)
+	}
+	if want := []string{"azurerm_key_vault.main", "azurerm_storage_account.main"}; !reflect.DeepEqual(addresses, want) {
+		t.Errorf("addresses = %v, want %v", addresses, want)
+	}
+	if want := []string{"OldResourceAddresses", "GetOldModuleContent"}; !reflect.DeepEqual(client.calls, want) {
+		t.Errorf("calls = %v, want %v", client.calls, want)
+	}
+}
+
+func TestGRPCRunnerServer_GetDataSourceContent(t *testing.T) {
+	var oldType, newType string
+	runner := &recordingRunner{
+		onGetOldDataSourceContent: func(dataSourceType string, schema *hclext.BodySchema, opts *tflint.GetModuleContentOption) (*hclext.BodyContent, error) {
+			oldType = dataSourceType
+			return &hclext.BodyContent{Blocks: []*hclext.Block{{Type: "data", Labels: []string{dataSourceType, "old"}}}}, nil
+		},
+		onGetNewDataSourceContent: func(dataSourceType string, schema *hclext.BodySchema, opts *tflint.GetModuleContentOption) (*hclext.BodyContent, error) {
+			newType = dataSourceType
+			return &hclext.BodyContent{Blocks: []*hclext.Block{{Type: "data", Labels: []string{dataSourceType, "new"}}}}, nil
+		},
+	}
+	server := &GRPCRunnerServer{impl: runner}
+
+	resp, err := server.GetOldDataSourceContent(context.Background(), &pb.GetResourceContent_Request{ResourceType: "azurerm_client_config"})
+	if err != nil {
+		t.Fatalf("GetOldDataSourceContent error: %v", err)
+	}
+	if oldType != "azurerm_client_config" || len(resp.GetContent().GetBlocks()) != 1 {
+		t.Errorf("GetOldDataSourceContent type = %q, blocks = %d", oldType, len(resp.GetContent().GetBlocks()))
+	}
+
+	resp, err = server.GetNewDataSourceContent(context.Background(), &pb.GetResourceContent_Request{ResourceType: "azurerm_client_config"})
+	if err != nil {
+		t.Fatalf("GetNewDataSourceContent error: %v", err)
+	}
+	if newType != "azurerm_client_config" || resp.GetContent().GetBlocks()[0].GetLabels()[1] != "new" {
+		t.Errorf("GetNewDataSourceContent type = %q, content = %v", newType, resp.GetContent())
+	}
+}
+
+func TestGRPCRunnerServer_GetFile(t *testing.T) {
+	src := []byte(`resource "aws_instance" "web" {}`)
+	runner := &recordingRunner{
+		onGetOldFile: func(filename string) (*hcl.File, error) {
+			return &hcl.File{Bytes: src}, nil
+		},
+		onGetNewFile: func(filename string) (*hcl.File, error) {
+			return &hcl.File{Bytes: src}, nil
+		},
+	}
+	server := &GRPCRunnerServer{impl: runner}
+
+	resp, err := server.GetOldFile(context.Background(), &pb.GetFile_Request{Filename: "main.tf"})
+	if err != nil {
+		t.Fatalf("GetOldFile error: %v", err)
+	}
+	if string(resp.GetBytes()) != string(src) {
+		t.Errorf("GetOldFile bytes = %q, want %q", resp.GetBytes(), src)
+	}
+
+	resp, err = server.GetNewFile(context.Background(), &pb.GetFile_Request{Filename: "main.tf"})
+	if err != nil {
+		t.Fatalf("GetNewFile error: %v", err)
+	}
+	if string(resp.GetBytes()) != string(src) {
+		t.Errorf("GetNewFile bytes = %q, want %q", resp.GetBytes(), src)
+	}
+}
+
+func TestGRPCRunnerServer_GetFileSource(t *testing.T) {
+	sources := map[tflint.Side][]byte{
+		tflint.SideOld: []byte(`resource "aws_instance" "web" { ami = "old" }`),
+		tflint.SideNew: []byte(`resource "aws_instance" "web" { ami = "new" }`),
+	}
+	runner := &recordingRunner{
+		onGetFileSource: func(filename string, side tflint.Side) ([]byte, error) {
+			if filename != "main.tf" {
+				return nil, fmt.Errorf("file not found: %s", filename)
+			}
+			return sources[side], nil
+		},
+	}
+	server := &GRPCRunnerServer{impl: runner}
+
+	for _, tt := range []struct {
+		side pb.Side
+		want []byte
+	}{
+		{pb.Side_SIDE_OLD, sources[tflint.SideOld]},
+		{pb.Side_SIDE_NEW, sources[tflint.SideNew]},
+	} {
+		resp, err := server.GetFileSource(context.Background(), &pb.GetFileSource_Request{Filename: "main.tf", Side: tt.side})
+		if err != nil {
+			t.Fatalf("GetFileSource(%s) error: %v", tt.side, err)
+		}
+		if string(resp.GetBytes()) != string(tt.want) {
+			t.Errorf("GetFileSource(%s) bytes = %q, want %q", tt.side, resp.GetBytes(), tt.want)
+		}
+	}
+
+	if _, err := server.GetFileSource(context.Background(), &pb.GetFileSource_Request{Filename: "missing.tf", Side: pb.Side_SIDE_NEW}); err == nil {
+		t.Error("expected error for unknown file, got nil")
+	}
+	if _, err := server.GetFileSource(context.Background(), &pb.GetFileSource_Request{Filename: "main.tf"}); err == nil {
+		t.Error("expected error for unspecified side, got nil")
+	}
+}
+
+func TestGRPCRunnerServer_ListFiles(t *testing.T) {
+	runner := &recordingRunner{
+		onListOldFiles: func() []string { return []string{"main.tf"} },
+		onListNewFiles: func() []string { return []string{"main.tf", "variables.tf"} },
+	}
+	server := &GRPCRunnerServer{impl: runner}
+
+	oldResp, err := server.ListOldFiles(context.Background(), &pb.ListFiles_Request{})
+	if err != nil {
+		t.Fatalf("ListOldFiles error: %v", err)
+	}
+	if len(oldResp.GetFilenames()) != 1 {
+		t.Errorf("ListOldFiles = %v, want 1 file", oldResp.GetFilenames())
+	}
+
+	newResp, err := server.ListNewFiles(context.Background(), &pb.ListFiles_Request{})
+	if err != nil {
+		t.Fatalf("ListNewFiles error: %v", err)
+	}
+	if len(newResp.GetFilenames()) != 2 {
+		t.Errorf("ListNewFiles = %v, want 2 files", newResp.GetFilenames())
+	}
+}
+
+func TestGRPCRunnerServer_FileChanges(t *testing.T) {
+	runner := &recordingRunner{
+		onListOldFiles: func() []string { return []string{"main.tf", "outputs.tf"} },
+		onListNewFiles: func() []string { return []string{"main.tf", "variables.tf"} },
+	}
+	server := &GRPCRunnerServer{impl: runner}
+
+	resp, err := server.FileChanges(context.Background(), &pb.FileChanges_Request{})
+	if err != nil {
+		t.Fatalf("FileChanges error: %v", err)
+	}
+	if !reflect.DeepEqual(resp.GetAdded(), []string{"variables.tf"}) {
+		t.Errorf("added = %v, want [variables.tf]", resp.GetAdded())
+	}
+	if !reflect.DeepEqual(resp.GetRemoved(), []string{"outputs.tf"}) {
+		t.Errorf("removed = %v, want [outputs.tf]", resp.GetRemoved())
+	}
+	if !reflect.DeepEqual(resp.GetCommon(), []string{"main.tf"}) {
+		t.Errorf("common = %v, want [main.tf]", resp.GetCommon())
+	}
+}
+
+func TestGRPCRunnerServer_GetProviderRequirements(t *testing.T) {
+	runner := &recordingRunner{
+		onGetOldProviderRequirements: func() (map[string]tflint.ProviderRequirement, error) {
+			return map[string]tflint.ProviderRequirement{
+				"azurerm": {Source: "hashicorp/azurerm", VersionConstraint: "~> 3.0"},
+			}, nil
+		},
+		onGetNewProviderRequirements: func() (map[string]tflint.ProviderRequirement, error) {
+			return map[string]tflint.ProviderRequirement{
+				"azurerm": {Source: "hashicorp/azurerm", VersionConstraint: ">= 4.0"},
+				"random":  {Source: "hashicorp/random"},
+			}, nil
+		},
+	}
+	server := &GRPCRunnerServer{impl: runner}
+
+	resp, err := server.GetOldProviderRequirements(context.Background(), &pb.GetProviderRequirements_Request{})
+	if err != nil {
+		t.Fatalf("GetOldProviderRequirements error: %v", err)
+	}
+	if got := fromProtoProviderRequirements(resp.GetRequirements()); got["azurerm"].VersionConstraint != "~> 3.0" || len(got) != 1 {
+		t.Errorf("GetOldProviderRequirements = %v", got)
+	}
+
+	resp, err = server.GetNewProviderRequirements(context.Background(), &pb.GetProviderRequirements_Request{})
+	if err != nil {
+		t.Fatalf("GetNewProviderRequirements error: %v", err)
+	}
+	want := map[string]tflint.ProviderRequirement{
+		"azurerm": {Source: "hashicorp/azurerm", VersionConstraint: ">= 4.0"},
+		"random":  {Source: "hashicorp/random"},
+	}
+	if got := fromProtoProviderRequirements(resp.GetRequirements()); !reflect.DeepEqual(got, want) {
+		t.Errorf("GetNewProviderRequirements = %v, want %v", got, want)
+	}
+}
+
+func TestGRPCRunnerServer_GetProviderRequirements_Empty(t *testing.T) {
+	server := &GRPCRunnerServer{impl: &recordingRunner{}}
+
+	resp, err := server.GetNewProviderRequirements(context.Background(), &pb.GetProviderRequirements_Request{})
+	if err != nil {
+		t.Fatalf("GetNewProviderRequirements error: %v", err)
+	}
+	if got := fromProtoProviderRequirements(resp.GetRequirements()); got == nil || len(got) != 0 {
+		t.Errorf("expected empty map, got %#v", got)
+	}
+}
+
+func TestGRPCRunnerServer_GetTerraformSettings(t *testing.T) {
+	backendRange := hcl.Range{Filename: "backend.tf", Start: hcl.Pos{Line: 2, Column: 3}, End: hcl.Pos{Line: 2, Column: 20}}
+	server := &GRPCRunnerServer{impl: &recordingRunner{
+		onGetNewTerraformSettings: func() (*tflint.TerraformSettings, error) {
+			return &tflint.TerraformSettings{
+				RequiredVersion: ">= 1.5",
+				Backend: &tflint.TerraformBackend{
+					Type: "azurerm",
+					Body: &hclext.BodyContent{
+						Attributes: map[string]*hclext.Attribute{
+							"key": {Name: "key", SourceBytes: []byte(`"prod.tfstate"`)},
+						},
+					},
+					DeclRange: backendRange,
+				},
+				RequiredProviders: map[string]tflint.ProviderRequirement{
+					"azurerm": {Source: "hashicorp/azurerm", VersionConstraint: "~> 4.0"},
+				},
+			}, nil
+		},
+	}}
+
+	resp, err := server.GetNewTerraformSettings(context.Background(), &pb.GetTerraformSettings_Request{})
+	if err != nil {
+		t.Fatalf("GetNewTerraformSettings error: %v", err)
+	}
+	settings := fromProtoTerraformSettings(resp.GetSettings())
+	if settings.RequiredVersion != ">= 1.5" {
+		t.Errorf("RequiredVersion = %q, want %q", settings.RequiredVersion, ">= 1.5")
+	}
+	if settings.Backend == nil || settings.Backend.Type != "azurerm" || settings.Backend.DeclRange != backendRange {
+		t.Fatalf("Backend = %+v, want azurerm at %s", settings.Backend, backendRange)
+	}
+	if attr := settings.Backend.Body.Attributes["key"]; attr == nil || string(attr.SourceBytes) != `"prod.tfstate"` {
+		t.Errorf("backend key = %+v, want \"prod.tfstate\"", attr)
+	}
+	if got := settings.RequiredProviders["azurerm"]; got.VersionConstraint != "~> 4.0" {
+		t.Errorf("RequiredProviders[azurerm] = %+v, want version ~> 4.0", got)
+	}
+
+	resp, err = server.GetOldTerraformSettings(context.Background(), &pb.GetTerraformSettings_Request{})
+	if err != nil {
+		t.Fatalf("GetOldTerraformSettings error: %v", err)
+	}
+	if settings := fromProtoTerraformSettings(resp.GetSettings()); settings.Backend != nil || settings.RequiredVersion != "" {
+		t.Errorf("expected empty old settings, got %+v", settings)
+	}
+}
+
+func TestGRPCRunnerServer_GetProviderConfig(t *testing.T) {
+	var gotName string
+	var gotSchema *hclext.BodySchema
+	server := &GRPCRunnerServer{impl: &recordingRunner{
+		onGetNewProviderConfig: func(name string, schema *hclext.BodySchema) (*hclext.Block, error) {
+			gotName, gotSchema = name, schema
+			return &hclext.Block{
+				Type:   "provider",
+				Labels: []string{"azurerm"},
+				Body: &hclext.BodyContent{
+					Attributes: map[string]*hclext.Attribute{"alias": {Name: "alias", SourceBytes: []byte(`"west"`)}},
+					Blocks:     []*hclext.Block{{Type: "features", Body: &hclext.BodyContent{}}},
+				},
+			}, nil
+		},
+	}}
+
+	schema := hclext.NewSchema().Block("features", nil).Build()
+	resp, err := server.GetNewProviderConfig(context.Background(), &pb.GetProviderConfig_Request{
+		Name:   "azurerm.west",
+		Schema: toProtoBodySchema(schema),
+	})
+	if err != nil {
+		t.Fatalf("GetNewProviderConfig error: %v", err)
+	}
+	if gotName != "azurerm.west" || gotSchema == nil || len(gotSchema.Blocks) != 1 {
+		t.Errorf("runner called with %q, %+v", gotName, gotSchema)
+	}
+	block := fromProtoBlock(resp.GetBlock())
+	if block == nil || block.Labels[0] != "azurerm" || len(block.Body.Blocks) != 1 {
+		t.Errorf("GetNewProviderConfig = %+v, want azurerm with a features block", block)
+	}
+
+	resp, err = server.GetOldProviderConfig(context.Background(), &pb.GetProviderConfig_Request{Name: "azurerm"})
+	if err != nil {
+		t.Fatalf("GetOldProviderConfig error: %v", err)
+	}
+	if resp.GetBlock() != nil {
+		t.Errorf("expected no old provider block, got %v", resp.GetBlock())
+	}
+}
+
+func TestGRPCRunnerServer_GetVariables(t *testing.T) {
+	vars := []tflint.VariableDef{
+		{
+			Name:       "location",
+			Type:       cty.String,
+			Default:    cty.StringVal("westus"),
+			HasDefault: true,
+			DeclRange:  hcl.Range{Filename: "variables.tf", Start: hcl.Pos{Line: 1, Column: 1}, End: hcl.Pos{Line: 1, Column: 20}},
+		},
+		{Name: "name", Type: cty.DynamicPseudoType, Required: true},
+		{Name: "zones", Type: cty.List(cty.Number), Default: cty.NullVal(cty.List(cty.Number)), HasDefault: true},
+	}
+	server := &GRPCRunnerServer{impl: &recordingRunner{
+		onGetNewVariables: func() ([]tflint.VariableDef, error) { return vars, nil },
+	}}
+
+	resp, err := server.GetNewVariables(context.Background(), &pb.GetVariables_Request{})
+	if err != nil {
+		t.Fatalf("GetNewVariables error: %v", err)
+	}
+	got := fromProtoVariableDefs(resp.GetVariables())
+	if len(got) != len(vars) {
+		t.Fatalf("got %d variables, want %d", len(got), len(vars))
+	}
+	for i, want := range vars {
+		v := got[i]
+		if v.Name != want.Name || !v.Type.Equals(want.Type) || v.HasDefault != want.HasDefault || v.Required != want.Required || v.DeclRange != want.DeclRange {
+			t.Errorf("variables[%d] = %+v, want %+v", i, v, want)
+		}
+		if want.HasDefault && !v.Default.RawEquals(want.Default) {
+			t.Errorf("variables[%d] default = %#v, want %#v", i, v.Default, want.Default)
+		}
+		if !want.HasDefault && v.Default != cty.NilVal {
+			t.Errorf("variables[%d] default = %#v, want NilVal", i, v.Default)
+		}
+	}
+
+	resp, err = server.GetOldVariables(context.Background(), &pb.GetVariables_Request{})
+	if err != nil {
+		t.Fatalf("GetOldVariables error: %v", err)
+	}
+	if len(resp.GetVariables()) != 0 {
+		t.Errorf("expected no old variables, got %v", resp.GetVariables())
+	}
+}
+
+func TestGRPCRunnerServer_GetOutputs(t *testing.T) {
+	outputs := []tflint.OutputDef{
+		{Name: "id", Description: "The ID.", DeclRange: hcl.Range{Filename: "outputs.tf", Start: hcl.Pos{Line: 1, Column: 1}}},
+		{Name: "key", Sensitive: true},
+	}
+	server := &GRPCRunnerServer{impl: &recordingRunner{
+		onGetOldOutputs: func() ([]tflint.OutputDef, error) { return outputs, nil },
+	}}
+
+	resp, err := server.GetOldOutputs(context.Background(), &pb.GetOutputs_Request{})
+	if err != nil {
+		t.Fatalf("GetOldOutputs error: %v", err)
+	}
+	if got := fromProtoOutputDefs(resp.GetOutputs()); !reflect.DeepEqual(got, outputs) {
+		t.Errorf("GetOldOutputs = %+v, want %+v", got, outputs)
+	}
+}
+
+func TestGRPCRunnerServer_GetModuleCalls(t *testing.T) {
+	calls := []tflint.ModuleCall{
+		{Name: "network", Source: "Azure/network/azurerm", Version: "~> 5.0", DeclRange: hcl.Range{Filename: "main.tf", Start: hcl.Pos{Line: 1, Column: 1}}},
+		{Name: "storage", Source: "./modules/storage"},
+	}
+	server := &GRPCRunnerServer{impl: &recordingRunner{
+		onGetNewModuleCalls: func() ([]tflint.ModuleCall, error) { return calls, nil },
+	}}
+
+	resp, err := server.GetNewModuleCalls(context.Background(), &pb.GetModuleCalls_Request{})
+	if err != nil {
+		t.Fatalf("GetNewModuleCalls error: %v", err)
+	}
+	if got := fromProtoModuleCalls(resp.GetModuleCalls()); !reflect.DeepEqual(got, calls) {
+		t.Errorf("GetNewModuleCalls = %+v, want %+v", got, calls)
+	}
+
+	resp, err = server.GetOldModuleCalls(context.Background(), &pb.GetModuleCalls_Request{})
+	if err != nil {
+		t.Fatalf("GetOldModuleCalls error: %v", err)
+	}
+	if len(resp.GetModuleCalls()) != 0 {
+		t.Errorf("expected no old module calls, got %v", resp.GetModuleCalls())
+	}
+}
+
+func TestGRPCRunnerServer_GetLocals(t *testing.T) {
+	server := &GRPCRunnerServer{impl: &recordingRunner{
+		onGetNewLocals: func() (map[string]cty.Value, error) {
+			return map[string]cty.Value{
+				"prefix":  cty.StringVal("app"),
+				"ports":   cty.ListVal([]cty.Value{cty.NumberIntVal(80), cty.NumberIntVal(443)}),
+				"none":    cty.NullVal(cty.String),
+				"env":     cty.DynamicVal,
+				"partial": cty.ListVal([]cty.Value{cty.UnknownVal(cty.String)}),
+			}, nil
+		},
+	}}
+
+	resp, err := server.GetNewLocals(context.Background(), &pb.GetLocals_Request{})
+	if err != nil {
+		t.Fatalf("GetNewLocals error: %v", err)
+	}
+	got := fromProtoLocals(resp.GetLocals())
+
+	known := map[string]cty.Value{
+		"prefix": cty.StringVal("app"),
+		"ports":  cty.ListVal([]cty.Value{cty.NumberIntVal(80), cty.NumberIntVal(443)}),
+		"none":   cty.NullVal(cty.String),
+	}
+	for name, want := range known {
+		if !got[name].RawEquals(want) {
+			t.Errorf("local.%s = %#v, want %#v", name, got[name], want)
+		}
+	}
+	for _, name := range []string{"env", "partial"} {
+		val, ok := got[name]
+		if !ok || val.IsKnown() {
+			t.Errorf("local.%s = %#v, want unknown", name, val)
+		}
+	}
+
+	oldResp, err := server.GetOldLocals(context.Background(), &pb.GetLocals_Request{})
+	if err != nil {
+		t.Fatalf("GetOldLocals error: %v", err)
+	}
+	if len(oldResp.GetLocals()) != 0 {
+		t.Errorf("expected no old locals, got %v", oldResp.GetLocals())
+	}
+}
+
+func TestGRPCRunnerServer_GetAllResources(t *testing.T) {
+	resources := []*hclext.Block{
+		{
+			Type:   "resource",
+			Labels: []string{"aws_instance", "web"},
+			Body: &hclext.BodyContent{
+				Attributes: map[string]*hclext.Attribute{
+					"ami": {Name: "ami", SourceBytes: []byte(`"ami-123"`)},
+				},
+				Blocks: []*hclext.Block{
+					{Type: "ebs_block_device", Body: &hclext.BodyContent{
+						Attributes: map[string]*hclext.Attribute{
+							"volume_size": {Name: "volume_size", SourceBytes: []byte("10")},
+						},
+					}},
+				},
+			},
+		},
+	}
+	server := &GRPCRunnerServer{impl: &recordingRunner{
+		onGetAllNewResources: func() ([]*hclext.Block, error) { return resources, nil },
+	}}
+
+	resp, err := server.GetAllNewResources(context.Background(), &pb.GetAllResources_Request{})
+	if err != nil {
+		t.Fatalf("GetAllNewResources error: %v", err)
+	}
+	got := fromProtoBlocks(resp.GetResources())
+	if len(got) != 1 || got[0].Address() != "aws_instance.web" {
+		t.Fatalf("GetAllNewResources = %+v, want aws_instance.web", got)
+	}
+	if attr := got[0].Body.Attributes["ami"]; attr == nil || string(attr.SourceBytes) != `"ami-123"` {
+		t.Errorf("ami = %+v, want source %q", attr, `"ami-123"`)
+	}
+	if len(got[0].Body.Blocks) != 1 || got[0].Body.Blocks[0].Body.Attributes["volume_size"] == nil {
+		t.Errorf("nested blocks = %+v, want ebs_block_device with volume_size", got[0].Body.Blocks)
+	}
+
+	oldResp, err := server.GetAllOldResources(context.Background(), &pb.GetAllResources_Request{})
+	if err != nil {
+		t.Fatalf("GetAllOldResources error: %v", err)
+	}
+	if len(oldResp.GetResources()) != 0 {
+		t.Errorf("expected no old resources, got %v", oldResp.GetResources())
+	}
+}
+
+func TestGRPCRunnerServer_GetMovedBlocks(t *testing.T) {
+	runner := &recordingRunner{
+		onGetMovedBlocks: func() []tflint.MovedBlock {
+			return []tflint.MovedBlock{
+				{
+					From:      "aws_instance.old",
+					To:        "aws_instance.new",
+					DeclRange: hcl.Range{Filename: "main.tf", Start: hcl.Pos{Line: 5, Column: 1}},
+				},
+			}
+		},
+	}
+	server := &GRPCRunnerServer{impl: runner}
+
+	resp, err := server.GetMovedBlocks(context.Background(), &pb.GetMovedBlocks_Request{})
+	if err != nil {
+		t.Fatalf("GetMovedBlocks error: %v", err)
+	}
+
+	moved := fromProtoMovedBlocks(resp.GetMovedBlocks())
+	if len(moved) != 1 {
+		t.Fatalf("expected 1 moved block, got %d", len(moved))
+	}
+	if moved[0].From != "aws_instance.old" || moved[0].To != "aws_instance.new" {
+		t.Errorf("moved = %s -> %s, want aws_instance.old -> aws_instance.new", moved[0].From, moved[0].To)
+	}
+	if moved[0].DeclRange.Start.Line != 5 {
+		t.Errorf("DeclRange line = %d, want 5", moved[0].DeclRange.Start.Line)
+	}
+}
+
+func TestParseFile(t *testing.T) {
+	file, err := parseFile([]byte(`resource "aws_instance" "web" {}`), "main.tf")
+	if err != nil {
+		t.Fatalf("parseFile error: %v", err)
+	}
+	if file.Body == nil {
+		t.Error("expected parsed body")
+	}
+
+	if _, err := parseFile([]byte(`{"resource": {}}`), "main.tf.json"); err != nil {
+		t.Errorf("parseFile JSON error: %v", err)
+	}
+
+	if _, err := parseFile([]byte(`resource "aws_instance" {`), "broken.tf"); err == nil {
+		t.Error("expected error for invalid HCL")
+	}
+}
+
 func TestGRPCRunnerServer_EmitIssue(t *testing.T) {
 	var capturedRule tflint.Rule
 	var capturedMessage string
@@ -314,6 +1257,153 @@ func TestGRPCRunnerServer_EmitIssue(t *testing.T) {
 	}
 }
 
+func TestGRPCRunnerServer_EmitIssue_IgnoreDirective(t *testing.T) {
+	src := []byte(`resource "azurerm_resource_group" "main" {
+  location = "westus" # tfbreak:ignore=ignored_rule
+}`)
+
+	var emitted []string
+	runner := &recordingRunner{
+		onGetNewFile: func(filename string) (*hcl.File, error) {
+			return &hcl.File{Bytes: src}, nil
+		},
+		onEmitIssue: func(rule tflint.Rule, message string, issueRange hcl.Range) error {
+			emitted = append(emitted, rule.Name())
+			return nil
+		},
+	}
+	server := &GRPCRunnerServer{impl: runner}
+
+	for _, name := range []string{"ignored_rule", "other_rule"} {
+		_, err := server.EmitIssue(context.Background(), &pb.EmitIssue_Request{
+			Rule:    &pb.Rule{Name: name},
+			Message: "location changed",
+			Range: &pb.Range{
+				Filename: "main.tf",
+				Start:    &pb.Position{Line: 2, Column: 3, Byte: 45},
+				End:      &pb.Position{Line: 2, Column: 22, Byte: 64},
+			},
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	if len(emitted) != 1 || emitted[0] != "other_rule" {
+		t.Errorf("emitted = %v, want [other_rule]", emitted)
+	}
+}
+
+func TestGRPCRunnerServer_EmitIssueWithOptions(t *testing.T) {
+	var captured []tflint.IssueOptions
+	var capturedSeverity tflint.Severity
+	emitIssueCalled := false
+
+	runner := &recordingRunner{
+		onEmitIssue: func(rule tflint.Rule, message string, issueRange hcl.Range) error {
+			emitIssueCalled = true
+			return nil
+		},
+		onEmitIssueWithOptions: func(rule tflint.Rule, message string, issueRange hcl.Range, opts tflint.IssueOptions) error {
+			captured = append(captured, opts)
+			capturedSeverity = rule.Severity()
+			return nil
+		},
+	}
+	server := &GRPCRunnerServer{impl: runner}
+
+	_, err := server.EmitIssue(context.Background(), &pb.EmitIssue_Request{
+		Rule:    &pb.Rule{Name: "test_rule", Severity: pb.Severity_SEVERITY_ERROR},
+		Message: "resource removed",
+		Range:   &pb.Range{Filename: "main.tf"},
+		Fix: &pb.Fix{
+			Edits: []*pb.TextEdit{
+				{Range: &pb.Range{Filename: "main.tf"}, NewText: "moved {}"},
+			},
+		},
+		Severity:        pb.Severity_SEVERITY_WARNING,
+		Kind:            pb.IssueKind_ISSUE_KIND_BREAKING,
+		ResourceAddress: "azurerm_storage_account.main",
+		Side:            pb.Side_SIDE_OLD,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if emitIssueCalled {
+		t.Error("EmitIssue should not be called when options are set")
+	}
+	if len(captured) != 1 {
+		t.Fatalf("expected 1 issue, got %d", len(captured))
+	}
+
+	// Every option is forwarded together
+	opts := captured[0]
+	if opts.Severity != tflint.WARNING || opts.Kind != tflint.KindBreaking || opts.Side != tflint.SideOld {
+		t.Errorf("severity, kind, side = %v, %v, %v, want WARNING, BREAKING, old", opts.Severity, opts.Kind, opts.Side)
+	}
+	if opts.ResourceAddress != "azurerm_storage_account.main" {
+		t.Errorf("address = %q, want azurerm_storage_account.main", opts.ResourceAddress)
+	}
+	if opts.Fix == nil || len(opts.Fix.Edits) != 1 || opts.Fix.Edits[0].NewText != "moved {}" {
+		t.Errorf("fix = %+v, want one edit inserting %q", opts.Fix, "moved {}")
+	}
+	if capturedSeverity != tflint.ERROR {
+		t.Errorf("rule severity = %v, want ERROR", capturedSeverity)
+	}
+}
+
+func TestGRPCRunnerServer_EmitIssueWithSide(t *testing.T) {
+	oldSrc := []byte(`resource "azurerm_storage_account" "main" { # tfbreak:ignore=ignored_rule
+}`)
+	newSrc := []byte(`resource "azurerm_storage_account" "main" {
+}`)
+
+	var emitted []string
+	var sides []tflint.Side
+	runner := &recordingRunner{
+		onGetOldFile: func(filename string) (*hcl.File, error) {
+			return &hcl.File{Bytes: oldSrc}, nil
+		},
+		onGetNewFile: func(filename string) (*hcl.File, error) {
+			return &hcl.File{Bytes: newSrc}, nil
+		},
+		onEmitIssue: func(rule tflint.Rule, message string, issueRange hcl.Range) error {
+			t.Error("EmitIssue should not be called when a side is set")
+			return nil
+		},
+		onEmitIssueWithOptions: func(rule tflint.Rule, message string, issueRange hcl.Range, opts tflint.IssueOptions) error {
+			emitted = append(emitted, rule.Name())
+			sides = append(sides, opts.Side)
+			return nil
+		},
+	}
+	server := &GRPCRunnerServer{impl: runner}
+
+	// The directive is only in the OLD file, so it applies to OLD issues
+	for _, name := range []string{"ignored_rule", "other_rule"} {
+		_, err := server.EmitIssue(context.Background(), &pb.EmitIssue_Request{
+			Rule:    &pb.Rule{Name: name},
+			Message: "resource removed",
+			Range: &pb.Range{
+				Filename: "main.tf",
+				Start:    &pb.Position{Line: 1, Column: 1, Byte: 0},
+				End:      &pb.Position{Line: 1, Column: 42, Byte: 41},
+			},
+			Side: pb.Side_SIDE_OLD,
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	if !reflect.DeepEqual(emitted, []string{"other_rule"}) {
+		t.Errorf("emitted = %v, want [other_rule]", emitted)
+	}
+	if !reflect.DeepEqual(sides, []tflint.Side{tflint.SideOld}) {
+		t.Errorf("sides = %v, want [old]", sides)
+	}
+}
+
 func TestGRPCRunnerServer_DecodeRuleConfig_NoConfig(t *testing.T) {
 	runner := &recordingRunner{
 		onDecodeRuleConfig: func(ruleName string, target any) error {
@@ -421,6 +1511,22 @@ func TestGRPCRunnerServer_MethodsReturnError(t *testing.T) {
 		}
 	})
 
+	t.Run("GetOldFile error", func(t *testing.T) {
+		server := &GRPCRunnerServer{impl: &recordingRunner{}}
+		_, err := server.GetOldFile(nil, &pb.GetFile_Request{Filename: "missing.tf"})
+		if err == nil {
+			t.Error("expected error, got nil")
+		}
+	})
+
+	t.Run("GetNewFile error", func(t *testing.T) {
+		server := &GRPCRunnerServer{impl: &recordingRunner{}}
+		_, err := server.GetNewFile(nil, &pb.GetFile_Request{Filename: "missing.tf"})
+		if err == nil {
+			t.Error("expected error, got nil")
+		}
+	})
+
 	t.Run("EmitIssue error", func(t *testing.T) {
 		runner := &recordingRunner{
 			onEmitIssue: func(rule tflint.Rule, message string, issueRange hcl.Range) error {
@@ -0,0 +1,80 @@
+// Package plugin provides gRPC-based plugin communication for tfbreak.
+//
+// This file implements issue buffering. When a plugin is served with
+// ServeOpts.BufferIssues, emitted issues are collected in the plugin process
+// and returned in the Check response instead of being sent to the host one
+// EmitIssue callback at a time.
+
+package plugin
+
+import (
+	"sync"
+
+	"github.com/hashicorp/hcl/v2"
+
+	pb "github.com/jokarl/tfbreak-plugin-sdk/plugin/proto"
+	"github.com/jokarl/tfbreak-plugin-sdk/tflint"
+)
+
+// bufferingRunner is a Runner that records emitted issues instead of
+// forwarding them. All other calls go to the embedded Runner.
+type bufferingRunner struct {
+	tflint.Runner
+
+	mu     sync.Mutex
+	issues []*pb.Issue
+}
+
+// EmitIssue records the issue in the buffer.
+func (r *bufferingRunner) EmitIssue(rule tflint.Rule, message string, issueRange hcl.Range) error {
+	return r.EmitIssueWithOptions(rule, message, issueRange, tflint.IssueOptions{})
+}
+
+// EmitIssueWithFix records the issue and its fix in the buffer.
+func (r *bufferingRunner) EmitIssueWithFix(rule tflint.Rule, message string, issueRange hcl.Range, fix *tflint.Fix) error {
+	return r.EmitIssueWithOptions(rule, message, issueRange, tflint.IssueOptions{Fix: fix})
+}
+
+// EmitIssueWithSeverity records the issue and its severity in the buffer.
+func (r *bufferingRunner) EmitIssueWithSeverity(rule tflint.Rule, severity tflint.Severity, message string, issueRange hcl.Range) error {
+	return r.EmitIssueWithOptions(rule, message, issueRange, tflint.IssueOptions{Severity: severity})
+}
+
+// EmitIssueWithOptions records the issue and its options in the buffer.
+func (r *bufferingRunner) EmitIssueWithOptions(rule tflint.Rule, message string, issueRange hcl.Range, opts tflint.IssueOptions) error {
+	return r.add(toProtoIssue(rule, message, issueRange, opts))
+}
+
+// add appends an issue to the buffer. Issues without a rule are rejected.
+func (r *bufferingRunner) add(issue *pb.Issue) error {
+	if issue.GetRule() == nil {
+		return nilRuleError(issue.GetMessage())
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.issues = append(r.issues, issue)
+	return nil
+}
+
+// drain returns the buffered issues and empties the buffer.
+func (r *bufferingRunner) drain() []*pb.Issue {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	issues := r.issues
+	r.issues = nil
+	return issues
+}
+
+// flush sends the buffered issues to the host through the EmitIssue callback.
+// This is used when Check fails, since a failed RPC cannot carry a response.
+func (r *bufferingRunner) flush() error {
+	for _, issue := range r.drain() {
+		if err := emitProtoIssue(r.Runner, issue); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,191 @@
+package plugin
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+
+	"github.com/jokarl/tfbreak-plugin-sdk/hclext"
+	"github.com/jokarl/tfbreak-plugin-sdk/tflint"
+)
+
+// emittingTestRule emits a fixed number of issues, then calls back to the
+// host so the test can observe how many issues were delivered by then.
+type emittingTestRule struct {
+	testRule
+	count int
+}
+
+func (r *emittingTestRule) Severity() tflint.Severity { return tflint.ERROR }
+
+func (r *emittingTestRule) Check(_ context.Context, runner tflint.Runner) error {
+	for i := 0; i < r.count; i++ {
+		issueRange := hcl.Range{
+			Filename: "main.tf",
+			Start:    hcl.Pos{Line: i + 1, Column: 1},
+			End:      hcl.Pos{Line: i + 1, Column: 10},
+		}
+		if err := runner.EmitIssue(r, fmt.Sprintf("issue %d", i), issueRange); err != nil {
+			return err
+		}
+	}
+	_, err := runner.GetOldModuleContent(&hclext.BodySchema{}, nil)
+	return err
+}
+
+// checkRoundtrip runs the rule through a real go-plugin connection and returns
+// the issues received by the host, plus how many had been received when the
+// rule finished emitting.
+func checkRoundtrip(t *testing.T, bufferIssues bool, rule tflint.Rule) ([]hcl.Range, []tflint.Severity, int) {
+	t.Helper()
+
+	ruleset := dispenseRuleSet(t, &tflint.BuiltinRuleSet{Name: "test", Version: "0.1.0", Rules: []tflint.Rule{rule}}, RuleSetPlugin{BufferIssues: bufferIssues})
+
+	var ranges []hcl.Range
+	var severities []tflint.Severity
+	deliveredDuringCheck := -1
+	runner := &recordingRunner{
+		onEmitIssue: func(rule tflint.Rule, message string, issueRange hcl.Range) error {
+			ranges = append(ranges, issueRange)
+			severities = append(severities, rule.Severity())
+			return nil
+		},
+		onGetOldModuleContent: func(*hclext.BodySchema, *tflint.GetModuleContentOption) (*hclext.BodyContent, error) {
+			deliveredDuringCheck = len(ranges)
+			return &hclext.BodyContent{}, nil
+		},
+	}
+
+	if err := ruleset.Check(runner); err != nil {
+		t.Fatalf("Check error: %v", err)
+	}
+	return ranges, severities, deliveredDuringCheck
+}
+
+func TestCheck_BufferIssues(t *testing.T) {
+	const count = 100
+	rule := &emittingTestRule{testRule: testRule{name: "emitting_rule"}, count: count}
+
+	ranges, severities, delivered := checkRoundtrip(t, true, rule)
+
+	if delivered != 0 {
+		t.Errorf("issues delivered via callback during Check = %d, want 0", delivered)
+	}
+	if len(ranges) != count {
+		t.Fatalf("received %d issues, want %d", len(ranges), count)
+	}
+	for i, r := range ranges {
+		if r.Filename != "main.tf" || r.Start.Line != i+1 || r.End.Column != 10 {
+			t.Errorf("issue %d range = %v, want main.tf line %d", i, r, i+1)
+		}
+		if severities[i] != tflint.ERROR {
+			t.Errorf("issue %d severity = %v, want ERROR", i, severities[i])
+		}
+	}
+}
+
+func TestCheck_CallbackIssues(t *testing.T) {
+	const count = 10
+	rule := &emittingTestRule{testRule: testRule{name: "emitting_rule"}, count: count}
+
+	ranges, _, delivered := checkRoundtrip(t, false, rule)
+
+	if delivered != count {
+		t.Errorf("issues delivered via callback during Check = %d, want %d", delivered, count)
+	}
+	if len(ranges) != count {
+		t.Errorf("received %d issues, want %d", len(ranges), count)
+	}
+}
+
+func TestCheck_CompositeRuleSet(t *testing.T) {
+	opts := &ServeOpts{RuleSets: []tflint.RuleSet{
+		&tflint.BuiltinRuleSet{Name: "azurerm", Version: "0.1.0", Rules: []tflint.Rule{
+			&emittingTestRule{testRule: testRule{name: "azurerm_rule"}, count: 1},
+		}},
+		&tflint.BuiltinRuleSet{Name: "azuread", Version: "0.1.0", Rules: []tflint.Rule{
+			&emittingTestRule{testRule: testRule{name: "azuread_rule"}, count: 2},
+		}},
+	}}
+
+	ruleset := dispenseRuleSet(t, opts.ruleSet(), RuleSetPlugin{})
+
+	if got, want := ruleset.RuleNames(), []string{"azurerm_rule", "azuread_rule"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("RuleNames() = %v, want %v", got, want)
+	}
+
+	issues := map[string]int{}
+	runner := &recordingRunner{
+		onEmitIssue: func(rule tflint.Rule, _ string, _ hcl.Range) error {
+			issues[rule.Name()]++
+			return nil
+		},
+	}
+	if err := ruleset.Check(runner); err != nil {
+		t.Fatalf("Check error: %v", err)
+	}
+	if want := map[string]int{"azurerm_rule": 1, "azuread_rule": 2}; !reflect.DeepEqual(issues, want) {
+		t.Errorf("issues by rule = %v, want %v", issues, want)
+	}
+}
+
+func TestBufferingRunner_Flush(t *testing.T) {
+	var messages []string
+	inner := &recordingRunner{
+		onEmitIssueWithOptions: func(rule tflint.Rule, message string, issueRange hcl.Range, opts tflint.IssueOptions) error {
+			messages = append(messages, message)
+			return nil
+		},
+		onEmitIssue: func(rule tflint.Rule, message string, issueRange hcl.Range) error {
+			messages = append(messages, message)
+			return nil
+		},
+	}
+	buffer := &bufferingRunner{Runner: inner}
+	rule := &testRule{name: "test_rule"}
+
+	_ = buffer.EmitIssue(rule, "first", hcl.Range{})
+	_ = buffer.EmitIssueWithFix(rule, "second", hcl.Range{}, &tflint.Fix{
+		Edits: []tflint.TextEdit{{NewText: "x"}},
+	})
+	if len(messages) != 0 {
+		t.Fatalf("issues forwarded before flush: %v", messages)
+	}
+
+	if err := buffer.flush(); err != nil {
+		t.Fatalf("flush error: %v", err)
+	}
+	if len(messages) != 2 || messages[0] != "first" || messages[1] != "second" {
+		t.Errorf("flushed messages = %v, want [first second]", messages)
+	}
+	if issues := buffer.drain(); len(issues) != 0 {
+		t.Errorf("buffer not empty after flush: %d issues", len(issues))
+	}
+}
+
+// BenchmarkCheck_BufferIssues compares delivering N issues through one
+// EmitIssue callback each with returning them in the Check response.
+func BenchmarkCheck_BufferIssues(b *testing.B) {
+	for _, count := range []int{10, 100, 1000} {
+		for _, bufferIssues := range []bool{false, true} {
+			mode := "callback"
+			if bufferIssues {
+				mode = "buffered"
+			}
+			b.Run(fmt.Sprintf("%s/issues=%d", mode, count), func(b *testing.B) {
+				rule := &emittingTestRule{testRule: testRule{name: "emitting_rule"}, count: count}
+				ruleset := dispenseRuleSet(b, &tflint.BuiltinRuleSet{Name: "test", Version: "0.1.0", Rules: []tflint.Rule{rule}}, RuleSetPlugin{BufferIssues: bufferIssues})
+				runner := &recordingRunner{}
+
+				for b.Loop() {
+					if err := ruleset.Check(runner); err != nil {
+						b.Fatal(err)
+					}
+				}
+			})
+		}
+	}
+}
@@ -0,0 +1,108 @@
+package plugin
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/hcl/v2"
+
+	"github.com/jokarl/tfbreak-plugin-sdk/tflint"
+)
+
+// optionsTestRule emits one issue with opts, then one plain issue.
+type optionsTestRule struct {
+	testRule
+	opts tflint.IssueOptions
+}
+
+func (r *optionsTestRule) Check(_ context.Context, runner tflint.Runner) error {
+	if err := runner.EmitIssueWithOptions(r, "with options", hcl.Range{Filename: "main.tf"}, r.opts); err != nil {
+		return err
+	}
+	return runner.EmitIssue(r, "plain", hcl.Range{Filename: "main.tf"})
+}
+
+// streamedOptions is the part of a streamed Issue that carries the options.
+type streamedOptions struct {
+	Kind            tflint.IssueKind
+	ResourceAddress string
+	Side            tflint.Side
+}
+
+func TestCheck_IssueOptions(t *testing.T) {
+	for _, tc := range []struct {
+		name string
+		opts tflint.IssueOptions
+	}{
+		{name: "kind", opts: tflint.IssueOptions{Kind: tflint.KindWarning}},
+		{name: "resource address", opts: tflint.IssueOptions{ResourceAddress: "azurerm_storage_account.main"}},
+		{name: "side", opts: tflint.IssueOptions{Side: tflint.SideOld}},
+		{name: "combined", opts: tflint.IssueOptions{
+			Severity:        tflint.NOTICE,
+			Kind:            tflint.KindBreaking,
+			ResourceAddress: "azurerm_storage_account.main",
+			Side:            tflint.SideOld,
+		}},
+	} {
+		rs := &tflint.BuiltinRuleSet{Name: "test", Version: "0.1.0", Rules: []tflint.Rule{
+			&optionsTestRule{testRule: testRule{name: "options_rule"}, opts: tc.opts},
+		}}
+
+		for _, bufferIssues := range []bool{false, true} {
+			t.Run(fmt.Sprintf("%s/buffered=%v", tc.name, bufferIssues), func(t *testing.T) {
+				ruleset := dispenseRuleSet(t, rs, RuleSetPlugin{BufferIssues: bufferIssues})
+
+				var got []tflint.IssueOptions
+				var plain []string
+				runner := &recordingRunner{
+					onEmitIssueWithOptions: func(rule tflint.Rule, message string, _ hcl.Range, opts tflint.IssueOptions) error {
+						if rule.Name() != "options_rule" || message != "with options" {
+							t.Errorf("got rule %q and message %q", rule.Name(), message)
+						}
+						got = append(got, opts)
+						return nil
+					},
+					onEmitIssue: func(rule tflint.Rule, message string, _ hcl.Range) error {
+						plain = append(plain, message)
+						return nil
+					},
+				}
+				if err := ruleset.Check(runner); err != nil {
+					t.Fatalf("Check error: %v", err)
+				}
+
+				if diff := cmp.Diff([]tflint.IssueOptions{tc.opts}, got); diff != "" {
+					t.Errorf("options mismatch (-want +got):\n%s", diff)
+				}
+				if !reflect.DeepEqual(plain, []string{"plain"}) {
+					t.Errorf("plain issues = %v, want [plain]", plain)
+				}
+			})
+		}
+
+		t.Run(tc.name+"/stream", func(t *testing.T) {
+			ruleset := dispenseRuleSet(t, rs, RuleSetPlugin{})
+
+			var got []streamedOptions
+			if err := ruleset.CheckStream(&recordingRunner{}, func(issue Issue) {
+				got = append(got, streamedOptions{Kind: issue.Kind, ResourceAddress: issue.ResourceAddress, Side: issue.Side})
+			}); err != nil {
+				t.Fatalf("CheckStream error: %v", err)
+			}
+
+			want := []streamedOptions{
+				{Kind: tc.opts.Kind, ResourceAddress: tc.opts.ResourceAddress, Side: tc.opts.Side},
+				{Side: tflint.SideNew},
+			}
+			if want[0].Side == tflint.SideUnspecified {
+				want[0].Side = tflint.SideNew
+			}
+			if diff := cmp.Diff(want, got); diff != "" {
+				t.Errorf("streamed options mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,61 @@
+// Package plugin provides gRPC-based plugin communication for tfbreak.
+//
+// This file implements issue streaming. During CheckStream, emitted issues
+// are sent to the host on the response stream as soon as rules report them,
+// so the host can show progress before every rule has finished.
+
+package plugin
+
+import (
+	"sync"
+
+	"github.com/hashicorp/hcl/v2"
+
+	pb "github.com/jokarl/tfbreak-plugin-sdk/plugin/proto"
+	"github.com/jokarl/tfbreak-plugin-sdk/tflint"
+)
+
+// streamingRunner is a Runner that sends emitted issues on a CheckStream
+// response stream. All other calls go to the embedded Runner.
+type streamingRunner struct {
+	tflint.Runner
+
+	// mu serializes sends, since a gRPC stream is not safe for concurrent use.
+	mu     sync.Mutex
+	stream pb.RuleSet_CheckStreamServer
+}
+
+// EmitIssue sends the issue on the stream.
+func (r *streamingRunner) EmitIssue(rule tflint.Rule, message string, issueRange hcl.Range) error {
+	return r.EmitIssueWithOptions(rule, message, issueRange, tflint.IssueOptions{})
+}
+
+// EmitIssueWithFix sends the issue and its fix on the stream.
+func (r *streamingRunner) EmitIssueWithFix(rule tflint.Rule, message string, issueRange hcl.Range, fix *tflint.Fix) error {
+	return r.EmitIssueWithOptions(rule, message, issueRange, tflint.IssueOptions{Fix: fix})
+}
+
+// EmitIssueWithSeverity sends the issue and its severity on the stream.
+func (r *streamingRunner) EmitIssueWithSeverity(rule tflint.Rule, severity tflint.Severity, message string, issueRange hcl.Range) error {
+	return r.EmitIssueWithOptions(rule, message, issueRange, tflint.IssueOptions{Severity: severity})
+}
+
+// EmitIssueWithOptions sends the issue and its options on the stream.
+func (r *streamingRunner) EmitIssueWithOptions(rule tflint.Rule, message string, issueRange hcl.Range, opts tflint.IssueOptions) error {
+	return r.send(toProtoIssue(rule, message, issueRange, opts))
+}
+
+// send writes an issue event to the stream. Issues without a rule are
+// rejected.
+func (r *streamingRunner) send(issue *pb.Issue) error {
+	if issue.GetRule() == nil {
+		return nilRuleError(issue.GetMessage())
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.stream.Send(&pb.CheckStream_Response{
+		Event: &pb.CheckStream_Response_Issue{Issue: issue},
+	})
+}
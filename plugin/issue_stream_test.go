@@ -0,0 +1,110 @@
+package plugin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+
+	"github.com/jokarl/tfbreak-plugin-sdk/hclext"
+	"github.com/jokarl/tfbreak-plugin-sdk/tflint"
+)
+
+// failingTestRule emits one issue, then fails.
+type failingTestRule struct {
+	testRule
+}
+
+func (r *failingTestRule) Check(_ context.Context, runner tflint.Runner) error {
+	if err := runner.EmitIssue(r, "before failure", hcl.Range{Filename: "main.tf"}); err != nil {
+		return err
+	}
+	return errors.New("rule failed")
+}
+
+func TestCheckStream(t *testing.T) {
+	rule := &emittingTestRule{testRule: testRule{name: "stream_rule"}, count: 3}
+
+	ruleset := dispenseRuleSet(t, &tflint.BuiltinRuleSet{Name: "test", Version: "0.1.0", Rules: []tflint.Rule{rule}}, RuleSetPlugin{})
+
+	emitCalled := false
+	runner := &recordingRunner{
+		onEmitIssue: func(tflint.Rule, string, hcl.Range) error {
+			emitCalled = true
+			return nil
+		},
+		onGetOldModuleContent: func(*hclext.BodySchema, *tflint.GetModuleContentOption) (*hclext.BodyContent, error) {
+			return &hclext.BodyContent{}, nil
+		},
+	}
+
+	var issues []Issue
+	if err := ruleset.CheckStream(runner, func(issue Issue) {
+		issues = append(issues, issue)
+	}); err != nil {
+		t.Fatalf("CheckStream error: %v", err)
+	}
+
+	if len(issues) != 3 {
+		t.Fatalf("received %d issues, want 3", len(issues))
+	}
+	for i, issue := range issues {
+		if issue.Rule.Name() != "stream_rule" {
+			t.Errorf("issues[%d] rule = %q, want %q", i, issue.Rule.Name(), "stream_rule")
+		}
+		if issue.Rule.Severity() != tflint.ERROR {
+			t.Errorf("issues[%d] severity = %v, want ERROR", i, issue.Rule.Severity())
+		}
+		if want := fmt.Sprintf("issue %d", i); issue.Message != want {
+			t.Errorf("issues[%d] message = %q, want %q", i, issue.Message, want)
+		}
+		if issue.Range.Start.Line != i+1 {
+			t.Errorf("issues[%d] line = %d, want %d", i, issue.Range.Start.Line, i+1)
+		}
+	}
+	if emitCalled {
+		t.Error("streamed issues should not be sent to the runner's EmitIssue")
+	}
+}
+
+func TestCheckStream_RuleError(t *testing.T) {
+	rule := &failingTestRule{testRule: testRule{name: "failing_rule"}}
+
+	ruleset := dispenseRuleSet(t, &tflint.BuiltinRuleSet{Name: "test", Version: "0.1.0", Rules: []tflint.Rule{rule}}, RuleSetPlugin{})
+
+	var received int
+	err := ruleset.CheckStream(&recordingRunner{}, func(Issue) { received++ })
+
+	// The rule error arrives structured, as it does from Check
+	var ruleErr *tflint.RuleError
+	if !errors.As(err, &ruleErr) {
+		t.Fatalf("expected a *tflint.RuleError, got %T: %v", err, err)
+	}
+	if ruleErr.Rule != "failing_rule" || ruleErr.Category != tflint.ErrorCategoryInternal {
+		t.Errorf("rule error = %s/%v, want failing_rule/internal", ruleErr.Rule, ruleErr.Category)
+	}
+	if ruleErr.Err.Error() != "rule failed" {
+		t.Errorf("rule error message = %q, want %q", ruleErr.Err.Error(), "rule failed")
+	}
+	if received != 1 {
+		t.Errorf("received %d issues before the error, want 1", received)
+	}
+}
+
+func TestCheckStream_CombineErrors(t *testing.T) {
+	rule := &failingTestRule{testRule: testRule{name: "failing_rule"}}
+
+	ruleset := dispenseRuleSet(t, &tflint.BuiltinRuleSet{Name: "test", Version: "0.1.0", Rules: []tflint.Rule{rule}}, RuleSetPlugin{CombineErrors: true})
+
+	err := ruleset.CheckStream(&recordingRunner{}, func(Issue) {})
+	if err == nil || !strings.Contains(err.Error(), "rule failed") {
+		t.Fatalf("CheckStream error = %v, want the combined rule error", err)
+	}
+	var multi *tflint.MultiRuleError
+	if errors.As(err, &multi) {
+		t.Error("combined errors should not be structured")
+	}
+}
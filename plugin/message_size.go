@@ -0,0 +1,31 @@
+// Package plugin provides gRPC-based plugin communication for tfbreak.
+//
+// This file implements the message size limit. gRPC servers reject messages
+// larger than 4MB by default, which BodyContent of large modules can exceed.
+// Clients need no limit of their own: go-plugin dials every connection,
+// including broker connections, accepting messages of up to 2GB.
+
+package plugin
+
+import (
+	"github.com/hashicorp/go-plugin"
+	"google.golang.org/grpc"
+)
+
+// messageSizeServerOptions returns the server options limiting received and
+// sent messages to size bytes, or nil to keep gRPC's defaults if size is
+// not positive.
+func messageSizeServerOptions(size int) []grpc.ServerOption {
+	if size <= 0 {
+		return nil
+	}
+	return []grpc.ServerOption{grpc.MaxRecvMsgSize(size), grpc.MaxSendMsgSize(size)}
+}
+
+// grpcServerFunc returns the go-plugin server constructor for the plugin's
+// RuleSet server, with messages limited to size bytes.
+func grpcServerFunc(size int) func([]grpc.ServerOption) *grpc.Server {
+	return func(opts []grpc.ServerOption) *grpc.Server {
+		return plugin.DefaultGRPCServer(append(opts, messageSizeServerOptions(size)...))
+	}
+}
@@ -0,0 +1,99 @@
+package plugin
+
+import (
+	"context"
+	"net"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/jokarl/tfbreak-plugin-sdk/hclext"
+	pb "github.com/jokarl/tfbreak-plugin-sdk/plugin/proto"
+	"github.com/jokarl/tfbreak-plugin-sdk/tflint"
+)
+
+// largeMessageSize exceeds gRPC's default 4MB receive limit.
+const largeMessageSize = 5 << 20
+
+// largeContent returns body content whose proto encoding exceeds
+// largeMessageSize.
+func largeContent() *hclext.BodyContent {
+	return &hclext.BodyContent{
+		Attributes: map[string]*hclext.Attribute{
+			"data": {Name: "data", SourceBytes: []byte(strings.Repeat("x", largeMessageSize))},
+		},
+	}
+}
+
+func TestGRPCServerFunc_MaxMessageSize(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		size     int
+		wantCode codes.Code
+	}{
+		{name: "default", size: 0, wantCode: codes.ResourceExhausted},
+		{name: "raised", size: 8 << 20, wantCode: codes.OK},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			ln := bufconn.Listen(1 << 20)
+			server := grpcServerFunc(tc.size)(nil)
+			pb.RegisterRuleSetServer(server, &GRPCRuleSetServer{
+				impl: &tflint.BuiltinRuleSet{Name: "test", Version: "0.1.0"},
+			})
+			go server.Serve(ln)
+			defer server.Stop()
+
+			conn, err := grpc.NewClient("passthrough:///bufnet",
+				grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return ln.DialContext(ctx) }),
+				grpc.WithTransportCredentials(insecure.NewCredentials()),
+			)
+			if err != nil {
+				t.Fatalf("NewClient error: %v", err)
+			}
+			defer conn.Close()
+
+			client := pb.NewRuleSetClient(conn)
+			_, err = client.ApplyConfig(context.Background(), &pb.ApplyConfig_Request{Content: toProtoBodyContent(largeContent())})
+			if got := status.Code(err); got != tc.wantCode {
+				t.Errorf("ApplyConfig code = %v, want %v (error: %v)", got, tc.wantCode, err)
+			}
+		})
+	}
+}
+
+type largeIssueTestRule struct {
+	testRule
+}
+
+func (r *largeIssueTestRule) Check(_ context.Context, runner tflint.Runner) error {
+	return runner.EmitIssue(r, strings.Repeat("x", largeMessageSize), hcl.Range{Filename: "main.tf"})
+}
+
+func TestGRPCRuleSetClient_CheckMaxMessageSize(t *testing.T) {
+	for _, tc := range []struct {
+		name    string
+		size    int
+		wantErr bool
+	}{
+		{name: "default", size: 0, wantErr: true},
+		{name: "raised", size: 8 << 20, wantErr: false},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			ruleset := dispenseRuleSet(t, &tflint.BuiltinRuleSet{
+				Name:    "test",
+				Version: "0.1.0",
+				Rules:   []tflint.Rule{&largeIssueTestRule{testRule{name: "large_issue"}}},
+			}, RuleSetPlugin{MaxMessageSize: tc.size})
+			err := ruleset.Check(&recordingRunner{})
+			if gotErr := err != nil; gotErr != tc.wantErr {
+				t.Errorf("Check error = %v, want error: %v", err, tc.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,38 @@
+package plugin
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+
+	"github.com/jokarl/tfbreak-plugin-sdk/tflint"
+)
+
+func TestEmitIssue_NilRule(t *testing.T) {
+	runners := map[string]tflint.Runner{
+		// The client rejects the issue before calling the host
+		"client":    &GRPCRunnerClient{},
+		"buffering": &bufferingRunner{Runner: &recordingRunner{}},
+		"streaming": &streamingRunner{Runner: &recordingRunner{}},
+	}
+	for name, runner := range runners {
+		emits := map[string]func() error{
+			"EmitIssue": func() error { return runner.EmitIssue(nil, "no rule", hcl.Range{}) },
+			"EmitIssueWithFix": func() error {
+				return runner.EmitIssueWithFix(nil, "no rule", hcl.Range{}, &tflint.Fix{})
+			},
+			"EmitIssueWithSeverity": func() error {
+				return runner.EmitIssueWithSeverity(nil, tflint.WARNING, "no rule", hcl.Range{})
+			},
+			"EmitIssueWithOptions": func() error {
+				return runner.EmitIssueWithOptions(nil, "no rule", hcl.Range{}, tflint.IssueOptions{Kind: tflint.KindBreaking})
+			},
+		}
+		for method, emit := range emits {
+			if err := emit(); !errors.Is(err, tflint.ErrNilRule) {
+				t.Errorf("%s %s error = %v, want ErrNilRule", name, method, err)
+			}
+		}
+	}
+}
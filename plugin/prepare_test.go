@@ -0,0 +1,83 @@
+package plugin
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/jokarl/tfbreak-plugin-sdk/tflint"
+)
+
+// preparingTestRule records its Prepare and Check calls in a shared log.
+type preparingTestRule struct {
+	testRule
+	mu         *sync.Mutex
+	calls      *[]string
+	prepareErr error
+}
+
+func (r *preparingTestRule) record(call string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	*r.calls = append(*r.calls, r.name+" "+call)
+}
+
+func (r *preparingTestRule) Prepare(_ tflint.Runner) error {
+	r.record("Prepare")
+	return r.prepareErr
+}
+
+func (r *preparingTestRule) Check(_ context.Context, _ tflint.Runner) error {
+	r.record("Check")
+	return nil
+}
+
+func TestGRPCRuleSetServer_CheckPreparesRules(t *testing.T) {
+	var mu sync.Mutex
+	var calls []string
+	rules := []tflint.Rule{
+		&preparingTestRule{testRule: testRule{name: "rule_a"}, mu: &mu, calls: &calls},
+		&preparingTestRule{testRule: testRule{name: "rule_b"}, mu: &mu, calls: &calls},
+		&testRule{name: "plain_rule"},
+	}
+
+	ruleset := dispenseRuleSet(t, &tflint.BuiltinRuleSet{Name: "test", Version: "0.1.0", Rules: rules}, RuleSetPlugin{})
+	if err := ruleset.Check(&recordingRunner{}); err != nil {
+		t.Fatalf("Check error: %v", err)
+	}
+
+	// Every rule is prepared exactly once, before any rule is checked
+	want := []string{"rule_a Prepare", "rule_b Prepare", "rule_a Check", "rule_b Check"}
+	if !reflect.DeepEqual(calls, want) {
+		t.Errorf("calls = %v, want %v", calls, want)
+	}
+}
+
+func TestGRPCRuleSetServer_CheckPrepareError(t *testing.T) {
+	var mu sync.Mutex
+	var calls []string
+	rules := []tflint.Rule{
+		&preparingTestRule{testRule: testRule{name: "rule_a"}, mu: &mu, calls: &calls, prepareErr: errors.New("lookup failed")},
+		&preparingTestRule{testRule: testRule{name: "rule_b"}, mu: &mu, calls: &calls},
+	}
+	server := &GRPCRuleSetServer{
+		impl: &tflint.BuiltinRuleSet{Name: "test", Version: "0.1.0", Rules: rules},
+	}
+
+	errs, err := server.runRules(context.Background(), &recordingRunner{})
+	if err != nil {
+		t.Fatalf("runRules error: %v", err)
+	}
+	if len(errs) != 1 || !strings.Contains(errs[0].Error(), "rule rule_a: lookup failed") {
+		t.Errorf("errors = %v, want the Prepare error of rule_a", errs)
+	}
+
+	// A rule that fails to prepare is not checked
+	want := []string{"rule_a Prepare", "rule_b Prepare", "rule_b Check"}
+	if !reflect.DeepEqual(calls, want) {
+		t.Errorf("calls = %v, want %v", calls, want)
+	}
+}
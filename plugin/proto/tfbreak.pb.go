@@ -31,6 +31,59 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// ErrorCategory classifies why a rule failed.
+type ErrorCategory int32
+
+const (
+	ErrorCategory_ERROR_CATEGORY_UNSPECIFIED ErrorCategory = 0
+	ErrorCategory_ERROR_CATEGORY_INTERNAL    ErrorCategory = 1
+	ErrorCategory_ERROR_CATEGORY_PANIC       ErrorCategory = 2
+	ErrorCategory_ERROR_CATEGORY_CONFIG      ErrorCategory = 3
+)
+
+// Enum value maps for ErrorCategory.
+var (
+	ErrorCategory_name = map[int32]string{
+		0: "ERROR_CATEGORY_UNSPECIFIED",
+		1: "ERROR_CATEGORY_INTERNAL",
+		2: "ERROR_CATEGORY_PANIC",
+		3: "ERROR_CATEGORY_CONFIG",
+	}
+	ErrorCategory_value = map[string]int32{
+		"ERROR_CATEGORY_UNSPECIFIED": 0,
+		"ERROR_CATEGORY_INTERNAL":    1,
+		"ERROR_CATEGORY_PANIC":       2,
+		"ERROR_CATEGORY_CONFIG":      3,
+	}
+)
+
+func (x ErrorCategory) Enum() *ErrorCategory {
+	p := new(ErrorCategory)
+	*p = x
+	return p
+}
+
+func (x ErrorCategory) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ErrorCategory) Descriptor() protoreflect.EnumDescriptor {
+	return file_plugin_proto_tfbreak_proto_enumTypes[0].Descriptor()
+}
+
+func (ErrorCategory) Type() protoreflect.EnumType {
+	return &file_plugin_proto_tfbreak_proto_enumTypes[0]
+}
+
+func (x ErrorCategory) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ErrorCategory.Descriptor instead.
+func (ErrorCategory) EnumDescriptor() ([]byte, []int) {
+	return file_plugin_proto_tfbreak_proto_rawDescGZIP(), []int{0}
+}
+
 // Severity represents issue severity levels.
 type Severity int32
 
@@ -68,11 +121,11 @@ func (x Severity) String() string {
 }
 
 func (Severity) Descriptor() protoreflect.EnumDescriptor {
-	return file_plugin_proto_tfbreak_proto_enumTypes[0].Descriptor()
+	return file_plugin_proto_tfbreak_proto_enumTypes[1].Descriptor()
 }
 
 func (Severity) Type() protoreflect.EnumType {
-	return &file_plugin_proto_tfbreak_proto_enumTypes[0]
+	return &file_plugin_proto_tfbreak_proto_enumTypes[1]
 }
 
 func (x Severity) Number() protoreflect.EnumNumber {
@@ -81,7 +134,60 @@ func (x Severity) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Severity.Descriptor instead.
 func (Severity) EnumDescriptor() ([]byte, []int) {
-	return file_plugin_proto_tfbreak_proto_rawDescGZIP(), []int{0}
+	return file_plugin_proto_tfbreak_proto_rawDescGZIP(), []int{1}
+}
+
+// IssueKind classifies an issue as a breaking change or an advisory.
+type IssueKind int32
+
+const (
+	IssueKind_ISSUE_KIND_UNSPECIFIED IssueKind = 0
+	IssueKind_ISSUE_KIND_BREAKING    IssueKind = 1
+	IssueKind_ISSUE_KIND_WARNING     IssueKind = 2
+	IssueKind_ISSUE_KIND_INFO        IssueKind = 3
+)
+
+// Enum value maps for IssueKind.
+var (
+	IssueKind_name = map[int32]string{
+		0: "ISSUE_KIND_UNSPECIFIED",
+		1: "ISSUE_KIND_BREAKING",
+		2: "ISSUE_KIND_WARNING",
+		3: "ISSUE_KIND_INFO",
+	}
+	IssueKind_value = map[string]int32{
+		"ISSUE_KIND_UNSPECIFIED": 0,
+		"ISSUE_KIND_BREAKING":    1,
+		"ISSUE_KIND_WARNING":     2,
+		"ISSUE_KIND_INFO":        3,
+	}
+)
+
+func (x IssueKind) Enum() *IssueKind {
+	p := new(IssueKind)
+	*p = x
+	return p
+}
+
+func (x IssueKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (IssueKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_plugin_proto_tfbreak_proto_enumTypes[2].Descriptor()
+}
+
+func (IssueKind) Type() protoreflect.EnumType {
+	return &file_plugin_proto_tfbreak_proto_enumTypes[2]
+}
+
+func (x IssueKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use IssueKind.Descriptor instead.
+func (IssueKind) EnumDescriptor() ([]byte, []int) {
+	return file_plugin_proto_tfbreak_proto_rawDescGZIP(), []int{2}
 }
 
 // SchemaMode specifies how schema matching behaves.
@@ -90,6 +196,8 @@ type SchemaMode int32
 const (
 	SchemaMode_SCHEMA_MODE_DEFAULT         SchemaMode = 0
 	SchemaMode_SCHEMA_MODE_JUST_ATTRIBUTES SchemaMode = 1
+	// SCHEMA_MODE_EXACT reports undeclared attributes and blocks as errors.
+	SchemaMode_SCHEMA_MODE_EXACT SchemaMode = 2
 )
 
 // Enum value maps for SchemaMode.
@@ -97,10 +205,12 @@ var (
 	SchemaMode_name = map[int32]string{
 		0: "SCHEMA_MODE_DEFAULT",
 		1: "SCHEMA_MODE_JUST_ATTRIBUTES",
+		2: "SCHEMA_MODE_EXACT",
 	}
 	SchemaMode_value = map[string]int32{
 		"SCHEMA_MODE_DEFAULT":         0,
 		"SCHEMA_MODE_JUST_ATTRIBUTES": 1,
+		"SCHEMA_MODE_EXACT":           2,
 	}
 )
 
@@ -115,11 +225,11 @@ func (x SchemaMode) String() string {
 }
 
 func (SchemaMode) Descriptor() protoreflect.EnumDescriptor {
-	return file_plugin_proto_tfbreak_proto_enumTypes[1].Descriptor()
+	return file_plugin_proto_tfbreak_proto_enumTypes[3].Descriptor()
 }
 
 func (SchemaMode) Type() protoreflect.EnumType {
-	return &file_plugin_proto_tfbreak_proto_enumTypes[1]
+	return &file_plugin_proto_tfbreak_proto_enumTypes[3]
 }
 
 func (x SchemaMode) Number() protoreflect.EnumNumber {
@@ -128,7 +238,57 @@ func (x SchemaMode) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use SchemaMode.Descriptor instead.
 func (SchemaMode) EnumDescriptor() ([]byte, []int) {
-	return file_plugin_proto_tfbreak_proto_rawDescGZIP(), []int{1}
+	return file_plugin_proto_tfbreak_proto_rawDescGZIP(), []int{3}
+}
+
+// DiagnosticSeverity is the severity of a Diagnostic.
+type DiagnosticSeverity int32
+
+const (
+	DiagnosticSeverity_DIAGNOSTIC_SEVERITY_UNSPECIFIED DiagnosticSeverity = 0
+	DiagnosticSeverity_DIAGNOSTIC_SEVERITY_ERROR       DiagnosticSeverity = 1
+	DiagnosticSeverity_DIAGNOSTIC_SEVERITY_WARNING     DiagnosticSeverity = 2
+)
+
+// Enum value maps for DiagnosticSeverity.
+var (
+	DiagnosticSeverity_name = map[int32]string{
+		0: "DIAGNOSTIC_SEVERITY_UNSPECIFIED",
+		1: "DIAGNOSTIC_SEVERITY_ERROR",
+		2: "DIAGNOSTIC_SEVERITY_WARNING",
+	}
+	DiagnosticSeverity_value = map[string]int32{
+		"DIAGNOSTIC_SEVERITY_UNSPECIFIED": 0,
+		"DIAGNOSTIC_SEVERITY_ERROR":       1,
+		"DIAGNOSTIC_SEVERITY_WARNING":     2,
+	}
+)
+
+func (x DiagnosticSeverity) Enum() *DiagnosticSeverity {
+	p := new(DiagnosticSeverity)
+	*p = x
+	return p
+}
+
+func (x DiagnosticSeverity) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DiagnosticSeverity) Descriptor() protoreflect.EnumDescriptor {
+	return file_plugin_proto_tfbreak_proto_enumTypes[4].Descriptor()
+}
+
+func (DiagnosticSeverity) Type() protoreflect.EnumType {
+	return &file_plugin_proto_tfbreak_proto_enumTypes[4]
+}
+
+func (x DiagnosticSeverity) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DiagnosticSeverity.Descriptor instead.
+func (DiagnosticSeverity) EnumDescriptor() ([]byte, []int) {
+	return file_plugin_proto_tfbreak_proto_rawDescGZIP(), []int{4}
 }
 
 // ModuleCtxType specifies the module context for content retrieval.
@@ -165,11 +325,11 @@ func (x ModuleCtxType) String() string {
 }
 
 func (ModuleCtxType) Descriptor() protoreflect.EnumDescriptor {
-	return file_plugin_proto_tfbreak_proto_enumTypes[2].Descriptor()
+	return file_plugin_proto_tfbreak_proto_enumTypes[5].Descriptor()
 }
 
 func (ModuleCtxType) Type() protoreflect.EnumType {
-	return &file_plugin_proto_tfbreak_proto_enumTypes[2]
+	return &file_plugin_proto_tfbreak_proto_enumTypes[5]
 }
 
 func (x ModuleCtxType) Number() protoreflect.EnumNumber {
@@ -178,7 +338,7 @@ func (x ModuleCtxType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use ModuleCtxType.Descriptor instead.
 func (ModuleCtxType) EnumDescriptor() ([]byte, []int) {
-	return file_plugin_proto_tfbreak_proto_rawDescGZIP(), []int{2}
+	return file_plugin_proto_tfbreak_proto_rawDescGZIP(), []int{5}
 }
 
 // ExpandMode specifies how dynamic blocks are handled.
@@ -212,11 +372,11 @@ func (x ExpandMode) String() string {
 }
 
 func (ExpandMode) Descriptor() protoreflect.EnumDescriptor {
-	return file_plugin_proto_tfbreak_proto_enumTypes[3].Descriptor()
+	return file_plugin_proto_tfbreak_proto_enumTypes[6].Descriptor()
 }
 
 func (ExpandMode) Type() protoreflect.EnumType {
-	return &file_plugin_proto_tfbreak_proto_enumTypes[3]
+	return &file_plugin_proto_tfbreak_proto_enumTypes[6]
 }
 
 func (x ExpandMode) Number() protoreflect.EnumNumber {
@@ -225,7 +385,57 @@ func (x ExpandMode) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use ExpandMode.Descriptor instead.
 func (ExpandMode) EnumDescriptor() ([]byte, []int) {
-	return file_plugin_proto_tfbreak_proto_rawDescGZIP(), []int{3}
+	return file_plugin_proto_tfbreak_proto_rawDescGZIP(), []int{6}
+}
+
+// Side selects the OLD or NEW configuration.
+type Side int32
+
+const (
+	Side_SIDE_UNSPECIFIED Side = 0
+	Side_SIDE_OLD         Side = 1
+	Side_SIDE_NEW         Side = 2
+)
+
+// Enum value maps for Side.
+var (
+	Side_name = map[int32]string{
+		0: "SIDE_UNSPECIFIED",
+		1: "SIDE_OLD",
+		2: "SIDE_NEW",
+	}
+	Side_value = map[string]int32{
+		"SIDE_UNSPECIFIED": 0,
+		"SIDE_OLD":         1,
+		"SIDE_NEW":         2,
+	}
+)
+
+func (x Side) Enum() *Side {
+	p := new(Side)
+	*p = x
+	return p
+}
+
+func (x Side) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Side) Descriptor() protoreflect.EnumDescriptor {
+	return file_plugin_proto_tfbreak_proto_enumTypes[7].Descriptor()
+}
+
+func (Side) Type() protoreflect.EnumType {
+	return &file_plugin_proto_tfbreak_proto_enumTypes[7]
+}
+
+func (x Side) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Side.Descriptor instead.
+func (Side) EnumDescriptor() ([]byte, []int) {
+	return file_plugin_proto_tfbreak_proto_rawDescGZIP(), []int{7}
 }
 
 type GetRuleSetName struct {
@@ -336,26 +546,26 @@ func (*GetRuleNames) Descriptor() ([]byte, []int) {
 	return file_plugin_proto_tfbreak_proto_rawDescGZIP(), []int{2}
 }
 
-type GetVersionConstraint struct {
+type GetRuleMetadata struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetVersionConstraint) Reset() {
-	*x = GetVersionConstraint{}
+func (x *GetRuleMetadata) Reset() {
+	*x = GetRuleMetadata{}
 	mi := &file_plugin_proto_tfbreak_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetVersionConstraint) String() string {
+func (x *GetRuleMetadata) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetVersionConstraint) ProtoMessage() {}
+func (*GetRuleMetadata) ProtoMessage() {}
 
-func (x *GetVersionConstraint) ProtoReflect() protoreflect.Message {
+func (x *GetRuleMetadata) ProtoReflect() protoreflect.Message {
 	mi := &file_plugin_proto_tfbreak_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -367,31 +577,31 @@ func (x *GetVersionConstraint) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetVersionConstraint.ProtoReflect.Descriptor instead.
-func (*GetVersionConstraint) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetRuleMetadata.ProtoReflect.Descriptor instead.
+func (*GetRuleMetadata) Descriptor() ([]byte, []int) {
 	return file_plugin_proto_tfbreak_proto_rawDescGZIP(), []int{3}
 }
 
-type GetConfigSchema struct {
+type GetRuleDefaults struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetConfigSchema) Reset() {
-	*x = GetConfigSchema{}
+func (x *GetRuleDefaults) Reset() {
+	*x = GetRuleDefaults{}
 	mi := &file_plugin_proto_tfbreak_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetConfigSchema) String() string {
+func (x *GetRuleDefaults) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetConfigSchema) ProtoMessage() {}
+func (*GetRuleDefaults) ProtoMessage() {}
 
-func (x *GetConfigSchema) ProtoReflect() protoreflect.Message {
+func (x *GetRuleDefaults) ProtoReflect() protoreflect.Message {
 	mi := &file_plugin_proto_tfbreak_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -403,31 +613,31 @@ func (x *GetConfigSchema) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetConfigSchema.ProtoReflect.Descriptor instead.
-func (*GetConfigSchema) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetRuleDefaults.ProtoReflect.Descriptor instead.
+func (*GetRuleDefaults) Descriptor() ([]byte, []int) {
 	return file_plugin_proto_tfbreak_proto_rawDescGZIP(), []int{4}
 }
 
-type ApplyGlobalConfig struct {
+type GetVersionConstraint struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ApplyGlobalConfig) Reset() {
-	*x = ApplyGlobalConfig{}
+func (x *GetVersionConstraint) Reset() {
+	*x = GetVersionConstraint{}
 	mi := &file_plugin_proto_tfbreak_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ApplyGlobalConfig) String() string {
+func (x *GetVersionConstraint) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ApplyGlobalConfig) ProtoMessage() {}
+func (*GetVersionConstraint) ProtoMessage() {}
 
-func (x *ApplyGlobalConfig) ProtoReflect() protoreflect.Message {
+func (x *GetVersionConstraint) ProtoReflect() protoreflect.Message {
 	mi := &file_plugin_proto_tfbreak_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -439,31 +649,31 @@ func (x *ApplyGlobalConfig) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ApplyGlobalConfig.ProtoReflect.Descriptor instead.
-func (*ApplyGlobalConfig) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetVersionConstraint.ProtoReflect.Descriptor instead.
+func (*GetVersionConstraint) Descriptor() ([]byte, []int) {
 	return file_plugin_proto_tfbreak_proto_rawDescGZIP(), []int{5}
 }
 
-type ApplyConfig struct {
+type GetSDKVersion struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ApplyConfig) Reset() {
-	*x = ApplyConfig{}
+func (x *GetSDKVersion) Reset() {
+	*x = GetSDKVersion{}
 	mi := &file_plugin_proto_tfbreak_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ApplyConfig) String() string {
+func (x *GetSDKVersion) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ApplyConfig) ProtoMessage() {}
+func (*GetSDKVersion) ProtoMessage() {}
 
-func (x *ApplyConfig) ProtoReflect() protoreflect.Message {
+func (x *GetSDKVersion) ProtoReflect() protoreflect.Message {
 	mi := &file_plugin_proto_tfbreak_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -475,31 +685,31 @@ func (x *ApplyConfig) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ApplyConfig.ProtoReflect.Descriptor instead.
-func (*ApplyConfig) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetSDKVersion.ProtoReflect.Descriptor instead.
+func (*GetSDKVersion) Descriptor() ([]byte, []int) {
 	return file_plugin_proto_tfbreak_proto_rawDescGZIP(), []int{6}
 }
 
-type Check struct {
+type GetCapabilities struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Check) Reset() {
-	*x = Check{}
+func (x *GetCapabilities) Reset() {
+	*x = GetCapabilities{}
 	mi := &file_plugin_proto_tfbreak_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Check) String() string {
+func (x *GetCapabilities) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Check) ProtoMessage() {}
+func (*GetCapabilities) ProtoMessage() {}
 
-func (x *Check) ProtoReflect() protoreflect.Message {
+func (x *GetCapabilities) ProtoReflect() protoreflect.Message {
 	mi := &file_plugin_proto_tfbreak_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -511,31 +721,31 @@ func (x *Check) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Check.ProtoReflect.Descriptor instead.
-func (*Check) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetCapabilities.ProtoReflect.Descriptor instead.
+func (*GetCapabilities) Descriptor() ([]byte, []int) {
 	return file_plugin_proto_tfbreak_proto_rawDescGZIP(), []int{7}
 }
 
-type GetModuleContent struct {
+type GetConfigSchema struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetModuleContent) Reset() {
-	*x = GetModuleContent{}
+func (x *GetConfigSchema) Reset() {
+	*x = GetConfigSchema{}
 	mi := &file_plugin_proto_tfbreak_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetModuleContent) String() string {
+func (x *GetConfigSchema) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetModuleContent) ProtoMessage() {}
+func (*GetConfigSchema) ProtoMessage() {}
 
-func (x *GetModuleContent) ProtoReflect() protoreflect.Message {
+func (x *GetConfigSchema) ProtoReflect() protoreflect.Message {
 	mi := &file_plugin_proto_tfbreak_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -547,31 +757,31 @@ func (x *GetModuleContent) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetModuleContent.ProtoReflect.Descriptor instead.
-func (*GetModuleContent) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetConfigSchema.ProtoReflect.Descriptor instead.
+func (*GetConfigSchema) Descriptor() ([]byte, []int) {
 	return file_plugin_proto_tfbreak_proto_rawDescGZIP(), []int{8}
 }
 
-type GetResourceContent struct {
+type ApplyGlobalConfig struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetResourceContent) Reset() {
-	*x = GetResourceContent{}
+func (x *ApplyGlobalConfig) Reset() {
+	*x = ApplyGlobalConfig{}
 	mi := &file_plugin_proto_tfbreak_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetResourceContent) String() string {
+func (x *ApplyGlobalConfig) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetResourceContent) ProtoMessage() {}
+func (*ApplyGlobalConfig) ProtoMessage() {}
 
-func (x *GetResourceContent) ProtoReflect() protoreflect.Message {
+func (x *ApplyGlobalConfig) ProtoReflect() protoreflect.Message {
 	mi := &file_plugin_proto_tfbreak_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -583,31 +793,31 @@ func (x *GetResourceContent) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetResourceContent.ProtoReflect.Descriptor instead.
-func (*GetResourceContent) Descriptor() ([]byte, []int) {
+// Deprecated: Use ApplyGlobalConfig.ProtoReflect.Descriptor instead.
+func (*ApplyGlobalConfig) Descriptor() ([]byte, []int) {
 	return file_plugin_proto_tfbreak_proto_rawDescGZIP(), []int{9}
 }
 
-type EmitIssue struct {
+type ApplyConfig struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *EmitIssue) Reset() {
-	*x = EmitIssue{}
+func (x *ApplyConfig) Reset() {
+	*x = ApplyConfig{}
 	mi := &file_plugin_proto_tfbreak_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *EmitIssue) String() string {
+func (x *ApplyConfig) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EmitIssue) ProtoMessage() {}
+func (*ApplyConfig) ProtoMessage() {}
 
-func (x *EmitIssue) ProtoReflect() protoreflect.Message {
+func (x *ApplyConfig) ProtoReflect() protoreflect.Message {
 	mi := &file_plugin_proto_tfbreak_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -619,31 +829,31 @@ func (x *EmitIssue) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EmitIssue.ProtoReflect.Descriptor instead.
-func (*EmitIssue) Descriptor() ([]byte, []int) {
+// Deprecated: Use ApplyConfig.ProtoReflect.Descriptor instead.
+func (*ApplyConfig) Descriptor() ([]byte, []int) {
 	return file_plugin_proto_tfbreak_proto_rawDescGZIP(), []int{10}
 }
 
-type DecodeRuleConfig struct {
+type Check struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DecodeRuleConfig) Reset() {
-	*x = DecodeRuleConfig{}
+func (x *Check) Reset() {
+	*x = Check{}
 	mi := &file_plugin_proto_tfbreak_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DecodeRuleConfig) String() string {
+func (x *Check) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DecodeRuleConfig) ProtoMessage() {}
+func (*Check) ProtoMessage() {}
 
-func (x *DecodeRuleConfig) ProtoReflect() protoreflect.Message {
+func (x *Check) ProtoReflect() protoreflect.Message {
 	mi := &file_plugin_proto_tfbreak_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -655,36 +865,31 @@ func (x *DecodeRuleConfig) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DecodeRuleConfig.ProtoReflect.Descriptor instead.
-func (*DecodeRuleConfig) Descriptor() ([]byte, []int) {
+// Deprecated: Use Check.ProtoReflect.Descriptor instead.
+func (*Check) Descriptor() ([]byte, []int) {
 	return file_plugin_proto_tfbreak_proto_rawDescGZIP(), []int{11}
 }
 
-// Config represents global tfbreak configuration.
-type Config struct {
-	state             protoimpl.MessageState `protogen:"open.v1"`
-	Rules             map[string]*RuleConfig `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	DisabledByDefault bool                   `protobuf:"varint,2,opt,name=disabled_by_default,json=disabledByDefault,proto3" json:"disabled_by_default,omitempty"`
-	Only              []string               `protobuf:"bytes,3,rep,name=only,proto3" json:"only,omitempty"`
-	PluginDir         string                 `protobuf:"bytes,4,opt,name=plugin_dir,json=pluginDir,proto3" json:"plugin_dir,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+type CheckStream struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Config) Reset() {
-	*x = Config{}
+func (x *CheckStream) Reset() {
+	*x = CheckStream{}
 	mi := &file_plugin_proto_tfbreak_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Config) String() string {
+func (x *CheckStream) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Config) ProtoMessage() {}
+func (*CheckStream) ProtoMessage() {}
 
-func (x *Config) ProtoReflect() protoreflect.Message {
+func (x *CheckStream) ProtoReflect() protoreflect.Message {
 	mi := &file_plugin_proto_tfbreak_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -696,65 +901,145 @@ func (x *Config) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Config.ProtoReflect.Descriptor instead.
-func (*Config) Descriptor() ([]byte, []int) {
+// Deprecated: Use CheckStream.ProtoReflect.Descriptor instead.
+func (*CheckStream) Descriptor() ([]byte, []int) {
 	return file_plugin_proto_tfbreak_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *Config) GetRules() map[string]*RuleConfig {
+// Issue is a finding buffered by the plugin and returned from Check,
+// or streamed from CheckStream.
+type Issue struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Rule    *Rule                  `protobuf:"bytes,1,opt,name=rule,proto3" json:"rule,omitempty"`
+	Message string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Range   *Range                 `protobuf:"bytes,3,opt,name=range,proto3" json:"range,omitempty"`
+	Fix     *Fix                   `protobuf:"bytes,4,opt,name=fix,proto3" json:"fix,omitempty"`
+	// severity overrides the rule's severity for this issue when set.
+	Severity Severity `protobuf:"varint,5,opt,name=severity,proto3,enum=tfbreak.Severity" json:"severity,omitempty"`
+	// kind classifies the issue, or is unspecified if the rule did not.
+	Kind IssueKind `protobuf:"varint,6,opt,name=kind,proto3,enum=tfbreak.IssueKind" json:"kind,omitempty"`
+	// resource_address is the address of the resource the issue is about,
+	// or empty if the rule did not set one.
+	ResourceAddress string `protobuf:"bytes,7,opt,name=resource_address,json=resourceAddress,proto3" json:"resource_address,omitempty"`
+	// side is the configuration range refers to. Unspecified means NEW.
+	Side          Side `protobuf:"varint,8,opt,name=side,proto3,enum=tfbreak.Side" json:"side,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Issue) Reset() {
+	*x = Issue{}
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Issue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Issue) ProtoMessage() {}
+
+func (x *Issue) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[13]
 	if x != nil {
-		return x.Rules
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Issue.ProtoReflect.Descriptor instead.
+func (*Issue) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_tfbreak_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *Issue) GetRule() *Rule {
+	if x != nil {
+		return x.Rule
 	}
 	return nil
 }
 
-func (x *Config) GetDisabledByDefault() bool {
+func (x *Issue) GetMessage() string {
 	if x != nil {
-		return x.DisabledByDefault
+		return x.Message
 	}
-	return false
+	return ""
 }
 
-func (x *Config) GetOnly() []string {
+func (x *Issue) GetRange() *Range {
 	if x != nil {
-		return x.Only
+		return x.Range
 	}
 	return nil
 }
 
-func (x *Config) GetPluginDir() string {
+func (x *Issue) GetFix() *Fix {
 	if x != nil {
-		return x.PluginDir
+		return x.Fix
+	}
+	return nil
+}
+
+func (x *Issue) GetSeverity() Severity {
+	if x != nil {
+		return x.Severity
+	}
+	return Severity_SEVERITY_UNSPECIFIED
+}
+
+func (x *Issue) GetKind() IssueKind {
+	if x != nil {
+		return x.Kind
+	}
+	return IssueKind_ISSUE_KIND_UNSPECIFIED
+}
+
+func (x *Issue) GetResourceAddress() string {
+	if x != nil {
+		return x.ResourceAddress
 	}
 	return ""
 }
 
-// RuleConfig represents configuration for a single rule.
-type RuleConfig struct {
-	state   protoimpl.MessageState `protogen:"open.v1"`
-	Name    string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Enabled bool                   `protobuf:"varint,2,opt,name=enabled,proto3" json:"enabled,omitempty"`
-	// body_bytes contains JSON-encoded HCL body for rule-specific configuration.
-	BodyBytes     []byte `protobuf:"bytes,3,opt,name=body_bytes,json=bodyBytes,proto3" json:"body_bytes,omitempty"`
+func (x *Issue) GetSide() Side {
+	if x != nil {
+		return x.Side
+	}
+	return Side_SIDE_UNSPECIFIED
+}
+
+// RuleError describes the failure of a single rule during Check.
+type RuleError struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Rule     string                 `protobuf:"bytes,1,opt,name=rule,proto3" json:"rule,omitempty"`
+	Category ErrorCategory          `protobuf:"varint,2,opt,name=category,proto3,enum=tfbreak.ErrorCategory" json:"category,omitempty"`
+	Message  string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	// diagnostics are the HCL diagnostics the error was caused by, if any.
+	Diagnostics   []*Diagnostic `protobuf:"bytes,4,rep,name=diagnostics,proto3" json:"diagnostics,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RuleConfig) Reset() {
-	*x = RuleConfig{}
-	mi := &file_plugin_proto_tfbreak_proto_msgTypes[13]
+func (x *RuleError) Reset() {
+	*x = RuleError{}
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RuleConfig) String() string {
+func (x *RuleError) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RuleConfig) ProtoMessage() {}
+func (*RuleError) ProtoMessage() {}
 
-func (x *RuleConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_plugin_proto_tfbreak_proto_msgTypes[13]
+func (x *RuleError) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -765,58 +1050,60 @@ func (x *RuleConfig) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RuleConfig.ProtoReflect.Descriptor instead.
-func (*RuleConfig) Descriptor() ([]byte, []int) {
-	return file_plugin_proto_tfbreak_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use RuleError.ProtoReflect.Descriptor instead.
+func (*RuleError) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_tfbreak_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *RuleConfig) GetName() string {
+func (x *RuleError) GetRule() string {
 	if x != nil {
-		return x.Name
+		return x.Rule
 	}
 	return ""
 }
 
-func (x *RuleConfig) GetEnabled() bool {
+func (x *RuleError) GetCategory() ErrorCategory {
 	if x != nil {
-		return x.Enabled
+		return x.Category
 	}
-	return false
+	return ErrorCategory_ERROR_CATEGORY_UNSPECIFIED
+}
+
+func (x *RuleError) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
 }
 
-func (x *RuleConfig) GetBodyBytes() []byte {
+func (x *RuleError) GetDiagnostics() []*Diagnostic {
 	if x != nil {
-		return x.BodyBytes
+		return x.Diagnostics
 	}
 	return nil
 }
 
-// Rule represents a rule's metadata.
-type Rule struct {
+type GetModuleContent struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Enabled       bool                   `protobuf:"varint,2,opt,name=enabled,proto3" json:"enabled,omitempty"`
-	Severity      Severity               `protobuf:"varint,3,opt,name=severity,proto3,enum=tfbreak.Severity" json:"severity,omitempty"`
-	Link          string                 `protobuf:"bytes,4,opt,name=link,proto3" json:"link,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Rule) Reset() {
-	*x = Rule{}
-	mi := &file_plugin_proto_tfbreak_proto_msgTypes[14]
+func (x *GetModuleContent) Reset() {
+	*x = GetModuleContent{}
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Rule) String() string {
+func (x *GetModuleContent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Rule) ProtoMessage() {}
+func (*GetModuleContent) ProtoMessage() {}
 
-func (x *Rule) ProtoReflect() protoreflect.Message {
-	mi := &file_plugin_proto_tfbreak_proto_msgTypes[14]
+func (x *GetModuleContent) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -827,64 +1114,3651 @@ func (x *Rule) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Rule.ProtoReflect.Descriptor instead.
-func (*Rule) Descriptor() ([]byte, []int) {
-	return file_plugin_proto_tfbreak_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use GetModuleContent.ProtoReflect.Descriptor instead.
+func (*GetModuleContent) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_tfbreak_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *Rule) GetName() string {
+// GetResourceContent is shared by the resource and data source RPCs.
+type GetResourceContent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetResourceContent) Reset() {
+	*x = GetResourceContent{}
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetResourceContent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetResourceContent) ProtoMessage() {}
+
+func (x *GetResourceContent) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[16]
 	if x != nil {
-		return x.Name
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *Rule) GetEnabled() bool {
+// Deprecated: Use GetResourceContent.ProtoReflect.Descriptor instead.
+func (*GetResourceContent) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_tfbreak_proto_rawDescGZIP(), []int{16}
+}
+
+type GetResourceContentPair struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetResourceContentPair) Reset() {
+	*x = GetResourceContentPair{}
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetResourceContentPair) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetResourceContentPair) ProtoMessage() {}
+
+func (x *GetResourceContentPair) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[17]
 	if x != nil {
-		return x.Enabled
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return false
+	return mi.MessageOf(x)
 }
 
-func (x *Rule) GetSeverity() Severity {
+// Deprecated: Use GetResourceContentPair.ProtoReflect.Descriptor instead.
+func (*GetResourceContentPair) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_tfbreak_proto_rawDescGZIP(), []int{17}
+}
+
+type GetFile struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFile) Reset() {
+	*x = GetFile{}
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFile) ProtoMessage() {}
+
+func (x *GetFile) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[18]
 	if x != nil {
-		return x.Severity
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return Severity_SEVERITY_UNSPECIFIED
+	return mi.MessageOf(x)
 }
 
-func (x *Rule) GetLink() string {
+// Deprecated: Use GetFile.ProtoReflect.Descriptor instead.
+func (*GetFile) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_tfbreak_proto_rawDescGZIP(), []int{18}
+}
+
+type GetFileSource struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFileSource) Reset() {
+	*x = GetFileSource{}
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFileSource) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFileSource) ProtoMessage() {}
+
+func (x *GetFileSource) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[19]
 	if x != nil {
-		return x.Link
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-// BodySchema represents the expected structure of an HCL body.
-type BodySchema struct {
+// Deprecated: Use GetFileSource.ProtoReflect.Descriptor instead.
+func (*GetFileSource) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_tfbreak_proto_rawDescGZIP(), []int{19}
+}
+
+type ListFiles struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Attributes    []*AttributeSchema     `protobuf:"bytes,1,rep,name=attributes,proto3" json:"attributes,omitempty"`
-	Blocks        []*BlockSchema         `protobuf:"bytes,2,rep,name=blocks,proto3" json:"blocks,omitempty"`
-	Mode          SchemaMode             `protobuf:"varint,3,opt,name=mode,proto3,enum=tfbreak.SchemaMode" json:"mode,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *BodySchema) Reset() {
-	*x = BodySchema{}
-	mi := &file_plugin_proto_tfbreak_proto_msgTypes[15]
+func (x *ListFiles) Reset() {
+	*x = ListFiles{}
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFiles) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFiles) ProtoMessage() {}
+
+func (x *ListFiles) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFiles.ProtoReflect.Descriptor instead.
+func (*ListFiles) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_tfbreak_proto_rawDescGZIP(), []int{20}
+}
+
+type FileChanges struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FileChanges) Reset() {
+	*x = FileChanges{}
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FileChanges) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FileChanges) ProtoMessage() {}
+
+func (x *FileChanges) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FileChanges.ProtoReflect.Descriptor instead.
+func (*FileChanges) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_tfbreak_proto_rawDescGZIP(), []int{21}
+}
+
+type GetProviderRequirements struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProviderRequirements) Reset() {
+	*x = GetProviderRequirements{}
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProviderRequirements) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProviderRequirements) ProtoMessage() {}
+
+func (x *GetProviderRequirements) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProviderRequirements.ProtoReflect.Descriptor instead.
+func (*GetProviderRequirements) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_tfbreak_proto_rawDescGZIP(), []int{22}
+}
+
+type GetTerraformSettings struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTerraformSettings) Reset() {
+	*x = GetTerraformSettings{}
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTerraformSettings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTerraformSettings) ProtoMessage() {}
+
+func (x *GetTerraformSettings) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTerraformSettings.ProtoReflect.Descriptor instead.
+func (*GetTerraformSettings) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_tfbreak_proto_rawDescGZIP(), []int{23}
+}
+
+// TerraformSettings is the content of the terraform blocks of the root module.
+type TerraformSettings struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// required_version is empty when not declared.
+	RequiredVersion      string `protobuf:"bytes,1,opt,name=required_version,json=requiredVersion,proto3" json:"required_version,omitempty"`
+	RequiredVersionRange *Range `protobuf:"bytes,2,opt,name=required_version_range,json=requiredVersionRange,proto3" json:"required_version_range,omitempty"`
+	// backend is unset if the configuration has no backend block.
+	Backend *TerraformBackend `protobuf:"bytes,3,opt,name=backend,proto3" json:"backend,omitempty"`
+	// required_providers is keyed by the provider's local name.
+	RequiredProviders map[string]*ProviderRequirement `protobuf:"bytes,4,rep,name=required_providers,json=requiredProviders,proto3" json:"required_providers,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *TerraformSettings) Reset() {
+	*x = TerraformSettings{}
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TerraformSettings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TerraformSettings) ProtoMessage() {}
+
+func (x *TerraformSettings) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TerraformSettings.ProtoReflect.Descriptor instead.
+func (*TerraformSettings) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_tfbreak_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *TerraformSettings) GetRequiredVersion() string {
+	if x != nil {
+		return x.RequiredVersion
+	}
+	return ""
+}
+
+func (x *TerraformSettings) GetRequiredVersionRange() *Range {
+	if x != nil {
+		return x.RequiredVersionRange
+	}
+	return nil
+}
+
+func (x *TerraformSettings) GetBackend() *TerraformBackend {
+	if x != nil {
+		return x.Backend
+	}
+	return nil
+}
+
+func (x *TerraformSettings) GetRequiredProviders() map[string]*ProviderRequirement {
+	if x != nil {
+		return x.RequiredProviders
+	}
+	return nil
+}
+
+// TerraformBackend is a backend block nested in a terraform block.
+type TerraformBackend struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Body          *BodyContent           `protobuf:"bytes,2,opt,name=body,proto3" json:"body,omitempty"`
+	DeclRange     *Range                 `protobuf:"bytes,3,opt,name=decl_range,json=declRange,proto3" json:"decl_range,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TerraformBackend) Reset() {
+	*x = TerraformBackend{}
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TerraformBackend) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TerraformBackend) ProtoMessage() {}
+
+func (x *TerraformBackend) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TerraformBackend.ProtoReflect.Descriptor instead.
+func (*TerraformBackend) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_tfbreak_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *TerraformBackend) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *TerraformBackend) GetBody() *BodyContent {
+	if x != nil {
+		return x.Body
+	}
+	return nil
+}
+
+func (x *TerraformBackend) GetDeclRange() *Range {
+	if x != nil {
+		return x.DeclRange
+	}
+	return nil
+}
+
+type GetProviderConfig struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProviderConfig) Reset() {
+	*x = GetProviderConfig{}
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProviderConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProviderConfig) ProtoMessage() {}
+
+func (x *GetProviderConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProviderConfig.ProtoReflect.Descriptor instead.
+func (*GetProviderConfig) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_tfbreak_proto_rawDescGZIP(), []int{26}
+}
+
+// ProviderRequirement is an entry of a required_providers block.
+type ProviderRequirement struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Source            string                 `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	VersionConstraint string                 `protobuf:"bytes,2,opt,name=version_constraint,json=versionConstraint,proto3" json:"version_constraint,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ProviderRequirement) Reset() {
+	*x = ProviderRequirement{}
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProviderRequirement) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProviderRequirement) ProtoMessage() {}
+
+func (x *ProviderRequirement) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProviderRequirement.ProtoReflect.Descriptor instead.
+func (*ProviderRequirement) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_tfbreak_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ProviderRequirement) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *ProviderRequirement) GetVersionConstraint() string {
+	if x != nil {
+		return x.VersionConstraint
+	}
+	return ""
+}
+
+type GetVariables struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetVariables) Reset() {
+	*x = GetVariables{}
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetVariables) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVariables) ProtoMessage() {}
+
+func (x *GetVariables) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVariables.ProtoReflect.Descriptor instead.
+func (*GetVariables) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_tfbreak_proto_rawDescGZIP(), []int{28}
+}
+
+// VariableDef is a variable block.
+type VariableDef struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Name  string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// type contains the JSON-encoded cty.Type of the type constraint.
+	Type []byte `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	// default is unset when the variable declares no default.
+	Default       *Value `protobuf:"bytes,3,opt,name=default,proto3" json:"default,omitempty"`
+	Required      bool   `protobuf:"varint,4,opt,name=required,proto3" json:"required,omitempty"`
+	DeclRange     *Range `protobuf:"bytes,5,opt,name=decl_range,json=declRange,proto3" json:"decl_range,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VariableDef) Reset() {
+	*x = VariableDef{}
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VariableDef) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VariableDef) ProtoMessage() {}
+
+func (x *VariableDef) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VariableDef.ProtoReflect.Descriptor instead.
+func (*VariableDef) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_tfbreak_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *VariableDef) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *VariableDef) GetType() []byte {
+	if x != nil {
+		return x.Type
+	}
+	return nil
+}
+
+func (x *VariableDef) GetDefault() *Value {
+	if x != nil {
+		return x.Default
+	}
+	return nil
+}
+
+func (x *VariableDef) GetRequired() bool {
+	if x != nil {
+		return x.Required
+	}
+	return false
+}
+
+func (x *VariableDef) GetDeclRange() *Range {
+	if x != nil {
+		return x.DeclRange
+	}
+	return nil
+}
+
+type GetOutputs struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOutputs) Reset() {
+	*x = GetOutputs{}
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOutputs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOutputs) ProtoMessage() {}
+
+func (x *GetOutputs) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOutputs.ProtoReflect.Descriptor instead.
+func (*GetOutputs) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_tfbreak_proto_rawDescGZIP(), []int{30}
+}
+
+// OutputDef is an output block.
+type OutputDef struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Sensitive     bool                   `protobuf:"varint,3,opt,name=sensitive,proto3" json:"sensitive,omitempty"`
+	DeclRange     *Range                 `protobuf:"bytes,4,opt,name=decl_range,json=declRange,proto3" json:"decl_range,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OutputDef) Reset() {
+	*x = OutputDef{}
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OutputDef) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OutputDef) ProtoMessage() {}
+
+func (x *OutputDef) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OutputDef.ProtoReflect.Descriptor instead.
+func (*OutputDef) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_tfbreak_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *OutputDef) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *OutputDef) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *OutputDef) GetSensitive() bool {
+	if x != nil {
+		return x.Sensitive
+	}
+	return false
+}
+
+func (x *OutputDef) GetDeclRange() *Range {
+	if x != nil {
+		return x.DeclRange
+	}
+	return nil
+}
+
+type GetModuleCalls struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetModuleCalls) Reset() {
+	*x = GetModuleCalls{}
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetModuleCalls) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetModuleCalls) ProtoMessage() {}
+
+func (x *GetModuleCalls) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetModuleCalls.ProtoReflect.Descriptor instead.
+func (*GetModuleCalls) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_tfbreak_proto_rawDescGZIP(), []int{32}
+}
+
+// ModuleCall is a module block.
+type ModuleCall struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Name   string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Source string                 `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
+	// version is empty when the module call declares no version.
+	Version       string `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`
+	DeclRange     *Range `protobuf:"bytes,4,opt,name=decl_range,json=declRange,proto3" json:"decl_range,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ModuleCall) Reset() {
+	*x = ModuleCall{}
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ModuleCall) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModuleCall) ProtoMessage() {}
+
+func (x *ModuleCall) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModuleCall.ProtoReflect.Descriptor instead.
+func (*ModuleCall) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_tfbreak_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ModuleCall) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ModuleCall) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *ModuleCall) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *ModuleCall) GetDeclRange() *Range {
+	if x != nil {
+		return x.DeclRange
+	}
+	return nil
+}
+
+type GetLocals struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLocals) Reset() {
+	*x = GetLocals{}
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLocals) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLocals) ProtoMessage() {}
+
+func (x *GetLocals) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLocals.ProtoReflect.Descriptor instead.
+func (*GetLocals) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_tfbreak_proto_rawDescGZIP(), []int{34}
+}
+
+type GetAllResources struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAllResources) Reset() {
+	*x = GetAllResources{}
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAllResources) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAllResources) ProtoMessage() {}
+
+func (x *GetAllResources) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAllResources.ProtoReflect.Descriptor instead.
+func (*GetAllResources) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_tfbreak_proto_rawDescGZIP(), []int{35}
+}
+
+type ResourceAddresses struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResourceAddresses) Reset() {
+	*x = ResourceAddresses{}
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResourceAddresses) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResourceAddresses) ProtoMessage() {}
+
+func (x *ResourceAddresses) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResourceAddresses.ProtoReflect.Descriptor instead.
+func (*ResourceAddresses) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_tfbreak_proto_rawDescGZIP(), []int{36}
+}
+
+type GetMovedBlocks struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMovedBlocks) Reset() {
+	*x = GetMovedBlocks{}
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMovedBlocks) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMovedBlocks) ProtoMessage() {}
+
+func (x *GetMovedBlocks) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMovedBlocks.ProtoReflect.Descriptor instead.
+func (*GetMovedBlocks) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_tfbreak_proto_rawDescGZIP(), []int{37}
+}
+
+// MovedBlock is a `moved` block. Addresses are raw traversal strings.
+type MovedBlock struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	From          string                 `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To            string                 `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	DeclRange     *Range                 `protobuf:"bytes,3,opt,name=decl_range,json=declRange,proto3" json:"decl_range,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MovedBlock) Reset() {
+	*x = MovedBlock{}
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MovedBlock) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MovedBlock) ProtoMessage() {}
+
+func (x *MovedBlock) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MovedBlock.ProtoReflect.Descriptor instead.
+func (*MovedBlock) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_tfbreak_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *MovedBlock) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *MovedBlock) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *MovedBlock) GetDeclRange() *Range {
+	if x != nil {
+		return x.DeclRange
+	}
+	return nil
+}
+
+type EmitIssue struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EmitIssue) Reset() {
+	*x = EmitIssue{}
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EmitIssue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EmitIssue) ProtoMessage() {}
+
+func (x *EmitIssue) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EmitIssue.ProtoReflect.Descriptor instead.
+func (*EmitIssue) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_tfbreak_proto_rawDescGZIP(), []int{39}
+}
+
+type DecodeRuleConfig struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DecodeRuleConfig) Reset() {
+	*x = DecodeRuleConfig{}
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DecodeRuleConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DecodeRuleConfig) ProtoMessage() {}
+
+func (x *DecodeRuleConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DecodeRuleConfig.ProtoReflect.Descriptor instead.
+func (*DecodeRuleConfig) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_tfbreak_proto_rawDescGZIP(), []int{40}
+}
+
+// Config represents global tfbreak configuration.
+type Config struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Rules             map[string]*RuleConfig `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	DisabledByDefault bool                   `protobuf:"varint,2,opt,name=disabled_by_default,json=disabledByDefault,proto3" json:"disabled_by_default,omitempty"`
+	Only              []string               `protobuf:"bytes,3,rep,name=only,proto3" json:"only,omitempty"`
+	PluginDir         string                 `protobuf:"bytes,4,opt,name=plugin_dir,json=pluginDir,proto3" json:"plugin_dir,omitempty"`
+	// min_severity disables rules less severe than this level.
+	// SEVERITY_UNSPECIFIED applies no filtering.
+	MinSeverity Severity `protobuf:"varint,5,opt,name=min_severity,json=minSeverity,proto3,enum=tfbreak.Severity" json:"min_severity,omitempty"`
+	// variables contains host-level key/value settings for the plugin.
+	Variables map[string]*Value `protobuf:"bytes,6,rep,name=variables,proto3" json:"variables,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// ignore disables the rules matching any of these names or patterns.
+	Ignore        []string `protobuf:"bytes,7,rep,name=ignore,proto3" json:"ignore,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Config) Reset() {
+	*x = Config{}
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Config) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Config) ProtoMessage() {}
+
+func (x *Config) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Config.ProtoReflect.Descriptor instead.
+func (*Config) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_tfbreak_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *Config) GetRules() map[string]*RuleConfig {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+func (x *Config) GetDisabledByDefault() bool {
+	if x != nil {
+		return x.DisabledByDefault
+	}
+	return false
+}
+
+func (x *Config) GetOnly() []string {
+	if x != nil {
+		return x.Only
+	}
+	return nil
+}
+
+func (x *Config) GetPluginDir() string {
+	if x != nil {
+		return x.PluginDir
+	}
+	return ""
+}
+
+func (x *Config) GetMinSeverity() Severity {
+	if x != nil {
+		return x.MinSeverity
+	}
+	return Severity_SEVERITY_UNSPECIFIED
+}
+
+func (x *Config) GetVariables() map[string]*Value {
+	if x != nil {
+		return x.Variables
+	}
+	return nil
+}
+
+func (x *Config) GetIgnore() []string {
+	if x != nil {
+		return x.Ignore
+	}
+	return nil
+}
+
+// Value represents a cty.Value serialized as JSON along with its type.
+type Value struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// value contains the JSON-encoded value.
+	Value []byte `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	// type contains the JSON-encoded cty.Type of value.
+	Type          []byte `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Value) Reset() {
+	*x = Value{}
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Value) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Value) ProtoMessage() {}
+
+func (x *Value) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Value.ProtoReflect.Descriptor instead.
+func (*Value) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_tfbreak_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *Value) GetValue() []byte {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+func (x *Value) GetType() []byte {
+	if x != nil {
+		return x.Type
+	}
+	return nil
+}
+
+// RuleConfig represents configuration for a single rule.
+type RuleConfig struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Name    string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Enabled bool                   `protobuf:"varint,2,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// config_bytes contains the rule-specific configuration body encoded as a
+	// JSON object, so the plugin can decode it without a DecodeRuleConfig
+	// callback. Empty if the body is unset or cannot be evaluated statically.
+	ConfigBytes []byte `protobuf:"bytes,3,opt,name=config_bytes,json=configBytes,proto3" json:"config_bytes,omitempty"`
+	// severity overrides the rule's default severity.
+	// SEVERITY_UNSPECIFIED means no override.
+	Severity      Severity `protobuf:"varint,4,opt,name=severity,proto3,enum=tfbreak.Severity" json:"severity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RuleConfig) Reset() {
+	*x = RuleConfig{}
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RuleConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RuleConfig) ProtoMessage() {}
+
+func (x *RuleConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RuleConfig.ProtoReflect.Descriptor instead.
+func (*RuleConfig) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_tfbreak_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *RuleConfig) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RuleConfig) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *RuleConfig) GetConfigBytes() []byte {
+	if x != nil {
+		return x.ConfigBytes
+	}
+	return nil
+}
+
+func (x *RuleConfig) GetSeverity() Severity {
+	if x != nil {
+		return x.Severity
+	}
+	return Severity_SEVERITY_UNSPECIFIED
+}
+
+// Rule represents a rule's metadata.
+type Rule struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Enabled       bool                   `protobuf:"varint,2,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	Severity      Severity               `protobuf:"varint,3,opt,name=severity,proto3,enum=tfbreak.Severity" json:"severity,omitempty"`
+	Link          string                 `protobuf:"bytes,4,opt,name=link,proto3" json:"link,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Rule) Reset() {
+	*x = Rule{}
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Rule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Rule) ProtoMessage() {}
+
+func (x *Rule) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Rule.ProtoReflect.Descriptor instead.
+func (*Rule) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_tfbreak_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *Rule) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Rule) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *Rule) GetSeverity() Severity {
+	if x != nil {
+		return x.Severity
+	}
+	return Severity_SEVERITY_UNSPECIFIED
+}
+
+func (x *Rule) GetLink() string {
+	if x != nil {
+		return x.Link
+	}
+	return ""
+}
+
+// RuleMetadata represents optional metadata declared by a rule.
+type RuleMetadata struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// resource_types lists the resource types the rule inspects.
+	// Empty means the rule is not scoped and must always run.
+	ResourceTypes []string `protobuf:"bytes,1,rep,name=resource_types,json=resourceTypes,proto3" json:"resource_types,omitempty"`
+	// description is a human-readable description of the rule.
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// tags are labels used to group the rule (e.g., "force-new").
+	Tags []string `protobuf:"bytes,3,rep,name=tags,proto3" json:"tags,omitempty"`
+	// deprecated is set when the rule is superseded and will be removed.
+	Deprecated bool `protobuf:"varint,4,opt,name=deprecated,proto3" json:"deprecated,omitempty"`
+	// replaced_by is the name of the rule replacing a deprecated rule.
+	// Empty if there is no replacement.
+	ReplacedBy    string `protobuf:"bytes,5,opt,name=replaced_by,json=replacedBy,proto3" json:"replaced_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RuleMetadata) Reset() {
+	*x = RuleMetadata{}
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RuleMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RuleMetadata) ProtoMessage() {}
+
+func (x *RuleMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RuleMetadata.ProtoReflect.Descriptor instead.
+func (*RuleMetadata) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_tfbreak_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *RuleMetadata) GetResourceTypes() []string {
+	if x != nil {
+		return x.ResourceTypes
+	}
+	return nil
+}
+
+func (x *RuleMetadata) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *RuleMetadata) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *RuleMetadata) GetDeprecated() bool {
+	if x != nil {
+		return x.Deprecated
+	}
+	return false
+}
+
+func (x *RuleMetadata) GetReplacedBy() string {
+	if x != nil {
+		return x.ReplacedBy
+	}
+	return ""
+}
+
+// Fix represents a suggested remediation for an issue.
+type Fix struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Edits         []*TextEdit            `protobuf:"bytes,1,rep,name=edits,proto3" json:"edits,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Fix) Reset() {
+	*x = Fix{}
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Fix) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Fix) ProtoMessage() {}
+
+func (x *Fix) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Fix.ProtoReflect.Descriptor instead.
+func (*Fix) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_tfbreak_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *Fix) GetEdits() []*TextEdit {
+	if x != nil {
+		return x.Edits
+	}
+	return nil
+}
+
+// TextEdit replaces the source within a range with new text.
+type TextEdit struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Range         *Range                 `protobuf:"bytes,1,opt,name=range,proto3" json:"range,omitempty"`
+	NewText       string                 `protobuf:"bytes,2,opt,name=new_text,json=newText,proto3" json:"new_text,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TextEdit) Reset() {
+	*x = TextEdit{}
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TextEdit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TextEdit) ProtoMessage() {}
+
+func (x *TextEdit) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TextEdit.ProtoReflect.Descriptor instead.
+func (*TextEdit) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_tfbreak_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *TextEdit) GetRange() *Range {
+	if x != nil {
+		return x.Range
+	}
+	return nil
+}
+
+func (x *TextEdit) GetNewText() string {
+	if x != nil {
+		return x.NewText
+	}
+	return ""
+}
+
+// BodySchema represents the expected structure of an HCL body.
+type BodySchema struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Attributes    []*AttributeSchema     `protobuf:"bytes,1,rep,name=attributes,proto3" json:"attributes,omitempty"`
+	Blocks        []*BlockSchema         `protobuf:"bytes,2,rep,name=blocks,proto3" json:"blocks,omitempty"`
+	Mode          SchemaMode             `protobuf:"varint,3,opt,name=mode,proto3,enum=tfbreak.SchemaMode" json:"mode,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BodySchema) Reset() {
+	*x = BodySchema{}
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BodySchema) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BodySchema) ProtoMessage() {}
+
+func (x *BodySchema) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BodySchema.ProtoReflect.Descriptor instead.
+func (*BodySchema) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_tfbreak_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *BodySchema) GetAttributes() []*AttributeSchema {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+func (x *BodySchema) GetBlocks() []*BlockSchema {
+	if x != nil {
+		return x.Blocks
+	}
+	return nil
+}
+
+func (x *BodySchema) GetMode() SchemaMode {
+	if x != nil {
+		return x.Mode
+	}
+	return SchemaMode_SCHEMA_MODE_DEFAULT
+}
+
+// AttributeSchema represents an expected HCL attribute.
+type AttributeSchema struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Required      bool                   `protobuf:"varint,2,opt,name=required,proto3" json:"required,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AttributeSchema) Reset() {
+	*x = AttributeSchema{}
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AttributeSchema) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttributeSchema) ProtoMessage() {}
+
+func (x *AttributeSchema) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttributeSchema.ProtoReflect.Descriptor instead.
+func (*AttributeSchema) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_tfbreak_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *AttributeSchema) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AttributeSchema) GetRequired() bool {
+	if x != nil {
+		return x.Required
+	}
+	return false
+}
+
+// BlockSchema represents an expected HCL block.
+type BlockSchema struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	LabelNames    []string               `protobuf:"bytes,2,rep,name=label_names,json=labelNames,proto3" json:"label_names,omitempty"`
+	Body          *BodySchema            `protobuf:"bytes,3,opt,name=body,proto3" json:"body,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BlockSchema) Reset() {
+	*x = BlockSchema{}
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BlockSchema) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockSchema) ProtoMessage() {}
+
+func (x *BlockSchema) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockSchema.ProtoReflect.Descriptor instead.
+func (*BlockSchema) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_tfbreak_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *BlockSchema) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *BlockSchema) GetLabelNames() []string {
+	if x != nil {
+		return x.LabelNames
+	}
+	return nil
+}
+
+func (x *BlockSchema) GetBody() *BodySchema {
+	if x != nil {
+		return x.Body
+	}
+	return nil
+}
+
+// BodyContent represents extracted content from an HCL body.
+type BodyContent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Attributes    map[string]*Attribute  `protobuf:"bytes,1,rep,name=attributes,proto3" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Blocks        []*Block               `protobuf:"bytes,2,rep,name=blocks,proto3" json:"blocks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BodyContent) Reset() {
+	*x = BodyContent{}
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BodyContent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BodyContent) ProtoMessage() {}
+
+func (x *BodyContent) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BodyContent.ProtoReflect.Descriptor instead.
+func (*BodyContent) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_tfbreak_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *BodyContent) GetAttributes() map[string]*Attribute {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+func (x *BodyContent) GetBlocks() []*Block {
+	if x != nil {
+		return x.Blocks
+	}
+	return nil
+}
+
+// Attribute represents an extracted HCL attribute.
+type Attribute struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Name  string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// expr_bytes contains the raw source text of the expression.
+	// We use bytes because hcl.Expression is not directly serializable.
+	ExprBytes []byte `protobuf:"bytes,2,opt,name=expr_bytes,json=exprBytes,proto3" json:"expr_bytes,omitempty"`
+	Range     *Range `protobuf:"bytes,3,opt,name=range,proto3" json:"range,omitempty"`
+	NameRange *Range `protobuf:"bytes,4,opt,name=name_range,json=nameRange,proto3" json:"name_range,omitempty"`
+	// expr_value contains the evaluated value as JSON when available.
+	ExprValue []byte `protobuf:"bytes,5,opt,name=expr_value,json=exprValue,proto3" json:"expr_value,omitempty"`
+	// expr_type contains the JSON-encoded cty.Type of expr_value.
+	// This allows the value to be decoded with its exact original type.
+	ExprType []byte `protobuf:"bytes,6,opt,name=expr_type,json=exprType,proto3" json:"expr_type,omitempty"`
+	// item_ranges maps each key of an object constructor expression to the
+	// range of its key/value pair. Empty for other expressions.
+	ItemRanges map[string]*Range `protobuf:"bytes,7,rep,name=item_ranges,json=itemRanges,proto3" json:"item_ranges,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// is_null is set when the attribute is present and evaluates to null.
+	// expr_type then holds the type of the null value and expr_value is empty.
+	IsNull bool `protobuf:"varint,8,opt,name=is_null,json=isNull,proto3" json:"is_null,omitempty"`
+	// is_unknown is set when the value cannot be determined, e.g. because the
+	// expression references a variable. expr_type then holds the type of the
+	// value if known and expr_value is empty.
+	IsUnknown bool `protobuf:"varint,9,opt,name=is_unknown,json=isUnknown,proto3" json:"is_unknown,omitempty"`
+	// references lists the variables and objects referenced by the
+	// expression, such as "var.region", in source order.
+	References    []string `protobuf:"bytes,10,rep,name=references,proto3" json:"references,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Attribute) Reset() {
+	*x = Attribute{}
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Attribute) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Attribute) ProtoMessage() {}
+
+func (x *Attribute) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Attribute.ProtoReflect.Descriptor instead.
+func (*Attribute) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_tfbreak_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *Attribute) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Attribute) GetExprBytes() []byte {
+	if x != nil {
+		return x.ExprBytes
+	}
+	return nil
+}
+
+func (x *Attribute) GetRange() *Range {
+	if x != nil {
+		return x.Range
+	}
+	return nil
+}
+
+func (x *Attribute) GetNameRange() *Range {
+	if x != nil {
+		return x.NameRange
+	}
+	return nil
+}
+
+func (x *Attribute) GetExprValue() []byte {
+	if x != nil {
+		return x.ExprValue
+	}
+	return nil
+}
+
+func (x *Attribute) GetExprType() []byte {
+	if x != nil {
+		return x.ExprType
+	}
+	return nil
+}
+
+func (x *Attribute) GetItemRanges() map[string]*Range {
+	if x != nil {
+		return x.ItemRanges
+	}
+	return nil
+}
+
+func (x *Attribute) GetIsNull() bool {
+	if x != nil {
+		return x.IsNull
+	}
+	return false
+}
+
+func (x *Attribute) GetIsUnknown() bool {
+	if x != nil {
+		return x.IsUnknown
+	}
+	return false
+}
+
+func (x *Attribute) GetReferences() []string {
+	if x != nil {
+		return x.References
+	}
+	return nil
+}
+
+// Block represents an extracted HCL block.
+type Block struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Type        string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Labels      []string               `protobuf:"bytes,2,rep,name=labels,proto3" json:"labels,omitempty"`
+	Body        *BodyContent           `protobuf:"bytes,3,opt,name=body,proto3" json:"body,omitempty"`
+	DefRange    *Range                 `protobuf:"bytes,4,opt,name=def_range,json=defRange,proto3" json:"def_range,omitempty"`
+	TypeRange   *Range                 `protobuf:"bytes,5,opt,name=type_range,json=typeRange,proto3" json:"type_range,omitempty"`
+	LabelRanges []*Range               `protobuf:"bytes,6,rep,name=label_ranges,json=labelRanges,proto3" json:"label_ranges,omitempty"`
+	// leading_comments are the comments directly above the block, one per comment.
+	LeadingComments []string `protobuf:"bytes,7,rep,name=leading_comments,json=leadingComments,proto3" json:"leading_comments,omitempty"`
+	// module_path lists the module call names from the root module to the
+	// module declaring the block. Empty for the root module.
+	ModulePath    []string `protobuf:"bytes,8,rep,name=module_path,json=modulePath,proto3" json:"module_path,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Block) Reset() {
+	*x = Block{}
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Block) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Block) ProtoMessage() {}
+
+func (x *Block) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Block.ProtoReflect.Descriptor instead.
+func (*Block) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_tfbreak_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *Block) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Block) GetLabels() []string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *Block) GetBody() *BodyContent {
+	if x != nil {
+		return x.Body
+	}
+	return nil
+}
+
+func (x *Block) GetDefRange() *Range {
+	if x != nil {
+		return x.DefRange
+	}
+	return nil
+}
+
+func (x *Block) GetTypeRange() *Range {
+	if x != nil {
+		return x.TypeRange
+	}
+	return nil
+}
+
+func (x *Block) GetLabelRanges() []*Range {
+	if x != nil {
+		return x.LabelRanges
+	}
+	return nil
+}
+
+func (x *Block) GetLeadingComments() []string {
+	if x != nil {
+		return x.LeadingComments
+	}
+	return nil
+}
+
+func (x *Block) GetModulePath() []string {
+	if x != nil {
+		return x.ModulePath
+	}
+	return nil
+}
+
+// Range represents a source code range.
+type Range struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Filename      string                 `protobuf:"bytes,1,opt,name=filename,proto3" json:"filename,omitempty"`
+	Start         *Position              `protobuf:"bytes,2,opt,name=start,proto3" json:"start,omitempty"`
+	End           *Position              `protobuf:"bytes,3,opt,name=end,proto3" json:"end,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Range) Reset() {
+	*x = Range{}
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Range) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Range) ProtoMessage() {}
+
+func (x *Range) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Range.ProtoReflect.Descriptor instead.
+func (*Range) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_tfbreak_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *Range) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *Range) GetStart() *Position {
+	if x != nil {
+		return x.Start
+	}
+	return nil
+}
+
+func (x *Range) GetEnd() *Position {
+	if x != nil {
+		return x.End
+	}
+	return nil
+}
+
+// Diagnostic is an HCL diagnostic, with the severity and ranges the host
+// needs to render it the way Terraform does.
+type Diagnostic struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Severity      DiagnosticSeverity     `protobuf:"varint,1,opt,name=severity,proto3,enum=tfbreak.DiagnosticSeverity" json:"severity,omitempty"`
+	Summary       string                 `protobuf:"bytes,2,opt,name=summary,proto3" json:"summary,omitempty"`
+	Detail        string                 `protobuf:"bytes,3,opt,name=detail,proto3" json:"detail,omitempty"`
+	Subject       *Range                 `protobuf:"bytes,4,opt,name=subject,proto3" json:"subject,omitempty"`
+	Context       *Range                 `protobuf:"bytes,5,opt,name=context,proto3" json:"context,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Diagnostic) Reset() {
+	*x = Diagnostic{}
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Diagnostic) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Diagnostic) ProtoMessage() {}
+
+func (x *Diagnostic) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Diagnostic.ProtoReflect.Descriptor instead.
+func (*Diagnostic) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_tfbreak_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *Diagnostic) GetSeverity() DiagnosticSeverity {
+	if x != nil {
+		return x.Severity
+	}
+	return DiagnosticSeverity_DIAGNOSTIC_SEVERITY_UNSPECIFIED
+}
+
+func (x *Diagnostic) GetSummary() string {
+	if x != nil {
+		return x.Summary
+	}
+	return ""
+}
+
+func (x *Diagnostic) GetDetail() string {
+	if x != nil {
+		return x.Detail
+	}
+	return ""
+}
+
+func (x *Diagnostic) GetSubject() *Range {
+	if x != nil {
+		return x.Subject
+	}
+	return nil
+}
+
+func (x *Diagnostic) GetContext() *Range {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+// Diagnostics is attached as a gRPC status detail to Runner errors that
+// were caused by HCL diagnostics, so they survive the callback.
+type Diagnostics struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Diagnostics   []*Diagnostic          `protobuf:"bytes,1,rep,name=diagnostics,proto3" json:"diagnostics,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Diagnostics) Reset() {
+	*x = Diagnostics{}
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Diagnostics) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Diagnostics) ProtoMessage() {}
+
+func (x *Diagnostics) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Diagnostics.ProtoReflect.Descriptor instead.
+func (*Diagnostics) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_tfbreak_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *Diagnostics) GetDiagnostics() []*Diagnostic {
+	if x != nil {
+		return x.Diagnostics
+	}
+	return nil
+}
+
+// Position represents a position in source code.
+type Position struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Line          int64                  `protobuf:"varint,1,opt,name=line,proto3" json:"line,omitempty"`
+	Column        int64                  `protobuf:"varint,2,opt,name=column,proto3" json:"column,omitempty"`
+	Byte          int64                  `protobuf:"varint,3,opt,name=byte,proto3" json:"byte,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Position) Reset() {
+	*x = Position{}
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Position) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Position) ProtoMessage() {}
+
+func (x *Position) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Position.ProtoReflect.Descriptor instead.
+func (*Position) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_tfbreak_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *Position) GetLine() int64 {
+	if x != nil {
+		return x.Line
+	}
+	return 0
+}
+
+func (x *Position) GetColumn() int64 {
+	if x != nil {
+		return x.Column
+	}
+	return 0
+}
+
+func (x *Position) GetByte() int64 {
+	if x != nil {
+		return x.Byte
+	}
+	return 0
+}
+
+// GetModuleContentOption configures how content is retrieved.
+type GetModuleContentOption struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	ModuleCtx        ModuleCtxType          `protobuf:"varint,1,opt,name=module_ctx,json=moduleCtx,proto3,enum=tfbreak.ModuleCtxType" json:"module_ctx,omitempty"`
+	ExpandMode       ExpandMode             `protobuf:"varint,2,opt,name=expand_mode,json=expandMode,proto3,enum=tfbreak.ExpandMode" json:"expand_mode,omitempty"`
+	ResourceTypeHint string                 `protobuf:"bytes,3,opt,name=resource_type_hint,json=resourceTypeHint,proto3" json:"resource_type_hint,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *GetModuleContentOption) Reset() {
+	*x = GetModuleContentOption{}
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetModuleContentOption) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetModuleContentOption) ProtoMessage() {}
+
+func (x *GetModuleContentOption) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetModuleContentOption.ProtoReflect.Descriptor instead.
+func (*GetModuleContentOption) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_tfbreak_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *GetModuleContentOption) GetModuleCtx() ModuleCtxType {
+	if x != nil {
+		return x.ModuleCtx
+	}
+	return ModuleCtxType_MODULE_CTX_SELF
+}
+
+func (x *GetModuleContentOption) GetExpandMode() ExpandMode {
+	if x != nil {
+		return x.ExpandMode
+	}
+	return ExpandMode_EXPAND_MODE_NONE
+}
+
+func (x *GetModuleContentOption) GetResourceTypeHint() string {
+	if x != nil {
+		return x.ResourceTypeHint
+	}
+	return ""
+}
+
+type GetRuleSetName_Request struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRuleSetName_Request) Reset() {
+	*x = GetRuleSetName_Request{}
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRuleSetName_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRuleSetName_Request) ProtoMessage() {}
+
+func (x *GetRuleSetName_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRuleSetName_Request.ProtoReflect.Descriptor instead.
+func (*GetRuleSetName_Request) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_tfbreak_proto_rawDescGZIP(), []int{0, 0}
+}
+
+type GetRuleSetName_Response struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRuleSetName_Response) Reset() {
+	*x = GetRuleSetName_Response{}
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRuleSetName_Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRuleSetName_Response) ProtoMessage() {}
+
+func (x *GetRuleSetName_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRuleSetName_Response.ProtoReflect.Descriptor instead.
+func (*GetRuleSetName_Response) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_tfbreak_proto_rawDescGZIP(), []int{0, 1}
+}
+
+func (x *GetRuleSetName_Response) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type GetRuleSetVersion_Request struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRuleSetVersion_Request) Reset() {
+	*x = GetRuleSetVersion_Request{}
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRuleSetVersion_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRuleSetVersion_Request) ProtoMessage() {}
+
+func (x *GetRuleSetVersion_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRuleSetVersion_Request.ProtoReflect.Descriptor instead.
+func (*GetRuleSetVersion_Request) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_tfbreak_proto_rawDescGZIP(), []int{1, 0}
+}
+
+type GetRuleSetVersion_Response struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Version       string                 `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRuleSetVersion_Response) Reset() {
+	*x = GetRuleSetVersion_Response{}
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRuleSetVersion_Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRuleSetVersion_Response) ProtoMessage() {}
+
+func (x *GetRuleSetVersion_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRuleSetVersion_Response.ProtoReflect.Descriptor instead.
+func (*GetRuleSetVersion_Response) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_tfbreak_proto_rawDescGZIP(), []int{1, 1}
+}
+
+func (x *GetRuleSetVersion_Response) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+type GetRuleNames_Request struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRuleNames_Request) Reset() {
+	*x = GetRuleNames_Request{}
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRuleNames_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRuleNames_Request) ProtoMessage() {}
+
+func (x *GetRuleNames_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRuleNames_Request.ProtoReflect.Descriptor instead.
+func (*GetRuleNames_Request) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_tfbreak_proto_rawDescGZIP(), []int{2, 0}
+}
+
+type GetRuleNames_Response struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Names         []string               `protobuf:"bytes,1,rep,name=names,proto3" json:"names,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRuleNames_Response) Reset() {
+	*x = GetRuleNames_Response{}
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRuleNames_Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRuleNames_Response) ProtoMessage() {}
+
+func (x *GetRuleNames_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRuleNames_Response.ProtoReflect.Descriptor instead.
+func (*GetRuleNames_Response) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_tfbreak_proto_rawDescGZIP(), []int{2, 1}
+}
+
+func (x *GetRuleNames_Response) GetNames() []string {
+	if x != nil {
+		return x.Names
+	}
+	return nil
+}
+
+type GetRuleMetadata_Request struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRuleMetadata_Request) Reset() {
+	*x = GetRuleMetadata_Request{}
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRuleMetadata_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRuleMetadata_Request) ProtoMessage() {}
+
+func (x *GetRuleMetadata_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRuleMetadata_Request.ProtoReflect.Descriptor instead.
+func (*GetRuleMetadata_Request) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_tfbreak_proto_rawDescGZIP(), []int{3, 0}
+}
+
+type GetRuleMetadata_Response struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Rules         map[string]*RuleMetadata `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRuleMetadata_Response) Reset() {
+	*x = GetRuleMetadata_Response{}
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRuleMetadata_Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRuleMetadata_Response) ProtoMessage() {}
+
+func (x *GetRuleMetadata_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRuleMetadata_Response.ProtoReflect.Descriptor instead.
+func (*GetRuleMetadata_Response) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_tfbreak_proto_rawDescGZIP(), []int{3, 1}
+}
+
+func (x *GetRuleMetadata_Response) GetRules() map[string]*RuleMetadata {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+type GetRuleDefaults_Request struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRuleDefaults_Request) Reset() {
+	*x = GetRuleDefaults_Request{}
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRuleDefaults_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRuleDefaults_Request) ProtoMessage() {}
+
+func (x *GetRuleDefaults_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRuleDefaults_Request.ProtoReflect.Descriptor instead.
+func (*GetRuleDefaults_Request) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_tfbreak_proto_rawDescGZIP(), []int{4, 0}
+}
+
+type GetRuleDefaults_Response struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// rules are in the order the ruleset declares them.
+	Rules         []*Rule `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRuleDefaults_Response) Reset() {
+	*x = GetRuleDefaults_Response{}
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRuleDefaults_Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRuleDefaults_Response) ProtoMessage() {}
+
+func (x *GetRuleDefaults_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRuleDefaults_Response.ProtoReflect.Descriptor instead.
+func (*GetRuleDefaults_Response) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_tfbreak_proto_rawDescGZIP(), []int{4, 1}
+}
+
+func (x *GetRuleDefaults_Response) GetRules() []*Rule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+type GetVersionConstraint_Request struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetVersionConstraint_Request) Reset() {
+	*x = GetVersionConstraint_Request{}
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetVersionConstraint_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVersionConstraint_Request) ProtoMessage() {}
+
+func (x *GetVersionConstraint_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVersionConstraint_Request.ProtoReflect.Descriptor instead.
+func (*GetVersionConstraint_Request) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_tfbreak_proto_rawDescGZIP(), []int{5, 0}
+}
+
+type GetVersionConstraint_Response struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Constraint    string                 `protobuf:"bytes,1,opt,name=constraint,proto3" json:"constraint,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetVersionConstraint_Response) Reset() {
+	*x = GetVersionConstraint_Response{}
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetVersionConstraint_Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVersionConstraint_Response) ProtoMessage() {}
+
+func (x *GetVersionConstraint_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVersionConstraint_Response.ProtoReflect.Descriptor instead.
+func (*GetVersionConstraint_Response) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_tfbreak_proto_rawDescGZIP(), []int{5, 1}
+}
+
+func (x *GetVersionConstraint_Response) GetConstraint() string {
+	if x != nil {
+		return x.Constraint
+	}
+	return ""
+}
+
+type GetSDKVersion_Request struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSDKVersion_Request) Reset() {
+	*x = GetSDKVersion_Request{}
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSDKVersion_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSDKVersion_Request) ProtoMessage() {}
+
+func (x *GetSDKVersion_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSDKVersion_Request.ProtoReflect.Descriptor instead.
+func (*GetSDKVersion_Request) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_tfbreak_proto_rawDescGZIP(), []int{6, 0}
+}
+
+type GetSDKVersion_Response struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Version            string                 `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+	ProtocolVersion    int32                  `protobuf:"varint,2,opt,name=protocol_version,json=protocolVersion,proto3" json:"protocol_version,omitempty"`
+	MinProtocolVersion int32                  `protobuf:"varint,3,opt,name=min_protocol_version,json=minProtocolVersion,proto3" json:"min_protocol_version,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *GetSDKVersion_Response) Reset() {
+	*x = GetSDKVersion_Response{}
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSDKVersion_Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSDKVersion_Response) ProtoMessage() {}
+
+func (x *GetSDKVersion_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSDKVersion_Response.ProtoReflect.Descriptor instead.
+func (*GetSDKVersion_Response) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_tfbreak_proto_rawDescGZIP(), []int{6, 1}
+}
+
+func (x *GetSDKVersion_Response) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *GetSDKVersion_Response) GetProtocolVersion() int32 {
+	if x != nil {
+		return x.ProtocolVersion
+	}
+	return 0
+}
+
+func (x *GetSDKVersion_Response) GetMinProtocolVersion() int32 {
+	if x != nil {
+		return x.MinProtocolVersion
+	}
+	return 0
+}
+
+type GetCapabilities_Request struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCapabilities_Request) Reset() {
+	*x = GetCapabilities_Request{}
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCapabilities_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCapabilities_Request) ProtoMessage() {}
+
+func (x *GetCapabilities_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCapabilities_Request.ProtoReflect.Descriptor instead.
+func (*GetCapabilities_Request) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_tfbreak_proto_rawDescGZIP(), []int{7, 0}
+}
+
+type GetCapabilities_Response struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// capabilities are the names of the supported features, sorted.
+	Capabilities  []string `protobuf:"bytes,1,rep,name=capabilities,proto3" json:"capabilities,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCapabilities_Response) Reset() {
+	*x = GetCapabilities_Response{}
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCapabilities_Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCapabilities_Response) ProtoMessage() {}
+
+func (x *GetCapabilities_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCapabilities_Response.ProtoReflect.Descriptor instead.
+func (*GetCapabilities_Response) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_tfbreak_proto_rawDescGZIP(), []int{7, 1}
+}
+
+func (x *GetCapabilities_Response) GetCapabilities() []string {
+	if x != nil {
+		return x.Capabilities
+	}
+	return nil
+}
+
+type GetConfigSchema_Request struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetConfigSchema_Request) Reset() {
+	*x = GetConfigSchema_Request{}
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetConfigSchema_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetConfigSchema_Request) ProtoMessage() {}
+
+func (x *GetConfigSchema_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetConfigSchema_Request.ProtoReflect.Descriptor instead.
+func (*GetConfigSchema_Request) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_tfbreak_proto_rawDescGZIP(), []int{8, 0}
+}
+
+type GetConfigSchema_Response struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Schema        *BodySchema            `protobuf:"bytes,1,opt,name=schema,proto3" json:"schema,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetConfigSchema_Response) Reset() {
+	*x = GetConfigSchema_Response{}
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetConfigSchema_Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetConfigSchema_Response) ProtoMessage() {}
+
+func (x *GetConfigSchema_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetConfigSchema_Response.ProtoReflect.Descriptor instead.
+func (*GetConfigSchema_Response) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_tfbreak_proto_rawDescGZIP(), []int{8, 1}
+}
+
+func (x *GetConfigSchema_Response) GetSchema() *BodySchema {
+	if x != nil {
+		return x.Schema
+	}
+	return nil
+}
+
+type ApplyGlobalConfig_Request struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Config        *Config                `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApplyGlobalConfig_Request) Reset() {
+	*x = ApplyGlobalConfig_Request{}
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApplyGlobalConfig_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyGlobalConfig_Request) ProtoMessage() {}
+
+func (x *ApplyGlobalConfig_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyGlobalConfig_Request.ProtoReflect.Descriptor instead.
+func (*ApplyGlobalConfig_Request) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_tfbreak_proto_rawDescGZIP(), []int{9, 0}
+}
+
+func (x *ApplyGlobalConfig_Request) GetConfig() *Config {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+type ApplyGlobalConfig_Response struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApplyGlobalConfig_Response) Reset() {
+	*x = ApplyGlobalConfig_Response{}
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApplyGlobalConfig_Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyGlobalConfig_Response) ProtoMessage() {}
+
+func (x *ApplyGlobalConfig_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyGlobalConfig_Response.ProtoReflect.Descriptor instead.
+func (*ApplyGlobalConfig_Response) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_tfbreak_proto_rawDescGZIP(), []int{9, 1}
+}
+
+type ApplyConfig_Request struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Content       *BodyContent           `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApplyConfig_Request) Reset() {
+	*x = ApplyConfig_Request{}
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApplyConfig_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyConfig_Request) ProtoMessage() {}
+
+func (x *ApplyConfig_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyConfig_Request.ProtoReflect.Descriptor instead.
+func (*ApplyConfig_Request) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_tfbreak_proto_rawDescGZIP(), []int{10, 0}
+}
+
+func (x *ApplyConfig_Request) GetContent() *BodyContent {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+type ApplyConfig_Response struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApplyConfig_Response) Reset() {
+	*x = ApplyConfig_Response{}
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApplyConfig_Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyConfig_Response) ProtoMessage() {}
+
+func (x *ApplyConfig_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyConfig_Response.ProtoReflect.Descriptor instead.
+func (*ApplyConfig_Response) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_tfbreak_proto_rawDescGZIP(), []int{10, 1}
+}
+
+type Check_Request struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Check_Request) Reset() {
+	*x = Check_Request{}
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Check_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Check_Request) ProtoMessage() {}
+
+func (x *Check_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Check_Request.ProtoReflect.Descriptor instead.
+func (*Check_Request) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_tfbreak_proto_rawDescGZIP(), []int{11, 0}
+}
+
+type Check_Response struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// issues contains issues buffered by the plugin during Check.
+	// Empty unless the plugin was served with ServeOpts.BufferIssues;
+	// otherwise issues are delivered through the EmitIssue callback.
+	Issues []*Issue `protobuf:"bytes,1,rep,name=issues,proto3" json:"issues,omitempty"`
+	// errors contains one entry per failed rule. Empty unless the plugin
+	// reports structured errors; with ServeOpts.CombineErrors, rule failures
+	// are returned as a single RPC error instead.
+	Errors        []*RuleError `protobuf:"bytes,2,rep,name=errors,proto3" json:"errors,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Check_Response) Reset() {
+	*x = Check_Response{}
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Check_Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Check_Response) ProtoMessage() {}
+
+func (x *Check_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Check_Response.ProtoReflect.Descriptor instead.
+func (*Check_Response) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_tfbreak_proto_rawDescGZIP(), []int{11, 1}
+}
+
+func (x *Check_Response) GetIssues() []*Issue {
+	if x != nil {
+		return x.Issues
+	}
+	return nil
+}
+
+func (x *Check_Response) GetErrors() []*RuleError {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+type CheckStream_Request struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckStream_Request) Reset() {
+	*x = CheckStream_Request{}
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckStream_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckStream_Request) ProtoMessage() {}
+
+func (x *CheckStream_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckStream_Request.ProtoReflect.Descriptor instead.
+func (*CheckStream_Request) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_tfbreak_proto_rawDescGZIP(), []int{12, 0}
+}
+
+// Response is a single event on the stream: an emitted issue, or the
+// completion message sent after every rule has finished.
+type CheckStream_Response struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Event:
+	//
+	//	*CheckStream_Response_Issue
+	//	*CheckStream_Response_Complete
+	Event         isCheckStream_Response_Event `protobuf_oneof:"event"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckStream_Response) Reset() {
+	*x = CheckStream_Response{}
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckStream_Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckStream_Response) ProtoMessage() {}
+
+func (x *CheckStream_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckStream_Response.ProtoReflect.Descriptor instead.
+func (*CheckStream_Response) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_tfbreak_proto_rawDescGZIP(), []int{12, 1}
+}
+
+func (x *CheckStream_Response) GetEvent() isCheckStream_Response_Event {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+func (x *CheckStream_Response) GetIssue() *Issue {
+	if x != nil {
+		if x, ok := x.Event.(*CheckStream_Response_Issue); ok {
+			return x.Issue
+		}
+	}
+	return nil
+}
+
+func (x *CheckStream_Response) GetComplete() *CheckStream_Complete {
+	if x != nil {
+		if x, ok := x.Event.(*CheckStream_Response_Complete); ok {
+			return x.Complete
+		}
+	}
+	return nil
+}
+
+type isCheckStream_Response_Event interface {
+	isCheckStream_Response_Event()
+}
+
+type CheckStream_Response_Issue struct {
+	Issue *Issue `protobuf:"bytes,1,opt,name=issue,proto3,oneof"`
+}
+
+type CheckStream_Response_Complete struct {
+	Complete *CheckStream_Complete `protobuf:"bytes,2,opt,name=complete,proto3,oneof"`
+}
+
+func (*CheckStream_Response_Issue) isCheckStream_Response_Event() {}
+
+func (*CheckStream_Response_Complete) isCheckStream_Response_Event() {}
+
+type CheckStream_Complete struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// errors contains one entry per failed rule. With ServeOpts.CombineErrors,
+	// rule failures end the stream with a single RPC error instead.
+	Errors        []*RuleError `protobuf:"bytes,1,rep,name=errors,proto3" json:"errors,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckStream_Complete) Reset() {
+	*x = CheckStream_Complete{}
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckStream_Complete) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckStream_Complete) ProtoMessage() {}
+
+func (x *CheckStream_Complete) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckStream_Complete.ProtoReflect.Descriptor instead.
+func (*CheckStream_Complete) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_tfbreak_proto_rawDescGZIP(), []int{12, 2}
+}
+
+func (x *CheckStream_Complete) GetErrors() []*RuleError {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+type GetModuleContent_Request struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Schema        *BodySchema             `protobuf:"bytes,1,opt,name=schema,proto3" json:"schema,omitempty"`
+	Option        *GetModuleContentOption `protobuf:"bytes,2,opt,name=option,proto3" json:"option,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetModuleContent_Request) Reset() {
+	*x = GetModuleContent_Request{}
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetModuleContent_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetModuleContent_Request) ProtoMessage() {}
+
+func (x *GetModuleContent_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetModuleContent_Request.ProtoReflect.Descriptor instead.
+func (*GetModuleContent_Request) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_tfbreak_proto_rawDescGZIP(), []int{15, 0}
+}
+
+func (x *GetModuleContent_Request) GetSchema() *BodySchema {
+	if x != nil {
+		return x.Schema
+	}
+	return nil
+}
+
+func (x *GetModuleContent_Request) GetOption() *GetModuleContentOption {
+	if x != nil {
+		return x.Option
+	}
+	return nil
+}
+
+type GetModuleContent_Response struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Content       *BodyContent           `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetModuleContent_Response) Reset() {
+	*x = GetModuleContent_Response{}
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetModuleContent_Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetModuleContent_Response) ProtoMessage() {}
+
+func (x *GetModuleContent_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetModuleContent_Response.ProtoReflect.Descriptor instead.
+func (*GetModuleContent_Response) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_tfbreak_proto_rawDescGZIP(), []int{15, 1}
+}
+
+func (x *GetModuleContent_Response) GetContent() *BodyContent {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+type GetResourceContent_Request struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// resource_type is the resource or data source type to filter on.
+	ResourceType  string                  `protobuf:"bytes,1,opt,name=resource_type,json=resourceType,proto3" json:"resource_type,omitempty"`
+	Schema        *BodySchema             `protobuf:"bytes,2,opt,name=schema,proto3" json:"schema,omitempty"`
+	Option        *GetModuleContentOption `protobuf:"bytes,3,opt,name=option,proto3" json:"option,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetResourceContent_Request) Reset() {
+	*x = GetResourceContent_Request{}
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[89]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BodySchema) String() string {
+func (x *GetResourceContent_Request) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BodySchema) ProtoMessage() {}
+func (*GetResourceContent_Request) ProtoMessage() {}
 
-func (x *BodySchema) ProtoReflect() protoreflect.Message {
-	mi := &file_plugin_proto_tfbreak_proto_msgTypes[15]
+func (x *GetResourceContent_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[89]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -895,56 +4769,54 @@ func (x *BodySchema) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BodySchema.ProtoReflect.Descriptor instead.
-func (*BodySchema) Descriptor() ([]byte, []int) {
-	return file_plugin_proto_tfbreak_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use GetResourceContent_Request.ProtoReflect.Descriptor instead.
+func (*GetResourceContent_Request) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_tfbreak_proto_rawDescGZIP(), []int{16, 0}
 }
 
-func (x *BodySchema) GetAttributes() []*AttributeSchema {
+func (x *GetResourceContent_Request) GetResourceType() string {
 	if x != nil {
-		return x.Attributes
+		return x.ResourceType
 	}
-	return nil
+	return ""
 }
 
-func (x *BodySchema) GetBlocks() []*BlockSchema {
+func (x *GetResourceContent_Request) GetSchema() *BodySchema {
 	if x != nil {
-		return x.Blocks
+		return x.Schema
 	}
 	return nil
 }
 
-func (x *BodySchema) GetMode() SchemaMode {
+func (x *GetResourceContent_Request) GetOption() *GetModuleContentOption {
 	if x != nil {
-		return x.Mode
+		return x.Option
 	}
-	return SchemaMode_SCHEMA_MODE_DEFAULT
+	return nil
 }
 
-// AttributeSchema represents an expected HCL attribute.
-type AttributeSchema struct {
+type GetResourceContent_Response struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Required      bool                   `protobuf:"varint,2,opt,name=required,proto3" json:"required,omitempty"`
+	Content       *BodyContent           `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AttributeSchema) Reset() {
-	*x = AttributeSchema{}
-	mi := &file_plugin_proto_tfbreak_proto_msgTypes[16]
+func (x *GetResourceContent_Response) Reset() {
+	*x = GetResourceContent_Response{}
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[90]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AttributeSchema) String() string {
+func (x *GetResourceContent_Response) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AttributeSchema) ProtoMessage() {}
+func (*GetResourceContent_Response) ProtoMessage() {}
 
-func (x *AttributeSchema) ProtoReflect() protoreflect.Message {
-	mi := &file_plugin_proto_tfbreak_proto_msgTypes[16]
+func (x *GetResourceContent_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[90]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -955,50 +4827,42 @@ func (x *AttributeSchema) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AttributeSchema.ProtoReflect.Descriptor instead.
-func (*AttributeSchema) Descriptor() ([]byte, []int) {
-	return file_plugin_proto_tfbreak_proto_rawDescGZIP(), []int{16}
-}
-
-func (x *AttributeSchema) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
+// Deprecated: Use GetResourceContent_Response.ProtoReflect.Descriptor instead.
+func (*GetResourceContent_Response) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_tfbreak_proto_rawDescGZIP(), []int{16, 1}
 }
 
-func (x *AttributeSchema) GetRequired() bool {
+func (x *GetResourceContent_Response) GetContent() *BodyContent {
 	if x != nil {
-		return x.Required
+		return x.Content
 	}
-	return false
+	return nil
 }
 
-// BlockSchema represents an expected HCL block.
-type BlockSchema struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
-	LabelNames    []string               `protobuf:"bytes,2,rep,name=label_names,json=labelNames,proto3" json:"label_names,omitempty"`
-	Body          *BodySchema            `protobuf:"bytes,3,opt,name=body,proto3" json:"body,omitempty"`
+type GetResourceContentPair_Request struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	ResourceType  string                  `protobuf:"bytes,1,opt,name=resource_type,json=resourceType,proto3" json:"resource_type,omitempty"`
+	Schema        *BodySchema             `protobuf:"bytes,2,opt,name=schema,proto3" json:"schema,omitempty"`
+	Option        *GetModuleContentOption `protobuf:"bytes,3,opt,name=option,proto3" json:"option,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *BlockSchema) Reset() {
-	*x = BlockSchema{}
-	mi := &file_plugin_proto_tfbreak_proto_msgTypes[17]
+func (x *GetResourceContentPair_Request) Reset() {
+	*x = GetResourceContentPair_Request{}
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[91]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BlockSchema) String() string {
+func (x *GetResourceContentPair_Request) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BlockSchema) ProtoMessage() {}
+func (*GetResourceContentPair_Request) ProtoMessage() {}
 
-func (x *BlockSchema) ProtoReflect() protoreflect.Message {
-	mi := &file_plugin_proto_tfbreak_proto_msgTypes[17]
+func (x *GetResourceContentPair_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[91]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1009,56 +4873,57 @@ func (x *BlockSchema) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BlockSchema.ProtoReflect.Descriptor instead.
-func (*BlockSchema) Descriptor() ([]byte, []int) {
-	return file_plugin_proto_tfbreak_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use GetResourceContentPair_Request.ProtoReflect.Descriptor instead.
+func (*GetResourceContentPair_Request) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_tfbreak_proto_rawDescGZIP(), []int{17, 0}
 }
 
-func (x *BlockSchema) GetType() string {
+func (x *GetResourceContentPair_Request) GetResourceType() string {
 	if x != nil {
-		return x.Type
+		return x.ResourceType
 	}
 	return ""
 }
 
-func (x *BlockSchema) GetLabelNames() []string {
+func (x *GetResourceContentPair_Request) GetSchema() *BodySchema {
 	if x != nil {
-		return x.LabelNames
+		return x.Schema
 	}
 	return nil
 }
 
-func (x *BlockSchema) GetBody() *BodySchema {
+func (x *GetResourceContentPair_Request) GetOption() *GetModuleContentOption {
 	if x != nil {
-		return x.Body
+		return x.Option
 	}
 	return nil
 }
 
-// BodyContent represents extracted content from an HCL body.
-type BodyContent struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Attributes    map[string]*Attribute  `protobuf:"bytes,1,rep,name=attributes,proto3" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	Blocks        []*Block               `protobuf:"bytes,2,rep,name=blocks,proto3" json:"blocks,omitempty"`
+type GetResourceContentPair_Response struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// old_content is the content of the OLD configuration.
+	OldContent *BodyContent `protobuf:"bytes,1,opt,name=old_content,json=oldContent,proto3" json:"old_content,omitempty"`
+	// new_content is the content of the NEW configuration.
+	NewContent    *BodyContent `protobuf:"bytes,2,opt,name=new_content,json=newContent,proto3" json:"new_content,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *BodyContent) Reset() {
-	*x = BodyContent{}
-	mi := &file_plugin_proto_tfbreak_proto_msgTypes[18]
+func (x *GetResourceContentPair_Response) Reset() {
+	*x = GetResourceContentPair_Response{}
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[92]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BodyContent) String() string {
+func (x *GetResourceContentPair_Response) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BodyContent) ProtoMessage() {}
+func (*GetResourceContentPair_Response) ProtoMessage() {}
 
-func (x *BodyContent) ProtoReflect() protoreflect.Message {
-	mi := &file_plugin_proto_tfbreak_proto_msgTypes[18]
+func (x *GetResourceContentPair_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[92]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1069,55 +4934,47 @@ func (x *BodyContent) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BodyContent.ProtoReflect.Descriptor instead.
-func (*BodyContent) Descriptor() ([]byte, []int) {
-	return file_plugin_proto_tfbreak_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use GetResourceContentPair_Response.ProtoReflect.Descriptor instead.
+func (*GetResourceContentPair_Response) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_tfbreak_proto_rawDescGZIP(), []int{17, 1}
 }
 
-func (x *BodyContent) GetAttributes() map[string]*Attribute {
+func (x *GetResourceContentPair_Response) GetOldContent() *BodyContent {
 	if x != nil {
-		return x.Attributes
+		return x.OldContent
 	}
 	return nil
 }
 
-func (x *BodyContent) GetBlocks() []*Block {
+func (x *GetResourceContentPair_Response) GetNewContent() *BodyContent {
 	if x != nil {
-		return x.Blocks
+		return x.NewContent
 	}
 	return nil
 }
 
-// Attribute represents an extracted HCL attribute.
-type Attribute struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	Name  string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	// expr_bytes contains the expression as a serializable format.
-	// We use bytes because hcl.Expression is not directly serializable.
-	ExprBytes []byte `protobuf:"bytes,2,opt,name=expr_bytes,json=exprBytes,proto3" json:"expr_bytes,omitempty"`
-	Range     *Range `protobuf:"bytes,3,opt,name=range,proto3" json:"range,omitempty"`
-	NameRange *Range `protobuf:"bytes,4,opt,name=name_range,json=nameRange,proto3" json:"name_range,omitempty"`
-	// expr_value contains the evaluated value as JSON when available.
-	ExprValue     []byte `protobuf:"bytes,5,opt,name=expr_value,json=exprValue,proto3" json:"expr_value,omitempty"`
+type GetFile_Request struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Filename      string                 `protobuf:"bytes,1,opt,name=filename,proto3" json:"filename,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Attribute) Reset() {
-	*x = Attribute{}
-	mi := &file_plugin_proto_tfbreak_proto_msgTypes[19]
+func (x *GetFile_Request) Reset() {
+	*x = GetFile_Request{}
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[93]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Attribute) String() string {
+func (x *GetFile_Request) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Attribute) ProtoMessage() {}
+func (*GetFile_Request) ProtoMessage() {}
 
-func (x *Attribute) ProtoReflect() protoreflect.Message {
-	mi := &file_plugin_proto_tfbreak_proto_msgTypes[19]
+func (x *GetFile_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[93]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1128,74 +4985,86 @@ func (x *Attribute) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Attribute.ProtoReflect.Descriptor instead.
-func (*Attribute) Descriptor() ([]byte, []int) {
-	return file_plugin_proto_tfbreak_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use GetFile_Request.ProtoReflect.Descriptor instead.
+func (*GetFile_Request) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_tfbreak_proto_rawDescGZIP(), []int{18, 0}
 }
 
-func (x *Attribute) GetName() string {
+func (x *GetFile_Request) GetFilename() string {
 	if x != nil {
-		return x.Name
+		return x.Filename
 	}
 	return ""
 }
 
-func (x *Attribute) GetExprBytes() []byte {
-	if x != nil {
-		return x.ExprBytes
-	}
-	return nil
+type GetFile_Response struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// bytes contains the raw file source. The plugin re-parses it.
+	Bytes         []byte `protobuf:"bytes,1,opt,name=bytes,proto3" json:"bytes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Attribute) GetRange() *Range {
-	if x != nil {
-		return x.Range
-	}
-	return nil
+func (x *GetFile_Response) Reset() {
+	*x = GetFile_Response{}
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[94]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *Attribute) GetNameRange() *Range {
+func (x *GetFile_Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFile_Response) ProtoMessage() {}
+
+func (x *GetFile_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[94]
 	if x != nil {
-		return x.NameRange
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *Attribute) GetExprValue() []byte {
+// Deprecated: Use GetFile_Response.ProtoReflect.Descriptor instead.
+func (*GetFile_Response) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_tfbreak_proto_rawDescGZIP(), []int{18, 1}
+}
+
+func (x *GetFile_Response) GetBytes() []byte {
 	if x != nil {
-		return x.ExprValue
+		return x.Bytes
 	}
 	return nil
 }
 
-// Block represents an extracted HCL block.
-type Block struct {
+type GetFileSource_Request struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
-	Labels        []string               `protobuf:"bytes,2,rep,name=labels,proto3" json:"labels,omitempty"`
-	Body          *BodyContent           `protobuf:"bytes,3,opt,name=body,proto3" json:"body,omitempty"`
-	DefRange      *Range                 `protobuf:"bytes,4,opt,name=def_range,json=defRange,proto3" json:"def_range,omitempty"`
-	TypeRange     *Range                 `protobuf:"bytes,5,opt,name=type_range,json=typeRange,proto3" json:"type_range,omitempty"`
-	LabelRanges   []*Range               `protobuf:"bytes,6,rep,name=label_ranges,json=labelRanges,proto3" json:"label_ranges,omitempty"`
+	Filename      string                 `protobuf:"bytes,1,opt,name=filename,proto3" json:"filename,omitempty"`
+	Side          Side                   `protobuf:"varint,2,opt,name=side,proto3,enum=tfbreak.Side" json:"side,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Block) Reset() {
-	*x = Block{}
-	mi := &file_plugin_proto_tfbreak_proto_msgTypes[20]
+func (x *GetFileSource_Request) Reset() {
+	*x = GetFileSource_Request{}
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[95]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Block) String() string {
+func (x *GetFileSource_Request) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Block) ProtoMessage() {}
+func (*GetFileSource_Request) ProtoMessage() {}
 
-func (x *Block) ProtoReflect() protoreflect.Message {
-	mi := &file_plugin_proto_tfbreak_proto_msgTypes[20]
+func (x *GetFileSource_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[95]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1206,78 +5075,47 @@ func (x *Block) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Block.ProtoReflect.Descriptor instead.
-func (*Block) Descriptor() ([]byte, []int) {
-	return file_plugin_proto_tfbreak_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use GetFileSource_Request.ProtoReflect.Descriptor instead.
+func (*GetFileSource_Request) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_tfbreak_proto_rawDescGZIP(), []int{19, 0}
 }
 
-func (x *Block) GetType() string {
+func (x *GetFileSource_Request) GetFilename() string {
 	if x != nil {
-		return x.Type
+		return x.Filename
 	}
 	return ""
 }
 
-func (x *Block) GetLabels() []string {
-	if x != nil {
-		return x.Labels
-	}
-	return nil
-}
-
-func (x *Block) GetBody() *BodyContent {
-	if x != nil {
-		return x.Body
-	}
-	return nil
-}
-
-func (x *Block) GetDefRange() *Range {
-	if x != nil {
-		return x.DefRange
-	}
-	return nil
-}
-
-func (x *Block) GetTypeRange() *Range {
-	if x != nil {
-		return x.TypeRange
-	}
-	return nil
-}
-
-func (x *Block) GetLabelRanges() []*Range {
+func (x *GetFileSource_Request) GetSide() Side {
 	if x != nil {
-		return x.LabelRanges
+		return x.Side
 	}
-	return nil
+	return Side_SIDE_UNSPECIFIED
 }
 
-// Range represents a source code range.
-type Range struct {
+type GetFileSource_Response struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Filename      string                 `protobuf:"bytes,1,opt,name=filename,proto3" json:"filename,omitempty"`
-	Start         *Position              `protobuf:"bytes,2,opt,name=start,proto3" json:"start,omitempty"`
-	End           *Position              `protobuf:"bytes,3,opt,name=end,proto3" json:"end,omitempty"`
+	Bytes         []byte                 `protobuf:"bytes,1,opt,name=bytes,proto3" json:"bytes,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Range) Reset() {
-	*x = Range{}
-	mi := &file_plugin_proto_tfbreak_proto_msgTypes[21]
+func (x *GetFileSource_Response) Reset() {
+	*x = GetFileSource_Response{}
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[96]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Range) String() string {
+func (x *GetFileSource_Response) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Range) ProtoMessage() {}
+func (*GetFileSource_Response) ProtoMessage() {}
 
-func (x *Range) ProtoReflect() protoreflect.Message {
-	mi := &file_plugin_proto_tfbreak_proto_msgTypes[21]
+func (x *GetFileSource_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[96]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1288,57 +5126,39 @@ func (x *Range) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Range.ProtoReflect.Descriptor instead.
-func (*Range) Descriptor() ([]byte, []int) {
-	return file_plugin_proto_tfbreak_proto_rawDescGZIP(), []int{21}
-}
-
-func (x *Range) GetFilename() string {
-	if x != nil {
-		return x.Filename
-	}
-	return ""
-}
-
-func (x *Range) GetStart() *Position {
-	if x != nil {
-		return x.Start
-	}
-	return nil
+// Deprecated: Use GetFileSource_Response.ProtoReflect.Descriptor instead.
+func (*GetFileSource_Response) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_tfbreak_proto_rawDescGZIP(), []int{19, 1}
 }
 
-func (x *Range) GetEnd() *Position {
+func (x *GetFileSource_Response) GetBytes() []byte {
 	if x != nil {
-		return x.End
+		return x.Bytes
 	}
 	return nil
 }
 
-// Position represents a position in source code.
-type Position struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Line          int64                  `protobuf:"varint,1,opt,name=line,proto3" json:"line,omitempty"`
-	Column        int64                  `protobuf:"varint,2,opt,name=column,proto3" json:"column,omitempty"`
-	Byte          int64                  `protobuf:"varint,3,opt,name=byte,proto3" json:"byte,omitempty"`
+type ListFiles_Request struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Position) Reset() {
-	*x = Position{}
-	mi := &file_plugin_proto_tfbreak_proto_msgTypes[22]
+func (x *ListFiles_Request) Reset() {
+	*x = ListFiles_Request{}
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[97]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Position) String() string {
+func (x *ListFiles_Request) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Position) ProtoMessage() {}
+func (*ListFiles_Request) ProtoMessage() {}
 
-func (x *Position) ProtoReflect() protoreflect.Message {
-	mi := &file_plugin_proto_tfbreak_proto_msgTypes[22]
+func (x *ListFiles_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[97]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1349,57 +5169,33 @@ func (x *Position) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Position.ProtoReflect.Descriptor instead.
-func (*Position) Descriptor() ([]byte, []int) {
-	return file_plugin_proto_tfbreak_proto_rawDescGZIP(), []int{22}
-}
-
-func (x *Position) GetLine() int64 {
-	if x != nil {
-		return x.Line
-	}
-	return 0
-}
-
-func (x *Position) GetColumn() int64 {
-	if x != nil {
-		return x.Column
-	}
-	return 0
-}
-
-func (x *Position) GetByte() int64 {
-	if x != nil {
-		return x.Byte
-	}
-	return 0
+// Deprecated: Use ListFiles_Request.ProtoReflect.Descriptor instead.
+func (*ListFiles_Request) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_tfbreak_proto_rawDescGZIP(), []int{20, 0}
 }
 
-// GetModuleContentOption configures how content is retrieved.
-type GetModuleContentOption struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	ModuleCtx        ModuleCtxType          `protobuf:"varint,1,opt,name=module_ctx,json=moduleCtx,proto3,enum=tfbreak.ModuleCtxType" json:"module_ctx,omitempty"`
-	ExpandMode       ExpandMode             `protobuf:"varint,2,opt,name=expand_mode,json=expandMode,proto3,enum=tfbreak.ExpandMode" json:"expand_mode,omitempty"`
-	ResourceTypeHint string                 `protobuf:"bytes,3,opt,name=resource_type_hint,json=resourceTypeHint,proto3" json:"resource_type_hint,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+type ListFiles_Response struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Filenames     []string               `protobuf:"bytes,1,rep,name=filenames,proto3" json:"filenames,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetModuleContentOption) Reset() {
-	*x = GetModuleContentOption{}
-	mi := &file_plugin_proto_tfbreak_proto_msgTypes[23]
+func (x *ListFiles_Response) Reset() {
+	*x = ListFiles_Response{}
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[98]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetModuleContentOption) String() string {
+func (x *ListFiles_Response) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetModuleContentOption) ProtoMessage() {}
+func (*ListFiles_Response) ProtoMessage() {}
 
-func (x *GetModuleContentOption) ProtoReflect() protoreflect.Message {
-	mi := &file_plugin_proto_tfbreak_proto_msgTypes[23]
+func (x *ListFiles_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[98]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1410,53 +5206,39 @@ func (x *GetModuleContentOption) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetModuleContentOption.ProtoReflect.Descriptor instead.
-func (*GetModuleContentOption) Descriptor() ([]byte, []int) {
-	return file_plugin_proto_tfbreak_proto_rawDescGZIP(), []int{23}
-}
-
-func (x *GetModuleContentOption) GetModuleCtx() ModuleCtxType {
-	if x != nil {
-		return x.ModuleCtx
-	}
-	return ModuleCtxType_MODULE_CTX_SELF
-}
-
-func (x *GetModuleContentOption) GetExpandMode() ExpandMode {
-	if x != nil {
-		return x.ExpandMode
-	}
-	return ExpandMode_EXPAND_MODE_NONE
+// Deprecated: Use ListFiles_Response.ProtoReflect.Descriptor instead.
+func (*ListFiles_Response) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_tfbreak_proto_rawDescGZIP(), []int{20, 1}
 }
 
-func (x *GetModuleContentOption) GetResourceTypeHint() string {
+func (x *ListFiles_Response) GetFilenames() []string {
 	if x != nil {
-		return x.ResourceTypeHint
+		return x.Filenames
 	}
-	return ""
+	return nil
 }
 
-type GetRuleSetName_Request struct {
+type FileChanges_Request struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetRuleSetName_Request) Reset() {
-	*x = GetRuleSetName_Request{}
-	mi := &file_plugin_proto_tfbreak_proto_msgTypes[24]
+func (x *FileChanges_Request) Reset() {
+	*x = FileChanges_Request{}
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[99]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetRuleSetName_Request) String() string {
+func (x *FileChanges_Request) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetRuleSetName_Request) ProtoMessage() {}
+func (*FileChanges_Request) ProtoMessage() {}
 
-func (x *GetRuleSetName_Request) ProtoReflect() protoreflect.Message {
-	mi := &file_plugin_proto_tfbreak_proto_msgTypes[24]
+func (x *FileChanges_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[99]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1467,33 +5249,38 @@ func (x *GetRuleSetName_Request) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetRuleSetName_Request.ProtoReflect.Descriptor instead.
-func (*GetRuleSetName_Request) Descriptor() ([]byte, []int) {
-	return file_plugin_proto_tfbreak_proto_rawDescGZIP(), []int{0, 0}
+// Deprecated: Use FileChanges_Request.ProtoReflect.Descriptor instead.
+func (*FileChanges_Request) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_tfbreak_proto_rawDescGZIP(), []int{21, 0}
 }
 
-type GetRuleSetName_Response struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+type FileChanges_Response struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// added are the files only in the NEW configuration.
+	Added []string `protobuf:"bytes,1,rep,name=added,proto3" json:"added,omitempty"`
+	// removed are the files only in the OLD configuration.
+	Removed []string `protobuf:"bytes,2,rep,name=removed,proto3" json:"removed,omitempty"`
+	// common are the files in both configurations.
+	Common        []string `protobuf:"bytes,3,rep,name=common,proto3" json:"common,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetRuleSetName_Response) Reset() {
-	*x = GetRuleSetName_Response{}
-	mi := &file_plugin_proto_tfbreak_proto_msgTypes[25]
+func (x *FileChanges_Response) Reset() {
+	*x = FileChanges_Response{}
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[100]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetRuleSetName_Response) String() string {
+func (x *FileChanges_Response) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetRuleSetName_Response) ProtoMessage() {}
+func (*FileChanges_Response) ProtoMessage() {}
 
-func (x *GetRuleSetName_Response) ProtoReflect() protoreflect.Message {
-	mi := &file_plugin_proto_tfbreak_proto_msgTypes[25]
+func (x *FileChanges_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[100]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1504,39 +5291,53 @@ func (x *GetRuleSetName_Response) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetRuleSetName_Response.ProtoReflect.Descriptor instead.
-func (*GetRuleSetName_Response) Descriptor() ([]byte, []int) {
-	return file_plugin_proto_tfbreak_proto_rawDescGZIP(), []int{0, 1}
+// Deprecated: Use FileChanges_Response.ProtoReflect.Descriptor instead.
+func (*FileChanges_Response) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_tfbreak_proto_rawDescGZIP(), []int{21, 1}
 }
 
-func (x *GetRuleSetName_Response) GetName() string {
+func (x *FileChanges_Response) GetAdded() []string {
 	if x != nil {
-		return x.Name
+		return x.Added
 	}
-	return ""
+	return nil
 }
 
-type GetRuleSetVersion_Request struct {
+func (x *FileChanges_Response) GetRemoved() []string {
+	if x != nil {
+		return x.Removed
+	}
+	return nil
+}
+
+func (x *FileChanges_Response) GetCommon() []string {
+	if x != nil {
+		return x.Common
+	}
+	return nil
+}
+
+type GetProviderRequirements_Request struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetRuleSetVersion_Request) Reset() {
-	*x = GetRuleSetVersion_Request{}
-	mi := &file_plugin_proto_tfbreak_proto_msgTypes[26]
+func (x *GetProviderRequirements_Request) Reset() {
+	*x = GetProviderRequirements_Request{}
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[101]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetRuleSetVersion_Request) String() string {
+func (x *GetProviderRequirements_Request) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetRuleSetVersion_Request) ProtoMessage() {}
+func (*GetProviderRequirements_Request) ProtoMessage() {}
 
-func (x *GetRuleSetVersion_Request) ProtoReflect() protoreflect.Message {
-	mi := &file_plugin_proto_tfbreak_proto_msgTypes[26]
+func (x *GetProviderRequirements_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[101]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1547,33 +5348,34 @@ func (x *GetRuleSetVersion_Request) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetRuleSetVersion_Request.ProtoReflect.Descriptor instead.
-func (*GetRuleSetVersion_Request) Descriptor() ([]byte, []int) {
-	return file_plugin_proto_tfbreak_proto_rawDescGZIP(), []int{1, 0}
+// Deprecated: Use GetProviderRequirements_Request.ProtoReflect.Descriptor instead.
+func (*GetProviderRequirements_Request) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_tfbreak_proto_rawDescGZIP(), []int{22, 0}
 }
 
-type GetRuleSetVersion_Response struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Version       string                 `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+type GetProviderRequirements_Response struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// requirements is keyed by the provider's local name.
+	Requirements  map[string]*ProviderRequirement `protobuf:"bytes,1,rep,name=requirements,proto3" json:"requirements,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetRuleSetVersion_Response) Reset() {
-	*x = GetRuleSetVersion_Response{}
-	mi := &file_plugin_proto_tfbreak_proto_msgTypes[27]
+func (x *GetProviderRequirements_Response) Reset() {
+	*x = GetProviderRequirements_Response{}
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[102]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetRuleSetVersion_Response) String() string {
+func (x *GetProviderRequirements_Response) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetRuleSetVersion_Response) ProtoMessage() {}
+func (*GetProviderRequirements_Response) ProtoMessage() {}
 
-func (x *GetRuleSetVersion_Response) ProtoReflect() protoreflect.Message {
-	mi := &file_plugin_proto_tfbreak_proto_msgTypes[27]
+func (x *GetProviderRequirements_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[102]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1584,39 +5386,39 @@ func (x *GetRuleSetVersion_Response) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetRuleSetVersion_Response.ProtoReflect.Descriptor instead.
-func (*GetRuleSetVersion_Response) Descriptor() ([]byte, []int) {
-	return file_plugin_proto_tfbreak_proto_rawDescGZIP(), []int{1, 1}
+// Deprecated: Use GetProviderRequirements_Response.ProtoReflect.Descriptor instead.
+func (*GetProviderRequirements_Response) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_tfbreak_proto_rawDescGZIP(), []int{22, 1}
 }
 
-func (x *GetRuleSetVersion_Response) GetVersion() string {
+func (x *GetProviderRequirements_Response) GetRequirements() map[string]*ProviderRequirement {
 	if x != nil {
-		return x.Version
+		return x.Requirements
 	}
-	return ""
+	return nil
 }
 
-type GetRuleNames_Request struct {
+type GetTerraformSettings_Request struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetRuleNames_Request) Reset() {
-	*x = GetRuleNames_Request{}
-	mi := &file_plugin_proto_tfbreak_proto_msgTypes[28]
+func (x *GetTerraformSettings_Request) Reset() {
+	*x = GetTerraformSettings_Request{}
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[104]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetRuleNames_Request) String() string {
+func (x *GetTerraformSettings_Request) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetRuleNames_Request) ProtoMessage() {}
+func (*GetTerraformSettings_Request) ProtoMessage() {}
 
-func (x *GetRuleNames_Request) ProtoReflect() protoreflect.Message {
-	mi := &file_plugin_proto_tfbreak_proto_msgTypes[28]
+func (x *GetTerraformSettings_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[104]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1627,33 +5429,33 @@ func (x *GetRuleNames_Request) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetRuleNames_Request.ProtoReflect.Descriptor instead.
-func (*GetRuleNames_Request) Descriptor() ([]byte, []int) {
-	return file_plugin_proto_tfbreak_proto_rawDescGZIP(), []int{2, 0}
+// Deprecated: Use GetTerraformSettings_Request.ProtoReflect.Descriptor instead.
+func (*GetTerraformSettings_Request) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_tfbreak_proto_rawDescGZIP(), []int{23, 0}
 }
 
-type GetRuleNames_Response struct {
+type GetTerraformSettings_Response struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Names         []string               `protobuf:"bytes,1,rep,name=names,proto3" json:"names,omitempty"`
+	Settings      *TerraformSettings     `protobuf:"bytes,1,opt,name=settings,proto3" json:"settings,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetRuleNames_Response) Reset() {
-	*x = GetRuleNames_Response{}
-	mi := &file_plugin_proto_tfbreak_proto_msgTypes[29]
+func (x *GetTerraformSettings_Response) Reset() {
+	*x = GetTerraformSettings_Response{}
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[105]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetRuleNames_Response) String() string {
+func (x *GetTerraformSettings_Response) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetRuleNames_Response) ProtoMessage() {}
+func (*GetTerraformSettings_Response) ProtoMessage() {}
 
-func (x *GetRuleNames_Response) ProtoReflect() protoreflect.Message {
-	mi := &file_plugin_proto_tfbreak_proto_msgTypes[29]
+func (x *GetTerraformSettings_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[105]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1664,39 +5466,43 @@ func (x *GetRuleNames_Response) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetRuleNames_Response.ProtoReflect.Descriptor instead.
-func (*GetRuleNames_Response) Descriptor() ([]byte, []int) {
-	return file_plugin_proto_tfbreak_proto_rawDescGZIP(), []int{2, 1}
+// Deprecated: Use GetTerraformSettings_Response.ProtoReflect.Descriptor instead.
+func (*GetTerraformSettings_Response) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_tfbreak_proto_rawDescGZIP(), []int{23, 1}
 }
 
-func (x *GetRuleNames_Response) GetNames() []string {
+func (x *GetTerraformSettings_Response) GetSettings() *TerraformSettings {
 	if x != nil {
-		return x.Names
+		return x.Settings
 	}
 	return nil
 }
 
-type GetVersionConstraint_Request struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+type GetProviderConfig_Request struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// name is the provider's local name, followed by "." and the alias
+	// for an aliased configuration.
+	Name          string      `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Schema        *BodySchema `protobuf:"bytes,2,opt,name=schema,proto3" json:"schema,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetVersionConstraint_Request) Reset() {
-	*x = GetVersionConstraint_Request{}
-	mi := &file_plugin_proto_tfbreak_proto_msgTypes[30]
+func (x *GetProviderConfig_Request) Reset() {
+	*x = GetProviderConfig_Request{}
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[107]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetVersionConstraint_Request) String() string {
+func (x *GetProviderConfig_Request) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetVersionConstraint_Request) ProtoMessage() {}
+func (*GetProviderConfig_Request) ProtoMessage() {}
 
-func (x *GetVersionConstraint_Request) ProtoReflect() protoreflect.Message {
-	mi := &file_plugin_proto_tfbreak_proto_msgTypes[30]
+func (x *GetProviderConfig_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[107]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1707,33 +5513,48 @@ func (x *GetVersionConstraint_Request) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetVersionConstraint_Request.ProtoReflect.Descriptor instead.
-func (*GetVersionConstraint_Request) Descriptor() ([]byte, []int) {
-	return file_plugin_proto_tfbreak_proto_rawDescGZIP(), []int{3, 0}
+// Deprecated: Use GetProviderConfig_Request.ProtoReflect.Descriptor instead.
+func (*GetProviderConfig_Request) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_tfbreak_proto_rawDescGZIP(), []int{26, 0}
 }
 
-type GetVersionConstraint_Response struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Constraint    string                 `protobuf:"bytes,1,opt,name=constraint,proto3" json:"constraint,omitempty"`
+func (x *GetProviderConfig_Request) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GetProviderConfig_Request) GetSchema() *BodySchema {
+	if x != nil {
+		return x.Schema
+	}
+	return nil
+}
+
+type GetProviderConfig_Response struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// block is unset if the configuration has no such provider block.
+	Block         *Block `protobuf:"bytes,1,opt,name=block,proto3" json:"block,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetVersionConstraint_Response) Reset() {
-	*x = GetVersionConstraint_Response{}
-	mi := &file_plugin_proto_tfbreak_proto_msgTypes[31]
+func (x *GetProviderConfig_Response) Reset() {
+	*x = GetProviderConfig_Response{}
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[108]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetVersionConstraint_Response) String() string {
+func (x *GetProviderConfig_Response) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetVersionConstraint_Response) ProtoMessage() {}
+func (*GetProviderConfig_Response) ProtoMessage() {}
 
-func (x *GetVersionConstraint_Response) ProtoReflect() protoreflect.Message {
-	mi := &file_plugin_proto_tfbreak_proto_msgTypes[31]
+func (x *GetProviderConfig_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[108]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1744,39 +5565,39 @@ func (x *GetVersionConstraint_Response) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetVersionConstraint_Response.ProtoReflect.Descriptor instead.
-func (*GetVersionConstraint_Response) Descriptor() ([]byte, []int) {
-	return file_plugin_proto_tfbreak_proto_rawDescGZIP(), []int{3, 1}
+// Deprecated: Use GetProviderConfig_Response.ProtoReflect.Descriptor instead.
+func (*GetProviderConfig_Response) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_tfbreak_proto_rawDescGZIP(), []int{26, 1}
 }
 
-func (x *GetVersionConstraint_Response) GetConstraint() string {
+func (x *GetProviderConfig_Response) GetBlock() *Block {
 	if x != nil {
-		return x.Constraint
+		return x.Block
 	}
-	return ""
+	return nil
 }
 
-type GetConfigSchema_Request struct {
+type GetVariables_Request struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetConfigSchema_Request) Reset() {
-	*x = GetConfigSchema_Request{}
-	mi := &file_plugin_proto_tfbreak_proto_msgTypes[32]
+func (x *GetVariables_Request) Reset() {
+	*x = GetVariables_Request{}
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[109]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetConfigSchema_Request) String() string {
+func (x *GetVariables_Request) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetConfigSchema_Request) ProtoMessage() {}
+func (*GetVariables_Request) ProtoMessage() {}
 
-func (x *GetConfigSchema_Request) ProtoReflect() protoreflect.Message {
-	mi := &file_plugin_proto_tfbreak_proto_msgTypes[32]
+func (x *GetVariables_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[109]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1787,33 +5608,33 @@ func (x *GetConfigSchema_Request) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetConfigSchema_Request.ProtoReflect.Descriptor instead.
-func (*GetConfigSchema_Request) Descriptor() ([]byte, []int) {
-	return file_plugin_proto_tfbreak_proto_rawDescGZIP(), []int{4, 0}
+// Deprecated: Use GetVariables_Request.ProtoReflect.Descriptor instead.
+func (*GetVariables_Request) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_tfbreak_proto_rawDescGZIP(), []int{28, 0}
 }
 
-type GetConfigSchema_Response struct {
+type GetVariables_Response struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Schema        *BodySchema            `protobuf:"bytes,1,opt,name=schema,proto3" json:"schema,omitempty"`
+	Variables     []*VariableDef         `protobuf:"bytes,1,rep,name=variables,proto3" json:"variables,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetConfigSchema_Response) Reset() {
-	*x = GetConfigSchema_Response{}
-	mi := &file_plugin_proto_tfbreak_proto_msgTypes[33]
+func (x *GetVariables_Response) Reset() {
+	*x = GetVariables_Response{}
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[110]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetConfigSchema_Response) String() string {
+func (x *GetVariables_Response) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetConfigSchema_Response) ProtoMessage() {}
+func (*GetVariables_Response) ProtoMessage() {}
 
-func (x *GetConfigSchema_Response) ProtoReflect() protoreflect.Message {
-	mi := &file_plugin_proto_tfbreak_proto_msgTypes[33]
+func (x *GetVariables_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[110]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1824,40 +5645,76 @@ func (x *GetConfigSchema_Response) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetConfigSchema_Response.ProtoReflect.Descriptor instead.
-func (*GetConfigSchema_Response) Descriptor() ([]byte, []int) {
-	return file_plugin_proto_tfbreak_proto_rawDescGZIP(), []int{4, 1}
+// Deprecated: Use GetVariables_Response.ProtoReflect.Descriptor instead.
+func (*GetVariables_Response) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_tfbreak_proto_rawDescGZIP(), []int{28, 1}
+}
+
+func (x *GetVariables_Response) GetVariables() []*VariableDef {
+	if x != nil {
+		return x.Variables
+	}
+	return nil
+}
+
+type GetOutputs_Request struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOutputs_Request) Reset() {
+	*x = GetOutputs_Request{}
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[111]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOutputs_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOutputs_Request) ProtoMessage() {}
+
+func (x *GetOutputs_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[111]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-func (x *GetConfigSchema_Response) GetSchema() *BodySchema {
-	if x != nil {
-		return x.Schema
-	}
-	return nil
+// Deprecated: Use GetOutputs_Request.ProtoReflect.Descriptor instead.
+func (*GetOutputs_Request) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_tfbreak_proto_rawDescGZIP(), []int{30, 0}
 }
 
-type ApplyGlobalConfig_Request struct {
+type GetOutputs_Response struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Config        *Config                `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
+	Outputs       []*OutputDef           `protobuf:"bytes,1,rep,name=outputs,proto3" json:"outputs,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ApplyGlobalConfig_Request) Reset() {
-	*x = ApplyGlobalConfig_Request{}
-	mi := &file_plugin_proto_tfbreak_proto_msgTypes[34]
+func (x *GetOutputs_Response) Reset() {
+	*x = GetOutputs_Response{}
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[112]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ApplyGlobalConfig_Request) String() string {
+func (x *GetOutputs_Response) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ApplyGlobalConfig_Request) ProtoMessage() {}
+func (*GetOutputs_Response) ProtoMessage() {}
 
-func (x *ApplyGlobalConfig_Request) ProtoReflect() protoreflect.Message {
-	mi := &file_plugin_proto_tfbreak_proto_msgTypes[34]
+func (x *GetOutputs_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[112]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1868,39 +5725,39 @@ func (x *ApplyGlobalConfig_Request) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ApplyGlobalConfig_Request.ProtoReflect.Descriptor instead.
-func (*ApplyGlobalConfig_Request) Descriptor() ([]byte, []int) {
-	return file_plugin_proto_tfbreak_proto_rawDescGZIP(), []int{5, 0}
+// Deprecated: Use GetOutputs_Response.ProtoReflect.Descriptor instead.
+func (*GetOutputs_Response) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_tfbreak_proto_rawDescGZIP(), []int{30, 1}
 }
 
-func (x *ApplyGlobalConfig_Request) GetConfig() *Config {
+func (x *GetOutputs_Response) GetOutputs() []*OutputDef {
 	if x != nil {
-		return x.Config
+		return x.Outputs
 	}
 	return nil
 }
 
-type ApplyGlobalConfig_Response struct {
+type GetModuleCalls_Request struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ApplyGlobalConfig_Response) Reset() {
-	*x = ApplyGlobalConfig_Response{}
-	mi := &file_plugin_proto_tfbreak_proto_msgTypes[35]
+func (x *GetModuleCalls_Request) Reset() {
+	*x = GetModuleCalls_Request{}
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[113]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ApplyGlobalConfig_Response) String() string {
+func (x *GetModuleCalls_Request) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ApplyGlobalConfig_Response) ProtoMessage() {}
+func (*GetModuleCalls_Request) ProtoMessage() {}
 
-func (x *ApplyGlobalConfig_Response) ProtoReflect() protoreflect.Message {
-	mi := &file_plugin_proto_tfbreak_proto_msgTypes[35]
+func (x *GetModuleCalls_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[113]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1911,33 +5768,33 @@ func (x *ApplyGlobalConfig_Response) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ApplyGlobalConfig_Response.ProtoReflect.Descriptor instead.
-func (*ApplyGlobalConfig_Response) Descriptor() ([]byte, []int) {
-	return file_plugin_proto_tfbreak_proto_rawDescGZIP(), []int{5, 1}
+// Deprecated: Use GetModuleCalls_Request.ProtoReflect.Descriptor instead.
+func (*GetModuleCalls_Request) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_tfbreak_proto_rawDescGZIP(), []int{32, 0}
 }
 
-type ApplyConfig_Request struct {
+type GetModuleCalls_Response struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Content       *BodyContent           `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
+	ModuleCalls   []*ModuleCall          `protobuf:"bytes,1,rep,name=module_calls,json=moduleCalls,proto3" json:"module_calls,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ApplyConfig_Request) Reset() {
-	*x = ApplyConfig_Request{}
-	mi := &file_plugin_proto_tfbreak_proto_msgTypes[36]
+func (x *GetModuleCalls_Response) Reset() {
+	*x = GetModuleCalls_Response{}
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[114]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ApplyConfig_Request) String() string {
+func (x *GetModuleCalls_Response) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ApplyConfig_Request) ProtoMessage() {}
+func (*GetModuleCalls_Response) ProtoMessage() {}
 
-func (x *ApplyConfig_Request) ProtoReflect() protoreflect.Message {
-	mi := &file_plugin_proto_tfbreak_proto_msgTypes[36]
+func (x *GetModuleCalls_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[114]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1948,39 +5805,39 @@ func (x *ApplyConfig_Request) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ApplyConfig_Request.ProtoReflect.Descriptor instead.
-func (*ApplyConfig_Request) Descriptor() ([]byte, []int) {
-	return file_plugin_proto_tfbreak_proto_rawDescGZIP(), []int{6, 0}
+// Deprecated: Use GetModuleCalls_Response.ProtoReflect.Descriptor instead.
+func (*GetModuleCalls_Response) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_tfbreak_proto_rawDescGZIP(), []int{32, 1}
 }
 
-func (x *ApplyConfig_Request) GetContent() *BodyContent {
+func (x *GetModuleCalls_Response) GetModuleCalls() []*ModuleCall {
 	if x != nil {
-		return x.Content
+		return x.ModuleCalls
 	}
 	return nil
 }
 
-type ApplyConfig_Response struct {
+type GetLocals_Request struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ApplyConfig_Response) Reset() {
-	*x = ApplyConfig_Response{}
-	mi := &file_plugin_proto_tfbreak_proto_msgTypes[37]
+func (x *GetLocals_Request) Reset() {
+	*x = GetLocals_Request{}
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[115]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ApplyConfig_Response) String() string {
+func (x *GetLocals_Request) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ApplyConfig_Response) ProtoMessage() {}
+func (*GetLocals_Request) ProtoMessage() {}
 
-func (x *ApplyConfig_Response) ProtoReflect() protoreflect.Message {
-	mi := &file_plugin_proto_tfbreak_proto_msgTypes[37]
+func (x *GetLocals_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[115]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1991,32 +5848,35 @@ func (x *ApplyConfig_Response) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ApplyConfig_Response.ProtoReflect.Descriptor instead.
-func (*ApplyConfig_Response) Descriptor() ([]byte, []int) {
-	return file_plugin_proto_tfbreak_proto_rawDescGZIP(), []int{6, 1}
+// Deprecated: Use GetLocals_Request.ProtoReflect.Descriptor instead.
+func (*GetLocals_Request) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_tfbreak_proto_rawDescGZIP(), []int{34, 0}
 }
 
-type Check_Request struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+type GetLocals_Response struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// locals maps local names to their values. Values that are not wholly
+	// known are sent empty.
+	Locals        map[string]*Value `protobuf:"bytes,1,rep,name=locals,proto3" json:"locals,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Check_Request) Reset() {
-	*x = Check_Request{}
-	mi := &file_plugin_proto_tfbreak_proto_msgTypes[38]
+func (x *GetLocals_Response) Reset() {
+	*x = GetLocals_Response{}
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[116]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Check_Request) String() string {
+func (x *GetLocals_Response) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Check_Request) ProtoMessage() {}
+func (*GetLocals_Response) ProtoMessage() {}
 
-func (x *Check_Request) ProtoReflect() protoreflect.Message {
-	mi := &file_plugin_proto_tfbreak_proto_msgTypes[38]
+func (x *GetLocals_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[116]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2027,32 +5887,39 @@ func (x *Check_Request) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Check_Request.ProtoReflect.Descriptor instead.
-func (*Check_Request) Descriptor() ([]byte, []int) {
-	return file_plugin_proto_tfbreak_proto_rawDescGZIP(), []int{7, 0}
+// Deprecated: Use GetLocals_Response.ProtoReflect.Descriptor instead.
+func (*GetLocals_Response) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_tfbreak_proto_rawDescGZIP(), []int{34, 1}
 }
 
-type Check_Response struct {
+func (x *GetLocals_Response) GetLocals() map[string]*Value {
+	if x != nil {
+		return x.Locals
+	}
+	return nil
+}
+
+type GetAllResources_Request struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Check_Response) Reset() {
-	*x = Check_Response{}
-	mi := &file_plugin_proto_tfbreak_proto_msgTypes[39]
+func (x *GetAllResources_Request) Reset() {
+	*x = GetAllResources_Request{}
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[118]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Check_Response) String() string {
+func (x *GetAllResources_Request) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Check_Response) ProtoMessage() {}
+func (*GetAllResources_Request) ProtoMessage() {}
 
-func (x *Check_Response) ProtoReflect() protoreflect.Message {
-	mi := &file_plugin_proto_tfbreak_proto_msgTypes[39]
+func (x *GetAllResources_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[118]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2063,34 +5930,35 @@ func (x *Check_Response) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Check_Response.ProtoReflect.Descriptor instead.
-func (*Check_Response) Descriptor() ([]byte, []int) {
-	return file_plugin_proto_tfbreak_proto_rawDescGZIP(), []int{7, 1}
+// Deprecated: Use GetAllResources_Request.ProtoReflect.Descriptor instead.
+func (*GetAllResources_Request) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_tfbreak_proto_rawDescGZIP(), []int{35, 0}
 }
 
-type GetModuleContent_Request struct {
-	state         protoimpl.MessageState  `protogen:"open.v1"`
-	Schema        *BodySchema             `protobuf:"bytes,1,opt,name=schema,proto3" json:"schema,omitempty"`
-	Option        *GetModuleContentOption `protobuf:"bytes,2,opt,name=option,proto3" json:"option,omitempty"`
+type GetAllResources_Response struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// resources are the resource blocks with all attributes and nested
+	// blocks extracted.
+	Resources     []*Block `protobuf:"bytes,1,rep,name=resources,proto3" json:"resources,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetModuleContent_Request) Reset() {
-	*x = GetModuleContent_Request{}
-	mi := &file_plugin_proto_tfbreak_proto_msgTypes[40]
+func (x *GetAllResources_Response) Reset() {
+	*x = GetAllResources_Response{}
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[119]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetModuleContent_Request) String() string {
+func (x *GetAllResources_Response) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetModuleContent_Request) ProtoMessage() {}
+func (*GetAllResources_Response) ProtoMessage() {}
 
-func (x *GetModuleContent_Request) ProtoReflect() protoreflect.Message {
-	mi := &file_plugin_proto_tfbreak_proto_msgTypes[40]
+func (x *GetAllResources_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[119]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2101,47 +5969,39 @@ func (x *GetModuleContent_Request) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetModuleContent_Request.ProtoReflect.Descriptor instead.
-func (*GetModuleContent_Request) Descriptor() ([]byte, []int) {
-	return file_plugin_proto_tfbreak_proto_rawDescGZIP(), []int{8, 0}
-}
-
-func (x *GetModuleContent_Request) GetSchema() *BodySchema {
-	if x != nil {
-		return x.Schema
-	}
-	return nil
+// Deprecated: Use GetAllResources_Response.ProtoReflect.Descriptor instead.
+func (*GetAllResources_Response) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_tfbreak_proto_rawDescGZIP(), []int{35, 1}
 }
 
-func (x *GetModuleContent_Request) GetOption() *GetModuleContentOption {
+func (x *GetAllResources_Response) GetResources() []*Block {
 	if x != nil {
-		return x.Option
+		return x.Resources
 	}
 	return nil
 }
 
-type GetModuleContent_Response struct {
+type ResourceAddresses_Request struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Content       *BodyContent           `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetModuleContent_Response) Reset() {
-	*x = GetModuleContent_Response{}
-	mi := &file_plugin_proto_tfbreak_proto_msgTypes[41]
+func (x *ResourceAddresses_Request) Reset() {
+	*x = ResourceAddresses_Request{}
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[120]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetModuleContent_Response) String() string {
+func (x *ResourceAddresses_Request) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetModuleContent_Response) ProtoMessage() {}
+func (*ResourceAddresses_Request) ProtoMessage() {}
 
-func (x *GetModuleContent_Response) ProtoReflect() protoreflect.Message {
-	mi := &file_plugin_proto_tfbreak_proto_msgTypes[41]
+func (x *ResourceAddresses_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[120]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2152,42 +6012,34 @@ func (x *GetModuleContent_Response) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetModuleContent_Response.ProtoReflect.Descriptor instead.
-func (*GetModuleContent_Response) Descriptor() ([]byte, []int) {
-	return file_plugin_proto_tfbreak_proto_rawDescGZIP(), []int{8, 1}
-}
-
-func (x *GetModuleContent_Response) GetContent() *BodyContent {
-	if x != nil {
-		return x.Content
-	}
-	return nil
+// Deprecated: Use ResourceAddresses_Request.ProtoReflect.Descriptor instead.
+func (*ResourceAddresses_Request) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_tfbreak_proto_rawDescGZIP(), []int{36, 0}
 }
 
-type GetResourceContent_Request struct {
-	state         protoimpl.MessageState  `protogen:"open.v1"`
-	ResourceType  string                  `protobuf:"bytes,1,opt,name=resource_type,json=resourceType,proto3" json:"resource_type,omitempty"`
-	Schema        *BodySchema             `protobuf:"bytes,2,opt,name=schema,proto3" json:"schema,omitempty"`
-	Option        *GetModuleContentOption `protobuf:"bytes,3,opt,name=option,proto3" json:"option,omitempty"`
+type ResourceAddresses_Response struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// addresses are the sorted "type.name" addresses of the resource blocks.
+	Addresses     []string `protobuf:"bytes,1,rep,name=addresses,proto3" json:"addresses,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetResourceContent_Request) Reset() {
-	*x = GetResourceContent_Request{}
-	mi := &file_plugin_proto_tfbreak_proto_msgTypes[42]
+func (x *ResourceAddresses_Response) Reset() {
+	*x = ResourceAddresses_Response{}
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[121]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetResourceContent_Request) String() string {
+func (x *ResourceAddresses_Response) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetResourceContent_Request) ProtoMessage() {}
+func (*ResourceAddresses_Response) ProtoMessage() {}
 
-func (x *GetResourceContent_Request) ProtoReflect() protoreflect.Message {
-	mi := &file_plugin_proto_tfbreak_proto_msgTypes[42]
+func (x *ResourceAddresses_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[121]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2198,54 +6050,76 @@ func (x *GetResourceContent_Request) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetResourceContent_Request.ProtoReflect.Descriptor instead.
-func (*GetResourceContent_Request) Descriptor() ([]byte, []int) {
-	return file_plugin_proto_tfbreak_proto_rawDescGZIP(), []int{9, 0}
+// Deprecated: Use ResourceAddresses_Response.ProtoReflect.Descriptor instead.
+func (*ResourceAddresses_Response) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_tfbreak_proto_rawDescGZIP(), []int{36, 1}
 }
 
-func (x *GetResourceContent_Request) GetResourceType() string {
+func (x *ResourceAddresses_Response) GetAddresses() []string {
 	if x != nil {
-		return x.ResourceType
+		return x.Addresses
 	}
-	return ""
+	return nil
 }
 
-func (x *GetResourceContent_Request) GetSchema() *BodySchema {
-	if x != nil {
-		return x.Schema
-	}
-	return nil
+type GetMovedBlocks_Request struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetResourceContent_Request) GetOption() *GetModuleContentOption {
+func (x *GetMovedBlocks_Request) Reset() {
+	*x = GetMovedBlocks_Request{}
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[122]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMovedBlocks_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMovedBlocks_Request) ProtoMessage() {}
+
+func (x *GetMovedBlocks_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[122]
 	if x != nil {
-		return x.Option
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-type GetResourceContent_Response struct {
+// Deprecated: Use GetMovedBlocks_Request.ProtoReflect.Descriptor instead.
+func (*GetMovedBlocks_Request) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_tfbreak_proto_rawDescGZIP(), []int{37, 0}
+}
+
+type GetMovedBlocks_Response struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Content       *BodyContent           `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
+	MovedBlocks   []*MovedBlock          `protobuf:"bytes,1,rep,name=moved_blocks,json=movedBlocks,proto3" json:"moved_blocks,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetResourceContent_Response) Reset() {
-	*x = GetResourceContent_Response{}
-	mi := &file_plugin_proto_tfbreak_proto_msgTypes[43]
+func (x *GetMovedBlocks_Response) Reset() {
+	*x = GetMovedBlocks_Response{}
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[123]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetResourceContent_Response) String() string {
+func (x *GetMovedBlocks_Response) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetResourceContent_Response) ProtoMessage() {}
+func (*GetMovedBlocks_Response) ProtoMessage() {}
 
-func (x *GetResourceContent_Response) ProtoReflect() protoreflect.Message {
-	mi := &file_plugin_proto_tfbreak_proto_msgTypes[43]
+func (x *GetMovedBlocks_Response) ProtoReflect() protoreflect.Message {
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[123]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2256,30 +6130,42 @@ func (x *GetResourceContent_Response) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetResourceContent_Response.ProtoReflect.Descriptor instead.
-func (*GetResourceContent_Response) Descriptor() ([]byte, []int) {
-	return file_plugin_proto_tfbreak_proto_rawDescGZIP(), []int{9, 1}
+// Deprecated: Use GetMovedBlocks_Response.ProtoReflect.Descriptor instead.
+func (*GetMovedBlocks_Response) Descriptor() ([]byte, []int) {
+	return file_plugin_proto_tfbreak_proto_rawDescGZIP(), []int{37, 1}
 }
 
-func (x *GetResourceContent_Response) GetContent() *BodyContent {
+func (x *GetMovedBlocks_Response) GetMovedBlocks() []*MovedBlock {
 	if x != nil {
-		return x.Content
+		return x.MovedBlocks
 	}
 	return nil
 }
 
 type EmitIssue_Request struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Rule          *Rule                  `protobuf:"bytes,1,opt,name=rule,proto3" json:"rule,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	Range         *Range                 `protobuf:"bytes,3,opt,name=range,proto3" json:"range,omitempty"`
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Rule    *Rule                  `protobuf:"bytes,1,opt,name=rule,proto3" json:"rule,omitempty"`
+	Message string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Range   *Range                 `protobuf:"bytes,3,opt,name=range,proto3" json:"range,omitempty"`
+	// fix is an optional suggested remediation.
+	// Hosts that do not support fixes ignore this field.
+	Fix *Fix `protobuf:"bytes,4,opt,name=fix,proto3" json:"fix,omitempty"`
+	// severity overrides the rule's severity for this issue when set.
+	Severity Severity `protobuf:"varint,5,opt,name=severity,proto3,enum=tfbreak.Severity" json:"severity,omitempty"`
+	// kind classifies the issue, or is unspecified if the rule did not.
+	Kind IssueKind `protobuf:"varint,6,opt,name=kind,proto3,enum=tfbreak.IssueKind" json:"kind,omitempty"`
+	// resource_address is the address of the resource the issue is about,
+	// or empty if the rule did not set one.
+	ResourceAddress string `protobuf:"bytes,7,opt,name=resource_address,json=resourceAddress,proto3" json:"resource_address,omitempty"`
+	// side is the configuration range refers to. Unspecified means NEW.
+	Side          Side `protobuf:"varint,8,opt,name=side,proto3,enum=tfbreak.Side" json:"side,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *EmitIssue_Request) Reset() {
 	*x = EmitIssue_Request{}
-	mi := &file_plugin_proto_tfbreak_proto_msgTypes[44]
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[124]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2291,7 +6177,7 @@ func (x *EmitIssue_Request) String() string {
 func (*EmitIssue_Request) ProtoMessage() {}
 
 func (x *EmitIssue_Request) ProtoReflect() protoreflect.Message {
-	mi := &file_plugin_proto_tfbreak_proto_msgTypes[44]
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[124]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2304,7 +6190,7 @@ func (x *EmitIssue_Request) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EmitIssue_Request.ProtoReflect.Descriptor instead.
 func (*EmitIssue_Request) Descriptor() ([]byte, []int) {
-	return file_plugin_proto_tfbreak_proto_rawDescGZIP(), []int{10, 0}
+	return file_plugin_proto_tfbreak_proto_rawDescGZIP(), []int{39, 0}
 }
 
 func (x *EmitIssue_Request) GetRule() *Rule {
@@ -2328,6 +6214,41 @@ func (x *EmitIssue_Request) GetRange() *Range {
 	return nil
 }
 
+func (x *EmitIssue_Request) GetFix() *Fix {
+	if x != nil {
+		return x.Fix
+	}
+	return nil
+}
+
+func (x *EmitIssue_Request) GetSeverity() Severity {
+	if x != nil {
+		return x.Severity
+	}
+	return Severity_SEVERITY_UNSPECIFIED
+}
+
+func (x *EmitIssue_Request) GetKind() IssueKind {
+	if x != nil {
+		return x.Kind
+	}
+	return IssueKind_ISSUE_KIND_UNSPECIFIED
+}
+
+func (x *EmitIssue_Request) GetResourceAddress() string {
+	if x != nil {
+		return x.ResourceAddress
+	}
+	return ""
+}
+
+func (x *EmitIssue_Request) GetSide() Side {
+	if x != nil {
+		return x.Side
+	}
+	return Side_SIDE_UNSPECIFIED
+}
+
 type EmitIssue_Response struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
@@ -2336,7 +6257,7 @@ type EmitIssue_Response struct {
 
 func (x *EmitIssue_Response) Reset() {
 	*x = EmitIssue_Response{}
-	mi := &file_plugin_proto_tfbreak_proto_msgTypes[45]
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[125]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2348,7 +6269,7 @@ func (x *EmitIssue_Response) String() string {
 func (*EmitIssue_Response) ProtoMessage() {}
 
 func (x *EmitIssue_Response) ProtoReflect() protoreflect.Message {
-	mi := &file_plugin_proto_tfbreak_proto_msgTypes[45]
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[125]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2361,7 +6282,7 @@ func (x *EmitIssue_Response) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EmitIssue_Response.ProtoReflect.Descriptor instead.
 func (*EmitIssue_Response) Descriptor() ([]byte, []int) {
-	return file_plugin_proto_tfbreak_proto_rawDescGZIP(), []int{10, 1}
+	return file_plugin_proto_tfbreak_proto_rawDescGZIP(), []int{39, 1}
 }
 
 type DecodeRuleConfig_Request struct {
@@ -2373,7 +6294,7 @@ type DecodeRuleConfig_Request struct {
 
 func (x *DecodeRuleConfig_Request) Reset() {
 	*x = DecodeRuleConfig_Request{}
-	mi := &file_plugin_proto_tfbreak_proto_msgTypes[46]
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[126]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2385,7 +6306,7 @@ func (x *DecodeRuleConfig_Request) String() string {
 func (*DecodeRuleConfig_Request) ProtoMessage() {}
 
 func (x *DecodeRuleConfig_Request) ProtoReflect() protoreflect.Message {
-	mi := &file_plugin_proto_tfbreak_proto_msgTypes[46]
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[126]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2398,7 +6319,7 @@ func (x *DecodeRuleConfig_Request) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DecodeRuleConfig_Request.ProtoReflect.Descriptor instead.
 func (*DecodeRuleConfig_Request) Descriptor() ([]byte, []int) {
-	return file_plugin_proto_tfbreak_proto_rawDescGZIP(), []int{11, 0}
+	return file_plugin_proto_tfbreak_proto_rawDescGZIP(), []int{40, 0}
 }
 
 func (x *DecodeRuleConfig_Request) GetRuleName() string {
@@ -2420,7 +6341,7 @@ type DecodeRuleConfig_Response struct {
 
 func (x *DecodeRuleConfig_Response) Reset() {
 	*x = DecodeRuleConfig_Response{}
-	mi := &file_plugin_proto_tfbreak_proto_msgTypes[47]
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[127]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2432,7 +6353,7 @@ func (x *DecodeRuleConfig_Response) String() string {
 func (*DecodeRuleConfig_Response) ProtoMessage() {}
 
 func (x *DecodeRuleConfig_Response) ProtoReflect() protoreflect.Message {
-	mi := &file_plugin_proto_tfbreak_proto_msgTypes[47]
+	mi := &file_plugin_proto_tfbreak_proto_msgTypes[127]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2445,7 +6366,7 @@ func (x *DecodeRuleConfig_Response) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DecodeRuleConfig_Response.ProtoReflect.Descriptor instead.
 func (*DecodeRuleConfig_Response) Descriptor() ([]byte, []int) {
-	return file_plugin_proto_tfbreak_proto_rawDescGZIP(), []int{11, 1}
+	return file_plugin_proto_tfbreak_proto_rawDescGZIP(), []int{40, 1}
 }
 
 func (x *DecodeRuleConfig_Response) GetConfigBytes() []byte {
@@ -2478,13 +6399,35 @@ const file_plugin_proto_tfbreak_proto_rawDesc = "" +
 	"\fGetRuleNames\x1a\t\n" +
 	"\aRequest\x1a \n" +
 	"\bResponse\x12\x14\n" +
-	"\x05names\x18\x01 \x03(\tR\x05names\"M\n" +
+	"\x05names\x18\x01 \x03(\tR\x05names\"\xbe\x01\n" +
+	"\x0fGetRuleMetadata\x1a\t\n" +
+	"\aRequest\x1a\x9f\x01\n" +
+	"\bResponse\x12B\n" +
+	"\x05rules\x18\x01 \x03(\v2,.tfbreak.GetRuleMetadata.Response.RulesEntryR\x05rules\x1aO\n" +
+	"\n" +
+	"RulesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12+\n" +
+	"\x05value\x18\x02 \x01(\v2\x15.tfbreak.RuleMetadataR\x05value:\x028\x01\"M\n" +
+	"\x0fGetRuleDefaults\x1a\t\n" +
+	"\aRequest\x1a/\n" +
+	"\bResponse\x12#\n" +
+	"\x05rules\x18\x01 \x03(\v2\r.tfbreak.RuleR\x05rules\"M\n" +
 	"\x14GetVersionConstraint\x1a\t\n" +
 	"\aRequest\x1a*\n" +
 	"\bResponse\x12\x1e\n" +
 	"\n" +
 	"constraint\x18\x01 \x01(\tR\n" +
-	"constraint\"U\n" +
+	"constraint\"\x9e\x01\n" +
+	"\rGetSDKVersion\x1a\t\n" +
+	"\aRequest\x1a\x81\x01\n" +
+	"\bResponse\x12\x18\n" +
+	"\aversion\x18\x01 \x01(\tR\aversion\x12)\n" +
+	"\x10protocol_version\x18\x02 \x01(\x05R\x0fprotocolVersion\x120\n" +
+	"\x14min_protocol_version\x18\x03 \x01(\x05R\x12minProtocolVersion\"L\n" +
+	"\x0fGetCapabilities\x1a\t\n" +
+	"\aRequest\x1a.\n" +
+	"\bResponse\x12\"\n" +
+	"\fcapabilities\x18\x01 \x03(\tR\fcapabilities\"U\n" +
 	"\x0fGetConfigSchema\x1a\t\n" +
 	"\aRequest\x1a7\n" +
 	"\bResponse\x12+\n" +
@@ -2498,11 +6441,34 @@ const file_plugin_proto_tfbreak_proto_rawDesc = "" +
 	"\aRequest\x12.\n" +
 	"\acontent\x18\x01 \x01(\v2\x14.tfbreak.BodyContentR\acontent\x1a\n" +
 	"\n" +
-	"\bResponse\"\x1e\n" +
+	"\bResponse\"\x86\x01\n" +
 	"\x05Check\x1a\t\n" +
-	"\aRequest\x1a\n" +
-	"\n" +
-	"\bResponse\"\xbf\x01\n" +
+	"\aRequest\x1ar\n" +
+	"\bResponse\x12&\n" +
+	"\x06issues\x18\x01 \x03(\v2\x0e.tfbreak.IssueR\x06issues\x12*\n" +
+	"\x06errors\x18\x02 \x03(\v2\x12.tfbreak.RuleErrorR\x06errorsJ\x04\b\x03\x10\x04R\fmax_severity\"\xca\x01\n" +
+	"\vCheckStream\x1a\t\n" +
+	"\aRequest\x1ax\n" +
+	"\bResponse\x12&\n" +
+	"\x05issue\x18\x01 \x01(\v2\x0e.tfbreak.IssueH\x00R\x05issue\x12;\n" +
+	"\bcomplete\x18\x02 \x01(\v2\x1d.tfbreak.CheckStream.CompleteH\x00R\bcompleteB\a\n" +
+	"\x05event\x1a6\n" +
+	"\bComplete\x12*\n" +
+	"\x06errors\x18\x01 \x03(\v2\x12.tfbreak.RuleErrorR\x06errors\"\xaf\x02\n" +
+	"\x05Issue\x12!\n" +
+	"\x04rule\x18\x01 \x01(\v2\r.tfbreak.RuleR\x04rule\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12$\n" +
+	"\x05range\x18\x03 \x01(\v2\x0e.tfbreak.RangeR\x05range\x12\x1e\n" +
+	"\x03fix\x18\x04 \x01(\v2\f.tfbreak.FixR\x03fix\x12-\n" +
+	"\bseverity\x18\x05 \x01(\x0e2\x11.tfbreak.SeverityR\bseverity\x12&\n" +
+	"\x04kind\x18\x06 \x01(\x0e2\x12.tfbreak.IssueKindR\x04kind\x12)\n" +
+	"\x10resource_address\x18\a \x01(\tR\x0fresourceAddress\x12!\n" +
+	"\x04side\x18\b \x01(\x0e2\r.tfbreak.SideR\x04side\"\xa4\x01\n" +
+	"\tRuleError\x12\x12\n" +
+	"\x04rule\x18\x01 \x01(\tR\x04rule\x122\n" +
+	"\bcategory\x18\x02 \x01(\x0e2\x16.tfbreak.ErrorCategoryR\bcategory\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\x125\n" +
+	"\vdiagnostics\x18\x04 \x03(\v2\x13.tfbreak.DiagnosticR\vdiagnostics\"\xbf\x01\n" +
 	"\x10GetModuleContent\x1ao\n" +
 	"\aRequest\x12+\n" +
 	"\x06schema\x18\x01 \x01(\v2\x13.tfbreak.BodySchemaR\x06schema\x127\n" +
@@ -2515,12 +6481,139 @@ const file_plugin_proto_tfbreak_proto_rawDesc = "" +
 	"\x06schema\x18\x02 \x01(\v2\x13.tfbreak.BodySchemaR\x06schema\x127\n" +
 	"\x06option\x18\x03 \x01(\v2\x1f.tfbreak.GetModuleContentOptionR\x06option\x1a:\n" +
 	"\bResponse\x12.\n" +
-	"\acontent\x18\x01 \x01(\v2\x14.tfbreak.BodyContentR\acontent\"\x85\x01\n" +
-	"\tEmitIssue\x1al\n" +
+	"\acontent\x18\x01 \x01(\v2\x14.tfbreak.BodyContentR\acontent\"\xa9\x02\n" +
+	"\x16GetResourceContentPair\x1a\x94\x01\n" +
+	"\aRequest\x12#\n" +
+	"\rresource_type\x18\x01 \x01(\tR\fresourceType\x12+\n" +
+	"\x06schema\x18\x02 \x01(\v2\x13.tfbreak.BodySchemaR\x06schema\x127\n" +
+	"\x06option\x18\x03 \x01(\v2\x1f.tfbreak.GetModuleContentOptionR\x06option\x1ax\n" +
+	"\bResponse\x125\n" +
+	"\vold_content\x18\x01 \x01(\v2\x14.tfbreak.BodyContentR\n" +
+	"oldContent\x125\n" +
+	"\vnew_content\x18\x02 \x01(\v2\x14.tfbreak.BodyContentR\n" +
+	"newContent\"R\n" +
+	"\aGetFile\x1a%\n" +
+	"\aRequest\x12\x1a\n" +
+	"\bfilename\x18\x01 \x01(\tR\bfilename\x1a \n" +
+	"\bResponse\x12\x14\n" +
+	"\x05bytes\x18\x01 \x01(\fR\x05bytes\"{\n" +
+	"\rGetFileSource\x1aH\n" +
+	"\aRequest\x12\x1a\n" +
+	"\bfilename\x18\x01 \x01(\tR\bfilename\x12!\n" +
+	"\x04side\x18\x02 \x01(\x0e2\r.tfbreak.SideR\x04side\x1a \n" +
+	"\bResponse\x12\x14\n" +
+	"\x05bytes\x18\x01 \x01(\fR\x05bytes\"@\n" +
+	"\tListFiles\x1a\t\n" +
+	"\aRequest\x1a(\n" +
+	"\bResponse\x12\x1c\n" +
+	"\tfilenames\x18\x01 \x03(\tR\tfilenames\"l\n" +
+	"\vFileChanges\x1a\t\n" +
+	"\aRequest\x1aR\n" +
+	"\bResponse\x12\x14\n" +
+	"\x05added\x18\x01 \x03(\tR\x05added\x12\x18\n" +
+	"\aremoved\x18\x02 \x03(\tR\aremoved\x12\x16\n" +
+	"\x06common\x18\x03 \x03(\tR\x06common\"\xf1\x01\n" +
+	"\x17GetProviderRequirements\x1a\t\n" +
+	"\aRequest\x1a\xca\x01\n" +
+	"\bResponse\x12_\n" +
+	"\frequirements\x18\x01 \x03(\v2;.tfbreak.GetProviderRequirements.Response.RequirementsEntryR\frequirements\x1a]\n" +
+	"\x11RequirementsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x122\n" +
+	"\x05value\x18\x02 \x01(\v2\x1c.tfbreak.ProviderRequirementR\x05value:\x028\x01\"e\n" +
+	"\x14GetTerraformSettings\x1a\t\n" +
+	"\aRequest\x1aB\n" +
+	"\bResponse\x126\n" +
+	"\bsettings\x18\x01 \x01(\v2\x1a.tfbreak.TerraformSettingsR\bsettings\"\xff\x02\n" +
+	"\x11TerraformSettings\x12)\n" +
+	"\x10required_version\x18\x01 \x01(\tR\x0frequiredVersion\x12D\n" +
+	"\x16required_version_range\x18\x02 \x01(\v2\x0e.tfbreak.RangeR\x14requiredVersionRange\x123\n" +
+	"\abackend\x18\x03 \x01(\v2\x19.tfbreak.TerraformBackendR\abackend\x12`\n" +
+	"\x12required_providers\x18\x04 \x03(\v21.tfbreak.TerraformSettings.RequiredProvidersEntryR\x11requiredProviders\x1ab\n" +
+	"\x16RequiredProvidersEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x122\n" +
+	"\x05value\x18\x02 \x01(\v2\x1c.tfbreak.ProviderRequirementR\x05value:\x028\x01\"\x7f\n" +
+	"\x10TerraformBackend\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12(\n" +
+	"\x04body\x18\x02 \x01(\v2\x14.tfbreak.BodyContentR\x04body\x12-\n" +
+	"\n" +
+	"decl_range\x18\x03 \x01(\v2\x0e.tfbreak.RangeR\tdeclRange\"\x91\x01\n" +
+	"\x11GetProviderConfig\x1aJ\n" +
+	"\aRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12+\n" +
+	"\x06schema\x18\x02 \x01(\v2\x13.tfbreak.BodySchemaR\x06schema\x1a0\n" +
+	"\bResponse\x12$\n" +
+	"\x05block\x18\x01 \x01(\v2\x0e.tfbreak.BlockR\x05block\"\\\n" +
+	"\x13ProviderRequirement\x12\x16\n" +
+	"\x06source\x18\x01 \x01(\tR\x06source\x12-\n" +
+	"\x12version_constraint\x18\x02 \x01(\tR\x11versionConstraint\"Y\n" +
+	"\fGetVariables\x1a\t\n" +
+	"\aRequest\x1a>\n" +
+	"\bResponse\x122\n" +
+	"\tvariables\x18\x01 \x03(\v2\x14.tfbreak.VariableDefR\tvariables\"\xaa\x01\n" +
+	"\vVariableDef\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\fR\x04type\x12(\n" +
+	"\adefault\x18\x03 \x01(\v2\x0e.tfbreak.ValueR\adefault\x12\x1a\n" +
+	"\brequired\x18\x04 \x01(\bR\brequired\x12-\n" +
+	"\n" +
+	"decl_range\x18\x05 \x01(\v2\x0e.tfbreak.RangeR\tdeclRange\"Q\n" +
+	"\n" +
+	"GetOutputs\x1a\t\n" +
+	"\aRequest\x1a8\n" +
+	"\bResponse\x12,\n" +
+	"\aoutputs\x18\x01 \x03(\v2\x12.tfbreak.OutputDefR\aoutputs\"\x8e\x01\n" +
+	"\tOutputDef\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x1c\n" +
+	"\tsensitive\x18\x03 \x01(\bR\tsensitive\x12-\n" +
+	"\n" +
+	"decl_range\x18\x04 \x01(\v2\x0e.tfbreak.RangeR\tdeclRange\"_\n" +
+	"\x0eGetModuleCalls\x1a\t\n" +
+	"\aRequest\x1aB\n" +
+	"\bResponse\x126\n" +
+	"\fmodule_calls\x18\x01 \x03(\v2\x13.tfbreak.ModuleCallR\vmoduleCalls\"\x81\x01\n" +
+	"\n" +
+	"ModuleCall\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x16\n" +
+	"\x06source\x18\x02 \x01(\tR\x06source\x12\x18\n" +
+	"\aversion\x18\x03 \x01(\tR\aversion\x12-\n" +
+	"\n" +
+	"decl_range\x18\x04 \x01(\v2\x0e.tfbreak.RangeR\tdeclRange\"\xaf\x01\n" +
+	"\tGetLocals\x1a\t\n" +
+	"\aRequest\x1a\x96\x01\n" +
+	"\bResponse\x12?\n" +
+	"\x06locals\x18\x01 \x03(\v2'.tfbreak.GetLocals.Response.LocalsEntryR\x06locals\x1aI\n" +
+	"\vLocalsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12$\n" +
+	"\x05value\x18\x02 \x01(\v2\x0e.tfbreak.ValueR\x05value:\x028\x01\"V\n" +
+	"\x0fGetAllResources\x1a\t\n" +
+	"\aRequest\x1a8\n" +
+	"\bResponse\x12,\n" +
+	"\tresources\x18\x01 \x03(\v2\x0e.tfbreak.BlockR\tresources\"H\n" +
+	"\x11ResourceAddresses\x1a\t\n" +
+	"\aRequest\x1a(\n" +
+	"\bResponse\x12\x1c\n" +
+	"\taddresses\x18\x01 \x03(\tR\taddresses\"_\n" +
+	"\x0eGetMovedBlocks\x1a\t\n" +
+	"\aRequest\x1aB\n" +
+	"\bResponse\x126\n" +
+	"\fmoved_blocks\x18\x01 \x03(\v2\x13.tfbreak.MovedBlockR\vmovedBlocks\"_\n" +
+	"\n" +
+	"MovedBlock\x12\x12\n" +
+	"\x04from\x18\x01 \x01(\tR\x04from\x12\x0e\n" +
+	"\x02to\x18\x02 \x01(\tR\x02to\x12-\n" +
+	"\n" +
+	"decl_range\x18\x03 \x01(\v2\x0e.tfbreak.RangeR\tdeclRange\"\xcb\x02\n" +
+	"\tEmitIssue\x1a\xb1\x02\n" +
 	"\aRequest\x12!\n" +
 	"\x04rule\x18\x01 \x01(\v2\r.tfbreak.RuleR\x04rule\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\x12$\n" +
-	"\x05range\x18\x03 \x01(\v2\x0e.tfbreak.RangeR\x05range\x1a\n" +
+	"\x05range\x18\x03 \x01(\v2\x0e.tfbreak.RangeR\x05range\x12\x1e\n" +
+	"\x03fix\x18\x04 \x01(\v2\f.tfbreak.FixR\x03fix\x12-\n" +
+	"\bseverity\x18\x05 \x01(\x0e2\x11.tfbreak.SeverityR\bseverity\x12&\n" +
+	"\x04kind\x18\x06 \x01(\x0e2\x12.tfbreak.IssueKindR\x04kind\x12)\n" +
+	"\x10resource_address\x18\a \x01(\tR\x0fresourceAddress\x12!\n" +
+	"\x04side\x18\b \x01(\x0e2\r.tfbreak.SideR\x04side\x1a\n" +
 	"\n" +
 	"\bResponse\"\x88\x01\n" +
 	"\x10DecodeRuleConfig\x1a&\n" +
@@ -2529,28 +6622,51 @@ const file_plugin_proto_tfbreak_proto_rawDesc = "" +
 	"\bResponse\x12!\n" +
 	"\fconfig_bytes\x18\x01 \x01(\fR\vconfigBytes\x12\x1d\n" +
 	"\n" +
-	"has_config\x18\x02 \x01(\bR\thasConfig\"\xec\x01\n" +
+	"has_config\x18\x02 \x01(\bR\thasConfig\"\xc6\x03\n" +
 	"\x06Config\x120\n" +
 	"\x05rules\x18\x01 \x03(\v2\x1a.tfbreak.Config.RulesEntryR\x05rules\x12.\n" +
 	"\x13disabled_by_default\x18\x02 \x01(\bR\x11disabledByDefault\x12\x12\n" +
 	"\x04only\x18\x03 \x03(\tR\x04only\x12\x1d\n" +
 	"\n" +
-	"plugin_dir\x18\x04 \x01(\tR\tpluginDir\x1aM\n" +
+	"plugin_dir\x18\x04 \x01(\tR\tpluginDir\x124\n" +
+	"\fmin_severity\x18\x05 \x01(\x0e2\x11.tfbreak.SeverityR\vminSeverity\x12<\n" +
+	"\tvariables\x18\x06 \x03(\v2\x1e.tfbreak.Config.VariablesEntryR\tvariables\x12\x16\n" +
+	"\x06ignore\x18\a \x03(\tR\x06ignore\x1aM\n" +
 	"\n" +
 	"RulesEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12)\n" +
-	"\x05value\x18\x02 \x01(\v2\x13.tfbreak.RuleConfigR\x05value:\x028\x01\"Y\n" +
+	"\x05value\x18\x02 \x01(\v2\x13.tfbreak.RuleConfigR\x05value:\x028\x01\x1aL\n" +
+	"\x0eVariablesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12$\n" +
+	"\x05value\x18\x02 \x01(\v2\x0e.tfbreak.ValueR\x05value:\x028\x01\"1\n" +
+	"\x05Value\x12\x14\n" +
+	"\x05value\x18\x01 \x01(\fR\x05value\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\fR\x04type\"\x8c\x01\n" +
 	"\n" +
 	"RuleConfig\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x18\n" +
-	"\aenabled\x18\x02 \x01(\bR\aenabled\x12\x1d\n" +
-	"\n" +
-	"body_bytes\x18\x03 \x01(\fR\tbodyBytes\"w\n" +
+	"\aenabled\x18\x02 \x01(\bR\aenabled\x12!\n" +
+	"\fconfig_bytes\x18\x03 \x01(\fR\vconfigBytes\x12-\n" +
+	"\bseverity\x18\x04 \x01(\x0e2\x11.tfbreak.SeverityR\bseverity\"w\n" +
 	"\x04Rule\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x18\n" +
 	"\aenabled\x18\x02 \x01(\bR\aenabled\x12-\n" +
 	"\bseverity\x18\x03 \x01(\x0e2\x11.tfbreak.SeverityR\bseverity\x12\x12\n" +
-	"\x04link\x18\x04 \x01(\tR\x04link\"\x9d\x01\n" +
+	"\x04link\x18\x04 \x01(\tR\x04link\"\xac\x01\n" +
+	"\fRuleMetadata\x12%\n" +
+	"\x0eresource_types\x18\x01 \x03(\tR\rresourceTypes\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x12\n" +
+	"\x04tags\x18\x03 \x03(\tR\x04tags\x12\x1e\n" +
+	"\n" +
+	"deprecated\x18\x04 \x01(\bR\n" +
+	"deprecated\x12\x1f\n" +
+	"\vreplaced_by\x18\x05 \x01(\tR\n" +
+	"replacedBy\".\n" +
+	"\x03Fix\x12'\n" +
+	"\x05edits\x18\x01 \x03(\v2\x11.tfbreak.TextEditR\x05edits\"K\n" +
+	"\bTextEdit\x12$\n" +
+	"\x05range\x18\x01 \x01(\v2\x0e.tfbreak.RangeR\x05range\x12\x19\n" +
+	"\bnew_text\x18\x02 \x01(\tR\anewText\"\x9d\x01\n" +
 	"\n" +
 	"BodySchema\x128\n" +
 	"\n" +
@@ -2573,7 +6689,7 @@ const file_plugin_proto_tfbreak_proto_rawDesc = "" +
 	"\x06blocks\x18\x02 \x03(\v2\x0e.tfbreak.BlockR\x06blocks\x1aQ\n" +
 	"\x0fAttributesEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12(\n" +
-	"\x05value\x18\x02 \x01(\v2\x12.tfbreak.AttributeR\x05value:\x028\x01\"\xb2\x01\n" +
+	"\x05value\x18\x02 \x01(\v2\x12.tfbreak.AttributeR\x05value:\x028\x01\"\xbb\x03\n" +
 	"\tAttribute\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1d\n" +
 	"\n" +
@@ -2582,7 +6698,20 @@ const file_plugin_proto_tfbreak_proto_rawDesc = "" +
 	"\n" +
 	"name_range\x18\x04 \x01(\v2\x0e.tfbreak.RangeR\tnameRange\x12\x1d\n" +
 	"\n" +
-	"expr_value\x18\x05 \x01(\fR\texprValue\"\xec\x01\n" +
+	"expr_value\x18\x05 \x01(\fR\texprValue\x12\x1b\n" +
+	"\texpr_type\x18\x06 \x01(\fR\bexprType\x12C\n" +
+	"\vitem_ranges\x18\a \x03(\v2\".tfbreak.Attribute.ItemRangesEntryR\n" +
+	"itemRanges\x12\x17\n" +
+	"\ais_null\x18\b \x01(\bR\x06isNull\x12\x1d\n" +
+	"\n" +
+	"is_unknown\x18\t \x01(\bR\tisUnknown\x12\x1e\n" +
+	"\n" +
+	"references\x18\n" +
+	" \x03(\tR\n" +
+	"references\x1aM\n" +
+	"\x0fItemRangesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12$\n" +
+	"\x05value\x18\x02 \x01(\v2\x0e.tfbreak.RangeR\x05value:\x028\x01\"\xb8\x02\n" +
 	"\x05Block\x12\x12\n" +
 	"\x04type\x18\x01 \x01(\tR\x04type\x12\x16\n" +
 	"\x06labels\x18\x02 \x03(\tR\x06labels\x12(\n" +
@@ -2590,11 +6719,23 @@ const file_plugin_proto_tfbreak_proto_rawDesc = "" +
 	"\tdef_range\x18\x04 \x01(\v2\x0e.tfbreak.RangeR\bdefRange\x12-\n" +
 	"\n" +
 	"type_range\x18\x05 \x01(\v2\x0e.tfbreak.RangeR\ttypeRange\x121\n" +
-	"\flabel_ranges\x18\x06 \x03(\v2\x0e.tfbreak.RangeR\vlabelRanges\"q\n" +
+	"\flabel_ranges\x18\x06 \x03(\v2\x0e.tfbreak.RangeR\vlabelRanges\x12)\n" +
+	"\x10leading_comments\x18\a \x03(\tR\x0fleadingComments\x12\x1f\n" +
+	"\vmodule_path\x18\b \x03(\tR\n" +
+	"modulePath\"q\n" +
 	"\x05Range\x12\x1a\n" +
 	"\bfilename\x18\x01 \x01(\tR\bfilename\x12'\n" +
 	"\x05start\x18\x02 \x01(\v2\x11.tfbreak.PositionR\x05start\x12#\n" +
-	"\x03end\x18\x03 \x01(\v2\x11.tfbreak.PositionR\x03end\"J\n" +
+	"\x03end\x18\x03 \x01(\v2\x11.tfbreak.PositionR\x03end\"\xcb\x01\n" +
+	"\n" +
+	"Diagnostic\x127\n" +
+	"\bseverity\x18\x01 \x01(\x0e2\x1b.tfbreak.DiagnosticSeverityR\bseverity\x12\x18\n" +
+	"\asummary\x18\x02 \x01(\tR\asummary\x12\x16\n" +
+	"\x06detail\x18\x03 \x01(\tR\x06detail\x12(\n" +
+	"\asubject\x18\x04 \x01(\v2\x0e.tfbreak.RangeR\asubject\x12(\n" +
+	"\acontext\x18\x05 \x01(\v2\x0e.tfbreak.RangeR\acontext\"D\n" +
+	"\vDiagnostics\x125\n" +
+	"\vdiagnostics\x18\x01 \x03(\v2\x13.tfbreak.DiagnosticR\vdiagnostics\"J\n" +
 	"\bPosition\x12\x12\n" +
 	"\x04line\x18\x01 \x01(\x03R\x04line\x12\x16\n" +
 	"\x06column\x18\x02 \x01(\x03R\x06column\x12\x12\n" +
@@ -2604,16 +6745,31 @@ const file_plugin_proto_tfbreak_proto_rawDesc = "" +
 	"module_ctx\x18\x01 \x01(\x0e2\x16.tfbreak.ModuleCtxTypeR\tmoduleCtx\x124\n" +
 	"\vexpand_mode\x18\x02 \x01(\x0e2\x13.tfbreak.ExpandModeR\n" +
 	"expandMode\x12,\n" +
-	"\x12resource_type_hint\x18\x03 \x01(\tR\x10resourceTypeHint*c\n" +
+	"\x12resource_type_hint\x18\x03 \x01(\tR\x10resourceTypeHint*\x81\x01\n" +
+	"\rErrorCategory\x12\x1e\n" +
+	"\x1aERROR_CATEGORY_UNSPECIFIED\x10\x00\x12\x1b\n" +
+	"\x17ERROR_CATEGORY_INTERNAL\x10\x01\x12\x18\n" +
+	"\x14ERROR_CATEGORY_PANIC\x10\x02\x12\x19\n" +
+	"\x15ERROR_CATEGORY_CONFIG\x10\x03*c\n" +
 	"\bSeverity\x12\x18\n" +
 	"\x14SEVERITY_UNSPECIFIED\x10\x00\x12\x12\n" +
 	"\x0eSEVERITY_ERROR\x10\x01\x12\x14\n" +
 	"\x10SEVERITY_WARNING\x10\x02\x12\x13\n" +
-	"\x0fSEVERITY_NOTICE\x10\x03*F\n" +
+	"\x0fSEVERITY_NOTICE\x10\x03*m\n" +
+	"\tIssueKind\x12\x1a\n" +
+	"\x16ISSUE_KIND_UNSPECIFIED\x10\x00\x12\x17\n" +
+	"\x13ISSUE_KIND_BREAKING\x10\x01\x12\x16\n" +
+	"\x12ISSUE_KIND_WARNING\x10\x02\x12\x13\n" +
+	"\x0fISSUE_KIND_INFO\x10\x03*]\n" +
 	"\n" +
 	"SchemaMode\x12\x17\n" +
 	"\x13SCHEMA_MODE_DEFAULT\x10\x00\x12\x1f\n" +
-	"\x1bSCHEMA_MODE_JUST_ATTRIBUTES\x10\x01*M\n" +
+	"\x1bSCHEMA_MODE_JUST_ATTRIBUTES\x10\x01\x12\x15\n" +
+	"\x11SCHEMA_MODE_EXACT\x10\x02*y\n" +
+	"\x12DiagnosticSeverity\x12#\n" +
+	"\x1fDIAGNOSTIC_SEVERITY_UNSPECIFIED\x10\x00\x12\x1d\n" +
+	"\x19DIAGNOSTIC_SEVERITY_ERROR\x10\x01\x12\x1f\n" +
+	"\x1bDIAGNOSTIC_SEVERITY_WARNING\x10\x02*M\n" +
 	"\rModuleCtxType\x12\x13\n" +
 	"\x0fMODULE_CTX_SELF\x10\x00\x12\x13\n" +
 	"\x0fMODULE_CTX_ROOT\x10\x01\x12\x12\n" +
@@ -2621,21 +6777,60 @@ const file_plugin_proto_tfbreak_proto_rawDesc = "" +
 	"\n" +
 	"ExpandMode\x12\x14\n" +
 	"\x10EXPAND_MODE_NONE\x10\x00\x12\x16\n" +
-	"\x12EXPAND_MODE_EXPAND\x10\x012\xae\x05\n" +
+	"\x12EXPAND_MODE_EXPAND\x10\x01*8\n" +
+	"\x04Side\x12\x14\n" +
+	"\x10SIDE_UNSPECIFIED\x10\x00\x12\f\n" +
+	"\bSIDE_OLD\x10\x01\x12\f\n" +
+	"\bSIDE_NEW\x10\x022\xd6\b\n" +
 	"\aRuleSet\x12S\n" +
 	"\x0eGetRuleSetName\x12\x1f.tfbreak.GetRuleSetName.Request\x1a .tfbreak.GetRuleSetName.Response\x12\\\n" +
 	"\x11GetRuleSetVersion\x12\".tfbreak.GetRuleSetVersion.Request\x1a#.tfbreak.GetRuleSetVersion.Response\x12M\n" +
-	"\fGetRuleNames\x12\x1d.tfbreak.GetRuleNames.Request\x1a\x1e.tfbreak.GetRuleNames.Response\x12e\n" +
-	"\x14GetVersionConstraint\x12%.tfbreak.GetVersionConstraint.Request\x1a&.tfbreak.GetVersionConstraint.Response\x12V\n" +
+	"\fGetRuleNames\x12\x1d.tfbreak.GetRuleNames.Request\x1a\x1e.tfbreak.GetRuleNames.Response\x12V\n" +
+	"\x0fGetRuleMetadata\x12 .tfbreak.GetRuleMetadata.Request\x1a!.tfbreak.GetRuleMetadata.Response\x12V\n" +
+	"\x0fGetRuleDefaults\x12 .tfbreak.GetRuleDefaults.Request\x1a!.tfbreak.GetRuleDefaults.Response\x12e\n" +
+	"\x14GetVersionConstraint\x12%.tfbreak.GetVersionConstraint.Request\x1a&.tfbreak.GetVersionConstraint.Response\x12P\n" +
+	"\rGetSDKVersion\x12\x1e.tfbreak.GetSDKVersion.Request\x1a\x1f.tfbreak.GetSDKVersion.Response\x12V\n" +
+	"\x0fGetCapabilities\x12 .tfbreak.GetCapabilities.Request\x1a!.tfbreak.GetCapabilities.Response\x12V\n" +
 	"\x0fGetConfigSchema\x12 .tfbreak.GetConfigSchema.Request\x1a!.tfbreak.GetConfigSchema.Response\x12\\\n" +
 	"\x11ApplyGlobalConfig\x12\".tfbreak.ApplyGlobalConfig.Request\x1a#.tfbreak.ApplyGlobalConfig.Response\x12J\n" +
 	"\vApplyConfig\x12\x1c.tfbreak.ApplyConfig.Request\x1a\x1d.tfbreak.ApplyConfig.Response\x128\n" +
-	"\x05Check\x12\x16.tfbreak.Check.Request\x1a\x17.tfbreak.Check.Response2\xad\x04\n" +
+	"\x05Check\x12\x16.tfbreak.Check.Request\x1a\x17.tfbreak.Check.Response\x12L\n" +
+	"\vCheckStream\x12\x1c.tfbreak.CheckStream.Request\x1a\x1d.tfbreak.CheckStream.Response0\x012\xe3\x17\n" +
 	"\x06Runner\x12\\\n" +
 	"\x13GetOldModuleContent\x12!.tfbreak.GetModuleContent.Request\x1a\".tfbreak.GetModuleContent.Response\x12\\\n" +
 	"\x13GetNewModuleContent\x12!.tfbreak.GetModuleContent.Request\x1a\".tfbreak.GetModuleContent.Response\x12b\n" +
 	"\x15GetOldResourceContent\x12#.tfbreak.GetResourceContent.Request\x1a$.tfbreak.GetResourceContent.Response\x12b\n" +
-	"\x15GetNewResourceContent\x12#.tfbreak.GetResourceContent.Request\x1a$.tfbreak.GetResourceContent.Response\x12D\n" +
+	"\x15GetNewResourceContent\x12#.tfbreak.GetResourceContent.Request\x1a$.tfbreak.GetResourceContent.Response\x12k\n" +
+	"\x16GetResourceContentPair\x12'.tfbreak.GetResourceContentPair.Request\x1a(.tfbreak.GetResourceContentPair.Response\x12d\n" +
+	"\x17GetOldDataSourceContent\x12#.tfbreak.GetResourceContent.Request\x1a$.tfbreak.GetResourceContent.Response\x12d\n" +
+	"\x17GetNewDataSourceContent\x12#.tfbreak.GetResourceContent.Request\x1a$.tfbreak.GetResourceContent.Response\x12A\n" +
+	"\n" +
+	"GetOldFile\x12\x18.tfbreak.GetFile.Request\x1a\x19.tfbreak.GetFile.Response\x12A\n" +
+	"\n" +
+	"GetNewFile\x12\x18.tfbreak.GetFile.Request\x1a\x19.tfbreak.GetFile.Response\x12P\n" +
+	"\rGetFileSource\x12\x1e.tfbreak.GetFileSource.Request\x1a\x1f.tfbreak.GetFileSource.Response\x12G\n" +
+	"\fListOldFiles\x12\x1a.tfbreak.ListFiles.Request\x1a\x1b.tfbreak.ListFiles.Response\x12G\n" +
+	"\fListNewFiles\x12\x1a.tfbreak.ListFiles.Request\x1a\x1b.tfbreak.ListFiles.Response\x12J\n" +
+	"\vFileChanges\x12\x1c.tfbreak.FileChanges.Request\x1a\x1d.tfbreak.FileChanges.Response\x12q\n" +
+	"\x1aGetOldProviderRequirements\x12(.tfbreak.GetProviderRequirements.Request\x1a).tfbreak.GetProviderRequirements.Response\x12q\n" +
+	"\x1aGetNewProviderRequirements\x12(.tfbreak.GetProviderRequirements.Request\x1a).tfbreak.GetProviderRequirements.Response\x12h\n" +
+	"\x17GetOldTerraformSettings\x12%.tfbreak.GetTerraformSettings.Request\x1a&.tfbreak.GetTerraformSettings.Response\x12h\n" +
+	"\x17GetNewTerraformSettings\x12%.tfbreak.GetTerraformSettings.Request\x1a&.tfbreak.GetTerraformSettings.Response\x12_\n" +
+	"\x14GetOldProviderConfig\x12\".tfbreak.GetProviderConfig.Request\x1a#.tfbreak.GetProviderConfig.Response\x12_\n" +
+	"\x14GetNewProviderConfig\x12\".tfbreak.GetProviderConfig.Request\x1a#.tfbreak.GetProviderConfig.Response\x12P\n" +
+	"\x0fGetOldVariables\x12\x1d.tfbreak.GetVariables.Request\x1a\x1e.tfbreak.GetVariables.Response\x12P\n" +
+	"\x0fGetNewVariables\x12\x1d.tfbreak.GetVariables.Request\x1a\x1e.tfbreak.GetVariables.Response\x12J\n" +
+	"\rGetOldOutputs\x12\x1b.tfbreak.GetOutputs.Request\x1a\x1c.tfbreak.GetOutputs.Response\x12J\n" +
+	"\rGetNewOutputs\x12\x1b.tfbreak.GetOutputs.Request\x1a\x1c.tfbreak.GetOutputs.Response\x12V\n" +
+	"\x11GetOldModuleCalls\x12\x1f.tfbreak.GetModuleCalls.Request\x1a .tfbreak.GetModuleCalls.Response\x12V\n" +
+	"\x11GetNewModuleCalls\x12\x1f.tfbreak.GetModuleCalls.Request\x1a .tfbreak.GetModuleCalls.Response\x12G\n" +
+	"\fGetOldLocals\x12\x1a.tfbreak.GetLocals.Request\x1a\x1b.tfbreak.GetLocals.Response\x12G\n" +
+	"\fGetNewLocals\x12\x1a.tfbreak.GetLocals.Request\x1a\x1b.tfbreak.GetLocals.Response\x12Y\n" +
+	"\x12GetAllOldResources\x12 .tfbreak.GetAllResources.Request\x1a!.tfbreak.GetAllResources.Response\x12Y\n" +
+	"\x12GetAllNewResources\x12 .tfbreak.GetAllResources.Request\x1a!.tfbreak.GetAllResources.Response\x12_\n" +
+	"\x14OldResourceAddresses\x12\".tfbreak.ResourceAddresses.Request\x1a#.tfbreak.ResourceAddresses.Response\x12_\n" +
+	"\x14NewResourceAddresses\x12\".tfbreak.ResourceAddresses.Request\x1a#.tfbreak.ResourceAddresses.Response\x12S\n" +
+	"\x0eGetMovedBlocks\x12\x1f.tfbreak.GetMovedBlocks.Request\x1a .tfbreak.GetMovedBlocks.Response\x12D\n" +
 	"\tEmitIssue\x12\x1a.tfbreak.EmitIssue.Request\x1a\x1b.tfbreak.EmitIssue.Response\x12Y\n" +
 	"\x10DecodeRuleConfig\x12!.tfbreak.DecodeRuleConfig.Request\x1a\".tfbreak.DecodeRuleConfig.ResponseB3Z1github.com/jokarl/tfbreak-plugin-sdk/plugin/protob\x06proto3"
 
@@ -2651,129 +6846,341 @@ func file_plugin_proto_tfbreak_proto_rawDescGZIP() []byte {
 	return file_plugin_proto_tfbreak_proto_rawDescData
 }
 
-var file_plugin_proto_tfbreak_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
-var file_plugin_proto_tfbreak_proto_msgTypes = make([]protoimpl.MessageInfo, 50)
+var file_plugin_proto_tfbreak_proto_enumTypes = make([]protoimpl.EnumInfo, 8)
+var file_plugin_proto_tfbreak_proto_msgTypes = make([]protoimpl.MessageInfo, 132)
 var file_plugin_proto_tfbreak_proto_goTypes = []any{
-	(Severity)(0),                         // 0: tfbreak.Severity
-	(SchemaMode)(0),                       // 1: tfbreak.SchemaMode
-	(ModuleCtxType)(0),                    // 2: tfbreak.ModuleCtxType
-	(ExpandMode)(0),                       // 3: tfbreak.ExpandMode
-	(*GetRuleSetName)(nil),                // 4: tfbreak.GetRuleSetName
-	(*GetRuleSetVersion)(nil),             // 5: tfbreak.GetRuleSetVersion
-	(*GetRuleNames)(nil),                  // 6: tfbreak.GetRuleNames
-	(*GetVersionConstraint)(nil),          // 7: tfbreak.GetVersionConstraint
-	(*GetConfigSchema)(nil),               // 8: tfbreak.GetConfigSchema
-	(*ApplyGlobalConfig)(nil),             // 9: tfbreak.ApplyGlobalConfig
-	(*ApplyConfig)(nil),                   // 10: tfbreak.ApplyConfig
-	(*Check)(nil),                         // 11: tfbreak.Check
-	(*GetModuleContent)(nil),              // 12: tfbreak.GetModuleContent
-	(*GetResourceContent)(nil),            // 13: tfbreak.GetResourceContent
-	(*EmitIssue)(nil),                     // 14: tfbreak.EmitIssue
-	(*DecodeRuleConfig)(nil),              // 15: tfbreak.DecodeRuleConfig
-	(*Config)(nil),                        // 16: tfbreak.Config
-	(*RuleConfig)(nil),                    // 17: tfbreak.RuleConfig
-	(*Rule)(nil),                          // 18: tfbreak.Rule
-	(*BodySchema)(nil),                    // 19: tfbreak.BodySchema
-	(*AttributeSchema)(nil),               // 20: tfbreak.AttributeSchema
-	(*BlockSchema)(nil),                   // 21: tfbreak.BlockSchema
-	(*BodyContent)(nil),                   // 22: tfbreak.BodyContent
-	(*Attribute)(nil),                     // 23: tfbreak.Attribute
-	(*Block)(nil),                         // 24: tfbreak.Block
-	(*Range)(nil),                         // 25: tfbreak.Range
-	(*Position)(nil),                      // 26: tfbreak.Position
-	(*GetModuleContentOption)(nil),        // 27: tfbreak.GetModuleContentOption
-	(*GetRuleSetName_Request)(nil),        // 28: tfbreak.GetRuleSetName.Request
-	(*GetRuleSetName_Response)(nil),       // 29: tfbreak.GetRuleSetName.Response
-	(*GetRuleSetVersion_Request)(nil),     // 30: tfbreak.GetRuleSetVersion.Request
-	(*GetRuleSetVersion_Response)(nil),    // 31: tfbreak.GetRuleSetVersion.Response
-	(*GetRuleNames_Request)(nil),          // 32: tfbreak.GetRuleNames.Request
-	(*GetRuleNames_Response)(nil),         // 33: tfbreak.GetRuleNames.Response
-	(*GetVersionConstraint_Request)(nil),  // 34: tfbreak.GetVersionConstraint.Request
-	(*GetVersionConstraint_Response)(nil), // 35: tfbreak.GetVersionConstraint.Response
-	(*GetConfigSchema_Request)(nil),       // 36: tfbreak.GetConfigSchema.Request
-	(*GetConfigSchema_Response)(nil),      // 37: tfbreak.GetConfigSchema.Response
-	(*ApplyGlobalConfig_Request)(nil),     // 38: tfbreak.ApplyGlobalConfig.Request
-	(*ApplyGlobalConfig_Response)(nil),    // 39: tfbreak.ApplyGlobalConfig.Response
-	(*ApplyConfig_Request)(nil),           // 40: tfbreak.ApplyConfig.Request
-	(*ApplyConfig_Response)(nil),          // 41: tfbreak.ApplyConfig.Response
-	(*Check_Request)(nil),                 // 42: tfbreak.Check.Request
-	(*Check_Response)(nil),                // 43: tfbreak.Check.Response
-	(*GetModuleContent_Request)(nil),      // 44: tfbreak.GetModuleContent.Request
-	(*GetModuleContent_Response)(nil),     // 45: tfbreak.GetModuleContent.Response
-	(*GetResourceContent_Request)(nil),    // 46: tfbreak.GetResourceContent.Request
-	(*GetResourceContent_Response)(nil),   // 47: tfbreak.GetResourceContent.Response
-	(*EmitIssue_Request)(nil),             // 48: tfbreak.EmitIssue.Request
-	(*EmitIssue_Response)(nil),            // 49: tfbreak.EmitIssue.Response
-	(*DecodeRuleConfig_Request)(nil),      // 50: tfbreak.DecodeRuleConfig.Request
-	(*DecodeRuleConfig_Response)(nil),     // 51: tfbreak.DecodeRuleConfig.Response
-	nil,                                   // 52: tfbreak.Config.RulesEntry
-	nil,                                   // 53: tfbreak.BodyContent.AttributesEntry
+	(ErrorCategory)(0),                       // 0: tfbreak.ErrorCategory
+	(Severity)(0),                            // 1: tfbreak.Severity
+	(IssueKind)(0),                           // 2: tfbreak.IssueKind
+	(SchemaMode)(0),                          // 3: tfbreak.SchemaMode
+	(DiagnosticSeverity)(0),                  // 4: tfbreak.DiagnosticSeverity
+	(ModuleCtxType)(0),                       // 5: tfbreak.ModuleCtxType
+	(ExpandMode)(0),                          // 6: tfbreak.ExpandMode
+	(Side)(0),                                // 7: tfbreak.Side
+	(*GetRuleSetName)(nil),                   // 8: tfbreak.GetRuleSetName
+	(*GetRuleSetVersion)(nil),                // 9: tfbreak.GetRuleSetVersion
+	(*GetRuleNames)(nil),                     // 10: tfbreak.GetRuleNames
+	(*GetRuleMetadata)(nil),                  // 11: tfbreak.GetRuleMetadata
+	(*GetRuleDefaults)(nil),                  // 12: tfbreak.GetRuleDefaults
+	(*GetVersionConstraint)(nil),             // 13: tfbreak.GetVersionConstraint
+	(*GetSDKVersion)(nil),                    // 14: tfbreak.GetSDKVersion
+	(*GetCapabilities)(nil),                  // 15: tfbreak.GetCapabilities
+	(*GetConfigSchema)(nil),                  // 16: tfbreak.GetConfigSchema
+	(*ApplyGlobalConfig)(nil),                // 17: tfbreak.ApplyGlobalConfig
+	(*ApplyConfig)(nil),                      // 18: tfbreak.ApplyConfig
+	(*Check)(nil),                            // 19: tfbreak.Check
+	(*CheckStream)(nil),                      // 20: tfbreak.CheckStream
+	(*Issue)(nil),                            // 21: tfbreak.Issue
+	(*RuleError)(nil),                        // 22: tfbreak.RuleError
+	(*GetModuleContent)(nil),                 // 23: tfbreak.GetModuleContent
+	(*GetResourceContent)(nil),               // 24: tfbreak.GetResourceContent
+	(*GetResourceContentPair)(nil),           // 25: tfbreak.GetResourceContentPair
+	(*GetFile)(nil),                          // 26: tfbreak.GetFile
+	(*GetFileSource)(nil),                    // 27: tfbreak.GetFileSource
+	(*ListFiles)(nil),                        // 28: tfbreak.ListFiles
+	(*FileChanges)(nil),                      // 29: tfbreak.FileChanges
+	(*GetProviderRequirements)(nil),          // 30: tfbreak.GetProviderRequirements
+	(*GetTerraformSettings)(nil),             // 31: tfbreak.GetTerraformSettings
+	(*TerraformSettings)(nil),                // 32: tfbreak.TerraformSettings
+	(*TerraformBackend)(nil),                 // 33: tfbreak.TerraformBackend
+	(*GetProviderConfig)(nil),                // 34: tfbreak.GetProviderConfig
+	(*ProviderRequirement)(nil),              // 35: tfbreak.ProviderRequirement
+	(*GetVariables)(nil),                     // 36: tfbreak.GetVariables
+	(*VariableDef)(nil),                      // 37: tfbreak.VariableDef
+	(*GetOutputs)(nil),                       // 38: tfbreak.GetOutputs
+	(*OutputDef)(nil),                        // 39: tfbreak.OutputDef
+	(*GetModuleCalls)(nil),                   // 40: tfbreak.GetModuleCalls
+	(*ModuleCall)(nil),                       // 41: tfbreak.ModuleCall
+	(*GetLocals)(nil),                        // 42: tfbreak.GetLocals
+	(*GetAllResources)(nil),                  // 43: tfbreak.GetAllResources
+	(*ResourceAddresses)(nil),                // 44: tfbreak.ResourceAddresses
+	(*GetMovedBlocks)(nil),                   // 45: tfbreak.GetMovedBlocks
+	(*MovedBlock)(nil),                       // 46: tfbreak.MovedBlock
+	(*EmitIssue)(nil),                        // 47: tfbreak.EmitIssue
+	(*DecodeRuleConfig)(nil),                 // 48: tfbreak.DecodeRuleConfig
+	(*Config)(nil),                           // 49: tfbreak.Config
+	(*Value)(nil),                            // 50: tfbreak.Value
+	(*RuleConfig)(nil),                       // 51: tfbreak.RuleConfig
+	(*Rule)(nil),                             // 52: tfbreak.Rule
+	(*RuleMetadata)(nil),                     // 53: tfbreak.RuleMetadata
+	(*Fix)(nil),                              // 54: tfbreak.Fix
+	(*TextEdit)(nil),                         // 55: tfbreak.TextEdit
+	(*BodySchema)(nil),                       // 56: tfbreak.BodySchema
+	(*AttributeSchema)(nil),                  // 57: tfbreak.AttributeSchema
+	(*BlockSchema)(nil),                      // 58: tfbreak.BlockSchema
+	(*BodyContent)(nil),                      // 59: tfbreak.BodyContent
+	(*Attribute)(nil),                        // 60: tfbreak.Attribute
+	(*Block)(nil),                            // 61: tfbreak.Block
+	(*Range)(nil),                            // 62: tfbreak.Range
+	(*Diagnostic)(nil),                       // 63: tfbreak.Diagnostic
+	(*Diagnostics)(nil),                      // 64: tfbreak.Diagnostics
+	(*Position)(nil),                         // 65: tfbreak.Position
+	(*GetModuleContentOption)(nil),           // 66: tfbreak.GetModuleContentOption
+	(*GetRuleSetName_Request)(nil),           // 67: tfbreak.GetRuleSetName.Request
+	(*GetRuleSetName_Response)(nil),          // 68: tfbreak.GetRuleSetName.Response
+	(*GetRuleSetVersion_Request)(nil),        // 69: tfbreak.GetRuleSetVersion.Request
+	(*GetRuleSetVersion_Response)(nil),       // 70: tfbreak.GetRuleSetVersion.Response
+	(*GetRuleNames_Request)(nil),             // 71: tfbreak.GetRuleNames.Request
+	(*GetRuleNames_Response)(nil),            // 72: tfbreak.GetRuleNames.Response
+	(*GetRuleMetadata_Request)(nil),          // 73: tfbreak.GetRuleMetadata.Request
+	(*GetRuleMetadata_Response)(nil),         // 74: tfbreak.GetRuleMetadata.Response
+	nil,                                      // 75: tfbreak.GetRuleMetadata.Response.RulesEntry
+	(*GetRuleDefaults_Request)(nil),          // 76: tfbreak.GetRuleDefaults.Request
+	(*GetRuleDefaults_Response)(nil),         // 77: tfbreak.GetRuleDefaults.Response
+	(*GetVersionConstraint_Request)(nil),     // 78: tfbreak.GetVersionConstraint.Request
+	(*GetVersionConstraint_Response)(nil),    // 79: tfbreak.GetVersionConstraint.Response
+	(*GetSDKVersion_Request)(nil),            // 80: tfbreak.GetSDKVersion.Request
+	(*GetSDKVersion_Response)(nil),           // 81: tfbreak.GetSDKVersion.Response
+	(*GetCapabilities_Request)(nil),          // 82: tfbreak.GetCapabilities.Request
+	(*GetCapabilities_Response)(nil),         // 83: tfbreak.GetCapabilities.Response
+	(*GetConfigSchema_Request)(nil),          // 84: tfbreak.GetConfigSchema.Request
+	(*GetConfigSchema_Response)(nil),         // 85: tfbreak.GetConfigSchema.Response
+	(*ApplyGlobalConfig_Request)(nil),        // 86: tfbreak.ApplyGlobalConfig.Request
+	(*ApplyGlobalConfig_Response)(nil),       // 87: tfbreak.ApplyGlobalConfig.Response
+	(*ApplyConfig_Request)(nil),              // 88: tfbreak.ApplyConfig.Request
+	(*ApplyConfig_Response)(nil),             // 89: tfbreak.ApplyConfig.Response
+	(*Check_Request)(nil),                    // 90: tfbreak.Check.Request
+	(*Check_Response)(nil),                   // 91: tfbreak.Check.Response
+	(*CheckStream_Request)(nil),              // 92: tfbreak.CheckStream.Request
+	(*CheckStream_Response)(nil),             // 93: tfbreak.CheckStream.Response
+	(*CheckStream_Complete)(nil),             // 94: tfbreak.CheckStream.Complete
+	(*GetModuleContent_Request)(nil),         // 95: tfbreak.GetModuleContent.Request
+	(*GetModuleContent_Response)(nil),        // 96: tfbreak.GetModuleContent.Response
+	(*GetResourceContent_Request)(nil),       // 97: tfbreak.GetResourceContent.Request
+	(*GetResourceContent_Response)(nil),      // 98: tfbreak.GetResourceContent.Response
+	(*GetResourceContentPair_Request)(nil),   // 99: tfbreak.GetResourceContentPair.Request
+	(*GetResourceContentPair_Response)(nil),  // 100: tfbreak.GetResourceContentPair.Response
+	(*GetFile_Request)(nil),                  // 101: tfbreak.GetFile.Request
+	(*GetFile_Response)(nil),                 // 102: tfbreak.GetFile.Response
+	(*GetFileSource_Request)(nil),            // 103: tfbreak.GetFileSource.Request
+	(*GetFileSource_Response)(nil),           // 104: tfbreak.GetFileSource.Response
+	(*ListFiles_Request)(nil),                // 105: tfbreak.ListFiles.Request
+	(*ListFiles_Response)(nil),               // 106: tfbreak.ListFiles.Response
+	(*FileChanges_Request)(nil),              // 107: tfbreak.FileChanges.Request
+	(*FileChanges_Response)(nil),             // 108: tfbreak.FileChanges.Response
+	(*GetProviderRequirements_Request)(nil),  // 109: tfbreak.GetProviderRequirements.Request
+	(*GetProviderRequirements_Response)(nil), // 110: tfbreak.GetProviderRequirements.Response
+	nil,                                      // 111: tfbreak.GetProviderRequirements.Response.RequirementsEntry
+	(*GetTerraformSettings_Request)(nil),     // 112: tfbreak.GetTerraformSettings.Request
+	(*GetTerraformSettings_Response)(nil),    // 113: tfbreak.GetTerraformSettings.Response
+	nil,                                      // 114: tfbreak.TerraformSettings.RequiredProvidersEntry
+	(*GetProviderConfig_Request)(nil),        // 115: tfbreak.GetProviderConfig.Request
+	(*GetProviderConfig_Response)(nil),       // 116: tfbreak.GetProviderConfig.Response
+	(*GetVariables_Request)(nil),             // 117: tfbreak.GetVariables.Request
+	(*GetVariables_Response)(nil),            // 118: tfbreak.GetVariables.Response
+	(*GetOutputs_Request)(nil),               // 119: tfbreak.GetOutputs.Request
+	(*GetOutputs_Response)(nil),              // 120: tfbreak.GetOutputs.Response
+	(*GetModuleCalls_Request)(nil),           // 121: tfbreak.GetModuleCalls.Request
+	(*GetModuleCalls_Response)(nil),          // 122: tfbreak.GetModuleCalls.Response
+	(*GetLocals_Request)(nil),                // 123: tfbreak.GetLocals.Request
+	(*GetLocals_Response)(nil),               // 124: tfbreak.GetLocals.Response
+	nil,                                      // 125: tfbreak.GetLocals.Response.LocalsEntry
+	(*GetAllResources_Request)(nil),          // 126: tfbreak.GetAllResources.Request
+	(*GetAllResources_Response)(nil),         // 127: tfbreak.GetAllResources.Response
+	(*ResourceAddresses_Request)(nil),        // 128: tfbreak.ResourceAddresses.Request
+	(*ResourceAddresses_Response)(nil),       // 129: tfbreak.ResourceAddresses.Response
+	(*GetMovedBlocks_Request)(nil),           // 130: tfbreak.GetMovedBlocks.Request
+	(*GetMovedBlocks_Response)(nil),          // 131: tfbreak.GetMovedBlocks.Response
+	(*EmitIssue_Request)(nil),                // 132: tfbreak.EmitIssue.Request
+	(*EmitIssue_Response)(nil),               // 133: tfbreak.EmitIssue.Response
+	(*DecodeRuleConfig_Request)(nil),         // 134: tfbreak.DecodeRuleConfig.Request
+	(*DecodeRuleConfig_Response)(nil),        // 135: tfbreak.DecodeRuleConfig.Response
+	nil,                                      // 136: tfbreak.Config.RulesEntry
+	nil,                                      // 137: tfbreak.Config.VariablesEntry
+	nil,                                      // 138: tfbreak.BodyContent.AttributesEntry
+	nil,                                      // 139: tfbreak.Attribute.ItemRangesEntry
 }
 var file_plugin_proto_tfbreak_proto_depIdxs = []int32{
-	52, // 0: tfbreak.Config.rules:type_name -> tfbreak.Config.RulesEntry
-	0,  // 1: tfbreak.Rule.severity:type_name -> tfbreak.Severity
-	20, // 2: tfbreak.BodySchema.attributes:type_name -> tfbreak.AttributeSchema
-	21, // 3: tfbreak.BodySchema.blocks:type_name -> tfbreak.BlockSchema
-	1,  // 4: tfbreak.BodySchema.mode:type_name -> tfbreak.SchemaMode
-	19, // 5: tfbreak.BlockSchema.body:type_name -> tfbreak.BodySchema
-	53, // 6: tfbreak.BodyContent.attributes:type_name -> tfbreak.BodyContent.AttributesEntry
-	24, // 7: tfbreak.BodyContent.blocks:type_name -> tfbreak.Block
-	25, // 8: tfbreak.Attribute.range:type_name -> tfbreak.Range
-	25, // 9: tfbreak.Attribute.name_range:type_name -> tfbreak.Range
-	22, // 10: tfbreak.Block.body:type_name -> tfbreak.BodyContent
-	25, // 11: tfbreak.Block.def_range:type_name -> tfbreak.Range
-	25, // 12: tfbreak.Block.type_range:type_name -> tfbreak.Range
-	25, // 13: tfbreak.Block.label_ranges:type_name -> tfbreak.Range
-	26, // 14: tfbreak.Range.start:type_name -> tfbreak.Position
-	26, // 15: tfbreak.Range.end:type_name -> tfbreak.Position
-	2,  // 16: tfbreak.GetModuleContentOption.module_ctx:type_name -> tfbreak.ModuleCtxType
-	3,  // 17: tfbreak.GetModuleContentOption.expand_mode:type_name -> tfbreak.ExpandMode
-	19, // 18: tfbreak.GetConfigSchema.Response.schema:type_name -> tfbreak.BodySchema
-	16, // 19: tfbreak.ApplyGlobalConfig.Request.config:type_name -> tfbreak.Config
-	22, // 20: tfbreak.ApplyConfig.Request.content:type_name -> tfbreak.BodyContent
-	19, // 21: tfbreak.GetModuleContent.Request.schema:type_name -> tfbreak.BodySchema
-	27, // 22: tfbreak.GetModuleContent.Request.option:type_name -> tfbreak.GetModuleContentOption
-	22, // 23: tfbreak.GetModuleContent.Response.content:type_name -> tfbreak.BodyContent
-	19, // 24: tfbreak.GetResourceContent.Request.schema:type_name -> tfbreak.BodySchema
-	27, // 25: tfbreak.GetResourceContent.Request.option:type_name -> tfbreak.GetModuleContentOption
-	22, // 26: tfbreak.GetResourceContent.Response.content:type_name -> tfbreak.BodyContent
-	18, // 27: tfbreak.EmitIssue.Request.rule:type_name -> tfbreak.Rule
-	25, // 28: tfbreak.EmitIssue.Request.range:type_name -> tfbreak.Range
-	17, // 29: tfbreak.Config.RulesEntry.value:type_name -> tfbreak.RuleConfig
-	23, // 30: tfbreak.BodyContent.AttributesEntry.value:type_name -> tfbreak.Attribute
-	28, // 31: tfbreak.RuleSet.GetRuleSetName:input_type -> tfbreak.GetRuleSetName.Request
-	30, // 32: tfbreak.RuleSet.GetRuleSetVersion:input_type -> tfbreak.GetRuleSetVersion.Request
-	32, // 33: tfbreak.RuleSet.GetRuleNames:input_type -> tfbreak.GetRuleNames.Request
-	34, // 34: tfbreak.RuleSet.GetVersionConstraint:input_type -> tfbreak.GetVersionConstraint.Request
-	36, // 35: tfbreak.RuleSet.GetConfigSchema:input_type -> tfbreak.GetConfigSchema.Request
-	38, // 36: tfbreak.RuleSet.ApplyGlobalConfig:input_type -> tfbreak.ApplyGlobalConfig.Request
-	40, // 37: tfbreak.RuleSet.ApplyConfig:input_type -> tfbreak.ApplyConfig.Request
-	42, // 38: tfbreak.RuleSet.Check:input_type -> tfbreak.Check.Request
-	44, // 39: tfbreak.Runner.GetOldModuleContent:input_type -> tfbreak.GetModuleContent.Request
-	44, // 40: tfbreak.Runner.GetNewModuleContent:input_type -> tfbreak.GetModuleContent.Request
-	46, // 41: tfbreak.Runner.GetOldResourceContent:input_type -> tfbreak.GetResourceContent.Request
-	46, // 42: tfbreak.Runner.GetNewResourceContent:input_type -> tfbreak.GetResourceContent.Request
-	48, // 43: tfbreak.Runner.EmitIssue:input_type -> tfbreak.EmitIssue.Request
-	50, // 44: tfbreak.Runner.DecodeRuleConfig:input_type -> tfbreak.DecodeRuleConfig.Request
-	29, // 45: tfbreak.RuleSet.GetRuleSetName:output_type -> tfbreak.GetRuleSetName.Response
-	31, // 46: tfbreak.RuleSet.GetRuleSetVersion:output_type -> tfbreak.GetRuleSetVersion.Response
-	33, // 47: tfbreak.RuleSet.GetRuleNames:output_type -> tfbreak.GetRuleNames.Response
-	35, // 48: tfbreak.RuleSet.GetVersionConstraint:output_type -> tfbreak.GetVersionConstraint.Response
-	37, // 49: tfbreak.RuleSet.GetConfigSchema:output_type -> tfbreak.GetConfigSchema.Response
-	39, // 50: tfbreak.RuleSet.ApplyGlobalConfig:output_type -> tfbreak.ApplyGlobalConfig.Response
-	41, // 51: tfbreak.RuleSet.ApplyConfig:output_type -> tfbreak.ApplyConfig.Response
-	43, // 52: tfbreak.RuleSet.Check:output_type -> tfbreak.Check.Response
-	45, // 53: tfbreak.Runner.GetOldModuleContent:output_type -> tfbreak.GetModuleContent.Response
-	45, // 54: tfbreak.Runner.GetNewModuleContent:output_type -> tfbreak.GetModuleContent.Response
-	47, // 55: tfbreak.Runner.GetOldResourceContent:output_type -> tfbreak.GetResourceContent.Response
-	47, // 56: tfbreak.Runner.GetNewResourceContent:output_type -> tfbreak.GetResourceContent.Response
-	49, // 57: tfbreak.Runner.EmitIssue:output_type -> tfbreak.EmitIssue.Response
-	51, // 58: tfbreak.Runner.DecodeRuleConfig:output_type -> tfbreak.DecodeRuleConfig.Response
-	45, // [45:59] is the sub-list for method output_type
-	31, // [31:45] is the sub-list for method input_type
-	31, // [31:31] is the sub-list for extension type_name
-	31, // [31:31] is the sub-list for extension extendee
-	0,  // [0:31] is the sub-list for field type_name
+	52,  // 0: tfbreak.Issue.rule:type_name -> tfbreak.Rule
+	62,  // 1: tfbreak.Issue.range:type_name -> tfbreak.Range
+	54,  // 2: tfbreak.Issue.fix:type_name -> tfbreak.Fix
+	1,   // 3: tfbreak.Issue.severity:type_name -> tfbreak.Severity
+	2,   // 4: tfbreak.Issue.kind:type_name -> tfbreak.IssueKind
+	7,   // 5: tfbreak.Issue.side:type_name -> tfbreak.Side
+	0,   // 6: tfbreak.RuleError.category:type_name -> tfbreak.ErrorCategory
+	63,  // 7: tfbreak.RuleError.diagnostics:type_name -> tfbreak.Diagnostic
+	62,  // 8: tfbreak.TerraformSettings.required_version_range:type_name -> tfbreak.Range
+	33,  // 9: tfbreak.TerraformSettings.backend:type_name -> tfbreak.TerraformBackend
+	114, // 10: tfbreak.TerraformSettings.required_providers:type_name -> tfbreak.TerraformSettings.RequiredProvidersEntry
+	59,  // 11: tfbreak.TerraformBackend.body:type_name -> tfbreak.BodyContent
+	62,  // 12: tfbreak.TerraformBackend.decl_range:type_name -> tfbreak.Range
+	50,  // 13: tfbreak.VariableDef.default:type_name -> tfbreak.Value
+	62,  // 14: tfbreak.VariableDef.decl_range:type_name -> tfbreak.Range
+	62,  // 15: tfbreak.OutputDef.decl_range:type_name -> tfbreak.Range
+	62,  // 16: tfbreak.ModuleCall.decl_range:type_name -> tfbreak.Range
+	62,  // 17: tfbreak.MovedBlock.decl_range:type_name -> tfbreak.Range
+	136, // 18: tfbreak.Config.rules:type_name -> tfbreak.Config.RulesEntry
+	1,   // 19: tfbreak.Config.min_severity:type_name -> tfbreak.Severity
+	137, // 20: tfbreak.Config.variables:type_name -> tfbreak.Config.VariablesEntry
+	1,   // 21: tfbreak.RuleConfig.severity:type_name -> tfbreak.Severity
+	1,   // 22: tfbreak.Rule.severity:type_name -> tfbreak.Severity
+	55,  // 23: tfbreak.Fix.edits:type_name -> tfbreak.TextEdit
+	62,  // 24: tfbreak.TextEdit.range:type_name -> tfbreak.Range
+	57,  // 25: tfbreak.BodySchema.attributes:type_name -> tfbreak.AttributeSchema
+	58,  // 26: tfbreak.BodySchema.blocks:type_name -> tfbreak.BlockSchema
+	3,   // 27: tfbreak.BodySchema.mode:type_name -> tfbreak.SchemaMode
+	56,  // 28: tfbreak.BlockSchema.body:type_name -> tfbreak.BodySchema
+	138, // 29: tfbreak.BodyContent.attributes:type_name -> tfbreak.BodyContent.AttributesEntry
+	61,  // 30: tfbreak.BodyContent.blocks:type_name -> tfbreak.Block
+	62,  // 31: tfbreak.Attribute.range:type_name -> tfbreak.Range
+	62,  // 32: tfbreak.Attribute.name_range:type_name -> tfbreak.Range
+	139, // 33: tfbreak.Attribute.item_ranges:type_name -> tfbreak.Attribute.ItemRangesEntry
+	59,  // 34: tfbreak.Block.body:type_name -> tfbreak.BodyContent
+	62,  // 35: tfbreak.Block.def_range:type_name -> tfbreak.Range
+	62,  // 36: tfbreak.Block.type_range:type_name -> tfbreak.Range
+	62,  // 37: tfbreak.Block.label_ranges:type_name -> tfbreak.Range
+	65,  // 38: tfbreak.Range.start:type_name -> tfbreak.Position
+	65,  // 39: tfbreak.Range.end:type_name -> tfbreak.Position
+	4,   // 40: tfbreak.Diagnostic.severity:type_name -> tfbreak.DiagnosticSeverity
+	62,  // 41: tfbreak.Diagnostic.subject:type_name -> tfbreak.Range
+	62,  // 42: tfbreak.Diagnostic.context:type_name -> tfbreak.Range
+	63,  // 43: tfbreak.Diagnostics.diagnostics:type_name -> tfbreak.Diagnostic
+	5,   // 44: tfbreak.GetModuleContentOption.module_ctx:type_name -> tfbreak.ModuleCtxType
+	6,   // 45: tfbreak.GetModuleContentOption.expand_mode:type_name -> tfbreak.ExpandMode
+	75,  // 46: tfbreak.GetRuleMetadata.Response.rules:type_name -> tfbreak.GetRuleMetadata.Response.RulesEntry
+	53,  // 47: tfbreak.GetRuleMetadata.Response.RulesEntry.value:type_name -> tfbreak.RuleMetadata
+	52,  // 48: tfbreak.GetRuleDefaults.Response.rules:type_name -> tfbreak.Rule
+	56,  // 49: tfbreak.GetConfigSchema.Response.schema:type_name -> tfbreak.BodySchema
+	49,  // 50: tfbreak.ApplyGlobalConfig.Request.config:type_name -> tfbreak.Config
+	59,  // 51: tfbreak.ApplyConfig.Request.content:type_name -> tfbreak.BodyContent
+	21,  // 52: tfbreak.Check.Response.issues:type_name -> tfbreak.Issue
+	22,  // 53: tfbreak.Check.Response.errors:type_name -> tfbreak.RuleError
+	21,  // 54: tfbreak.CheckStream.Response.issue:type_name -> tfbreak.Issue
+	94,  // 55: tfbreak.CheckStream.Response.complete:type_name -> tfbreak.CheckStream.Complete
+	22,  // 56: tfbreak.CheckStream.Complete.errors:type_name -> tfbreak.RuleError
+	56,  // 57: tfbreak.GetModuleContent.Request.schema:type_name -> tfbreak.BodySchema
+	66,  // 58: tfbreak.GetModuleContent.Request.option:type_name -> tfbreak.GetModuleContentOption
+	59,  // 59: tfbreak.GetModuleContent.Response.content:type_name -> tfbreak.BodyContent
+	56,  // 60: tfbreak.GetResourceContent.Request.schema:type_name -> tfbreak.BodySchema
+	66,  // 61: tfbreak.GetResourceContent.Request.option:type_name -> tfbreak.GetModuleContentOption
+	59,  // 62: tfbreak.GetResourceContent.Response.content:type_name -> tfbreak.BodyContent
+	56,  // 63: tfbreak.GetResourceContentPair.Request.schema:type_name -> tfbreak.BodySchema
+	66,  // 64: tfbreak.GetResourceContentPair.Request.option:type_name -> tfbreak.GetModuleContentOption
+	59,  // 65: tfbreak.GetResourceContentPair.Response.old_content:type_name -> tfbreak.BodyContent
+	59,  // 66: tfbreak.GetResourceContentPair.Response.new_content:type_name -> tfbreak.BodyContent
+	7,   // 67: tfbreak.GetFileSource.Request.side:type_name -> tfbreak.Side
+	111, // 68: tfbreak.GetProviderRequirements.Response.requirements:type_name -> tfbreak.GetProviderRequirements.Response.RequirementsEntry
+	35,  // 69: tfbreak.GetProviderRequirements.Response.RequirementsEntry.value:type_name -> tfbreak.ProviderRequirement
+	32,  // 70: tfbreak.GetTerraformSettings.Response.settings:type_name -> tfbreak.TerraformSettings
+	35,  // 71: tfbreak.TerraformSettings.RequiredProvidersEntry.value:type_name -> tfbreak.ProviderRequirement
+	56,  // 72: tfbreak.GetProviderConfig.Request.schema:type_name -> tfbreak.BodySchema
+	61,  // 73: tfbreak.GetProviderConfig.Response.block:type_name -> tfbreak.Block
+	37,  // 74: tfbreak.GetVariables.Response.variables:type_name -> tfbreak.VariableDef
+	39,  // 75: tfbreak.GetOutputs.Response.outputs:type_name -> tfbreak.OutputDef
+	41,  // 76: tfbreak.GetModuleCalls.Response.module_calls:type_name -> tfbreak.ModuleCall
+	125, // 77: tfbreak.GetLocals.Response.locals:type_name -> tfbreak.GetLocals.Response.LocalsEntry
+	50,  // 78: tfbreak.GetLocals.Response.LocalsEntry.value:type_name -> tfbreak.Value
+	61,  // 79: tfbreak.GetAllResources.Response.resources:type_name -> tfbreak.Block
+	46,  // 80: tfbreak.GetMovedBlocks.Response.moved_blocks:type_name -> tfbreak.MovedBlock
+	52,  // 81: tfbreak.EmitIssue.Request.rule:type_name -> tfbreak.Rule
+	62,  // 82: tfbreak.EmitIssue.Request.range:type_name -> tfbreak.Range
+	54,  // 83: tfbreak.EmitIssue.Request.fix:type_name -> tfbreak.Fix
+	1,   // 84: tfbreak.EmitIssue.Request.severity:type_name -> tfbreak.Severity
+	2,   // 85: tfbreak.EmitIssue.Request.kind:type_name -> tfbreak.IssueKind
+	7,   // 86: tfbreak.EmitIssue.Request.side:type_name -> tfbreak.Side
+	51,  // 87: tfbreak.Config.RulesEntry.value:type_name -> tfbreak.RuleConfig
+	50,  // 88: tfbreak.Config.VariablesEntry.value:type_name -> tfbreak.Value
+	60,  // 89: tfbreak.BodyContent.AttributesEntry.value:type_name -> tfbreak.Attribute
+	62,  // 90: tfbreak.Attribute.ItemRangesEntry.value:type_name -> tfbreak.Range
+	67,  // 91: tfbreak.RuleSet.GetRuleSetName:input_type -> tfbreak.GetRuleSetName.Request
+	69,  // 92: tfbreak.RuleSet.GetRuleSetVersion:input_type -> tfbreak.GetRuleSetVersion.Request
+	71,  // 93: tfbreak.RuleSet.GetRuleNames:input_type -> tfbreak.GetRuleNames.Request
+	73,  // 94: tfbreak.RuleSet.GetRuleMetadata:input_type -> tfbreak.GetRuleMetadata.Request
+	76,  // 95: tfbreak.RuleSet.GetRuleDefaults:input_type -> tfbreak.GetRuleDefaults.Request
+	78,  // 96: tfbreak.RuleSet.GetVersionConstraint:input_type -> tfbreak.GetVersionConstraint.Request
+	80,  // 97: tfbreak.RuleSet.GetSDKVersion:input_type -> tfbreak.GetSDKVersion.Request
+	82,  // 98: tfbreak.RuleSet.GetCapabilities:input_type -> tfbreak.GetCapabilities.Request
+	84,  // 99: tfbreak.RuleSet.GetConfigSchema:input_type -> tfbreak.GetConfigSchema.Request
+	86,  // 100: tfbreak.RuleSet.ApplyGlobalConfig:input_type -> tfbreak.ApplyGlobalConfig.Request
+	88,  // 101: tfbreak.RuleSet.ApplyConfig:input_type -> tfbreak.ApplyConfig.Request
+	90,  // 102: tfbreak.RuleSet.Check:input_type -> tfbreak.Check.Request
+	92,  // 103: tfbreak.RuleSet.CheckStream:input_type -> tfbreak.CheckStream.Request
+	95,  // 104: tfbreak.Runner.GetOldModuleContent:input_type -> tfbreak.GetModuleContent.Request
+	95,  // 105: tfbreak.Runner.GetNewModuleContent:input_type -> tfbreak.GetModuleContent.Request
+	97,  // 106: tfbreak.Runner.GetOldResourceContent:input_type -> tfbreak.GetResourceContent.Request
+	97,  // 107: tfbreak.Runner.GetNewResourceContent:input_type -> tfbreak.GetResourceContent.Request
+	99,  // 108: tfbreak.Runner.GetResourceContentPair:input_type -> tfbreak.GetResourceContentPair.Request
+	97,  // 109: tfbreak.Runner.GetOldDataSourceContent:input_type -> tfbreak.GetResourceContent.Request
+	97,  // 110: tfbreak.Runner.GetNewDataSourceContent:input_type -> tfbreak.GetResourceContent.Request
+	101, // 111: tfbreak.Runner.GetOldFile:input_type -> tfbreak.GetFile.Request
+	101, // 112: tfbreak.Runner.GetNewFile:input_type -> tfbreak.GetFile.Request
+	103, // 113: tfbreak.Runner.GetFileSource:input_type -> tfbreak.GetFileSource.Request
+	105, // 114: tfbreak.Runner.ListOldFiles:input_type -> tfbreak.ListFiles.Request
+	105, // 115: tfbreak.Runner.ListNewFiles:input_type -> tfbreak.ListFiles.Request
+	107, // 116: tfbreak.Runner.FileChanges:input_type -> tfbreak.FileChanges.Request
+	109, // 117: tfbreak.Runner.GetOldProviderRequirements:input_type -> tfbreak.GetProviderRequirements.Request
+	109, // 118: tfbreak.Runner.GetNewProviderRequirements:input_type -> tfbreak.GetProviderRequirements.Request
+	112, // 119: tfbreak.Runner.GetOldTerraformSettings:input_type -> tfbreak.GetTerraformSettings.Request
+	112, // 120: tfbreak.Runner.GetNewTerraformSettings:input_type -> tfbreak.GetTerraformSettings.Request
+	115, // 121: tfbreak.Runner.GetOldProviderConfig:input_type -> tfbreak.GetProviderConfig.Request
+	115, // 122: tfbreak.Runner.GetNewProviderConfig:input_type -> tfbreak.GetProviderConfig.Request
+	117, // 123: tfbreak.Runner.GetOldVariables:input_type -> tfbreak.GetVariables.Request
+	117, // 124: tfbreak.Runner.GetNewVariables:input_type -> tfbreak.GetVariables.Request
+	119, // 125: tfbreak.Runner.GetOldOutputs:input_type -> tfbreak.GetOutputs.Request
+	119, // 126: tfbreak.Runner.GetNewOutputs:input_type -> tfbreak.GetOutputs.Request
+	121, // 127: tfbreak.Runner.GetOldModuleCalls:input_type -> tfbreak.GetModuleCalls.Request
+	121, // 128: tfbreak.Runner.GetNewModuleCalls:input_type -> tfbreak.GetModuleCalls.Request
+	123, // 129: tfbreak.Runner.GetOldLocals:input_type -> tfbreak.GetLocals.Request
+	123, // 130: tfbreak.Runner.GetNewLocals:input_type -> tfbreak.GetLocals.Request
+	126, // 131: tfbreak.Runner.GetAllOldResources:input_type -> tfbreak.GetAllResources.Request
+	126, // 132: tfbreak.Runner.GetAllNewResources:input_type -> tfbreak.GetAllResources.Request
+	128, // 133: tfbreak.Runner.OldResourceAddresses:input_type -> tfbreak.ResourceAddresses.Request
+	128, // 134: tfbreak.Runner.NewResourceAddresses:input_type -> tfbreak.ResourceAddresses.Request
+	130, // 135: tfbreak.Runner.GetMovedBlocks:input_type -> tfbreak.GetMovedBlocks.Request
+	132, // 136: tfbreak.Runner.EmitIssue:input_type -> tfbreak.EmitIssue.Request
+	134, // 137: tfbreak.Runner.DecodeRuleConfig:input_type -> tfbreak.DecodeRuleConfig.Request
+	68,  // 138: tfbreak.RuleSet.GetRuleSetName:output_type -> tfbreak.GetRuleSetName.Response
+	70,  // 139: tfbreak.RuleSet.GetRuleSetVersion:output_type -> tfbreak.GetRuleSetVersion.Response
+	72,  // 140: tfbreak.RuleSet.GetRuleNames:output_type -> tfbreak.GetRuleNames.Response
+	74,  // 141: tfbreak.RuleSet.GetRuleMetadata:output_type -> tfbreak.GetRuleMetadata.Response
+	77,  // 142: tfbreak.RuleSet.GetRuleDefaults:output_type -> tfbreak.GetRuleDefaults.Response
+	79,  // 143: tfbreak.RuleSet.GetVersionConstraint:output_type -> tfbreak.GetVersionConstraint.Response
+	81,  // 144: tfbreak.RuleSet.GetSDKVersion:output_type -> tfbreak.GetSDKVersion.Response
+	83,  // 145: tfbreak.RuleSet.GetCapabilities:output_type -> tfbreak.GetCapabilities.Response
+	85,  // 146: tfbreak.RuleSet.GetConfigSchema:output_type -> tfbreak.GetConfigSchema.Response
+	87,  // 147: tfbreak.RuleSet.ApplyGlobalConfig:output_type -> tfbreak.ApplyGlobalConfig.Response
+	89,  // 148: tfbreak.RuleSet.ApplyConfig:output_type -> tfbreak.ApplyConfig.Response
+	91,  // 149: tfbreak.RuleSet.Check:output_type -> tfbreak.Check.Response
+	93,  // 150: tfbreak.RuleSet.CheckStream:output_type -> tfbreak.CheckStream.Response
+	96,  // 151: tfbreak.Runner.GetOldModuleContent:output_type -> tfbreak.GetModuleContent.Response
+	96,  // 152: tfbreak.Runner.GetNewModuleContent:output_type -> tfbreak.GetModuleContent.Response
+	98,  // 153: tfbreak.Runner.GetOldResourceContent:output_type -> tfbreak.GetResourceContent.Response
+	98,  // 154: tfbreak.Runner.GetNewResourceContent:output_type -> tfbreak.GetResourceContent.Response
+	100, // 155: tfbreak.Runner.GetResourceContentPair:output_type -> tfbreak.GetResourceContentPair.Response
+	98,  // 156: tfbreak.Runner.GetOldDataSourceContent:output_type -> tfbreak.GetResourceContent.Response
+	98,  // 157: tfbreak.Runner.GetNewDataSourceContent:output_type -> tfbreak.GetResourceContent.Response
+	102, // 158: tfbreak.Runner.GetOldFile:output_type -> tfbreak.GetFile.Response
+	102, // 159: tfbreak.Runner.GetNewFile:output_type -> tfbreak.GetFile.Response
+	104, // 160: tfbreak.Runner.GetFileSource:output_type -> tfbreak.GetFileSource.Response
+	106, // 161: tfbreak.Runner.ListOldFiles:output_type -> tfbreak.ListFiles.Response
+	106, // 162: tfbreak.Runner.ListNewFiles:output_type -> tfbreak.ListFiles.Response
+	108, // 163: tfbreak.Runner.FileChanges:output_type -> tfbreak.FileChanges.Response
+	110, // 164: tfbreak.Runner.GetOldProviderRequirements:output_type -> tfbreak.GetProviderRequirements.Response
+	110, // 165: tfbreak.Runner.GetNewProviderRequirements:output_type -> tfbreak.GetProviderRequirements.Response
+	113, // 166: tfbreak.Runner.GetOldTerraformSettings:output_type -> tfbreak.GetTerraformSettings.Response
+	113, // 167: tfbreak.Runner.GetNewTerraformSettings:output_type -> tfbreak.GetTerraformSettings.Response
+	116, // 168: tfbreak.Runner.GetOldProviderConfig:output_type -> tfbreak.GetProviderConfig.Response
+	116, // 169: tfbreak.Runner.GetNewProviderConfig:output_type -> tfbreak.GetProviderConfig.Response
+	118, // 170: tfbreak.Runner.GetOldVariables:output_type -> tfbreak.GetVariables.Response
+	118, // 171: tfbreak.Runner.GetNewVariables:output_type -> tfbreak.GetVariables.Response
+	120, // 172: tfbreak.Runner.GetOldOutputs:output_type -> tfbreak.GetOutputs.Response
+	120, // 173: tfbreak.Runner.GetNewOutputs:output_type -> tfbreak.GetOutputs.Response
+	122, // 174: tfbreak.Runner.GetOldModuleCalls:output_type -> tfbreak.GetModuleCalls.Response
+	122, // 175: tfbreak.Runner.GetNewModuleCalls:output_type -> tfbreak.GetModuleCalls.Response
+	124, // 176: tfbreak.Runner.GetOldLocals:output_type -> tfbreak.GetLocals.Response
+	124, // 177: tfbreak.Runner.GetNewLocals:output_type -> tfbreak.GetLocals.Response
+	127, // 178: tfbreak.Runner.GetAllOldResources:output_type -> tfbreak.GetAllResources.Response
+	127, // 179: tfbreak.Runner.GetAllNewResources:output_type -> tfbreak.GetAllResources.Response
+	129, // 180: tfbreak.Runner.OldResourceAddresses:output_type -> tfbreak.ResourceAddresses.Response
+	129, // 181: tfbreak.Runner.NewResourceAddresses:output_type -> tfbreak.ResourceAddresses.Response
+	131, // 182: tfbreak.Runner.GetMovedBlocks:output_type -> tfbreak.GetMovedBlocks.Response
+	133, // 183: tfbreak.Runner.EmitIssue:output_type -> tfbreak.EmitIssue.Response
+	135, // 184: tfbreak.Runner.DecodeRuleConfig:output_type -> tfbreak.DecodeRuleConfig.Response
+	138, // [138:185] is the sub-list for method output_type
+	91,  // [91:138] is the sub-list for method input_type
+	91,  // [91:91] is the sub-list for extension type_name
+	91,  // [91:91] is the sub-list for extension extendee
+	0,   // [0:91] is the sub-list for field type_name
 }
 
 func init() { file_plugin_proto_tfbreak_proto_init() }
@@ -2781,13 +7188,17 @@ func file_plugin_proto_tfbreak_proto_init() {
 	if File_plugin_proto_tfbreak_proto != nil {
 		return
 	}
+	file_plugin_proto_tfbreak_proto_msgTypes[85].OneofWrappers = []any{
+		(*CheckStream_Response_Issue)(nil),
+		(*CheckStream_Response_Complete)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_plugin_proto_tfbreak_proto_rawDesc), len(file_plugin_proto_tfbreak_proto_rawDesc)),
-			NumEnums:      4,
-			NumMessages:   50,
+			NumEnums:      8,
+			NumMessages:   132,
 			NumExtensions: 0,
 			NumServices:   2,
 		},
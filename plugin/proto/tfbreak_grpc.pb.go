@@ -32,11 +32,16 @@ const (
 	RuleSet_GetRuleSetName_FullMethodName       = "/tfbreak.RuleSet/GetRuleSetName"
 	RuleSet_GetRuleSetVersion_FullMethodName    = "/tfbreak.RuleSet/GetRuleSetVersion"
 	RuleSet_GetRuleNames_FullMethodName         = "/tfbreak.RuleSet/GetRuleNames"
+	RuleSet_GetRuleMetadata_FullMethodName      = "/tfbreak.RuleSet/GetRuleMetadata"
+	RuleSet_GetRuleDefaults_FullMethodName      = "/tfbreak.RuleSet/GetRuleDefaults"
 	RuleSet_GetVersionConstraint_FullMethodName = "/tfbreak.RuleSet/GetVersionConstraint"
+	RuleSet_GetSDKVersion_FullMethodName        = "/tfbreak.RuleSet/GetSDKVersion"
+	RuleSet_GetCapabilities_FullMethodName      = "/tfbreak.RuleSet/GetCapabilities"
 	RuleSet_GetConfigSchema_FullMethodName      = "/tfbreak.RuleSet/GetConfigSchema"
 	RuleSet_ApplyGlobalConfig_FullMethodName    = "/tfbreak.RuleSet/ApplyGlobalConfig"
 	RuleSet_ApplyConfig_FullMethodName          = "/tfbreak.RuleSet/ApplyConfig"
 	RuleSet_Check_FullMethodName                = "/tfbreak.RuleSet/Check"
+	RuleSet_CheckStream_FullMethodName          = "/tfbreak.RuleSet/CheckStream"
 )
 
 // RuleSetClient is the client API for RuleSet service.
@@ -54,8 +59,20 @@ type RuleSetClient interface {
 	GetRuleSetVersion(ctx context.Context, in *GetRuleSetVersion_Request, opts ...grpc.CallOption) (*GetRuleSetVersion_Response, error)
 	// GetRuleNames returns the names of all rules in this ruleset.
 	GetRuleNames(ctx context.Context, in *GetRuleNames_Request, opts ...grpc.CallOption) (*GetRuleNames_Response, error)
+	// GetRuleMetadata returns metadata for all rules, keyed by rule name.
+	GetRuleMetadata(ctx context.Context, in *GetRuleMetadata_Request, opts ...grpc.CallOption) (*GetRuleMetadata_Response, error)
+	// GetRuleDefaults returns each rule's default enabled state and severity,
+	// before any configuration is applied.
+	GetRuleDefaults(ctx context.Context, in *GetRuleDefaults_Request, opts ...grpc.CallOption) (*GetRuleDefaults_Response, error)
 	// GetVersionConstraint returns the tfbreak version constraint.
 	GetVersionConstraint(ctx context.Context, in *GetVersionConstraint_Request, opts ...grpc.CallOption) (*GetVersionConstraint_Response, error)
+	// GetSDKVersion returns the version of the SDK the plugin was built with
+	// and the range of protocol versions it can serve. This RPC must remain
+	// compatible across protocol versions so the host can explain mismatches.
+	GetSDKVersion(ctx context.Context, in *GetSDKVersion_Request, opts ...grpc.CallOption) (*GetSDKVersion_Response, error)
+	// GetCapabilities returns the optional features the plugin supports, so
+	// the host can avoid features an older plugin does not implement.
+	GetCapabilities(ctx context.Context, in *GetCapabilities_Request, opts ...grpc.CallOption) (*GetCapabilities_Response, error)
 	// GetConfigSchema returns the schema for plugin-specific configuration.
 	GetConfigSchema(ctx context.Context, in *GetConfigSchema_Request, opts ...grpc.CallOption) (*GetConfigSchema_Response, error)
 	// ApplyGlobalConfig applies global tfbreak configuration.
@@ -64,6 +81,9 @@ type RuleSetClient interface {
 	ApplyConfig(ctx context.Context, in *ApplyConfig_Request, opts ...grpc.CallOption) (*ApplyConfig_Response, error)
 	// Check executes all enabled rules.
 	Check(ctx context.Context, in *Check_Request, opts ...grpc.CallOption) (*Check_Response, error)
+	// CheckStream executes all enabled rules, streaming issues as they are
+	// emitted and ending with a completion message.
+	CheckStream(ctx context.Context, in *CheckStream_Request, opts ...grpc.CallOption) (grpc.ServerStreamingClient[CheckStream_Response], error)
 }
 
 type ruleSetClient struct {
@@ -104,6 +124,26 @@ func (c *ruleSetClient) GetRuleNames(ctx context.Context, in *GetRuleNames_Reque
 	return out, nil
 }
 
+func (c *ruleSetClient) GetRuleMetadata(ctx context.Context, in *GetRuleMetadata_Request, opts ...grpc.CallOption) (*GetRuleMetadata_Response, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetRuleMetadata_Response)
+	err := c.cc.Invoke(ctx, RuleSet_GetRuleMetadata_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ruleSetClient) GetRuleDefaults(ctx context.Context, in *GetRuleDefaults_Request, opts ...grpc.CallOption) (*GetRuleDefaults_Response, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetRuleDefaults_Response)
+	err := c.cc.Invoke(ctx, RuleSet_GetRuleDefaults_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *ruleSetClient) GetVersionConstraint(ctx context.Context, in *GetVersionConstraint_Request, opts ...grpc.CallOption) (*GetVersionConstraint_Response, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetVersionConstraint_Response)
@@ -114,6 +154,26 @@ func (c *ruleSetClient) GetVersionConstraint(ctx context.Context, in *GetVersion
 	return out, nil
 }
 
+func (c *ruleSetClient) GetSDKVersion(ctx context.Context, in *GetSDKVersion_Request, opts ...grpc.CallOption) (*GetSDKVersion_Response, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSDKVersion_Response)
+	err := c.cc.Invoke(ctx, RuleSet_GetSDKVersion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ruleSetClient) GetCapabilities(ctx context.Context, in *GetCapabilities_Request, opts ...grpc.CallOption) (*GetCapabilities_Response, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCapabilities_Response)
+	err := c.cc.Invoke(ctx, RuleSet_GetCapabilities_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *ruleSetClient) GetConfigSchema(ctx context.Context, in *GetConfigSchema_Request, opts ...grpc.CallOption) (*GetConfigSchema_Response, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetConfigSchema_Response)
@@ -154,6 +214,25 @@ func (c *ruleSetClient) Check(ctx context.Context, in *Check_Request, opts ...gr
 	return out, nil
 }
 
+func (c *ruleSetClient) CheckStream(ctx context.Context, in *CheckStream_Request, opts ...grpc.CallOption) (grpc.ServerStreamingClient[CheckStream_Response], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &RuleSet_ServiceDesc.Streams[0], RuleSet_CheckStream_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[CheckStream_Request, CheckStream_Response]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type RuleSet_CheckStreamClient = grpc.ServerStreamingClient[CheckStream_Response]
+
 // RuleSetServer is the server API for RuleSet service.
 // All implementations must embed UnimplementedRuleSetServer
 // for forward compatibility.
@@ -169,8 +248,20 @@ type RuleSetServer interface {
 	GetRuleSetVersion(context.Context, *GetRuleSetVersion_Request) (*GetRuleSetVersion_Response, error)
 	// GetRuleNames returns the names of all rules in this ruleset.
 	GetRuleNames(context.Context, *GetRuleNames_Request) (*GetRuleNames_Response, error)
+	// GetRuleMetadata returns metadata for all rules, keyed by rule name.
+	GetRuleMetadata(context.Context, *GetRuleMetadata_Request) (*GetRuleMetadata_Response, error)
+	// GetRuleDefaults returns each rule's default enabled state and severity,
+	// before any configuration is applied.
+	GetRuleDefaults(context.Context, *GetRuleDefaults_Request) (*GetRuleDefaults_Response, error)
 	// GetVersionConstraint returns the tfbreak version constraint.
 	GetVersionConstraint(context.Context, *GetVersionConstraint_Request) (*GetVersionConstraint_Response, error)
+	// GetSDKVersion returns the version of the SDK the plugin was built with
+	// and the range of protocol versions it can serve. This RPC must remain
+	// compatible across protocol versions so the host can explain mismatches.
+	GetSDKVersion(context.Context, *GetSDKVersion_Request) (*GetSDKVersion_Response, error)
+	// GetCapabilities returns the optional features the plugin supports, so
+	// the host can avoid features an older plugin does not implement.
+	GetCapabilities(context.Context, *GetCapabilities_Request) (*GetCapabilities_Response, error)
 	// GetConfigSchema returns the schema for plugin-specific configuration.
 	GetConfigSchema(context.Context, *GetConfigSchema_Request) (*GetConfigSchema_Response, error)
 	// ApplyGlobalConfig applies global tfbreak configuration.
@@ -179,6 +270,9 @@ type RuleSetServer interface {
 	ApplyConfig(context.Context, *ApplyConfig_Request) (*ApplyConfig_Response, error)
 	// Check executes all enabled rules.
 	Check(context.Context, *Check_Request) (*Check_Response, error)
+	// CheckStream executes all enabled rules, streaming issues as they are
+	// emitted and ending with a completion message.
+	CheckStream(*CheckStream_Request, grpc.ServerStreamingServer[CheckStream_Response]) error
 	mustEmbedUnimplementedRuleSetServer()
 }
 
@@ -198,9 +292,21 @@ func (UnimplementedRuleSetServer) GetRuleSetVersion(context.Context, *GetRuleSet
 func (UnimplementedRuleSetServer) GetRuleNames(context.Context, *GetRuleNames_Request) (*GetRuleNames_Response, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetRuleNames not implemented")
 }
+func (UnimplementedRuleSetServer) GetRuleMetadata(context.Context, *GetRuleMetadata_Request) (*GetRuleMetadata_Response, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetRuleMetadata not implemented")
+}
+func (UnimplementedRuleSetServer) GetRuleDefaults(context.Context, *GetRuleDefaults_Request) (*GetRuleDefaults_Response, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetRuleDefaults not implemented")
+}
 func (UnimplementedRuleSetServer) GetVersionConstraint(context.Context, *GetVersionConstraint_Request) (*GetVersionConstraint_Response, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetVersionConstraint not implemented")
 }
+func (UnimplementedRuleSetServer) GetSDKVersion(context.Context, *GetSDKVersion_Request) (*GetSDKVersion_Response, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetSDKVersion not implemented")
+}
+func (UnimplementedRuleSetServer) GetCapabilities(context.Context, *GetCapabilities_Request) (*GetCapabilities_Response, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetCapabilities not implemented")
+}
 func (UnimplementedRuleSetServer) GetConfigSchema(context.Context, *GetConfigSchema_Request) (*GetConfigSchema_Response, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetConfigSchema not implemented")
 }
@@ -213,6 +319,9 @@ func (UnimplementedRuleSetServer) ApplyConfig(context.Context, *ApplyConfig_Requ
 func (UnimplementedRuleSetServer) Check(context.Context, *Check_Request) (*Check_Response, error) {
 	return nil, status.Error(codes.Unimplemented, "method Check not implemented")
 }
+func (UnimplementedRuleSetServer) CheckStream(*CheckStream_Request, grpc.ServerStreamingServer[CheckStream_Response]) error {
+	return status.Error(codes.Unimplemented, "method CheckStream not implemented")
+}
 func (UnimplementedRuleSetServer) mustEmbedUnimplementedRuleSetServer() {}
 func (UnimplementedRuleSetServer) testEmbeddedByValue()                 {}
 
@@ -288,6 +397,42 @@ func _RuleSet_GetRuleNames_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RuleSet_GetRuleMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRuleMetadata_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RuleSetServer).GetRuleMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RuleSet_GetRuleMetadata_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RuleSetServer).GetRuleMetadata(ctx, req.(*GetRuleMetadata_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RuleSet_GetRuleDefaults_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRuleDefaults_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RuleSetServer).GetRuleDefaults(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RuleSet_GetRuleDefaults_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RuleSetServer).GetRuleDefaults(ctx, req.(*GetRuleDefaults_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _RuleSet_GetVersionConstraint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetVersionConstraint_Request)
 	if err := dec(in); err != nil {
@@ -306,6 +451,42 @@ func _RuleSet_GetVersionConstraint_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RuleSet_GetSDKVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSDKVersion_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RuleSetServer).GetSDKVersion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RuleSet_GetSDKVersion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RuleSetServer).GetSDKVersion(ctx, req.(*GetSDKVersion_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RuleSet_GetCapabilities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCapabilities_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RuleSetServer).GetCapabilities(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RuleSet_GetCapabilities_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RuleSetServer).GetCapabilities(ctx, req.(*GetCapabilities_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _RuleSet_GetConfigSchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetConfigSchema_Request)
 	if err := dec(in); err != nil {
@@ -378,6 +559,17 @@ func _RuleSet_Check_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RuleSet_CheckStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(CheckStream_Request)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(RuleSetServer).CheckStream(m, &grpc.GenericServerStream[CheckStream_Request, CheckStream_Response]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type RuleSet_CheckStreamServer = grpc.ServerStreamingServer[CheckStream_Response]
+
 // RuleSet_ServiceDesc is the grpc.ServiceDesc for RuleSet service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -397,10 +589,26 @@ var RuleSet_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetRuleNames",
 			Handler:    _RuleSet_GetRuleNames_Handler,
 		},
+		{
+			MethodName: "GetRuleMetadata",
+			Handler:    _RuleSet_GetRuleMetadata_Handler,
+		},
+		{
+			MethodName: "GetRuleDefaults",
+			Handler:    _RuleSet_GetRuleDefaults_Handler,
+		},
 		{
 			MethodName: "GetVersionConstraint",
 			Handler:    _RuleSet_GetVersionConstraint_Handler,
 		},
+		{
+			MethodName: "GetSDKVersion",
+			Handler:    _RuleSet_GetSDKVersion_Handler,
+		},
+		{
+			MethodName: "GetCapabilities",
+			Handler:    _RuleSet_GetCapabilities_Handler,
+		},
 		{
 			MethodName: "GetConfigSchema",
 			Handler:    _RuleSet_GetConfigSchema_Handler,
@@ -418,17 +626,51 @@ var RuleSet_ServiceDesc = grpc.ServiceDesc{
 			Handler:    _RuleSet_Check_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "CheckStream",
+			Handler:       _RuleSet_CheckStream_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "plugin/proto/tfbreak.proto",
 }
 
 const (
-	Runner_GetOldModuleContent_FullMethodName   = "/tfbreak.Runner/GetOldModuleContent"
-	Runner_GetNewModuleContent_FullMethodName   = "/tfbreak.Runner/GetNewModuleContent"
-	Runner_GetOldResourceContent_FullMethodName = "/tfbreak.Runner/GetOldResourceContent"
-	Runner_GetNewResourceContent_FullMethodName = "/tfbreak.Runner/GetNewResourceContent"
-	Runner_EmitIssue_FullMethodName             = "/tfbreak.Runner/EmitIssue"
-	Runner_DecodeRuleConfig_FullMethodName      = "/tfbreak.Runner/DecodeRuleConfig"
+	Runner_GetOldModuleContent_FullMethodName        = "/tfbreak.Runner/GetOldModuleContent"
+	Runner_GetNewModuleContent_FullMethodName        = "/tfbreak.Runner/GetNewModuleContent"
+	Runner_GetOldResourceContent_FullMethodName      = "/tfbreak.Runner/GetOldResourceContent"
+	Runner_GetNewResourceContent_FullMethodName      = "/tfbreak.Runner/GetNewResourceContent"
+	Runner_GetResourceContentPair_FullMethodName     = "/tfbreak.Runner/GetResourceContentPair"
+	Runner_GetOldDataSourceContent_FullMethodName    = "/tfbreak.Runner/GetOldDataSourceContent"
+	Runner_GetNewDataSourceContent_FullMethodName    = "/tfbreak.Runner/GetNewDataSourceContent"
+	Runner_GetOldFile_FullMethodName                 = "/tfbreak.Runner/GetOldFile"
+	Runner_GetNewFile_FullMethodName                 = "/tfbreak.Runner/GetNewFile"
+	Runner_GetFileSource_FullMethodName              = "/tfbreak.Runner/GetFileSource"
+	Runner_ListOldFiles_FullMethodName               = "/tfbreak.Runner/ListOldFiles"
+	Runner_ListNewFiles_FullMethodName               = "/tfbreak.Runner/ListNewFiles"
+	Runner_FileChanges_FullMethodName                = "/tfbreak.Runner/FileChanges"
+	Runner_GetOldProviderRequirements_FullMethodName = "/tfbreak.Runner/GetOldProviderRequirements"
+	Runner_GetNewProviderRequirements_FullMethodName = "/tfbreak.Runner/GetNewProviderRequirements"
+	Runner_GetOldTerraformSettings_FullMethodName    = "/tfbreak.Runner/GetOldTerraformSettings"
+	Runner_GetNewTerraformSettings_FullMethodName    = "/tfbreak.Runner/GetNewTerraformSettings"
+	Runner_GetOldProviderConfig_FullMethodName       = "/tfbreak.Runner/GetOldProviderConfig"
+	Runner_GetNewProviderConfig_FullMethodName       = "/tfbreak.Runner/GetNewProviderConfig"
+	Runner_GetOldVariables_FullMethodName            = "/tfbreak.Runner/GetOldVariables"
+	Runner_GetNewVariables_FullMethodName            = "/tfbreak.Runner/GetNewVariables"
+	Runner_GetOldOutputs_FullMethodName              = "/tfbreak.Runner/GetOldOutputs"
+	Runner_GetNewOutputs_FullMethodName              = "/tfbreak.Runner/GetNewOutputs"
+	Runner_GetOldModuleCalls_FullMethodName          = "/tfbreak.Runner/GetOldModuleCalls"
+	Runner_GetNewModuleCalls_FullMethodName          = "/tfbreak.Runner/GetNewModuleCalls"
+	Runner_GetOldLocals_FullMethodName               = "/tfbreak.Runner/GetOldLocals"
+	Runner_GetNewLocals_FullMethodName               = "/tfbreak.Runner/GetNewLocals"
+	Runner_GetAllOldResources_FullMethodName         = "/tfbreak.Runner/GetAllOldResources"
+	Runner_GetAllNewResources_FullMethodName         = "/tfbreak.Runner/GetAllNewResources"
+	Runner_OldResourceAddresses_FullMethodName       = "/tfbreak.Runner/OldResourceAddresses"
+	Runner_NewResourceAddresses_FullMethodName       = "/tfbreak.Runner/NewResourceAddresses"
+	Runner_GetMovedBlocks_FullMethodName             = "/tfbreak.Runner/GetMovedBlocks"
+	Runner_EmitIssue_FullMethodName                  = "/tfbreak.Runner/EmitIssue"
+	Runner_DecodeRuleConfig_FullMethodName           = "/tfbreak.Runner/DecodeRuleConfig"
 )
 
 // RunnerClient is the client API for Runner service.
@@ -451,6 +693,63 @@ type RunnerClient interface {
 	GetOldResourceContent(ctx context.Context, in *GetResourceContent_Request, opts ...grpc.CallOption) (*GetResourceContent_Response, error)
 	// GetNewResourceContent retrieves resources from the NEW configuration.
 	GetNewResourceContent(ctx context.Context, in *GetResourceContent_Request, opts ...grpc.CallOption) (*GetResourceContent_Response, error)
+	// GetResourceContentPair retrieves resources from both the OLD and NEW
+	// configurations in one round trip.
+	GetResourceContentPair(ctx context.Context, in *GetResourceContentPair_Request, opts ...grpc.CallOption) (*GetResourceContentPair_Response, error)
+	// GetOldDataSourceContent retrieves data sources of a type from the OLD configuration.
+	GetOldDataSourceContent(ctx context.Context, in *GetResourceContent_Request, opts ...grpc.CallOption) (*GetResourceContent_Response, error)
+	// GetNewDataSourceContent retrieves data sources of a type from the NEW configuration.
+	GetNewDataSourceContent(ctx context.Context, in *GetResourceContent_Request, opts ...grpc.CallOption) (*GetResourceContent_Response, error)
+	// GetOldFile retrieves the raw source of a file from the OLD configuration.
+	GetOldFile(ctx context.Context, in *GetFile_Request, opts ...grpc.CallOption) (*GetFile_Response, error)
+	// GetNewFile retrieves the raw source of a file from the NEW configuration.
+	GetNewFile(ctx context.Context, in *GetFile_Request, opts ...grpc.CallOption) (*GetFile_Response, error)
+	// GetFileSource retrieves the raw source of a file from either configuration.
+	GetFileSource(ctx context.Context, in *GetFileSource_Request, opts ...grpc.CallOption) (*GetFileSource_Response, error)
+	// ListOldFiles lists the files in the OLD configuration.
+	ListOldFiles(ctx context.Context, in *ListFiles_Request, opts ...grpc.CallOption) (*ListFiles_Response, error)
+	// ListNewFiles lists the files in the NEW configuration.
+	ListNewFiles(ctx context.Context, in *ListFiles_Request, opts ...grpc.CallOption) (*ListFiles_Response, error)
+	// FileChanges compares the files of the OLD and NEW configurations.
+	FileChanges(ctx context.Context, in *FileChanges_Request, opts ...grpc.CallOption) (*FileChanges_Response, error)
+	// GetOldProviderRequirements retrieves the required providers of the OLD configuration.
+	GetOldProviderRequirements(ctx context.Context, in *GetProviderRequirements_Request, opts ...grpc.CallOption) (*GetProviderRequirements_Response, error)
+	// GetNewProviderRequirements retrieves the required providers of the NEW configuration.
+	GetNewProviderRequirements(ctx context.Context, in *GetProviderRequirements_Request, opts ...grpc.CallOption) (*GetProviderRequirements_Response, error)
+	// GetOldTerraformSettings retrieves the terraform block settings of the OLD configuration.
+	GetOldTerraformSettings(ctx context.Context, in *GetTerraformSettings_Request, opts ...grpc.CallOption) (*GetTerraformSettings_Response, error)
+	// GetNewTerraformSettings retrieves the terraform block settings of the NEW configuration.
+	GetNewTerraformSettings(ctx context.Context, in *GetTerraformSettings_Request, opts ...grpc.CallOption) (*GetTerraformSettings_Response, error)
+	// GetOldProviderConfig retrieves a provider block of the OLD configuration.
+	GetOldProviderConfig(ctx context.Context, in *GetProviderConfig_Request, opts ...grpc.CallOption) (*GetProviderConfig_Response, error)
+	// GetNewProviderConfig retrieves a provider block of the NEW configuration.
+	GetNewProviderConfig(ctx context.Context, in *GetProviderConfig_Request, opts ...grpc.CallOption) (*GetProviderConfig_Response, error)
+	// GetOldVariables retrieves the variable blocks of the OLD configuration.
+	GetOldVariables(ctx context.Context, in *GetVariables_Request, opts ...grpc.CallOption) (*GetVariables_Response, error)
+	// GetNewVariables retrieves the variable blocks of the NEW configuration.
+	GetNewVariables(ctx context.Context, in *GetVariables_Request, opts ...grpc.CallOption) (*GetVariables_Response, error)
+	// GetOldOutputs retrieves the output blocks of the OLD configuration.
+	GetOldOutputs(ctx context.Context, in *GetOutputs_Request, opts ...grpc.CallOption) (*GetOutputs_Response, error)
+	// GetNewOutputs retrieves the output blocks of the NEW configuration.
+	GetNewOutputs(ctx context.Context, in *GetOutputs_Request, opts ...grpc.CallOption) (*GetOutputs_Response, error)
+	// GetOldModuleCalls retrieves the module blocks of the OLD configuration.
+	GetOldModuleCalls(ctx context.Context, in *GetModuleCalls_Request, opts ...grpc.CallOption) (*GetModuleCalls_Response, error)
+	// GetNewModuleCalls retrieves the module blocks of the NEW configuration.
+	GetNewModuleCalls(ctx context.Context, in *GetModuleCalls_Request, opts ...grpc.CallOption) (*GetModuleCalls_Response, error)
+	// GetOldLocals evaluates the locals blocks of the OLD configuration.
+	GetOldLocals(ctx context.Context, in *GetLocals_Request, opts ...grpc.CallOption) (*GetLocals_Response, error)
+	// GetNewLocals evaluates the locals blocks of the NEW configuration.
+	GetNewLocals(ctx context.Context, in *GetLocals_Request, opts ...grpc.CallOption) (*GetLocals_Response, error)
+	// GetAllOldResources retrieves every resource block of the OLD configuration.
+	GetAllOldResources(ctx context.Context, in *GetAllResources_Request, opts ...grpc.CallOption) (*GetAllResources_Response, error)
+	// GetAllNewResources retrieves every resource block of the NEW configuration.
+	GetAllNewResources(ctx context.Context, in *GetAllResources_Request, opts ...grpc.CallOption) (*GetAllResources_Response, error)
+	// OldResourceAddresses lists the resource addresses of the OLD configuration.
+	OldResourceAddresses(ctx context.Context, in *ResourceAddresses_Request, opts ...grpc.CallOption) (*ResourceAddresses_Response, error)
+	// NewResourceAddresses lists the resource addresses of the NEW configuration.
+	NewResourceAddresses(ctx context.Context, in *ResourceAddresses_Request, opts ...grpc.CallOption) (*ResourceAddresses_Response, error)
+	// GetMovedBlocks retrieves the moved blocks declared in the NEW configuration.
+	GetMovedBlocks(ctx context.Context, in *GetMovedBlocks_Request, opts ...grpc.CallOption) (*GetMovedBlocks_Response, error)
 	// EmitIssue reports a finding from the rule.
 	EmitIssue(ctx context.Context, in *EmitIssue_Request, opts ...grpc.CallOption) (*EmitIssue_Response, error)
 	// DecodeRuleConfig retrieves and decodes rule configuration.
@@ -505,167 +804,1092 @@ func (c *runnerClient) GetNewResourceContent(ctx context.Context, in *GetResourc
 	return out, nil
 }
 
-func (c *runnerClient) EmitIssue(ctx context.Context, in *EmitIssue_Request, opts ...grpc.CallOption) (*EmitIssue_Response, error) {
+func (c *runnerClient) GetResourceContentPair(ctx context.Context, in *GetResourceContentPair_Request, opts ...grpc.CallOption) (*GetResourceContentPair_Response, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(EmitIssue_Response)
-	err := c.cc.Invoke(ctx, Runner_EmitIssue_FullMethodName, in, out, cOpts...)
+	out := new(GetResourceContentPair_Response)
+	err := c.cc.Invoke(ctx, Runner_GetResourceContentPair_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *runnerClient) DecodeRuleConfig(ctx context.Context, in *DecodeRuleConfig_Request, opts ...grpc.CallOption) (*DecodeRuleConfig_Response, error) {
+func (c *runnerClient) GetOldDataSourceContent(ctx context.Context, in *GetResourceContent_Request, opts ...grpc.CallOption) (*GetResourceContent_Response, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(DecodeRuleConfig_Response)
-	err := c.cc.Invoke(ctx, Runner_DecodeRuleConfig_FullMethodName, in, out, cOpts...)
+	out := new(GetResourceContent_Response)
+	err := c.cc.Invoke(ctx, Runner_GetOldDataSourceContent_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-// RunnerServer is the server API for Runner service.
-// All implementations must embed UnimplementedRunnerServer
-// for forward compatibility.
-//
-// Runner is the callback service that plugins use to:
-// - Access old and new Terraform configurations
-// - Emit issues when breaking changes are detected
-// - Decode rule-specific configuration
-//
-// DEVIATION FROM TFLINT (see ADR-0002):
-// Runner provides GetOld* and GetNew* method pairs instead of single methods.
-type RunnerServer interface {
-	// GetOldModuleContent retrieves module content from the OLD (baseline) configuration.
-	GetOldModuleContent(context.Context, *GetModuleContent_Request) (*GetModuleContent_Response, error)
-	// GetNewModuleContent retrieves module content from the NEW configuration.
-	GetNewModuleContent(context.Context, *GetModuleContent_Request) (*GetModuleContent_Response, error)
-	// GetOldResourceContent retrieves resources from the OLD configuration.
-	GetOldResourceContent(context.Context, *GetResourceContent_Request) (*GetResourceContent_Response, error)
-	// GetNewResourceContent retrieves resources from the NEW configuration.
-	GetNewResourceContent(context.Context, *GetResourceContent_Request) (*GetResourceContent_Response, error)
-	// EmitIssue reports a finding from the rule.
-	EmitIssue(context.Context, *EmitIssue_Request) (*EmitIssue_Response, error)
-	// DecodeRuleConfig retrieves and decodes rule configuration.
-	DecodeRuleConfig(context.Context, *DecodeRuleConfig_Request) (*DecodeRuleConfig_Response, error)
-	mustEmbedUnimplementedRunnerServer()
-}
-
-// UnimplementedRunnerServer must be embedded to have
-// forward compatible implementations.
-//
-// NOTE: this should be embedded by value instead of pointer to avoid a nil
-// pointer dereference when methods are called.
-type UnimplementedRunnerServer struct{}
-
-func (UnimplementedRunnerServer) GetOldModuleContent(context.Context, *GetModuleContent_Request) (*GetModuleContent_Response, error) {
-	return nil, status.Error(codes.Unimplemented, "method GetOldModuleContent not implemented")
-}
-func (UnimplementedRunnerServer) GetNewModuleContent(context.Context, *GetModuleContent_Request) (*GetModuleContent_Response, error) {
-	return nil, status.Error(codes.Unimplemented, "method GetNewModuleContent not implemented")
-}
-func (UnimplementedRunnerServer) GetOldResourceContent(context.Context, *GetResourceContent_Request) (*GetResourceContent_Response, error) {
-	return nil, status.Error(codes.Unimplemented, "method GetOldResourceContent not implemented")
-}
-func (UnimplementedRunnerServer) GetNewResourceContent(context.Context, *GetResourceContent_Request) (*GetResourceContent_Response, error) {
-	return nil, status.Error(codes.Unimplemented, "method GetNewResourceContent not implemented")
-}
-func (UnimplementedRunnerServer) EmitIssue(context.Context, *EmitIssue_Request) (*EmitIssue_Response, error) {
-	return nil, status.Error(codes.Unimplemented, "method EmitIssue not implemented")
-}
-func (UnimplementedRunnerServer) DecodeRuleConfig(context.Context, *DecodeRuleConfig_Request) (*DecodeRuleConfig_Response, error) {
-	return nil, status.Error(codes.Unimplemented, "method DecodeRuleConfig not implemented")
+func (c *runnerClient) GetNewDataSourceContent(ctx context.Context, in *GetResourceContent_Request, opts ...grpc.CallOption) (*GetResourceContent_Response, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetResourceContent_Response)
+	err := c.cc.Invoke(ctx, Runner_GetNewDataSourceContent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedRunnerServer) mustEmbedUnimplementedRunnerServer() {}
-func (UnimplementedRunnerServer) testEmbeddedByValue()                {}
 
-// UnsafeRunnerServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to RunnerServer will
-// result in compilation errors.
-type UnsafeRunnerServer interface {
-	mustEmbedUnimplementedRunnerServer()
+func (c *runnerClient) GetOldFile(ctx context.Context, in *GetFile_Request, opts ...grpc.CallOption) (*GetFile_Response, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetFile_Response)
+	err := c.cc.Invoke(ctx, Runner_GetOldFile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func RegisterRunnerServer(s grpc.ServiceRegistrar, srv RunnerServer) {
-	// If the following call panics, it indicates UnimplementedRunnerServer was
-	// embedded by pointer and is nil.  This will cause panics if an
-	// unimplemented method is ever invoked, so we test this at initialization
-	// time to prevent it from happening at runtime later due to I/O.
-	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
-		t.testEmbeddedByValue()
+func (c *runnerClient) GetNewFile(ctx context.Context, in *GetFile_Request, opts ...grpc.CallOption) (*GetFile_Response, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetFile_Response)
+	err := c.cc.Invoke(ctx, Runner_GetNewFile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
 	}
-	s.RegisterService(&Runner_ServiceDesc, srv)
+	return out, nil
 }
 
-func _Runner_GetOldModuleContent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetModuleContent_Request)
-	if err := dec(in); err != nil {
+func (c *runnerClient) GetFileSource(ctx context.Context, in *GetFileSource_Request, opts ...grpc.CallOption) (*GetFileSource_Response, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetFileSource_Response)
+	err := c.cc.Invoke(ctx, Runner_GetFileSource_FullMethodName, in, out, cOpts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(RunnerServer).GetOldModuleContent(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: Runner_GetOldModuleContent_FullMethodName,
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RunnerServer).GetOldModuleContent(ctx, req.(*GetModuleContent_Request))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Runner_GetNewModuleContent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetModuleContent_Request)
-	if err := dec(in); err != nil {
+func (c *runnerClient) ListOldFiles(ctx context.Context, in *ListFiles_Request, opts ...grpc.CallOption) (*ListFiles_Response, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListFiles_Response)
+	err := c.cc.Invoke(ctx, Runner_ListOldFiles_FullMethodName, in, out, cOpts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(RunnerServer).GetNewModuleContent(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: Runner_GetNewModuleContent_FullMethodName,
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RunnerServer).GetNewModuleContent(ctx, req.(*GetModuleContent_Request))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Runner_GetOldResourceContent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetResourceContent_Request)
-	if err := dec(in); err != nil {
+func (c *runnerClient) ListNewFiles(ctx context.Context, in *ListFiles_Request, opts ...grpc.CallOption) (*ListFiles_Response, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListFiles_Response)
+	err := c.cc.Invoke(ctx, Runner_ListNewFiles_FullMethodName, in, out, cOpts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(RunnerServer).GetOldResourceContent(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: Runner_GetOldResourceContent_FullMethodName,
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RunnerServer).GetOldResourceContent(ctx, req.(*GetResourceContent_Request))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Runner_GetNewResourceContent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetResourceContent_Request)
-	if err := dec(in); err != nil {
+func (c *runnerClient) FileChanges(ctx context.Context, in *FileChanges_Request, opts ...grpc.CallOption) (*FileChanges_Response, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FileChanges_Response)
+	err := c.cc.Invoke(ctx, Runner_FileChanges_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *runnerClient) GetOldProviderRequirements(ctx context.Context, in *GetProviderRequirements_Request, opts ...grpc.CallOption) (*GetProviderRequirements_Response, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetProviderRequirements_Response)
+	err := c.cc.Invoke(ctx, Runner_GetOldProviderRequirements_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *runnerClient) GetNewProviderRequirements(ctx context.Context, in *GetProviderRequirements_Request, opts ...grpc.CallOption) (*GetProviderRequirements_Response, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetProviderRequirements_Response)
+	err := c.cc.Invoke(ctx, Runner_GetNewProviderRequirements_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *runnerClient) GetOldTerraformSettings(ctx context.Context, in *GetTerraformSettings_Request, opts ...grpc.CallOption) (*GetTerraformSettings_Response, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTerraformSettings_Response)
+	err := c.cc.Invoke(ctx, Runner_GetOldTerraformSettings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *runnerClient) GetNewTerraformSettings(ctx context.Context, in *GetTerraformSettings_Request, opts ...grpc.CallOption) (*GetTerraformSettings_Response, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTerraformSettings_Response)
+	err := c.cc.Invoke(ctx, Runner_GetNewTerraformSettings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *runnerClient) GetOldProviderConfig(ctx context.Context, in *GetProviderConfig_Request, opts ...grpc.CallOption) (*GetProviderConfig_Response, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetProviderConfig_Response)
+	err := c.cc.Invoke(ctx, Runner_GetOldProviderConfig_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *runnerClient) GetNewProviderConfig(ctx context.Context, in *GetProviderConfig_Request, opts ...grpc.CallOption) (*GetProviderConfig_Response, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetProviderConfig_Response)
+	err := c.cc.Invoke(ctx, Runner_GetNewProviderConfig_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *runnerClient) GetOldVariables(ctx context.Context, in *GetVariables_Request, opts ...grpc.CallOption) (*GetVariables_Response, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetVariables_Response)
+	err := c.cc.Invoke(ctx, Runner_GetOldVariables_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *runnerClient) GetNewVariables(ctx context.Context, in *GetVariables_Request, opts ...grpc.CallOption) (*GetVariables_Response, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetVariables_Response)
+	err := c.cc.Invoke(ctx, Runner_GetNewVariables_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *runnerClient) GetOldOutputs(ctx context.Context, in *GetOutputs_Request, opts ...grpc.CallOption) (*GetOutputs_Response, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetOutputs_Response)
+	err := c.cc.Invoke(ctx, Runner_GetOldOutputs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *runnerClient) GetNewOutputs(ctx context.Context, in *GetOutputs_Request, opts ...grpc.CallOption) (*GetOutputs_Response, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetOutputs_Response)
+	err := c.cc.Invoke(ctx, Runner_GetNewOutputs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *runnerClient) GetOldModuleCalls(ctx context.Context, in *GetModuleCalls_Request, opts ...grpc.CallOption) (*GetModuleCalls_Response, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetModuleCalls_Response)
+	err := c.cc.Invoke(ctx, Runner_GetOldModuleCalls_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *runnerClient) GetNewModuleCalls(ctx context.Context, in *GetModuleCalls_Request, opts ...grpc.CallOption) (*GetModuleCalls_Response, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetModuleCalls_Response)
+	err := c.cc.Invoke(ctx, Runner_GetNewModuleCalls_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *runnerClient) GetOldLocals(ctx context.Context, in *GetLocals_Request, opts ...grpc.CallOption) (*GetLocals_Response, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetLocals_Response)
+	err := c.cc.Invoke(ctx, Runner_GetOldLocals_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *runnerClient) GetNewLocals(ctx context.Context, in *GetLocals_Request, opts ...grpc.CallOption) (*GetLocals_Response, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetLocals_Response)
+	err := c.cc.Invoke(ctx, Runner_GetNewLocals_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *runnerClient) GetAllOldResources(ctx context.Context, in *GetAllResources_Request, opts ...grpc.CallOption) (*GetAllResources_Response, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAllResources_Response)
+	err := c.cc.Invoke(ctx, Runner_GetAllOldResources_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *runnerClient) GetAllNewResources(ctx context.Context, in *GetAllResources_Request, opts ...grpc.CallOption) (*GetAllResources_Response, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAllResources_Response)
+	err := c.cc.Invoke(ctx, Runner_GetAllNewResources_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *runnerClient) OldResourceAddresses(ctx context.Context, in *ResourceAddresses_Request, opts ...grpc.CallOption) (*ResourceAddresses_Response, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResourceAddresses_Response)
+	err := c.cc.Invoke(ctx, Runner_OldResourceAddresses_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *runnerClient) NewResourceAddresses(ctx context.Context, in *ResourceAddresses_Request, opts ...grpc.CallOption) (*ResourceAddresses_Response, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResourceAddresses_Response)
+	err := c.cc.Invoke(ctx, Runner_NewResourceAddresses_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *runnerClient) GetMovedBlocks(ctx context.Context, in *GetMovedBlocks_Request, opts ...grpc.CallOption) (*GetMovedBlocks_Response, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetMovedBlocks_Response)
+	err := c.cc.Invoke(ctx, Runner_GetMovedBlocks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *runnerClient) EmitIssue(ctx context.Context, in *EmitIssue_Request, opts ...grpc.CallOption) (*EmitIssue_Response, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EmitIssue_Response)
+	err := c.cc.Invoke(ctx, Runner_EmitIssue_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *runnerClient) DecodeRuleConfig(ctx context.Context, in *DecodeRuleConfig_Request, opts ...grpc.CallOption) (*DecodeRuleConfig_Response, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DecodeRuleConfig_Response)
+	err := c.cc.Invoke(ctx, Runner_DecodeRuleConfig_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RunnerServer is the server API for Runner service.
+// All implementations must embed UnimplementedRunnerServer
+// for forward compatibility.
+//
+// Runner is the callback service that plugins use to:
+// - Access old and new Terraform configurations
+// - Emit issues when breaking changes are detected
+// - Decode rule-specific configuration
+//
+// DEVIATION FROM TFLINT (see ADR-0002):
+// Runner provides GetOld* and GetNew* method pairs instead of single methods.
+type RunnerServer interface {
+	// GetOldModuleContent retrieves module content from the OLD (baseline) configuration.
+	GetOldModuleContent(context.Context, *GetModuleContent_Request) (*GetModuleContent_Response, error)
+	// GetNewModuleContent retrieves module content from the NEW configuration.
+	GetNewModuleContent(context.Context, *GetModuleContent_Request) (*GetModuleContent_Response, error)
+	// GetOldResourceContent retrieves resources from the OLD configuration.
+	GetOldResourceContent(context.Context, *GetResourceContent_Request) (*GetResourceContent_Response, error)
+	// GetNewResourceContent retrieves resources from the NEW configuration.
+	GetNewResourceContent(context.Context, *GetResourceContent_Request) (*GetResourceContent_Response, error)
+	// GetResourceContentPair retrieves resources from both the OLD and NEW
+	// configurations in one round trip.
+	GetResourceContentPair(context.Context, *GetResourceContentPair_Request) (*GetResourceContentPair_Response, error)
+	// GetOldDataSourceContent retrieves data sources of a type from the OLD configuration.
+	GetOldDataSourceContent(context.Context, *GetResourceContent_Request) (*GetResourceContent_Response, error)
+	// GetNewDataSourceContent retrieves data sources of a type from the NEW configuration.
+	GetNewDataSourceContent(context.Context, *GetResourceContent_Request) (*GetResourceContent_Response, error)
+	// GetOldFile retrieves the raw source of a file from the OLD configuration.
+	GetOldFile(context.Context, *GetFile_Request) (*GetFile_Response, error)
+	// GetNewFile retrieves the raw source of a file from the NEW configuration.
+	GetNewFile(context.Context, *GetFile_Request) (*GetFile_Response, error)
+	// GetFileSource retrieves the raw source of a file from either configuration.
+	GetFileSource(context.Context, *GetFileSource_Request) (*GetFileSource_Response, error)
+	// ListOldFiles lists the files in the OLD configuration.
+	ListOldFiles(context.Context, *ListFiles_Request) (*ListFiles_Response, error)
+	// ListNewFiles lists the files in the NEW configuration.
+	ListNewFiles(context.Context, *ListFiles_Request) (*ListFiles_Response, error)
+	// FileChanges compares the files of the OLD and NEW configurations.
+	FileChanges(context.Context, *FileChanges_Request) (*FileChanges_Response, error)
+	// GetOldProviderRequirements retrieves the required providers of the OLD configuration.
+	GetOldProviderRequirements(context.Context, *GetProviderRequirements_Request) (*GetProviderRequirements_Response, error)
+	// GetNewProviderRequirements retrieves the required providers of the NEW configuration.
+	GetNewProviderRequirements(context.Context, *GetProviderRequirements_Request) (*GetProviderRequirements_Response, error)
+	// GetOldTerraformSettings retrieves the terraform block settings of the OLD configuration.
+	GetOldTerraformSettings(context.Context, *GetTerraformSettings_Request) (*GetTerraformSettings_Response, error)
+	// GetNewTerraformSettings retrieves the terraform block settings of the NEW configuration.
+	GetNewTerraformSettings(context.Context, *GetTerraformSettings_Request) (*GetTerraformSettings_Response, error)
+	// GetOldProviderConfig retrieves a provider block of the OLD configuration.
+	GetOldProviderConfig(context.Context, *GetProviderConfig_Request) (*GetProviderConfig_Response, error)
+	// GetNewProviderConfig retrieves a provider block of the NEW configuration.
+	GetNewProviderConfig(context.Context, *GetProviderConfig_Request) (*GetProviderConfig_Response, error)
+	// GetOldVariables retrieves the variable blocks of the OLD configuration.
+	GetOldVariables(context.Context, *GetVariables_Request) (*GetVariables_Response, error)
+	// GetNewVariables retrieves the variable blocks of the NEW configuration.
+	GetNewVariables(context.Context, *GetVariables_Request) (*GetVariables_Response, error)
+	// GetOldOutputs retrieves the output blocks of the OLD configuration.
+	GetOldOutputs(context.Context, *GetOutputs_Request) (*GetOutputs_Response, error)
+	// GetNewOutputs retrieves the output blocks of the NEW configuration.
+	GetNewOutputs(context.Context, *GetOutputs_Request) (*GetOutputs_Response, error)
+	// GetOldModuleCalls retrieves the module blocks of the OLD configuration.
+	GetOldModuleCalls(context.Context, *GetModuleCalls_Request) (*GetModuleCalls_Response, error)
+	// GetNewModuleCalls retrieves the module blocks of the NEW configuration.
+	GetNewModuleCalls(context.Context, *GetModuleCalls_Request) (*GetModuleCalls_Response, error)
+	// GetOldLocals evaluates the locals blocks of the OLD configuration.
+	GetOldLocals(context.Context, *GetLocals_Request) (*GetLocals_Response, error)
+	// GetNewLocals evaluates the locals blocks of the NEW configuration.
+	GetNewLocals(context.Context, *GetLocals_Request) (*GetLocals_Response, error)
+	// GetAllOldResources retrieves every resource block of the OLD configuration.
+	GetAllOldResources(context.Context, *GetAllResources_Request) (*GetAllResources_Response, error)
+	// GetAllNewResources retrieves every resource block of the NEW configuration.
+	GetAllNewResources(context.Context, *GetAllResources_Request) (*GetAllResources_Response, error)
+	// OldResourceAddresses lists the resource addresses of the OLD configuration.
+	OldResourceAddresses(context.Context, *ResourceAddresses_Request) (*ResourceAddresses_Response, error)
+	// NewResourceAddresses lists the resource addresses of the NEW configuration.
+	NewResourceAddresses(context.Context, *ResourceAddresses_Request) (*ResourceAddresses_Response, error)
+	// GetMovedBlocks retrieves the moved blocks declared in the NEW configuration.
+	GetMovedBlocks(context.Context, *GetMovedBlocks_Request) (*GetMovedBlocks_Response, error)
+	// EmitIssue reports a finding from the rule.
+	EmitIssue(context.Context, *EmitIssue_Request) (*EmitIssue_Response, error)
+	// DecodeRuleConfig retrieves and decodes rule configuration.
+	DecodeRuleConfig(context.Context, *DecodeRuleConfig_Request) (*DecodeRuleConfig_Response, error)
+	mustEmbedUnimplementedRunnerServer()
+}
+
+// UnimplementedRunnerServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedRunnerServer struct{}
+
+func (UnimplementedRunnerServer) GetOldModuleContent(context.Context, *GetModuleContent_Request) (*GetModuleContent_Response, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetOldModuleContent not implemented")
+}
+func (UnimplementedRunnerServer) GetNewModuleContent(context.Context, *GetModuleContent_Request) (*GetModuleContent_Response, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetNewModuleContent not implemented")
+}
+func (UnimplementedRunnerServer) GetOldResourceContent(context.Context, *GetResourceContent_Request) (*GetResourceContent_Response, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetOldResourceContent not implemented")
+}
+func (UnimplementedRunnerServer) GetNewResourceContent(context.Context, *GetResourceContent_Request) (*GetResourceContent_Response, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetNewResourceContent not implemented")
+}
+func (UnimplementedRunnerServer) GetResourceContentPair(context.Context, *GetResourceContentPair_Request) (*GetResourceContentPair_Response, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetResourceContentPair not implemented")
+}
+func (UnimplementedRunnerServer) GetOldDataSourceContent(context.Context, *GetResourceContent_Request) (*GetResourceContent_Response, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetOldDataSourceContent not implemented")
+}
+func (UnimplementedRunnerServer) GetNewDataSourceContent(context.Context, *GetResourceContent_Request) (*GetResourceContent_Response, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetNewDataSourceContent not implemented")
+}
+func (UnimplementedRunnerServer) GetOldFile(context.Context, *GetFile_Request) (*GetFile_Response, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetOldFile not implemented")
+}
+func (UnimplementedRunnerServer) GetNewFile(context.Context, *GetFile_Request) (*GetFile_Response, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetNewFile not implemented")
+}
+func (UnimplementedRunnerServer) GetFileSource(context.Context, *GetFileSource_Request) (*GetFileSource_Response, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetFileSource not implemented")
+}
+func (UnimplementedRunnerServer) ListOldFiles(context.Context, *ListFiles_Request) (*ListFiles_Response, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListOldFiles not implemented")
+}
+func (UnimplementedRunnerServer) ListNewFiles(context.Context, *ListFiles_Request) (*ListFiles_Response, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListNewFiles not implemented")
+}
+func (UnimplementedRunnerServer) FileChanges(context.Context, *FileChanges_Request) (*FileChanges_Response, error) {
+	return nil, status.Error(codes.Unimplemented, "method FileChanges not implemented")
+}
+func (UnimplementedRunnerServer) GetOldProviderRequirements(context.Context, *GetProviderRequirements_Request) (*GetProviderRequirements_Response, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetOldProviderRequirements not implemented")
+}
+func (UnimplementedRunnerServer) GetNewProviderRequirements(context.Context, *GetProviderRequirements_Request) (*GetProviderRequirements_Response, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetNewProviderRequirements not implemented")
+}
+func (UnimplementedRunnerServer) GetOldTerraformSettings(context.Context, *GetTerraformSettings_Request) (*GetTerraformSettings_Response, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetOldTerraformSettings not implemented")
+}
+func (UnimplementedRunnerServer) GetNewTerraformSettings(context.Context, *GetTerraformSettings_Request) (*GetTerraformSettings_Response, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetNewTerraformSettings not implemented")
+}
+func (UnimplementedRunnerServer) GetOldProviderConfig(context.Context, *GetProviderConfig_Request) (*GetProviderConfig_Response, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetOldProviderConfig not implemented")
+}
+func (UnimplementedRunnerServer) GetNewProviderConfig(context.Context, *GetProviderConfig_Request) (*GetProviderConfig_Response, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetNewProviderConfig not implemented")
+}
+func (UnimplementedRunnerServer) GetOldVariables(context.Context, *GetVariables_Request) (*GetVariables_Response, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetOldVariables not implemented")
+}
+func (UnimplementedRunnerServer) GetNewVariables(context.Context, *GetVariables_Request) (*GetVariables_Response, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetNewVariables not implemented")
+}
+func (UnimplementedRunnerServer) GetOldOutputs(context.Context, *GetOutputs_Request) (*GetOutputs_Response, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetOldOutputs not implemented")
+}
+func (UnimplementedRunnerServer) GetNewOutputs(context.Context, *GetOutputs_Request) (*GetOutputs_Response, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetNewOutputs not implemented")
+}
+func (UnimplementedRunnerServer) GetOldModuleCalls(context.Context, *GetModuleCalls_Request) (*GetModuleCalls_Response, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetOldModuleCalls not implemented")
+}
+func (UnimplementedRunnerServer) GetNewModuleCalls(context.Context, *GetModuleCalls_Request) (*GetModuleCalls_Response, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetNewModuleCalls not implemented")
+}
+func (UnimplementedRunnerServer) GetOldLocals(context.Context, *GetLocals_Request) (*GetLocals_Response, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetOldLocals not implemented")
+}
+func (UnimplementedRunnerServer) GetNewLocals(context.Context, *GetLocals_Request) (*GetLocals_Response, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetNewLocals not implemented")
+}
+func (UnimplementedRunnerServer) GetAllOldResources(context.Context, *GetAllResources_Request) (*GetAllResources_Response, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetAllOldResources not implemented")
+}
+func (UnimplementedRunnerServer) GetAllNewResources(context.Context, *GetAllResources_Request) (*GetAllResources_Response, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetAllNewResources not implemented")
+}
+func (UnimplementedRunnerServer) OldResourceAddresses(context.Context, *ResourceAddresses_Request) (*ResourceAddresses_Response, error) {
+	return nil, status.Error(codes.Unimplemented, "method OldResourceAddresses not implemented")
+}
+func (UnimplementedRunnerServer) NewResourceAddresses(context.Context, *ResourceAddresses_Request) (*ResourceAddresses_Response, error) {
+	return nil, status.Error(codes.Unimplemented, "method NewResourceAddresses not implemented")
+}
+func (UnimplementedRunnerServer) GetMovedBlocks(context.Context, *GetMovedBlocks_Request) (*GetMovedBlocks_Response, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetMovedBlocks not implemented")
+}
+func (UnimplementedRunnerServer) EmitIssue(context.Context, *EmitIssue_Request) (*EmitIssue_Response, error) {
+	return nil, status.Error(codes.Unimplemented, "method EmitIssue not implemented")
+}
+func (UnimplementedRunnerServer) DecodeRuleConfig(context.Context, *DecodeRuleConfig_Request) (*DecodeRuleConfig_Response, error) {
+	return nil, status.Error(codes.Unimplemented, "method DecodeRuleConfig not implemented")
+}
+func (UnimplementedRunnerServer) mustEmbedUnimplementedRunnerServer() {}
+func (UnimplementedRunnerServer) testEmbeddedByValue()                {}
+
+// UnsafeRunnerServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to RunnerServer will
+// result in compilation errors.
+type UnsafeRunnerServer interface {
+	mustEmbedUnimplementedRunnerServer()
+}
+
+func RegisterRunnerServer(s grpc.ServiceRegistrar, srv RunnerServer) {
+	// If the following call panics, it indicates UnimplementedRunnerServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Runner_ServiceDesc, srv)
+}
+
+func _Runner_GetOldModuleContent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetModuleContent_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RunnerServer).GetOldModuleContent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Runner_GetOldModuleContent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RunnerServer).GetOldModuleContent(ctx, req.(*GetModuleContent_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Runner_GetNewModuleContent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetModuleContent_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RunnerServer).GetNewModuleContent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Runner_GetNewModuleContent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RunnerServer).GetNewModuleContent(ctx, req.(*GetModuleContent_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Runner_GetOldResourceContent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetResourceContent_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RunnerServer).GetOldResourceContent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Runner_GetOldResourceContent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RunnerServer).GetOldResourceContent(ctx, req.(*GetResourceContent_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Runner_GetNewResourceContent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetResourceContent_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RunnerServer).GetNewResourceContent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Runner_GetNewResourceContent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RunnerServer).GetNewResourceContent(ctx, req.(*GetResourceContent_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Runner_GetResourceContentPair_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetResourceContentPair_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RunnerServer).GetResourceContentPair(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Runner_GetResourceContentPair_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RunnerServer).GetResourceContentPair(ctx, req.(*GetResourceContentPair_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Runner_GetOldDataSourceContent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetResourceContent_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RunnerServer).GetOldDataSourceContent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Runner_GetOldDataSourceContent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RunnerServer).GetOldDataSourceContent(ctx, req.(*GetResourceContent_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Runner_GetNewDataSourceContent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetResourceContent_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RunnerServer).GetNewDataSourceContent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Runner_GetNewDataSourceContent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RunnerServer).GetNewDataSourceContent(ctx, req.(*GetResourceContent_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Runner_GetOldFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFile_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RunnerServer).GetOldFile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Runner_GetOldFile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RunnerServer).GetOldFile(ctx, req.(*GetFile_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Runner_GetNewFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFile_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RunnerServer).GetNewFile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Runner_GetNewFile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RunnerServer).GetNewFile(ctx, req.(*GetFile_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Runner_GetFileSource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFileSource_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RunnerServer).GetFileSource(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Runner_GetFileSource_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RunnerServer).GetFileSource(ctx, req.(*GetFileSource_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Runner_ListOldFiles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFiles_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RunnerServer).ListOldFiles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Runner_ListOldFiles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RunnerServer).ListOldFiles(ctx, req.(*ListFiles_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Runner_ListNewFiles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFiles_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RunnerServer).ListNewFiles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Runner_ListNewFiles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RunnerServer).ListNewFiles(ctx, req.(*ListFiles_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Runner_FileChanges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FileChanges_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RunnerServer).FileChanges(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Runner_FileChanges_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RunnerServer).FileChanges(ctx, req.(*FileChanges_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Runner_GetOldProviderRequirements_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProviderRequirements_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RunnerServer).GetOldProviderRequirements(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Runner_GetOldProviderRequirements_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RunnerServer).GetOldProviderRequirements(ctx, req.(*GetProviderRequirements_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Runner_GetNewProviderRequirements_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProviderRequirements_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RunnerServer).GetNewProviderRequirements(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Runner_GetNewProviderRequirements_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RunnerServer).GetNewProviderRequirements(ctx, req.(*GetProviderRequirements_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Runner_GetOldTerraformSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTerraformSettings_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RunnerServer).GetOldTerraformSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Runner_GetOldTerraformSettings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RunnerServer).GetOldTerraformSettings(ctx, req.(*GetTerraformSettings_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Runner_GetNewTerraformSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTerraformSettings_Request)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RunnerServer).GetNewResourceContent(ctx, in)
+		return srv.(RunnerServer).GetNewTerraformSettings(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Runner_GetNewResourceContent_FullMethodName,
+		FullMethod: Runner_GetNewTerraformSettings_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RunnerServer).GetNewResourceContent(ctx, req.(*GetResourceContent_Request))
+		return srv.(RunnerServer).GetNewTerraformSettings(ctx, req.(*GetTerraformSettings_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Runner_GetOldProviderConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProviderConfig_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RunnerServer).GetOldProviderConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Runner_GetOldProviderConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RunnerServer).GetOldProviderConfig(ctx, req.(*GetProviderConfig_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Runner_GetNewProviderConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProviderConfig_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RunnerServer).GetNewProviderConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Runner_GetNewProviderConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RunnerServer).GetNewProviderConfig(ctx, req.(*GetProviderConfig_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Runner_GetOldVariables_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetVariables_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RunnerServer).GetOldVariables(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Runner_GetOldVariables_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RunnerServer).GetOldVariables(ctx, req.(*GetVariables_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Runner_GetNewVariables_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetVariables_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RunnerServer).GetNewVariables(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Runner_GetNewVariables_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RunnerServer).GetNewVariables(ctx, req.(*GetVariables_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Runner_GetOldOutputs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOutputs_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RunnerServer).GetOldOutputs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Runner_GetOldOutputs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RunnerServer).GetOldOutputs(ctx, req.(*GetOutputs_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Runner_GetNewOutputs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOutputs_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RunnerServer).GetNewOutputs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Runner_GetNewOutputs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RunnerServer).GetNewOutputs(ctx, req.(*GetOutputs_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Runner_GetOldModuleCalls_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetModuleCalls_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RunnerServer).GetOldModuleCalls(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Runner_GetOldModuleCalls_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RunnerServer).GetOldModuleCalls(ctx, req.(*GetModuleCalls_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Runner_GetNewModuleCalls_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetModuleCalls_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RunnerServer).GetNewModuleCalls(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Runner_GetNewModuleCalls_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RunnerServer).GetNewModuleCalls(ctx, req.(*GetModuleCalls_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Runner_GetOldLocals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLocals_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RunnerServer).GetOldLocals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Runner_GetOldLocals_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RunnerServer).GetOldLocals(ctx, req.(*GetLocals_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Runner_GetNewLocals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLocals_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RunnerServer).GetNewLocals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Runner_GetNewLocals_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RunnerServer).GetNewLocals(ctx, req.(*GetLocals_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Runner_GetAllOldResources_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAllResources_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RunnerServer).GetAllOldResources(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Runner_GetAllOldResources_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RunnerServer).GetAllOldResources(ctx, req.(*GetAllResources_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Runner_GetAllNewResources_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAllResources_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RunnerServer).GetAllNewResources(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Runner_GetAllNewResources_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RunnerServer).GetAllNewResources(ctx, req.(*GetAllResources_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Runner_OldResourceAddresses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResourceAddresses_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RunnerServer).OldResourceAddresses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Runner_OldResourceAddresses_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RunnerServer).OldResourceAddresses(ctx, req.(*ResourceAddresses_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Runner_NewResourceAddresses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResourceAddresses_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RunnerServer).NewResourceAddresses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Runner_NewResourceAddresses_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RunnerServer).NewResourceAddresses(ctx, req.(*ResourceAddresses_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Runner_GetMovedBlocks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMovedBlocks_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RunnerServer).GetMovedBlocks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Runner_GetMovedBlocks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RunnerServer).GetMovedBlocks(ctx, req.(*GetMovedBlocks_Request))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -729,6 +1953,118 @@ var Runner_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetNewResourceContent",
 			Handler:    _Runner_GetNewResourceContent_Handler,
 		},
+		{
+			MethodName: "GetResourceContentPair",
+			Handler:    _Runner_GetResourceContentPair_Handler,
+		},
+		{
+			MethodName: "GetOldDataSourceContent",
+			Handler:    _Runner_GetOldDataSourceContent_Handler,
+		},
+		{
+			MethodName: "GetNewDataSourceContent",
+			Handler:    _Runner_GetNewDataSourceContent_Handler,
+		},
+		{
+			MethodName: "GetOldFile",
+			Handler:    _Runner_GetOldFile_Handler,
+		},
+		{
+			MethodName: "GetNewFile",
+			Handler:    _Runner_GetNewFile_Handler,
+		},
+		{
+			MethodName: "GetFileSource",
+			Handler:    _Runner_GetFileSource_Handler,
+		},
+		{
+			MethodName: "ListOldFiles",
+			Handler:    _Runner_ListOldFiles_Handler,
+		},
+		{
+			MethodName: "ListNewFiles",
+			Handler:    _Runner_ListNewFiles_Handler,
+		},
+		{
+			MethodName: "FileChanges",
+			Handler:    _Runner_FileChanges_Handler,
+		},
+		{
+			MethodName: "GetOldProviderRequirements",
+			Handler:    _Runner_GetOldProviderRequirements_Handler,
+		},
+		{
+			MethodName: "GetNewProviderRequirements",
+			Handler:    _Runner_GetNewProviderRequirements_Handler,
+		},
+		{
+			MethodName: "GetOldTerraformSettings",
+			Handler:    _Runner_GetOldTerraformSettings_Handler,
+		},
+		{
+			MethodName: "GetNewTerraformSettings",
+			Handler:    _Runner_GetNewTerraformSettings_Handler,
+		},
+		{
+			MethodName: "GetOldProviderConfig",
+			Handler:    _Runner_GetOldProviderConfig_Handler,
+		},
+		{
+			MethodName: "GetNewProviderConfig",
+			Handler:    _Runner_GetNewProviderConfig_Handler,
+		},
+		{
+			MethodName: "GetOldVariables",
+			Handler:    _Runner_GetOldVariables_Handler,
+		},
+		{
+			MethodName: "GetNewVariables",
+			Handler:    _Runner_GetNewVariables_Handler,
+		},
+		{
+			MethodName: "GetOldOutputs",
+			Handler:    _Runner_GetOldOutputs_Handler,
+		},
+		{
+			MethodName: "GetNewOutputs",
+			Handler:    _Runner_GetNewOutputs_Handler,
+		},
+		{
+			MethodName: "GetOldModuleCalls",
+			Handler:    _Runner_GetOldModuleCalls_Handler,
+		},
+		{
+			MethodName: "GetNewModuleCalls",
+			Handler:    _Runner_GetNewModuleCalls_Handler,
+		},
+		{
+			MethodName: "GetOldLocals",
+			Handler:    _Runner_GetOldLocals_Handler,
+		},
+		{
+			MethodName: "GetNewLocals",
+			Handler:    _Runner_GetNewLocals_Handler,
+		},
+		{
+			MethodName: "GetAllOldResources",
+			Handler:    _Runner_GetAllOldResources_Handler,
+		},
+		{
+			MethodName: "GetAllNewResources",
+			Handler:    _Runner_GetAllNewResources_Handler,
+		},
+		{
+			MethodName: "OldResourceAddresses",
+			Handler:    _Runner_OldResourceAddresses_Handler,
+		},
+		{
+			MethodName: "NewResourceAddresses",
+			Handler:    _Runner_NewResourceAddresses_Handler,
+		},
+		{
+			MethodName: "GetMovedBlocks",
+			Handler:    _Runner_GetMovedBlocks_Handler,
+		},
 		{
 			MethodName: "EmitIssue",
 			Handler:    _Runner_EmitIssue_Handler,
@@ -0,0 +1,113 @@
+// Package plugin provides gRPC-based plugin communication for tfbreak.
+//
+// This file implements retries for Runner callbacks. When a plugin is served
+// with ServeOpts.RunnerRetry, callbacks to the host that fail with a transient
+// gRPC status are retried with exponential backoff instead of failing the rule.
+
+package plugin
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Backoff defaults used when a RetryPolicy leaves them unset.
+const (
+	defaultRetryInitialBackoff = 100 * time.Millisecond
+	defaultRetryMaxBackoff     = 2 * time.Second
+)
+
+// RetryPolicy controls how Runner callbacks to the host are retried when the
+// host is temporarily unable to answer. Only calls failing with the gRPC codes
+// Unavailable or DeadlineExceeded are retried; errors returned by the host's
+// Runner implementation are passed to the rule as-is.
+//
+// Retries never outlive the callback's own timeout or the Check call: a retry
+// is skipped when its backoff would end after the context deadline.
+//
+// The zero value disables retries.
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts per callback, including
+	// the first. Values below 2 disable retries.
+	MaxAttempts int
+	// InitialBackoff is the wait before the first retry. It doubles after
+	// each attempt. Defaults to 100ms.
+	InitialBackoff time.Duration
+	// MaxBackoff caps the wait between attempts. Defaults to 2s.
+	MaxBackoff time.Duration
+}
+
+// enabled reports whether the policy retries at all.
+func (p RetryPolicy) enabled() bool {
+	return p.MaxAttempts > 1
+}
+
+// backoff returns the wait before the given retry, starting at 1.
+func (p RetryPolicy) backoff(retry int) time.Duration {
+	initial, max := p.InitialBackoff, p.MaxBackoff
+	if initial <= 0 {
+		initial = defaultRetryInitialBackoff
+	}
+	if max <= 0 {
+		max = defaultRetryMaxBackoff
+	}
+
+	wait := initial
+	for i := 1; i < retry && wait < max; i++ {
+		wait *= 2
+	}
+	return min(wait, max)
+}
+
+// retryingConn is a gRPC connection that retries unary calls according to
+// a RetryPolicy. Streams are passed through unchanged.
+type retryingConn struct {
+	grpc.ClientConnInterface
+	policy RetryPolicy
+}
+
+// withRetry wraps conn so unary calls are retried according to policy.
+// It returns conn unchanged when the policy disables retries.
+func withRetry(conn grpc.ClientConnInterface, policy RetryPolicy) grpc.ClientConnInterface {
+	if !policy.enabled() {
+		return conn
+	}
+	return &retryingConn{ClientConnInterface: conn, policy: policy}
+}
+
+// Invoke performs the unary call, retrying transient failures.
+func (c *retryingConn) Invoke(ctx context.Context, method string, args, reply any, opts ...grpc.CallOption) error {
+	for attempt := 1; ; attempt++ {
+		err := c.ClientConnInterface.Invoke(ctx, method, args, reply, opts...)
+		if err == nil || attempt >= c.policy.MaxAttempts || !isTransient(err) || ctx.Err() != nil {
+			return err
+		}
+
+		wait := c.policy.backoff(attempt)
+		if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) <= wait {
+			return err
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return err
+		case <-timer.C:
+		}
+	}
+}
+
+// isTransient reports whether err is a gRPC status worth retrying.
+func isTransient(err error) bool {
+	switch status.Code(err) {
+	case codes.Unavailable, codes.DeadlineExceeded:
+		return true
+	default:
+		return false
+	}
+}
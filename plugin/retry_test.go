@@ -0,0 +1,131 @@
+package plugin
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/jokarl/tfbreak-plugin-sdk/hclext"
+	pb "github.com/jokarl/tfbreak-plugin-sdk/plugin/proto"
+)
+
+// flakyConn is a gRPC connection whose first calls fail with the given errors.
+type flakyConn struct {
+	grpc.ClientConnInterface
+	errs  []error
+	calls int
+}
+
+func (c *flakyConn) Invoke(_ context.Context, _ string, _, _ any, _ ...grpc.CallOption) error {
+	c.calls++
+	if c.calls <= len(c.errs) {
+		return c.errs[c.calls-1]
+	}
+	return nil
+}
+
+func TestRetryingConn_RetriesTransientErrors(t *testing.T) {
+	conn := &flakyConn{errs: []error{
+		status.Error(codes.Unavailable, "host busy"),
+		status.Error(codes.DeadlineExceeded, "host slow"),
+	}}
+	policy := RetryPolicy{MaxAttempts: 3, InitialBackoff: time.Millisecond}
+	runner := &GRPCRunnerClient{client: pb.NewRunnerClient(withRetry(conn, policy))}
+
+	if _, err := runner.GetOldModuleContent(&hclext.BodySchema{}, nil); err != nil {
+		t.Fatalf("GetOldModuleContent() error = %v, want nil after retries", err)
+	}
+	if conn.calls != 3 {
+		t.Errorf("calls = %d, want 3", conn.calls)
+	}
+}
+
+func TestRetryingConn_StopsAtMaxAttempts(t *testing.T) {
+	unavailable := status.Error(codes.Unavailable, "host busy")
+	conn := &flakyConn{errs: []error{unavailable, unavailable, unavailable}}
+	policy := RetryPolicy{MaxAttempts: 2, InitialBackoff: time.Millisecond}
+	runner := &GRPCRunnerClient{client: pb.NewRunnerClient(withRetry(conn, policy))}
+
+	_, err := runner.GetOldModuleContent(&hclext.BodySchema{}, nil)
+	if status.Code(err) != codes.Unavailable {
+		t.Errorf("error = %v, want Unavailable", err)
+	}
+	if conn.calls != 2 {
+		t.Errorf("calls = %d, want 2", conn.calls)
+	}
+}
+
+func TestRetryingConn_DoesNotRetryApplicationErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+	}{
+		{"status error", status.Error(codes.InvalidArgument, "unknown resource type")},
+		{"plain error", errors.New("boom")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conn := &flakyConn{errs: []error{tt.err}}
+			policy := RetryPolicy{MaxAttempts: 3, InitialBackoff: time.Millisecond}
+			runner := &GRPCRunnerClient{client: pb.NewRunnerClient(withRetry(conn, policy))}
+
+			if _, err := runner.GetOldModuleContent(&hclext.BodySchema{}, nil); err == nil {
+				t.Fatal("expected error")
+			}
+			if conn.calls != 1 {
+				t.Errorf("calls = %d, want 1", conn.calls)
+			}
+		})
+	}
+}
+
+func TestRetryingConn_RespectsDeadline(t *testing.T) {
+	unavailable := status.Error(codes.Unavailable, "host busy")
+	conn := &flakyConn{errs: []error{unavailable, unavailable}}
+	policy := RetryPolicy{MaxAttempts: 3, InitialBackoff: time.Minute}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	err := withRetry(conn, policy).Invoke(ctx, "/Runner/ListOldFiles", nil, nil)
+	if status.Code(err) != codes.Unavailable {
+		t.Errorf("error = %v, want Unavailable", err)
+	}
+	if conn.calls != 1 {
+		t.Errorf("calls = %d, want 1 since the backoff exceeds the deadline", conn.calls)
+	}
+	if elapsed := time.Since(start); elapsed > 40*time.Millisecond {
+		t.Errorf("Invoke took %v, should give up without waiting", elapsed)
+	}
+}
+
+func TestWithRetry_Disabled(t *testing.T) {
+	conn := &flakyConn{}
+	if got := withRetry(conn, RetryPolicy{}); got != conn {
+		t.Error("zero RetryPolicy should return the connection unchanged")
+	}
+	if got := withRetry(conn, RetryPolicy{MaxAttempts: 1}); got != conn {
+		t.Error("MaxAttempts 1 should return the connection unchanged")
+	}
+}
+
+func TestRetryPolicy_Backoff(t *testing.T) {
+	policy := RetryPolicy{InitialBackoff: 10 * time.Millisecond, MaxBackoff: 35 * time.Millisecond}
+	want := []time.Duration{10 * time.Millisecond, 20 * time.Millisecond, 35 * time.Millisecond, 35 * time.Millisecond}
+	for i, w := range want {
+		if got := policy.backoff(i + 1); got != w {
+			t.Errorf("backoff(%d) = %v, want %v", i+1, got, w)
+		}
+	}
+
+	if got := (RetryPolicy{}).backoff(1); got != defaultRetryInitialBackoff {
+		t.Errorf("default backoff(1) = %v, want %v", got, defaultRetryInitialBackoff)
+	}
+}
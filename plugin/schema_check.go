@@ -0,0 +1,90 @@
+// Package plugin provides gRPC-based plugin communication for tfbreak.
+//
+// This file checks the ruleset's ConfigSchema for mistakes before serving.
+// The checks are advisory: problems are logged as warnings and the plugin
+// is served regardless, since the host may never send matching config.
+
+package plugin
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/go-hclog"
+
+	"github.com/jokarl/tfbreak-plugin-sdk/hclext"
+	"github.com/jokarl/tfbreak-plugin-sdk/tflint"
+)
+
+// warnConfigSchema logs a warning for each problem found in the config
+// schema of rs.
+func warnConfigSchema(logger hclog.Logger, rs tflint.RuleSet) {
+	for _, problem := range configSchemaProblems(rs.ConfigSchema()) {
+		logger.Warn("invalid plugin config schema", "ruleset", rs.RuleSetName(), "problem", problem)
+	}
+}
+
+// configSchemaProblems returns a description of each inconsistency in
+// schema, such as names declared twice, that would make config decoding
+// fail or silently drop content at runtime. A nil schema has no problems.
+func configSchemaProblems(schema *hclext.BodySchema) []string {
+	return schemaProblems(schema, "")
+}
+
+// schemaProblems checks schema and its nested block schemas. path is the
+// dotted path of block types leading to schema, empty at the top level.
+func schemaProblems(schema *hclext.BodySchema, path string) []string {
+	if schema == nil {
+		return nil
+	}
+
+	where := "at the top level"
+	if path != "" {
+		where = fmt.Sprintf("in block %q", path)
+	}
+
+	var problems []string
+	attrs := make(map[string]bool, len(schema.Attributes))
+	for _, attrS := range schema.Attributes {
+		switch {
+		case attrS.Name == "":
+			problems = append(problems, fmt.Sprintf("attribute with an empty name %s", where))
+		case attrs[attrS.Name]:
+			problems = append(problems, fmt.Sprintf("attribute %q is declared more than once %s", attrS.Name, where))
+		}
+		attrs[attrS.Name] = true
+	}
+
+	if schema.Mode == hclext.SchemaJustAttributesMode && len(schema.Blocks) > 0 {
+		problems = append(problems, fmt.Sprintf("blocks are declared %s, which uses JustAttributes mode and cannot contain blocks", where))
+	}
+
+	blocks := make(map[string]bool, len(schema.Blocks))
+	for _, blockS := range schema.Blocks {
+		switch {
+		case blockS.Type == "":
+			problems = append(problems, fmt.Sprintf("block with an empty type %s", where))
+			continue
+		case blocks[blockS.Type]:
+			problems = append(problems, fmt.Sprintf("block %q is declared more than once %s", blockS.Type, where))
+		case attrs[blockS.Type]:
+			problems = append(problems, fmt.Sprintf("%q is declared as both an attribute and a block %s", blockS.Type, where))
+		}
+		blocks[blockS.Type] = true
+
+		blockPath := blockS.Type
+		if path != "" {
+			blockPath = path + "." + blockS.Type
+		}
+		for i, label := range blockS.LabelNames {
+			if label == "" {
+				problems = append(problems, fmt.Sprintf("label %d of block %q has an empty name", i, blockPath))
+			}
+		}
+		if blockS.Body == nil {
+			problems = append(problems, fmt.Sprintf("block %q has no body schema, so its attributes and nested blocks are not extracted", blockPath))
+			continue
+		}
+		problems = append(problems, schemaProblems(blockS.Body, blockPath)...)
+	}
+	return problems
+}
@@ -0,0 +1,132 @@
+package plugin
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-hclog"
+
+	"github.com/jokarl/tfbreak-plugin-sdk/hclext"
+	"github.com/jokarl/tfbreak-plugin-sdk/tflint"
+)
+
+// schemaRuleSet is a ruleset with a fixed config schema.
+type schemaRuleSet struct {
+	tflint.BuiltinRuleSet
+	schema *hclext.BodySchema
+}
+
+func (rs *schemaRuleSet) ConfigSchema() *hclext.BodySchema { return rs.schema }
+
+func TestWarnConfigSchema_DuplicateAttribute(t *testing.T) {
+	var buf bytes.Buffer
+	logger := hclog.New(&hclog.LoggerOptions{Output: &buf, Level: hclog.Warn})
+	rs := &schemaRuleSet{
+		BuiltinRuleSet: tflint.BuiltinRuleSet{Name: "test", Version: "0.1.0"},
+		schema: &hclext.BodySchema{
+			Attributes: []hclext.AttributeSchema{{Name: "threshold"}, {Name: "threshold", Required: true}},
+		},
+	}
+
+	warnConfigSchema(logger, rs)
+
+	out := buf.String()
+	if !strings.Contains(out, "[WARN]") || !strings.Contains(out, "invalid plugin config schema") {
+		t.Fatalf("expected a warning, got %q", out)
+	}
+	if !strings.Contains(out, `attribute \"threshold\" is declared more than once at the top level`) {
+		t.Errorf("warning should name the duplicate attribute, got %q", out)
+	}
+}
+
+func TestWarnConfigSchema_Valid(t *testing.T) {
+	var buf bytes.Buffer
+	logger := hclog.New(&hclog.LoggerOptions{Output: &buf, Level: hclog.Trace})
+
+	warnConfigSchema(logger, &tflint.BuiltinRuleSet{Name: "test", Version: "0.1.0"})
+	warnConfigSchema(logger, &schemaRuleSet{schema: &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{{Name: "threshold"}},
+		Blocks: []hclext.BlockSchema{{
+			Type:       "rule",
+			LabelNames: []string{"name"},
+			Body:       &hclext.BodySchema{Attributes: []hclext.AttributeSchema{{Name: "enabled"}}},
+		}},
+	}})
+
+	if buf.Len() != 0 {
+		t.Errorf("expected no warnings, got %q", buf.String())
+	}
+}
+
+func TestConfigSchemaProblems(t *testing.T) {
+	tests := []struct {
+		name   string
+		schema *hclext.BodySchema
+		want   []string
+	}{
+		{
+			name:   "nil schema",
+			schema: nil,
+			want:   nil,
+		},
+		{
+			name: "duplicate block",
+			schema: &hclext.BodySchema{Blocks: []hclext.BlockSchema{
+				{Type: "rule", Body: &hclext.BodySchema{}},
+				{Type: "rule", Body: &hclext.BodySchema{}},
+			}},
+			want: []string{`block "rule" is declared more than once at the top level`},
+		},
+		{
+			name: "attribute and block with the same name",
+			schema: &hclext.BodySchema{
+				Attributes: []hclext.AttributeSchema{{Name: "rule"}},
+				Blocks:     []hclext.BlockSchema{{Type: "rule", Body: &hclext.BodySchema{}}},
+			},
+			want: []string{`"rule" is declared as both an attribute and a block at the top level`},
+		},
+		{
+			name: "nil block body",
+			schema: &hclext.BodySchema{Blocks: []hclext.BlockSchema{
+				{Type: "rule", LabelNames: []string{"name"}},
+			}},
+			want: []string{`block "rule" has no body schema, so its attributes and nested blocks are not extracted`},
+		},
+		{
+			name: "nested problems",
+			schema: &hclext.BodySchema{Blocks: []hclext.BlockSchema{{
+				Type: "rule",
+				Body: &hclext.BodySchema{
+					Attributes: []hclext.AttributeSchema{{Name: ""}, {Name: "a"}, {Name: "a"}},
+					Blocks:     []hclext.BlockSchema{{Type: "options", LabelNames: []string{""}, Body: &hclext.BodySchema{}}},
+				},
+			}}},
+			want: []string{
+				`attribute with an empty name in block "rule"`,
+				`attribute "a" is declared more than once in block "rule"`,
+				`label 0 of block "rule.options" has an empty name`,
+			},
+		},
+		{
+			name: "blocks in JustAttributes mode",
+			schema: &hclext.BodySchema{
+				Mode:   hclext.SchemaJustAttributesMode,
+				Blocks: []hclext.BlockSchema{{Type: "", Body: &hclext.BodySchema{}}},
+			},
+			want: []string{
+				"blocks are declared at the top level, which uses JustAttributes mode and cannot contain blocks",
+				"block with an empty type at the top level",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := configSchemaProblems(tt.schema); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("configSchemaProblems() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -1,7 +1,19 @@
 package plugin
 
 import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync/atomic"
 	"testing"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/go-plugin"
 
 	"github.com/jokarl/tfbreak-plugin-sdk/tflint"
 )
@@ -14,7 +26,7 @@ type testRule struct {
 
 func (r *testRule) Name() string                   { return r.name }
 func (r *testRule) Link() string                   { return "" }
-func (r *testRule) Check(_ tflint.Runner) error { return nil }
+func (r *testRule) Check(_ context.Context, _ tflint.Runner) error { return nil }
 
 func TestServe_NilOpts(t *testing.T) {
 	// Should not panic with nil opts
@@ -60,6 +72,28 @@ func TestServe_RuleSetValidation(t *testing.T) {
 	}
 }
 
+func TestValidateRuleSet(t *testing.T) {
+	valid := &tflint.BuiltinRuleSet{Name: "test", Rules: []tflint.Rule{&testRule{name: "rule1"}, &testRule{name: "rule2"}}}
+	if err := validateRuleSet(valid); err != nil {
+		t.Errorf("validateRuleSet() error = %v, want nil", err)
+	}
+
+	duplicate := &tflint.BuiltinRuleSet{Name: "test", Rules: []tflint.Rule{&testRule{name: "rule1"}, &testRule{name: "rule1"}}}
+	if err := validateRuleSet(duplicate); err == nil || !strings.Contains(err.Error(), `"rule1"`) {
+		t.Errorf("validateRuleSet() error = %v, want duplicate rule1", err)
+	}
+
+	empty := &tflint.BuiltinRuleSet{Name: "test", Rules: []tflint.Rule{&testRule{}}}
+	if err := validateRuleSet(empty); err == nil || !strings.Contains(err.Error(), "empty name") {
+		t.Errorf("validateRuleSet() error = %v, want empty name", err)
+	}
+
+	composite := tflint.NewCompositeRuleSet(valid, duplicate)
+	if err := validateRuleSet(composite); err == nil {
+		t.Error("validateRuleSet() error = nil, want the member's duplicate reported")
+	}
+}
+
 func TestServeOpts_RuleSetField(t *testing.T) {
 	rs := &tflint.BuiltinRuleSet{Name: "test"}
 	opts := &ServeOpts{RuleSet: rs}
@@ -68,3 +102,306 @@ func TestServeOpts_RuleSetField(t *testing.T) {
 		t.Error("ServeOpts.RuleSet should hold the provided RuleSet")
 	}
 }
+
+func TestParseLogLevel(t *testing.T) {
+	tests := []struct {
+		names []string
+		want  hclog.Level
+	}{
+		{nil, hclog.Warn},
+		{[]string{""}, hclog.Warn},
+		{[]string{"debug"}, hclog.Debug},
+		{[]string{"TRACE"}, hclog.Trace},
+		{[]string{"bogus"}, hclog.Warn},
+		{[]string{"", "info"}, hclog.Info},
+		{[]string{"debug", "error"}, hclog.Debug},
+		{[]string{"bogus", "error"}, hclog.Error},
+	}
+
+	for _, tt := range tests {
+		if got := parseLogLevel(tt.names...); got != tt.want {
+			t.Errorf("parseLogLevel(%q) = %v, want %v", tt.names, got, tt.want)
+		}
+	}
+}
+
+func TestNewLogger_Level(t *testing.T) {
+	t.Setenv(LogLevelEnvVar, "")
+	if got := newLogger(&ServeOpts{}).GetLevel(); got != hclog.Warn {
+		t.Errorf("default level = %v, want Warn", got)
+	}
+	if got := newLogger(&ServeOpts{LogLevel: "info"}).GetLevel(); got != hclog.Info {
+		t.Errorf("LogLevel info = %v, want Info", got)
+	}
+
+	t.Setenv(LogLevelEnvVar, "debug")
+	if got := newLogger(&ServeOpts{LogLevel: "info"}).GetLevel(); got != hclog.Debug {
+		t.Errorf("TFBREAK_LOG=debug level = %v, want Debug", got)
+	}
+}
+
+func TestNewLogger_Provided(t *testing.T) {
+	t.Setenv(LogLevelEnvVar, "debug")
+	logger := hclog.New(&hclog.LoggerOptions{Name: "custom", Level: hclog.Error})
+
+	got := newLogger(&ServeOpts{Logger: logger, LogLevel: "trace"})
+	if got != logger {
+		t.Error("newLogger should return the provided logger")
+	}
+	if got.GetLevel() != hclog.Error {
+		t.Errorf("provided logger level changed to %v", got.GetLevel())
+	}
+}
+
+// warningTestRule is a disabled-by-default rule with a documentation link.
+type warningTestRule struct {
+	testRule
+}
+
+func (r *warningTestRule) Enabled() bool             { return false }
+func (r *warningTestRule) Severity() tflint.Severity { return tflint.WARNING }
+func (r *warningTestRule) Link() string              { return "https://example.com/rules/warning_rule" }
+
+func TestRulesJSONRequested(t *testing.T) {
+	tests := []struct {
+		args []string
+		env  string
+		want bool
+	}{
+		{nil, "", false},
+		{[]string{"--rules-json"}, "", true},
+		{[]string{"--verbose", "--rules-json"}, "", true},
+		{nil, "json", true},
+		{nil, "text", false},
+		{[]string{"--rules"}, "", false},
+	}
+
+	for _, tt := range tests {
+		if got := rulesJSONRequested(tt.args, tt.env); got != tt.want {
+			t.Errorf("rulesJSONRequested(%q, %q) = %v, want %v", tt.args, tt.env, got, tt.want)
+		}
+	}
+}
+
+func TestWriteRulesJSON(t *testing.T) {
+	rs := &tflint.BuiltinRuleSet{
+		Name:       "azurerm",
+		Version:    "1.2.0",
+		Constraint: ">= 0.3.0",
+		Rules: []tflint.Rule{
+			&testRule{name: "error_rule"},
+			&warningTestRule{testRule: testRule{name: "warning_rule"}},
+		},
+	}
+
+	var buf bytes.Buffer
+	if err := writeRulesJSON(&buf, rs); err != nil {
+		t.Fatalf("writeRulesJSON error: %v", err)
+	}
+	for _, name := range rs.RuleNames() {
+		if !strings.Contains(buf.String(), `"`+name+`"`) {
+			t.Errorf("output does not contain rule %q:\n%s", name, buf.String())
+		}
+	}
+
+	var got ruleSetListing
+	if err := json.Unmarshal(buf.Bytes(), &got); err != nil {
+		t.Fatalf("output is not valid JSON: %v\n%s", err, buf.String())
+	}
+	want := ruleSetListing{
+		Name:              "azurerm",
+		Version:           "1.2.0",
+		VersionConstraint: ">= 0.3.0",
+		Rules: []ruleListing{
+			{Name: "error_rule", Enabled: true, Severity: "ERROR"},
+			{Name: "warning_rule", Enabled: false, Severity: "WARNING", Link: "https://example.com/rules/warning_rule"},
+		},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("listing = %+v, want %+v", got, want)
+	}
+}
+
+func TestVersionedPlugins(t *testing.T) {
+	versions := versionedPlugins(PluginMap)
+	if len(versions) != ProtocolVersion-MinProtocolVersion+1 {
+		t.Errorf("versionedPlugins() has %d versions, want %d", len(versions), ProtocolVersion-MinProtocolVersion+1)
+	}
+	for v := MinProtocolVersion; v <= ProtocolVersion; v++ {
+		if _, ok := versions[v][PluginName]; !ok {
+			t.Errorf("protocol version %d does not serve %q", v, PluginName)
+		}
+	}
+}
+
+func TestProtocolMismatchMessage(t *testing.T) {
+	newerHost := protocolMismatchMessage(2, 3)
+	want := "Incompatible tfbreak plugin protocol version.\n\n" +
+		"Plugin protocol version: 2\n" +
+		"tfbreak protocol version: 3\n\n" +
+		"This plugin is older than tfbreak. Upgrade the plugin to a release built with a newer tfbreak-plugin-sdk.\n"
+	if newerHost != want {
+		t.Errorf("protocolMismatchMessage(2, 3) = %q, want %q", newerHost, want)
+	}
+
+	olderHost := protocolMismatchMessage(3, 1)
+	for _, part := range []string{"Plugin protocol version: 3\n", "tfbreak protocol version: 1\n", "Upgrade tfbreak"} {
+		if !strings.Contains(olderHost, part) {
+			t.Errorf("protocolMismatchMessage(3, 1) = %q, want it to contain %q", olderHost, part)
+		}
+	}
+}
+
+func TestHostProtocolMismatch(t *testing.T) {
+	tests := []struct {
+		env          string
+		wantMismatch bool
+	}{
+		{"", false},
+		{strconv.Itoa(ProtocolVersion), false},
+		{"1," + strconv.Itoa(ProtocolVersion), false},
+		{strconv.Itoa(ProtocolVersion + 1), true},
+		{"invalid", false},
+	}
+	for _, tt := range tests {
+		if got := hostProtocolMismatch(tt.env); (got != "") != tt.wantMismatch {
+			t.Errorf("hostProtocolMismatch(%q) = %q, want mismatch %v", tt.env, got, tt.wantMismatch)
+		}
+	}
+
+	got := hostProtocolMismatch(strconv.Itoa(ProtocolVersion+2) + "," + strconv.Itoa(ProtocolVersion+1))
+	if want := protocolMismatchMessage(ProtocolVersion, ProtocolVersion+2); got != want {
+		t.Errorf("hostProtocolMismatch() = %q, want the message for the newest host version %q", got, want)
+	}
+}
+
+func TestServeOpts_RuleSets(t *testing.T) {
+	azurerm := &tflint.BuiltinRuleSet{Name: "azurerm", Version: "0.1.0", Rules: []tflint.Rule{&testRule{name: "azurerm_rule"}}}
+	azuread := &tflint.BuiltinRuleSet{Name: "azuread", Version: "0.1.0", Rules: []tflint.Rule{&testRule{name: "azuread_rule"}}}
+
+	if got := (&ServeOpts{RuleSet: azurerm}).ruleSet(); got != azurerm {
+		t.Errorf("ruleSet() = %v, want RuleSet unchanged", got)
+	}
+	if got := (&ServeOpts{}).ruleSet(); got != nil {
+		t.Errorf("ruleSet() = %v, want nil", got)
+	}
+
+	got := (&ServeOpts{RuleSet: azurerm, RuleSets: []tflint.RuleSet{azuread}}).ruleSet()
+	if !reflect.DeepEqual(got.RuleNames(), []string{"azurerm_rule", "azuread_rule"}) {
+		t.Errorf("RuleNames() = %v, want rules of RuleSet, then RuleSets", got.RuleNames())
+	}
+}
+
+// closingRuleSet counts its Close calls.
+type closingRuleSet struct {
+	tflint.BuiltinRuleSet
+	closed atomic.Int32
+	err    error
+}
+
+func (rs *closingRuleSet) Close() error {
+	rs.closed.Add(1)
+	return rs.err
+}
+
+func TestServe_ClosesRuleSet(t *testing.T) {
+	rs := &closingRuleSet{BuiltinRuleSet: tflint.BuiltinRuleSet{Name: "test", Version: "1.0.0"}}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	reattach := make(chan *plugin.ReattachConfig, 1)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		serve(&ServeOpts{RuleSet: rs, Logger: hclog.NewNullLogger()}, rs, &plugin.ServeTestConfig{
+			Context:          ctx,
+			ReattachConfigCh: reattach,
+		})
+	}()
+
+	select {
+	case <-reattach:
+	case <-time.After(5 * time.Second):
+		t.Fatal("server did not start")
+	}
+	if got := rs.closed.Load(); got != 0 {
+		t.Fatalf("Close() called %d times while serving, want 0", got)
+	}
+
+	cancel()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("server did not stop")
+	}
+	if got := rs.closed.Load(); got != 1 {
+		t.Errorf("Close() called %d times, want 1", got)
+	}
+}
+
+func TestServeConfig_Handshake(t *testing.T) {
+	rs := &tflint.BuiltinRuleSet{Name: "test", Version: "1.0.0"}
+	logger := hclog.NewNullLogger()
+
+	config := serveConfig(&ServeOpts{RuleSet: rs}, rs, logger, nil)
+	if config.HandshakeConfig != Handshake {
+		t.Errorf("default HandshakeConfig = %+v, want %+v", config.HandshakeConfig, Handshake)
+	}
+
+	custom := &plugin.HandshakeConfig{
+		ProtocolVersion:  ProtocolVersion,
+		MagicCookieKey:   "ACME_TFBREAK_PLUGIN_MAGIC_COOKIE",
+		MagicCookieValue: "acme-tfbreak-plugin",
+	}
+	config = serveConfig(&ServeOpts{RuleSet: rs, Handshake: custom}, rs, logger, nil)
+	if config.HandshakeConfig != *custom {
+		t.Errorf("custom HandshakeConfig = %+v, want %+v", config.HandshakeConfig, *custom)
+	}
+	if len(config.VersionedPlugins) != ProtocolVersion-MinProtocolVersion+1 {
+		t.Errorf("VersionedPlugins has %d versions with a custom handshake", len(config.VersionedPlugins))
+	}
+}
+
+func TestServeOpts_ValidateHandshake(t *testing.T) {
+	tests := []struct {
+		name      string
+		handshake *plugin.HandshakeConfig
+		wantErr   string
+	}{
+		{name: "default"},
+		{name: "custom", handshake: &plugin.HandshakeConfig{MagicCookieKey: "ACME_COOKIE", MagicCookieValue: "acme"}},
+		{name: "empty key", handshake: &plugin.HandshakeConfig{MagicCookieValue: "acme"}, wantErr: "key is empty"},
+		{name: "empty value", handshake: &plugin.HandshakeConfig{MagicCookieKey: "ACME_COOKIE"}, wantErr: "value is empty"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := (&ServeOpts{Handshake: tt.handshake}).validateHandshake()
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("validateHandshake() error = %v, want nil", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("validateHandshake() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestCloseRuleSet(t *testing.T) {
+	var buf bytes.Buffer
+	logger := hclog.New(&hclog.LoggerOptions{Output: &buf})
+
+	rs := &closingRuleSet{err: errors.New("cache locked")}
+	closeRuleSet(logger, rs)
+	if got := rs.closed.Load(); got != 1 {
+		t.Errorf("Close() called %d times, want 1", got)
+	}
+	if !strings.Contains(buf.String(), "cache locked") {
+		t.Errorf("log = %q, want the Close error", buf.String())
+	}
+
+	// Rulesets without Close are left alone
+	closeRuleSet(logger, &tflint.BuiltinRuleSet{Name: "test"})
+}
@@ -0,0 +1,95 @@
+// Package plugin provides gRPC-based plugin communication for tfbreak.
+//
+// This file tracks the highest severity among the issues the host accepts
+// during Check, so it can choose an exit code without inspecting every issue.
+// Issues dropped because of a tfbreak:ignore directive never reach the tracker.
+
+package plugin
+
+import (
+	"sync"
+
+	"github.com/hashicorp/hcl/v2"
+
+	"github.com/jokarl/tfbreak-plugin-sdk/tflint"
+)
+
+// severityTracker is a Runner that records the highest severity of the
+// issues emitted through it. All calls go to the embedded Runner.
+type severityTracker struct {
+	tflint.Runner
+
+	mu  sync.Mutex
+	max tflint.Severity
+}
+
+// EmitIssue reports the issue and records the rule's severity.
+func (r *severityTracker) EmitIssue(rule tflint.Rule, message string, issueRange hcl.Range) error {
+	if err := r.Runner.EmitIssue(rule, message, issueRange); err != nil {
+		return err
+	}
+	r.record(ruleSeverity(rule))
+	return nil
+}
+
+// EmitIssueWithFix reports the issue and records the rule's severity.
+func (r *severityTracker) EmitIssueWithFix(rule tflint.Rule, message string, issueRange hcl.Range, fix *tflint.Fix) error {
+	if err := r.Runner.EmitIssueWithFix(rule, message, issueRange, fix); err != nil {
+		return err
+	}
+	r.record(ruleSeverity(rule))
+	return nil
+}
+
+// EmitIssueWithSeverity reports the issue and records its severity.
+func (r *severityTracker) EmitIssueWithSeverity(rule tflint.Rule, severity tflint.Severity, message string, issueRange hcl.Range) error {
+	if err := r.Runner.EmitIssueWithSeverity(rule, severity, message, issueRange); err != nil {
+		return err
+	}
+	r.record(severity)
+	return nil
+}
+
+// EmitIssueWithOptions reports the issue and records its severity: the
+// per-issue severity if set, otherwise the rule's.
+func (r *severityTracker) EmitIssueWithOptions(rule tflint.Rule, message string, issueRange hcl.Range, opts tflint.IssueOptions) error {
+	if err := r.Runner.EmitIssueWithOptions(rule, message, issueRange, opts); err != nil {
+		return err
+	}
+	severity := opts.Severity
+	if severity == 0 {
+		severity = ruleSeverity(rule)
+	}
+	r.record(severity)
+	return nil
+}
+
+// record raises the tracked severity to severity if it is higher.
+// ERROR is the highest severity and has the lowest value.
+func (r *severityTracker) record(severity tflint.Severity) {
+	if severity == 0 {
+		return
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.max == 0 || severity < r.max {
+		r.max = severity
+	}
+}
+
+// maxSeverity returns the highest severity recorded, or 0 if no issue
+// was emitted.
+func (r *severityTracker) maxSeverity() tflint.Severity {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.max
+}
+
+// ruleSeverity returns the severity of rule, or 0 for a nil rule.
+func ruleSeverity(rule tflint.Rule) tflint.Severity {
+	if rule == nil {
+		return 0
+	}
+	return rule.Severity()
+}
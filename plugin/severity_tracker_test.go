@@ -0,0 +1,117 @@
+package plugin
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+
+	"github.com/jokarl/tfbreak-plugin-sdk/tflint"
+)
+
+// mixedSeverityTestRule emits a WARNING issue, then an ERROR issue with a
+// per-issue severity, then a NOTICE issue.
+type mixedSeverityTestRule struct {
+	testRule
+}
+
+func (r *mixedSeverityTestRule) Severity() tflint.Severity { return tflint.WARNING }
+
+func (r *mixedSeverityTestRule) Check(_ context.Context, runner tflint.Runner) error {
+	issueRange := hcl.Range{Filename: "main.tf", Start: hcl.Pos{Line: 1, Column: 1}}
+	if err := runner.EmitIssue(r, "warning", issueRange); err != nil {
+		return err
+	}
+	if err := runner.EmitIssueWithSeverity(r, tflint.ERROR, "error", issueRange); err != nil {
+		return err
+	}
+	return runner.EmitIssueWithSeverity(r, tflint.NOTICE, "notice", issueRange)
+}
+
+// checkMaxSeverity runs rules through a real go-plugin connection and
+// returns the max severity reported to the host.
+func checkMaxSeverity(t *testing.T, bufferIssues bool, rules ...tflint.Rule) tflint.Severity {
+	t.Helper()
+	return checkMaxSeverityWith(t, &recordingRunner{}, bufferIssues, rules...)
+}
+
+// checkMaxSeverityWith is checkMaxSeverity with the given host runner.
+func checkMaxSeverityWith(t *testing.T, runner tflint.Runner, bufferIssues bool, rules ...tflint.Rule) tflint.Severity {
+	t.Helper()
+
+	ruleset := dispenseRuleSet(t, &tflint.BuiltinRuleSet{Name: "test", Version: "0.1.0", Rules: rules}, RuleSetPlugin{BufferIssues: bufferIssues})
+
+	severity, err := ruleset.CheckMaxSeverity(runner)
+	if err != nil {
+		t.Fatalf("CheckMaxSeverity error: %v", err)
+	}
+	return severity
+}
+
+func TestCheckMaxSeverity(t *testing.T) {
+	for _, buffer := range []bool{false, true} {
+		rule := &mixedSeverityTestRule{testRule: testRule{name: "mixed_rule"}}
+		if got := checkMaxSeverity(t, buffer, rule); got != tflint.ERROR {
+			t.Errorf("BufferIssues=%v: max severity = %v, want ERROR", buffer, got)
+		}
+	}
+}
+
+func TestCheckMaxSeverity_NoIssues(t *testing.T) {
+	if got := checkMaxSeverity(t, false, &testRule{name: "quiet_rule"}); got != 0 {
+		t.Errorf("max severity = %v, want 0", got)
+	}
+}
+
+func TestCheckMaxSeverity_IgnoredIssues(t *testing.T) {
+	src := []byte(`resource "azurerm_resource_group" "main" {} # tfbreak:ignore=mixed_rule`)
+
+	for _, buffer := range []bool{false, true} {
+		var received int
+		runner := &recordingRunner{
+			onGetNewFile: func(string) (*hcl.File, error) {
+				return &hcl.File{Bytes: src}, nil
+			},
+			onEmitIssue: func(tflint.Rule, string, hcl.Range) error {
+				received++
+				return nil
+			},
+			onEmitIssueWithOptions: func(tflint.Rule, string, hcl.Range, tflint.IssueOptions) error {
+				received++
+				return nil
+			},
+		}
+
+		rule := &mixedSeverityTestRule{testRule: testRule{name: "mixed_rule"}}
+		got := checkMaxSeverityWith(t, runner, buffer, rule)
+		if received != 0 {
+			t.Errorf("BufferIssues=%v: host received %d issues, want all suppressed", buffer, received)
+		}
+		if got != 0 {
+			t.Errorf("BufferIssues=%v: max severity = %v, want 0 when every issue is suppressed", buffer, got)
+		}
+	}
+}
+
+func TestSeverityTracker(t *testing.T) {
+	tracker := &severityTracker{Runner: &recordingRunner{}}
+	rule := &warningTestRule{testRule: testRule{name: "warning_rule"}}
+	issueRange := hcl.Range{Filename: "main.tf"}
+
+	if got := tracker.maxSeverity(); got != 0 {
+		t.Errorf("initial max severity = %v, want 0", got)
+	}
+
+	_ = tracker.EmitIssueWithSeverity(rule, tflint.NOTICE, "notice", issueRange)
+	if got := tracker.maxSeverity(); got != tflint.NOTICE {
+		t.Errorf("max severity = %v, want NOTICE", got)
+	}
+	_ = tracker.EmitIssueWithFix(rule, "warning", issueRange, nil)
+	if got := tracker.maxSeverity(); got != tflint.WARNING {
+		t.Errorf("max severity = %v, want WARNING", got)
+	}
+	_ = tracker.EmitIssueWithSeverity(rule, tflint.NOTICE, "notice", issueRange)
+	if got := tracker.maxSeverity(); got != tflint.WARNING {
+		t.Errorf("max severity = %v, want WARNING to be kept", got)
+	}
+}
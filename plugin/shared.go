@@ -6,12 +6,78 @@
 package plugin
 
 import (
+	"fmt"
+	"runtime/debug"
+
 	"github.com/hashicorp/go-plugin"
 )
 
 // ProtocolVersion is the plugin protocol version.
 // Increment this when making breaking changes to the plugin interface.
-const ProtocolVersion = 1
+//
+// Version history:
+//   - 1: Initial protocol
+//   - 2: Rule.Check receives a context.Context
+const ProtocolVersion = 2
+
+// MinProtocolVersion is the oldest protocol version this SDK can serve.
+// Together with ProtocolVersion it forms the range reported to the host
+// through the GetSDKVersion RPC.
+const MinProtocolVersion = 2
+
+// SDKModulePath is the module path of this SDK, used to find its version
+// in the build info of a plugin binary.
+const SDKModulePath = "github.com/jokarl/tfbreak-plugin-sdk"
+
+// SDKVersion is the semantic version of the SDK a plugin is built with.
+// It is empty by default, in which case the version is read from the
+// plugin's build info. Builds can stamp it explicitly:
+//
+//	go build -ldflags "-X github.com/jokarl/tfbreak-plugin-sdk/plugin.SDKVersion=v0.4.0"
+var SDKVersion string
+
+// sdkVersion returns SDKVersion, falling back to the version of the SDK
+// module recorded in the build info, or "(devel)" if neither is known.
+func sdkVersion() string {
+	if SDKVersion != "" {
+		return SDKVersion
+	}
+	if info, ok := debug.ReadBuildInfo(); ok {
+		if info.Main.Path == SDKModulePath && info.Main.Version != "" {
+			return info.Main.Version
+		}
+		for _, dep := range info.Deps {
+			if dep.Path == SDKModulePath {
+				if dep.Replace != nil && dep.Replace.Version != "" {
+					return dep.Replace.Version
+				}
+				return dep.Version
+			}
+		}
+	}
+	return "(devel)"
+}
+
+// SDKInfo describes the SDK a plugin was built with, as reported by
+// GRPCRuleSetClient.SDKInfo.
+type SDKInfo struct {
+	// Version is the semantic version of the SDK, e.g. "v0.4.0".
+	Version string
+	// ProtocolVersion is the newest protocol version the plugin can serve.
+	ProtocolVersion int
+	// MinProtocolVersion is the oldest protocol version the plugin can serve.
+	MinProtocolVersion int
+}
+
+// CheckProtocol returns an error describing the mismatch if the plugin
+// cannot serve the given protocol version, or nil if it can.
+func (i *SDKInfo) CheckProtocol(version int) error {
+	if version >= i.MinProtocolVersion && version <= i.ProtocolVersion {
+		return nil
+	}
+	return fmt.Errorf("plugin built with SDK %s supports protocol versions %d to %d, host requires protocol version %d",
+		i.Version, i.MinProtocolVersion, i.ProtocolVersion, version)
+}
 
 // MagicCookieKey is the environment variable name for the magic cookie.
 const MagicCookieKey = "TFBREAK_PLUGIN_MAGIC_COOKIE"
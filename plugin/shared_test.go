@@ -1,6 +1,7 @@
 package plugin
 
 import (
+	"strings"
 	"testing"
 )
 
@@ -51,3 +52,44 @@ func TestMagicCookieFormat(t *testing.T) {
 		t.Errorf("MagicCookieValue = %q, expected tfbreak-plugin-v1", MagicCookieValue)
 	}
 }
+
+func TestSDKVersion(t *testing.T) {
+	if got := sdkVersion(); got == "" {
+		t.Error("sdkVersion() should not be empty")
+	}
+
+	old := SDKVersion
+	defer func() { SDKVersion = old }()
+	SDKVersion = "v9.9.9"
+	if got := sdkVersion(); got != "v9.9.9" {
+		t.Errorf("sdkVersion() = %q, want the stamped SDKVersion", got)
+	}
+}
+
+func TestSDKInfo_CheckProtocol(t *testing.T) {
+	info := &SDKInfo{Version: "v0.4.0", ProtocolVersion: 3, MinProtocolVersion: 2}
+
+	for _, v := range []int{2, 3} {
+		if err := info.CheckProtocol(v); err != nil {
+			t.Errorf("CheckProtocol(%d) error = %v", v, err)
+		}
+	}
+
+	err := info.CheckProtocol(4)
+	if err == nil {
+		t.Fatal("CheckProtocol(4) should fail")
+	}
+	want := "plugin built with SDK v0.4.0 supports protocol versions 2 to 3, host requires protocol version 4"
+	if err.Error() != want {
+		t.Errorf("CheckProtocol(4) error = %q, want %q", err, want)
+	}
+	if err := info.CheckProtocol(1); err == nil || !strings.Contains(err.Error(), "requires protocol version 1") {
+		t.Errorf("CheckProtocol(1) error = %v", err)
+	}
+}
+
+func TestProtocolVersionRange(t *testing.T) {
+	if MinProtocolVersion < 1 || MinProtocolVersion > ProtocolVersion {
+		t.Errorf("MinProtocolVersion = %d, want between 1 and ProtocolVersion (%d)", MinProtocolVersion, ProtocolVersion)
+	}
+}
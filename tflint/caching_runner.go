@@ -0,0 +1,175 @@
+package tflint
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"sync"
+
+	"github.com/jokarl/tfbreak-plugin-sdk/hclext"
+)
+
+// NewCachingRunner wraps runner so that content retrieved with the same
+// schema and options is only requested once. A repeated call to
+// GetOldModuleContent, GetNewModuleContent, the resource and data source
+// content methods or GetResourceContentPair returns the content of the
+// first call, and GetModuleDiff, WalkOldResources and WalkNewResources are
+// composed from the cached calls. Errors are not cached.
+//
+// The configurations do not change during a Check, so the cache is never
+// invalidated; create a new caching runner for each Check. Cached content
+// is shared between callers and must not be modified.
+//
+// Example:
+//
+//	func (r *MyRule) Check(ctx context.Context, runner tflint.Runner) error {
+//	    runner = tflint.NewCachingRunner(runner)
+//	    // repeated lookups with the same schema now reach the host once
+//	}
+func NewCachingRunner(runner Runner) Runner {
+	return &cachingRunner{Runner: runner, cache: make(map[contentKey]*hclext.BodyContent)}
+}
+
+// cachingRunner is the Runner returned by NewCachingRunner.
+type cachingRunner struct {
+	Runner
+	mu    sync.Mutex
+	cache map[contentKey]*hclext.BodyContent
+}
+
+// contentKey is the SHA-256 hash of a contentRequest.
+type contentKey [sha256.Size]byte
+
+// contentRequest identifies a content lookup. Block is "module" for module
+// content, or the block type looked up by its first label.
+type contentRequest struct {
+	Block  string
+	Label  string
+	Side   Side
+	Schema *hclext.BodySchema
+	Opts   GetModuleContentOption
+}
+
+// key returns the cache key of the request. A nil schema or options is the
+// same request as an empty one.
+func (req contentRequest) key() contentKey {
+	if req.Schema == nil {
+		req.Schema = &hclext.BodySchema{}
+	}
+	// Marshaling plain structs of strings, ints and slices cannot fail
+	b, _ := json.Marshal(req)
+	return sha256.Sum256(b)
+}
+
+func newContentRequest(block, label string, side Side, schema *hclext.BodySchema, opts *GetModuleContentOption) contentRequest {
+	req := contentRequest{Block: block, Label: label, Side: side, Schema: schema}
+	if opts != nil {
+		req.Opts = *opts
+	}
+	return req
+}
+
+// content returns the cached content of req, calling get on a miss.
+func (r *cachingRunner) content(req contentRequest, get func() (*hclext.BodyContent, error)) (*hclext.BodyContent, error) {
+	key := req.key()
+	r.mu.Lock()
+	content, ok := r.cache[key]
+	r.mu.Unlock()
+	if ok {
+		return content, nil
+	}
+
+	content, err := get()
+	if err != nil {
+		return nil, err
+	}
+	r.store(key, content)
+	return content, nil
+}
+
+// store caches content under key.
+func (r *cachingRunner) store(key contentKey, content *hclext.BodyContent) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.cache[key] = content
+}
+
+// GetOldModuleContent returns the cached OLD module content for schema.
+func (r *cachingRunner) GetOldModuleContent(schema *hclext.BodySchema, opts *GetModuleContentOption) (*hclext.BodyContent, error) {
+	return r.content(newContentRequest("module", "", SideOld, schema, opts), func() (*hclext.BodyContent, error) {
+		return r.Runner.GetOldModuleContent(schema, opts)
+	})
+}
+
+// GetNewModuleContent returns the cached NEW module content for schema.
+func (r *cachingRunner) GetNewModuleContent(schema *hclext.BodySchema, opts *GetModuleContentOption) (*hclext.BodyContent, error) {
+	return r.content(newContentRequest("module", "", SideNew, schema, opts), func() (*hclext.BodyContent, error) {
+		return r.Runner.GetNewModuleContent(schema, opts)
+	})
+}
+
+// GetOldResourceContent returns the cached OLD resources of resourceType.
+func (r *cachingRunner) GetOldResourceContent(resourceType string, schema *hclext.BodySchema, opts *GetModuleContentOption) (*hclext.BodyContent, error) {
+	return r.content(newContentRequest("resource", resourceType, SideOld, schema, opts), func() (*hclext.BodyContent, error) {
+		return r.Runner.GetOldResourceContent(resourceType, schema, opts)
+	})
+}
+
+// GetNewResourceContent returns the cached NEW resources of resourceType.
+func (r *cachingRunner) GetNewResourceContent(resourceType string, schema *hclext.BodySchema, opts *GetModuleContentOption) (*hclext.BodyContent, error) {
+	return r.content(newContentRequest("resource", resourceType, SideNew, schema, opts), func() (*hclext.BodyContent, error) {
+		return r.Runner.GetNewResourceContent(resourceType, schema, opts)
+	})
+}
+
+// GetResourceContentPair returns the cached OLD and NEW resources of
+// resourceType. It shares its cache entries with GetOldResourceContent and
+// GetNewResourceContent, and only calls the runner if either is missing.
+func (r *cachingRunner) GetResourceContentPair(resourceType string, schema *hclext.BodySchema, opts *GetModuleContentOption) (*hclext.BodyContent, *hclext.BodyContent, error) {
+	oldKey := newContentRequest("resource", resourceType, SideOld, schema, opts).key()
+	newKey := newContentRequest("resource", resourceType, SideNew, schema, opts).key()
+
+	r.mu.Lock()
+	oldContent, oldOK := r.cache[oldKey]
+	newContent, newOK := r.cache[newKey]
+	r.mu.Unlock()
+	if oldOK && newOK {
+		return oldContent, newContent, nil
+	}
+
+	oldContent, newContent, err := r.Runner.GetResourceContentPair(resourceType, schema, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+	r.store(oldKey, oldContent)
+	r.store(newKey, newContent)
+	return oldContent, newContent, nil
+}
+
+// GetOldDataSourceContent returns the cached OLD data sources of dataSourceType.
+func (r *cachingRunner) GetOldDataSourceContent(dataSourceType string, schema *hclext.BodySchema, opts *GetModuleContentOption) (*hclext.BodyContent, error) {
+	return r.content(newContentRequest("data", dataSourceType, SideOld, schema, opts), func() (*hclext.BodyContent, error) {
+		return r.Runner.GetOldDataSourceContent(dataSourceType, schema, opts)
+	})
+}
+
+// GetNewDataSourceContent returns the cached NEW data sources of dataSourceType.
+func (r *cachingRunner) GetNewDataSourceContent(dataSourceType string, schema *hclext.BodySchema, opts *GetModuleContentOption) (*hclext.BodyContent, error) {
+	return r.content(newContentRequest("data", dataSourceType, SideNew, schema, opts), func() (*hclext.BodyContent, error) {
+		return r.Runner.GetNewDataSourceContent(dataSourceType, schema, opts)
+	})
+}
+
+// GetModuleDiff pairs the cached OLD and NEW module content.
+func (r *cachingRunner) GetModuleDiff(schema *hclext.BodySchema, opts *GetModuleContentOption) (*ModuleDiff, error) {
+	return GetModuleDiff(r, schema, opts)
+}
+
+// WalkOldResources walks the cached OLD resources.
+func (r *cachingRunner) WalkOldResources(schema *hclext.BodySchema, fn WalkResourcesFunc) error {
+	return WalkOldResources(r, schema, fn)
+}
+
+// WalkNewResources walks the cached NEW resources.
+func (r *cachingRunner) WalkNewResources(schema *hclext.BodySchema, fn WalkResourcesFunc) error {
+	return WalkNewResources(r, schema, fn)
+}
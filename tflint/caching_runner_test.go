@@ -0,0 +1,175 @@
+package tflint
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/jokarl/tfbreak-plugin-sdk/hclext"
+)
+
+// countingRunner returns new content on every content call and counts the
+// calls.
+type countingRunner struct {
+	Runner
+	calls int
+	err   error
+}
+
+func (r *countingRunner) get() (*hclext.BodyContent, error) {
+	r.calls++
+	if r.err != nil {
+		return nil, r.err
+	}
+	return &hclext.BodyContent{}, nil
+}
+
+func (r *countingRunner) GetOldModuleContent(*hclext.BodySchema, *GetModuleContentOption) (*hclext.BodyContent, error) {
+	return r.get()
+}
+
+func (r *countingRunner) GetNewModuleContent(*hclext.BodySchema, *GetModuleContentOption) (*hclext.BodyContent, error) {
+	return r.get()
+}
+
+func (r *countingRunner) GetOldResourceContent(string, *hclext.BodySchema, *GetModuleContentOption) (*hclext.BodyContent, error) {
+	return r.get()
+}
+
+func (r *countingRunner) GetNewResourceContent(string, *hclext.BodySchema, *GetModuleContentOption) (*hclext.BodyContent, error) {
+	return r.get()
+}
+
+func (r *countingRunner) GetResourceContentPair(string, *hclext.BodySchema, *GetModuleContentOption) (*hclext.BodyContent, *hclext.BodyContent, error) {
+	oldContent, _ := r.get()
+	newContent, err := r.get()
+	return oldContent, newContent, err
+}
+
+func TestCachingRunner_ReturnsCachedContent(t *testing.T) {
+	inner := &countingRunner{}
+	runner := NewCachingRunner(inner)
+	schema := &hclext.BodySchema{Attributes: []hclext.AttributeSchema{{Name: "location"}}}
+
+	first, err := runner.GetOldModuleContent(schema, nil)
+	if err != nil {
+		t.Fatalf("GetOldModuleContent error: %v", err)
+	}
+	// An equal schema and empty options are the same request
+	second, err := runner.GetOldModuleContent(
+		&hclext.BodySchema{Attributes: []hclext.AttributeSchema{{Name: "location"}}},
+		&GetModuleContentOption{},
+	)
+	if err != nil {
+		t.Fatalf("GetOldModuleContent error: %v", err)
+	}
+
+	if first != second {
+		t.Error("second call returned a different instance, want the cached one")
+	}
+	if inner.calls != 1 {
+		t.Errorf("inner runner called %d times, want 1", inner.calls)
+	}
+}
+
+func TestCachingRunner_DifferentRequestsMiss(t *testing.T) {
+	inner := &countingRunner{}
+	runner := NewCachingRunner(inner)
+	location := &hclext.BodySchema{Attributes: []hclext.AttributeSchema{{Name: "location"}}}
+	name := &hclext.BodySchema{Attributes: []hclext.AttributeSchema{{Name: "name"}}}
+
+	calls := []func() (*hclext.BodyContent, error){
+		func() (*hclext.BodyContent, error) { return runner.GetOldModuleContent(location, nil) },
+		func() (*hclext.BodyContent, error) { return runner.GetOldModuleContent(name, nil) },
+		func() (*hclext.BodyContent, error) { return runner.GetNewModuleContent(location, nil) },
+		func() (*hclext.BodyContent, error) {
+			return runner.GetOldModuleContent(location, &GetModuleContentOption{ModuleCtx: ModuleCtxAll})
+		},
+		func() (*hclext.BodyContent, error) {
+			return runner.GetOldResourceContent("azurerm_storage_account", location, nil)
+		},
+		func() (*hclext.BodyContent, error) {
+			return runner.GetOldResourceContent("azurerm_key_vault", location, nil)
+		},
+	}
+
+	seen := make(map[*hclext.BodyContent]bool)
+	for i, call := range calls {
+		content, err := call()
+		if err != nil {
+			t.Fatalf("call %d: %v", i, err)
+		}
+		if seen[content] {
+			t.Errorf("call %d returned cached content of an earlier request", i)
+		}
+		seen[content] = true
+	}
+	if inner.calls != len(calls) {
+		t.Errorf("inner runner called %d times, want %d", inner.calls, len(calls))
+	}
+}
+
+func TestCachingRunner_ResourceContentPair(t *testing.T) {
+	inner := &countingRunner{}
+	runner := NewCachingRunner(inner)
+	schema := &hclext.BodySchema{Attributes: []hclext.AttributeSchema{{Name: "location"}}}
+
+	oldContent, newContent, err := runner.GetResourceContentPair("azurerm_storage_account", schema, nil)
+	if err != nil {
+		t.Fatalf("GetResourceContentPair error: %v", err)
+	}
+
+	// The pair fills the entries of the single-sided methods, and the other
+	// way around
+	cachedOld, _ := runner.GetOldResourceContent("azurerm_storage_account", schema, nil)
+	cachedNew, _ := runner.GetNewResourceContent("azurerm_storage_account", schema, nil)
+	if cachedOld != oldContent || cachedNew != newContent {
+		t.Error("single-sided calls did not return the content of the pair")
+	}
+	againOld, againNew, _ := runner.GetResourceContentPair("azurerm_storage_account", schema, nil)
+	if againOld != oldContent || againNew != newContent {
+		t.Error("second pair call did not return the cached content")
+	}
+	if inner.calls != 2 {
+		t.Errorf("inner runner called %d times, want 2", inner.calls)
+	}
+}
+
+func TestCachingRunner_ErrorsAreNotCached(t *testing.T) {
+	inner := &countingRunner{err: errors.New("host unavailable")}
+	runner := NewCachingRunner(inner)
+
+	if _, err := runner.GetOldModuleContent(nil, nil); err == nil {
+		t.Fatal("expected an error")
+	}
+	inner.err = nil
+	if _, err := runner.GetOldModuleContent(nil, nil); err != nil {
+		t.Fatalf("GetOldModuleContent error after recovery: %v", err)
+	}
+	if inner.calls != 2 {
+		t.Errorf("inner runner called %d times, want 2", inner.calls)
+	}
+}
+
+func TestCachingRunner_ComposedMethodsUseCache(t *testing.T) {
+	inner := &countingRunner{}
+	runner := NewCachingRunner(inner)
+	schema := &hclext.BodySchema{}
+
+	if _, err := runner.GetModuleDiff(schema, nil); err != nil {
+		t.Fatalf("GetModuleDiff error: %v", err)
+	}
+	if _, err := runner.GetModuleDiff(schema, nil); err != nil {
+		t.Fatalf("GetModuleDiff error: %v", err)
+	}
+	for range 2 {
+		walk := func(string, *hclext.Block) error { return nil }
+		if err := runner.WalkOldResources(schema, walk); err != nil {
+			t.Fatalf("WalkOldResources error: %v", err)
+		}
+	}
+
+	// One OLD and one NEW module content call for the diff, one for the walk
+	if inner.calls != 3 {
+		t.Errorf("inner runner called %d times, want 3", inner.calls)
+	}
+}
@@ -0,0 +1,40 @@
+package tflint
+
+import (
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/jokarl/tfbreak-plugin-sdk/hclext"
+)
+
+// CompareAttributes compares the attribute named attrName in the OLD and NEW
+// content, which is the core check of ForceNew rules.
+//
+// The attribute is changed if it is present on only one side (added or
+// removed), or if its values differ as determined by hclext.AttributesEqual.
+// oldVal and newVal are the decoded values; cty.NilVal is returned for a
+// side where the attribute is absent or its value cannot be determined.
+// Nil content is treated as empty.
+//
+// Example:
+//
+//	changed, oldVal, newVal := tflint.CompareAttributes(change.Old.Body, change.New.Body, "location")
+//	if changed {
+//	    runner.EmitIssue(r, fmt.Sprintf("location changed from %s to %s, forcing replacement",
+//	        oldVal.GoString(), newVal.GoString()), change.New.DefRange)
+//	}
+func CompareAttributes(oldContent, newContent *hclext.BodyContent, attrName string) (changed bool, oldVal, newVal cty.Value) {
+	oldAttr := contentAttribute(oldContent, attrName)
+	newAttr := contentAttribute(newContent, attrName)
+
+	oldVal, _ = hclext.AttributeValue(oldAttr)
+	newVal, _ = hclext.AttributeValue(newAttr)
+	return !hclext.AttributesEqual(oldAttr, newAttr), oldVal, newVal
+}
+
+// contentAttribute returns the named attribute of content, or nil.
+func contentAttribute(content *hclext.BodyContent, name string) *hclext.Attribute {
+	if content == nil {
+		return nil
+	}
+	return content.Attributes[name]
+}
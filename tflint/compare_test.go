@@ -0,0 +1,52 @@
+package tflint
+
+import (
+	"testing"
+
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/jokarl/tfbreak-plugin-sdk/hclext"
+)
+
+func TestCompareAttributes(t *testing.T) {
+	content := func(location string) *hclext.BodyContent {
+		return &hclext.BodyContent{
+			Attributes: map[string]*hclext.Attribute{
+				"location": {Name: "location", Value: cty.StringVal(location)},
+			},
+		}
+	}
+	empty := &hclext.BodyContent{Attributes: map[string]*hclext.Attribute{}}
+
+	tests := []struct {
+		name        string
+		old         *hclext.BodyContent
+		new         *hclext.BodyContent
+		wantChanged bool
+		wantOld     cty.Value
+		wantNew     cty.Value
+	}{
+		{"changed", content("westus"), content("eastus"), true, cty.StringVal("westus"), cty.StringVal("eastus")},
+		{"unchanged", content("westus"), content("westus"), false, cty.StringVal("westus"), cty.StringVal("westus")},
+		{"added", empty, content("eastus"), true, cty.NilVal, cty.StringVal("eastus")},
+		{"removed", content("westus"), empty, true, cty.StringVal("westus"), cty.NilVal},
+		{"absent on both sides", empty, empty, false, cty.NilVal, cty.NilVal},
+		{"nil old content", nil, content("eastus"), true, cty.NilVal, cty.StringVal("eastus")},
+		{"nil content", nil, nil, false, cty.NilVal, cty.NilVal},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			changed, oldVal, newVal := CompareAttributes(tt.old, tt.new, "location")
+			if changed != tt.wantChanged {
+				t.Errorf("changed = %v, want %v", changed, tt.wantChanged)
+			}
+			if !oldVal.RawEquals(tt.wantOld) {
+				t.Errorf("oldVal = %#v, want %#v", oldVal, tt.wantOld)
+			}
+			if !newVal.RawEquals(tt.wantNew) {
+				t.Errorf("newVal = %#v, want %#v", newVal, tt.wantNew)
+			}
+		})
+	}
+}
@@ -0,0 +1,214 @@
+package tflint
+
+import (
+	"errors"
+	"slices"
+	"strings"
+
+	"github.com/jokarl/tfbreak-plugin-sdk/hclext"
+)
+
+// CompositeRuleSet serves several rulesets as one, so a single plugin binary
+// can ship, for example, both azurerm and azuread rules.
+//
+// The embedded BuiltinRuleSet holds the union of the members' rules, so rule
+// names must be unique across members: when several members declare a rule
+// with the same name, only the first one is kept (see Duplicates). Global
+// configuration is applied to the union and to every member. Plugin-specific
+// configuration is split so that each member's ApplyConfig receives only the
+// attributes and blocks its own ConfigSchema declares.
+//
+// Members must provide a BuiltinImpl; members without one contribute no
+// rules. The runner wrappers returned by the members' NewRunner are chained
+// in member order and apply to the rules of every member.
+//
+// Example:
+//
+//	plugin.Serve(&plugin.ServeOpts{
+//	    RuleSet: tflint.NewCompositeRuleSet(azurermRuleSet, azureadRuleSet),
+//	})
+type CompositeRuleSet struct {
+	BuiltinRuleSet
+
+	// RuleSets are the member rulesets, in order.
+	RuleSets []RuleSet
+
+	duplicates []string
+}
+
+// NewCompositeRuleSet returns a ruleset combining rulesets. Its name joins
+// the member names with "+" (e.g., "azurerm+azuread"), as does its version
+// unless all members share one. Set Name and Version to override them.
+func NewCompositeRuleSet(rulesets ...RuleSet) *CompositeRuleSet {
+	c := &CompositeRuleSet{RuleSets: rulesets}
+
+	var names, versions, constraints []string
+	seen := make(map[string]bool)
+	for _, rs := range rulesets {
+		names = append(names, rs.RuleSetName())
+		if version := rs.RuleSetVersion(); !slices.Contains(versions, version) {
+			versions = append(versions, version)
+		}
+		if constraint := rs.VersionConstraint(); constraint != "" && !slices.Contains(constraints, constraint) {
+			constraints = append(constraints, constraint)
+		}
+
+		builtin := rs.BuiltinImpl()
+		if builtin == nil {
+			continue
+		}
+		for _, rule := range builtin.Rules {
+			if seen[rule.Name()] {
+				if !slices.Contains(c.duplicates, rule.Name()) {
+					c.duplicates = append(c.duplicates, rule.Name())
+				}
+				continue
+			}
+			seen[rule.Name()] = true
+			c.Rules = append(c.Rules, rule)
+		}
+	}
+
+	c.Name = strings.Join(names, "+")
+	c.Version = strings.Join(versions, "+")
+	// Comma-separated constraints must all be satisfied
+	c.Constraint = strings.Join(constraints, ", ")
+	return c
+}
+
+// Duplicates returns the names of rules declared by more than one member,
+// in the order first seen. Only the first member's rule is used.
+func (c *CompositeRuleSet) Duplicates() []string {
+	return c.duplicates
+}
+
+// Validate validates every member, reporting empty rule names and rule
+// names declared more than once by the same member. Rules declared by
+// several members are not an error; see Duplicates.
+func (c *CompositeRuleSet) Validate() error {
+	var errs []error
+	for _, rs := range c.RuleSets {
+		if v, ok := rs.(interface{ Validate() error }); ok {
+			errs = append(errs, v.Validate())
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// Close closes the members implementing Closer, in reverse order, so a
+// member can rely on the members declared before it during its own Close.
+// Every member is closed even if an earlier Close fails; errors are joined.
+func (c *CompositeRuleSet) Close() error {
+	var errs []error
+	for i := len(c.RuleSets) - 1; i >= 0; i-- {
+		if closer, ok := c.RuleSets[i].(Closer); ok {
+			errs = append(errs, closer.Close())
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// ConfigSchema returns the union of the members' config schemas. An
+// attribute or block type declared by several members is included once.
+// The union is in exact mode only if every member's schema is, so a lenient
+// member still accepts the keys of the others. It returns nil if no member
+// has a config schema.
+func (c *CompositeRuleSet) ConfigSchema() *hclext.BodySchema {
+	var merged *hclext.BodySchema
+	for _, rs := range c.RuleSets {
+		schema := rs.ConfigSchema()
+		if schema == nil {
+			continue
+		}
+		if merged == nil {
+			merged = &hclext.BodySchema{Mode: schema.Mode}
+		}
+		switch {
+		case schema.Mode == hclext.SchemaJustAttributesMode:
+			merged.Mode = hclext.SchemaJustAttributesMode
+		case schema.Mode != hclext.SchemaExactMode && merged.Mode == hclext.SchemaExactMode:
+			merged.Mode = hclext.SchemaDefaultMode
+		}
+		for _, attrS := range schema.Attributes {
+			if !slices.ContainsFunc(merged.Attributes, func(a hclext.AttributeSchema) bool { return a.Name == attrS.Name }) {
+				merged.Attributes = append(merged.Attributes, attrS)
+			}
+		}
+		for _, blockS := range schema.Blocks {
+			if !slices.ContainsFunc(merged.Blocks, func(b hclext.BlockSchema) bool { return b.Type == blockS.Type }) {
+				merged.Blocks = append(merged.Blocks, blockS)
+			}
+		}
+	}
+	// A JustAttributes body cannot contain blocks
+	if merged != nil && merged.Mode == hclext.SchemaJustAttributesMode {
+		merged.Blocks = nil
+	}
+	return merged
+}
+
+// ApplyGlobalConfig applies the configuration to the combined rules and to
+// every member. Errors from all members are joined.
+func (c *CompositeRuleSet) ApplyGlobalConfig(config *Config) error {
+	errs := []error{c.BuiltinRuleSet.ApplyGlobalConfig(config)}
+	for _, rs := range c.RuleSets {
+		errs = append(errs, rs.ApplyGlobalConfig(config))
+	}
+	return errors.Join(errs...)
+}
+
+// ResetConfig discards the global configuration of the composite and of
+// every member.
+func (c *CompositeRuleSet) ResetConfig() {
+	c.BuiltinRuleSet.ResetConfig()
+	for _, rs := range c.RuleSets {
+		if impl := rs.BuiltinImpl(); impl != nil {
+			impl.ResetConfig()
+		}
+	}
+}
+
+// ApplyConfig passes each member the part of content matching its
+// ConfigSchema. Members without a schema receive empty content.
+// Errors from all members are joined.
+func (c *CompositeRuleSet) ApplyConfig(content *hclext.BodyContent) error {
+	var errs []error
+	for _, rs := range c.RuleSets {
+		errs = append(errs, rs.ApplyConfig(filterContent(content, rs.ConfigSchema())))
+	}
+	return errors.Join(errs...)
+}
+
+// NewRunner wraps runner with the NewRunner of every member, in order.
+func (c *CompositeRuleSet) NewRunner(runner Runner) (Runner, error) {
+	for _, rs := range c.RuleSets {
+		wrapped, err := rs.NewRunner(runner)
+		if err != nil {
+			return nil, err
+		}
+		runner = wrapped
+	}
+	return runner, nil
+}
+
+// filterContent returns the attributes and blocks of content declared by
+// schema. Every attribute is kept for a schema in JustAttributes mode.
+func filterContent(content *hclext.BodyContent, schema *hclext.BodySchema) *hclext.BodyContent {
+	result := &hclext.BodyContent{Attributes: map[string]*hclext.Attribute{}}
+	if content == nil || schema == nil {
+		return result
+	}
+
+	for name, attr := range content.Attributes {
+		if schema.Mode == hclext.SchemaJustAttributesMode ||
+			slices.ContainsFunc(schema.Attributes, func(a hclext.AttributeSchema) bool { return a.Name == name }) {
+			result.Attributes[name] = attr
+		}
+	}
+	for _, block := range content.Blocks {
+		if slices.ContainsFunc(schema.Blocks, func(b hclext.BlockSchema) bool { return b.Type == block.Type }) {
+			result.Blocks = append(result.Blocks, block)
+		}
+	}
+	return result
+}
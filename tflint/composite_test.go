@@ -0,0 +1,216 @@
+package tflint
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/jokarl/tfbreak-plugin-sdk/hclext"
+)
+
+// configRuleSet is a ruleset with a fixed config schema that records the
+// content passed to ApplyConfig.
+type configRuleSet struct {
+	BuiltinRuleSet
+	schema  *hclext.BodySchema
+	applied *hclext.BodyContent
+	err     error
+}
+
+func (rs *configRuleSet) ConfigSchema() *hclext.BodySchema { return rs.schema }
+
+func (rs *configRuleSet) ApplyConfig(content *hclext.BodyContent) error {
+	rs.applied = content
+	return rs.err
+}
+
+func TestNewCompositeRuleSet(t *testing.T) {
+	azurerm := &BuiltinRuleSet{
+		Name: "azurerm", Version: "0.1.0", Constraint: ">= 0.1.0",
+		Rules: []Rule{newTestRule("azurerm_location", true), newTestRule("shared_rule", true)},
+	}
+	azuread := &BuiltinRuleSet{
+		Name: "azuread", Version: "0.2.0", Constraint: ">= 0.2.0",
+		Rules: []Rule{newTestRule("azuread_app", false), newTestRule("shared_rule", false)},
+	}
+
+	c := NewCompositeRuleSet(azurerm, azuread)
+
+	if got, want := c.RuleNames(), []string{"azurerm_location", "shared_rule", "azuread_app"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("RuleNames() = %v, want %v", got, want)
+	}
+	if got := c.Duplicates(); !reflect.DeepEqual(got, []string{"shared_rule"}) {
+		t.Errorf("Duplicates() = %v, want [shared_rule]", got)
+	}
+	if !c.GetRule("shared_rule").Enabled() {
+		t.Error("the first declaration of shared_rule should be kept")
+	}
+	if c.RuleSetName() != "azurerm+azuread" || c.RuleSetVersion() != "0.1.0+0.2.0" {
+		t.Errorf("name and version = %q %q, want azurerm+azuread 0.1.0+0.2.0", c.RuleSetName(), c.RuleSetVersion())
+	}
+	if got := c.VersionConstraint(); got != ">= 0.1.0, >= 0.2.0" {
+		t.Errorf("VersionConstraint() = %q", got)
+	}
+	if c.BuiltinImpl() != &c.BuiltinRuleSet {
+		t.Error("BuiltinImpl() should return the combined rules")
+	}
+
+	same := NewCompositeRuleSet(&BuiltinRuleSet{Name: "a", Version: "1.0.0"}, &BuiltinRuleSet{Name: "b", Version: "1.0.0"})
+	if same.RuleSetVersion() != "1.0.0" {
+		t.Errorf("shared version = %q, want 1.0.0", same.RuleSetVersion())
+	}
+}
+
+func TestCompositeRuleSet_Validate(t *testing.T) {
+	azurerm := &BuiltinRuleSet{Name: "azurerm", Rules: []Rule{newTestRule("shared_rule", true)}}
+	azuread := &BuiltinRuleSet{Name: "azuread", Rules: []Rule{newTestRule("shared_rule", true)}}
+	if err := NewCompositeRuleSet(azurerm, azuread).Validate(); err != nil {
+		t.Errorf("Validate() error = %v, rules shared across members should only be reported by Duplicates", err)
+	}
+
+	broken := &BuiltinRuleSet{Name: "broken", Rules: []Rule{newTestRule("rule", true), newTestRule("rule", true)}}
+	if err := NewCompositeRuleSet(azurerm, broken).Validate(); err == nil {
+		t.Error("Validate() error = nil, want error for a member declaring a rule twice")
+	}
+}
+
+// closingRuleSet records the order in which rulesets are closed.
+type closingRuleSet struct {
+	BuiltinRuleSet
+	closed *[]string
+	err    error
+}
+
+func (rs *closingRuleSet) Close() error {
+	*rs.closed = append(*rs.closed, rs.Name)
+	return rs.err
+}
+
+func TestCompositeRuleSet_Close(t *testing.T) {
+	var closed []string
+	azurerm := &closingRuleSet{BuiltinRuleSet: BuiltinRuleSet{Name: "azurerm"}, closed: &closed, err: errors.New("azurerm failed")}
+	plain := &BuiltinRuleSet{Name: "plain"}
+	azuread := &closingRuleSet{BuiltinRuleSet: BuiltinRuleSet{Name: "azuread"}, closed: &closed, err: errors.New("azuread failed")}
+
+	err := NewCompositeRuleSet(azurerm, plain, azuread).Close()
+	if !reflect.DeepEqual(closed, []string{"azuread", "azurerm"}) {
+		t.Errorf("closed = %v, want members closed in reverse order", closed)
+	}
+	if err == nil || err.Error() != "azuread failed\nazurerm failed" {
+		t.Errorf("Close() error = %v, want both members' errors", err)
+	}
+}
+
+func TestCompositeRuleSet_ApplyGlobalConfig(t *testing.T) {
+	azurerm := &BuiltinRuleSet{Name: "azurerm", Rules: []Rule{newTestRule("azurerm_location", true)}}
+	azuread := &BuiltinRuleSet{Name: "azuread", Rules: []Rule{newTestRule("azuread_app", true)}}
+	c := NewCompositeRuleSet(azurerm, azuread)
+
+	if err := c.ApplyGlobalConfig(&Config{Only: []string{"azuread_app"}}); err != nil {
+		t.Fatalf("ApplyGlobalConfig error: %v", err)
+	}
+
+	var enabled []string
+	for _, rule := range c.EnabledRules() {
+		enabled = append(enabled, rule.Name())
+	}
+	if !reflect.DeepEqual(enabled, []string{"azuread_app"}) {
+		t.Errorf("EnabledRules() = %v, want [azuread_app]", enabled)
+	}
+	if azurerm.IsRuleEnabled("azurerm_location") {
+		t.Error("global config should also be applied to the members")
+	}
+}
+
+func TestCompositeRuleSet_ResetConfig(t *testing.T) {
+	azurerm := &BuiltinRuleSet{Name: "azurerm", Rules: []Rule{newTestRule("azurerm_location", true)}}
+	c := NewCompositeRuleSet(azurerm)
+	if err := c.ApplyGlobalConfig(&Config{DisabledByDefault: true}); err != nil {
+		t.Fatalf("ApplyGlobalConfig error: %v", err)
+	}
+
+	c.ResetConfig()
+	if !c.IsRuleEnabled("azurerm_location") || !azurerm.IsRuleEnabled("azurerm_location") {
+		t.Error("azurerm_location should be enabled again in the composite and its member")
+	}
+}
+
+func TestCompositeRuleSet_Config(t *testing.T) {
+	azurerm := &configRuleSet{
+		BuiltinRuleSet: BuiltinRuleSet{Name: "azurerm"},
+		schema: &hclext.BodySchema{
+			Attributes: []hclext.AttributeSchema{{Name: "subscription_id"}, {Name: "environment"}},
+			Blocks:     []hclext.BlockSchema{{Type: "features", Body: &hclext.BodySchema{}}},
+		},
+	}
+	azuread := &configRuleSet{
+		BuiltinRuleSet: BuiltinRuleSet{Name: "azuread"},
+		schema: &hclext.BodySchema{
+			Attributes: []hclext.AttributeSchema{{Name: "tenant_id"}, {Name: "environment"}},
+		},
+	}
+	plain := &BuiltinRuleSet{Name: "plain"}
+	c := NewCompositeRuleSet(azurerm, plain, azuread)
+
+	want := &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{{Name: "subscription_id"}, {Name: "environment"}, {Name: "tenant_id"}},
+		Blocks:     []hclext.BlockSchema{{Type: "features", Body: &hclext.BodySchema{}}},
+	}
+	if got := c.ConfigSchema(); !reflect.DeepEqual(got, want) {
+		t.Errorf("ConfigSchema() = %+v, want %+v", got, want)
+	}
+
+	content := &hclext.BodyContent{
+		Attributes: map[string]*hclext.Attribute{
+			"subscription_id": {Name: "subscription_id"},
+			"tenant_id":       {Name: "tenant_id"},
+			"environment":     {Name: "environment"},
+		},
+		Blocks: []*hclext.Block{{Type: "features"}},
+	}
+	if err := c.ApplyConfig(content); err != nil {
+		t.Fatalf("ApplyConfig error: %v", err)
+	}
+	if got := azurerm.applied; len(got.Attributes) != 2 || got.Attributes["tenant_id"] != nil || len(got.Blocks) != 1 {
+		t.Errorf("azurerm received %+v, want subscription_id, environment, and features", got)
+	}
+	if got := azuread.applied; len(got.Attributes) != 2 || got.Attributes["subscription_id"] != nil || len(got.Blocks) != 0 {
+		t.Errorf("azuread received %+v, want tenant_id and environment", got)
+	}
+
+	azuread.err = errors.New("invalid tenant_id")
+	if err := c.ApplyConfig(content); err == nil || err.Error() != "invalid tenant_id" {
+		t.Errorf("ApplyConfig error = %v, want the member's error", err)
+	}
+
+	if NewCompositeRuleSet(plain).ConfigSchema() != nil {
+		t.Error("ConfigSchema() should be nil when no member has a schema")
+	}
+}
+
+func TestCompositeRuleSet_ConfigSchemaExactMode(t *testing.T) {
+	exact := func(name string) *configRuleSet {
+		return &configRuleSet{
+			BuiltinRuleSet: BuiltinRuleSet{Name: name},
+			schema: &hclext.BodySchema{
+				Attributes: []hclext.AttributeSchema{{Name: name + "_id"}},
+				Mode:       hclext.SchemaExactMode,
+			},
+		}
+	}
+	lenient := &configRuleSet{
+		BuiltinRuleSet: BuiltinRuleSet{Name: "lenient"},
+		schema:         &hclext.BodySchema{Attributes: []hclext.AttributeSchema{{Name: "lenient_id"}}},
+	}
+
+	if got := NewCompositeRuleSet(exact("azurerm"), exact("azuread")).ConfigSchema().Mode; got != hclext.SchemaExactMode {
+		t.Errorf("all exact: Mode = %v, want SchemaExactMode", got)
+	}
+	// A lenient member must still accept keys the exact members do not declare
+	if got := NewCompositeRuleSet(exact("azurerm"), lenient).ConfigSchema().Mode; got != hclext.SchemaDefaultMode {
+		t.Errorf("exact and lenient: Mode = %v, want SchemaDefaultMode", got)
+	}
+	if got := NewCompositeRuleSet(lenient, exact("azurerm")).ConfigSchema().Mode; got != hclext.SchemaDefaultMode {
+		t.Errorf("lenient and exact: Mode = %v, want SchemaDefaultMode", got)
+	}
+}
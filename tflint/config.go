@@ -1,6 +1,9 @@
 package tflint
 
-import "github.com/hashicorp/hcl/v2"
+import (
+	"github.com/hashicorp/hcl/v2"
+	"github.com/zclconf/go-cty/cty"
+)
 
 // Config represents global tfbreak configuration passed to plugins.
 // This configuration is used to enable/disable rules and provide
@@ -12,10 +15,25 @@ type Config struct {
 	// When true, rules must be explicitly enabled.
 	DisabledByDefault bool
 	// Only enables only these rules if set.
-	// Takes precedence over individual rule configurations.
+	// Individual rule configurations and Ignore take precedence over it.
+	// Entries may be glob or regular expression patterns; see MatchRuleName.
 	Only []string
+	// Ignore disables the rules matching any of these patterns, whatever
+	// Only and the individual rule configurations say. Entries may be rule
+	// names, glob or regular expression patterns; see MatchRuleName.
+	Ignore []string
 	// PluginDir is the directory where plugins are installed.
 	PluginDir string
+	// MinSeverity disables rules less severe than this level if set.
+	// Since ERROR < WARNING < NOTICE numerically, a rule is disabled when
+	// its severity value is greater than MinSeverity. For example,
+	// MinSeverity: WARNING keeps ERROR and WARNING rules but drops NOTICE rules.
+	// The zero value applies no filtering.
+	MinSeverity Severity
+	// Variables holds ad-hoc host-level settings keyed by name, such as a
+	// ruleset-wide severity_map. Unlike rule configs, these do not require
+	// a ConfigSchema and can be read directly in ApplyGlobalConfig.
+	Variables map[string]cty.Value
 }
 
 // RuleConfig represents configuration for a single rule.
@@ -24,6 +42,9 @@ type RuleConfig struct {
 	Name string
 	// Enabled indicates if the rule is enabled.
 	Enabled bool
+	// Severity overrides the rule's default severity if set.
+	// For example, operators can downgrade a rule from ERROR to WARNING.
+	Severity *Severity
 	// Body is the raw HCL body for rule-specific configuration.
 	// Rules can decode this using runner.DecodeRuleConfig().
 	Body hcl.Body
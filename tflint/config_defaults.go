@@ -0,0 +1,148 @@
+package tflint
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// defaultTagKey is the struct tag key that holds rule config defaults.
+const defaultTagKey = "tfbreak"
+
+// DecodeRuleConfigWithDefaults decodes the rule's configuration into target
+// after applying defaults declared with `tfbreak:"default=<value>"` struct tags.
+//
+// Merge semantics:
+//   - Defaults are applied only to fields that hold their zero value, so values
+//     set on target before the call are kept.
+//   - Configured keys then overwrite the field, even when set to a zero value
+//     (e.g., `max_count = 0` replaces a default of 10).
+//   - Keys absent from the configuration leave the default in place.
+//   - Nested struct fields are merged field by field; defaults in nested structs
+//     (and in non-nil pointers to structs) are applied recursively.
+//   - Configured slices replace the default slice; they are not appended.
+//
+// Slice defaults are written as comma-separated values, and time.Duration
+// fields accept any value understood by time.ParseDuration.
+//
+// Example:
+//
+//	type MyRuleConfig struct {
+//	    MaxCount int      `hcl:"max_count,optional" tfbreak:"default=10"`
+//	    Ignore   []string `hcl:"ignore,optional" tfbreak:"default=tags,labels"`
+//	}
+//	var config MyRuleConfig
+//	if err := tflint.DecodeRuleConfigWithDefaults(runner, r.Name(), &config); err != nil {
+//	    return err
+//	}
+func DecodeRuleConfigWithDefaults(runner Runner, ruleName string, target any) error {
+	if err := ApplyConfigDefaults(target); err != nil {
+		return err
+	}
+	return runner.DecodeRuleConfig(ruleName, target)
+}
+
+// ApplyConfigDefaults sets zero-valued fields of target, which must be a
+// pointer to a struct, to the defaults declared in their `tfbreak` struct tags.
+func ApplyConfigDefaults(target any) error {
+	v := reflect.ValueOf(target)
+	if v.Kind() != reflect.Pointer || v.IsNil() || v.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("config target must be a non-nil pointer to a struct, got %T", target)
+	}
+	return applyStructDefaults(v.Elem())
+}
+
+// applyStructDefaults applies tag defaults to the fields of a struct value.
+func applyStructDefaults(v reflect.Value) error {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		fieldV := v.Field(i)
+
+		// Recurse into nested structs
+		switch {
+		case fieldV.Kind() == reflect.Struct:
+			if err := applyStructDefaults(fieldV); err != nil {
+				return err
+			}
+			continue
+		case fieldV.Kind() == reflect.Pointer && !fieldV.IsNil() && fieldV.Elem().Kind() == reflect.Struct:
+			if err := applyStructDefaults(fieldV.Elem()); err != nil {
+				return err
+			}
+			continue
+		}
+
+		value, ok := defaultTagValue(field.Tag.Get(defaultTagKey))
+		if !ok || !fieldV.IsZero() {
+			continue
+		}
+		if err := setDefault(fieldV, value); err != nil {
+			return fmt.Errorf("invalid default for field %s: %w", field.Name, err)
+		}
+	}
+	return nil
+}
+
+// defaultTagValue extracts the value of "default=<value>" from a tag.
+func defaultTagValue(tag string) (string, bool) {
+	return strings.CutPrefix(tag, "default=")
+}
+
+// setDefault parses value into v according to v's type.
+func setDefault(v reflect.Value, value string) error {
+	if v.Type() == reflect.TypeOf(time.Duration(0)) {
+		d, err := time.ParseDuration(value)
+		if err != nil {
+			return err
+		}
+		v.SetInt(int64(d))
+		return nil
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString(value)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return err
+		}
+		v.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(value, 10, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n, err := strconv.ParseUint(value, 10, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetUint(n)
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(value, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetFloat(f)
+	case reflect.Slice:
+		parts := strings.Split(value, ",")
+		slice := reflect.MakeSlice(v.Type(), len(parts), len(parts))
+		for i, part := range parts {
+			if err := setDefault(slice.Index(i), strings.TrimSpace(part)); err != nil {
+				return err
+			}
+		}
+		v.Set(slice)
+	default:
+		return fmt.Errorf("unsupported type %s", v.Type())
+	}
+	return nil
+}
@@ -0,0 +1,150 @@
+package tflint
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+)
+
+// jsonConfigRunner decodes rule config from JSON, like the gRPC runner does.
+type jsonConfigRunner struct {
+	Runner
+	configs map[string]string
+}
+
+func (r *jsonConfigRunner) DecodeRuleConfig(ruleName string, target any) error {
+	config, ok := r.configs[ruleName]
+	if !ok {
+		return nil
+	}
+	return json.Unmarshal([]byte(config), target)
+}
+
+type defaultsLimits struct {
+	Max int `json:"max" tfbreak:"default=100"`
+	Min int `json:"min" tfbreak:"default=1"`
+}
+
+type defaultsConfig struct {
+	Count    int            `json:"count" tfbreak:"default=10"`
+	Name     string         `json:"name" tfbreak:"default=example"`
+	Enabled  bool           `json:"enabled" tfbreak:"default=true"`
+	Ratio    float64        `json:"ratio" tfbreak:"default=0.5"`
+	Timeout  time.Duration  `json:"timeout" tfbreak:"default=30s"`
+	Ignore   []string       `json:"ignore" tfbreak:"default=tags, labels"`
+	Limits   defaultsLimits `json:"limits"`
+	NoTag    string         `json:"no_tag"`
+	internal int            `tfbreak:"default=5"`
+}
+
+func TestApplyConfigDefaults(t *testing.T) {
+	var config defaultsConfig
+	if err := ApplyConfigDefaults(&config); err != nil {
+		t.Fatalf("ApplyConfigDefaults error: %v", err)
+	}
+
+	want := defaultsConfig{
+		Count:   10,
+		Name:    "example",
+		Enabled: true,
+		Ratio:   0.5,
+		Timeout: 30 * time.Second,
+		Ignore:  []string{"tags", "labels"},
+		Limits:  defaultsLimits{Max: 100, Min: 1},
+	}
+	if !reflect.DeepEqual(config, want) {
+		t.Errorf("config = %+v, want %+v", config, want)
+	}
+}
+
+func TestApplyConfigDefaults_KeepsPresetValues(t *testing.T) {
+	config := defaultsConfig{Count: 3, Limits: defaultsLimits{Max: 7}}
+	if err := ApplyConfigDefaults(&config); err != nil {
+		t.Fatalf("ApplyConfigDefaults error: %v", err)
+	}
+	if config.Count != 3 || config.Limits.Max != 7 {
+		t.Errorf("preset values overwritten: Count=%d, Limits.Max=%d", config.Count, config.Limits.Max)
+	}
+	if config.Limits.Min != 1 {
+		t.Errorf("Limits.Min = %d, want default 1", config.Limits.Min)
+	}
+}
+
+func TestApplyConfigDefaults_PointerToStruct(t *testing.T) {
+	type config struct {
+		Limits *defaultsLimits
+		Unset  *defaultsLimits
+	}
+	c := config{Limits: &defaultsLimits{}}
+	if err := ApplyConfigDefaults(&c); err != nil {
+		t.Fatalf("ApplyConfigDefaults error: %v", err)
+	}
+	if c.Limits.Max != 100 {
+		t.Errorf("Limits.Max = %d, want 100", c.Limits.Max)
+	}
+	if c.Unset != nil {
+		t.Error("nil pointer fields should stay nil")
+	}
+}
+
+func TestApplyConfigDefaults_Errors(t *testing.T) {
+	var config defaultsConfig
+	if err := ApplyConfigDefaults(config); err == nil {
+		t.Error("expected error for non-pointer target")
+	}
+
+	var invalid struct {
+		Count int `tfbreak:"default=ten"`
+	}
+	if err := ApplyConfigDefaults(&invalid); err == nil {
+		t.Error("expected error for unparsable default")
+	}
+
+	var unsupported struct {
+		Values map[string]string `tfbreak:"default=a"`
+	}
+	if err := ApplyConfigDefaults(&unsupported); err == nil {
+		t.Error("expected error for unsupported field type")
+	}
+}
+
+func TestDecodeRuleConfigWithDefaults(t *testing.T) {
+	runner := &jsonConfigRunner{configs: map[string]string{
+		"my_rule": `{"count": 0, "ignore": ["id"], "limits": {"max": 50}}`,
+	}}
+
+	var config defaultsConfig
+	if err := DecodeRuleConfigWithDefaults(runner, "my_rule", &config); err != nil {
+		t.Fatalf("DecodeRuleConfigWithDefaults error: %v", err)
+	}
+
+	// Present keys overwrite defaults, even with zero values
+	if config.Count != 0 {
+		t.Errorf("Count = %d, want 0 from config", config.Count)
+	}
+	// Configured slices replace the default
+	if !reflect.DeepEqual(config.Ignore, []string{"id"}) {
+		t.Errorf("Ignore = %v, want [id]", config.Ignore)
+	}
+	// Nested structs merge field by field
+	if config.Limits.Max != 50 || config.Limits.Min != 1 {
+		t.Errorf("Limits = %+v, want {Max:50 Min:1}", config.Limits)
+	}
+	// Absent keys keep their defaults
+	if config.Name != "example" || config.Timeout != 30*time.Second {
+		t.Errorf("Name = %q, Timeout = %v, want defaults", config.Name, config.Timeout)
+	}
+}
+
+func TestDecodeRuleConfigWithDefaults_NoConfig(t *testing.T) {
+	runner := &jsonConfigRunner{}
+
+	var config defaultsConfig
+	if err := DecodeRuleConfigWithDefaults(runner, "my_rule", &config); err != nil {
+		t.Fatalf("DecodeRuleConfigWithDefaults error: %v", err)
+	}
+	if config.Count != 10 || config.Limits.Max != 100 {
+		t.Errorf("config = %+v, want defaults", config)
+	}
+}
@@ -0,0 +1,83 @@
+package tflint
+
+import (
+	"fmt"
+	"os"
+	"reflect"
+	"strings"
+)
+
+// RuleConfigEnvPrefix is the prefix of environment variables that override
+// rule configuration. See ApplyConfigEnv.
+const RuleConfigEnvPrefix = "TFBREAK_RULE_"
+
+// DecodeRuleConfigWithEnv decodes the rule's configuration into target, then
+// overrides fields with environment variables as described in ApplyConfigEnv.
+// This lets operators adjust settings in CI without editing configuration files.
+//
+// Example:
+//
+//	type MyRuleConfig struct {
+//	    Threshold int `hcl:"threshold,optional"`
+//	}
+//	var config MyRuleConfig
+//	// TFBREAK_RULE_my_rule_THRESHOLD=5 overrides `threshold = 10`
+//	if err := tflint.DecodeRuleConfigWithEnv(runner, r.Name(), &config); err != nil {
+//	    return err
+//	}
+func DecodeRuleConfigWithEnv(runner Runner, ruleName string, target any) error {
+	if err := runner.DecodeRuleConfig(ruleName, target); err != nil {
+		return err
+	}
+	return ApplyConfigEnv(ruleName, target)
+}
+
+// ApplyConfigEnv overrides fields of target, which must be a pointer to a
+// struct, with environment variables named
+//
+//	TFBREAK_RULE_<rule name>_<FIELD>
+//
+// where <rule name> is used as-is and <FIELD> is the upper-cased name of the
+// field's hcl tag, falling back to its json tag and then the Go field name.
+// For example, a field tagged `hcl:"threshold"` in the configuration of the
+// rule "azurerm_force_new" is set by TFBREAK_RULE_azurerm_force_new_THRESHOLD.
+//
+// Only top-level fields are considered. Values are parsed like `tfbreak`
+// default tags: strings, bools, numbers, durations, and comma-separated slices.
+// Fields without a matching variable are left untouched.
+func ApplyConfigEnv(ruleName string, target any) error {
+	v := reflect.ValueOf(target)
+	if v.Kind() != reflect.Pointer || v.IsNil() || v.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("config target must be a non-nil pointer to a struct, got %T", target)
+	}
+
+	v = v.Elem()
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+
+		name := RuleConfigEnvPrefix + ruleName + "_" + strings.ToUpper(configFieldName(field))
+		value, ok := os.LookupEnv(name)
+		if !ok {
+			continue
+		}
+		if err := setDefault(v.Field(i), value); err != nil {
+			return fmt.Errorf("invalid value for %s: %w", name, err)
+		}
+	}
+	return nil
+}
+
+// configFieldName returns the configuration key of a struct field: its hcl
+// tag name, its json tag name, or the field name, in that order.
+func configFieldName(field reflect.StructField) string {
+	for _, key := range []string{"hcl", "json"} {
+		if name, _, _ := strings.Cut(field.Tag.Get(key), ","); name != "" && name != "-" {
+			return name
+		}
+	}
+	return field.Name
+}
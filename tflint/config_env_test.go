@@ -0,0 +1,68 @@
+package tflint
+
+import (
+	"reflect"
+	"testing"
+)
+
+type envConfig struct {
+	Threshold int      `hcl:"threshold,optional" json:"threshold"`
+	Mode      string   `json:"mode"`
+	Strict    bool     `hcl:"strict,optional"`
+	Ignore    []string `hcl:"ignore,optional" json:"ignore"`
+	Untagged  string
+}
+
+func TestDecodeRuleConfigWithEnv(t *testing.T) {
+	runner := &jsonConfigRunner{configs: map[string]string{
+		"my_rule": `{"threshold": 10, "mode": "warn", "ignore": ["tags"]}`,
+	}}
+	t.Setenv("TFBREAK_RULE_my_rule_THRESHOLD", "5")
+	t.Setenv("TFBREAK_RULE_my_rule_STRICT", "true")
+	t.Setenv("TFBREAK_RULE_my_rule_UNTAGGED", "from env")
+	t.Setenv("TFBREAK_RULE_other_rule_MODE", "error")
+
+	var config envConfig
+	if err := DecodeRuleConfigWithEnv(runner, "my_rule", &config); err != nil {
+		t.Fatalf("DecodeRuleConfigWithEnv error: %v", err)
+	}
+
+	want := envConfig{
+		Threshold: 5,      // overridden by env
+		Mode:      "warn", // env var for another rule is ignored
+		Strict:    true,   // set by env only
+		Ignore:    []string{"tags"},
+		Untagged:  "from env",
+	}
+	if !reflect.DeepEqual(config, want) {
+		t.Errorf("config = %+v, want %+v", config, want)
+	}
+}
+
+func TestDecodeRuleConfigWithEnv_NoEnv(t *testing.T) {
+	runner := &jsonConfigRunner{configs: map[string]string{
+		"my_rule": `{"threshold": 10, "mode": "warn"}`,
+	}}
+
+	var config envConfig
+	if err := DecodeRuleConfigWithEnv(runner, "my_rule", &config); err != nil {
+		t.Fatalf("DecodeRuleConfigWithEnv error: %v", err)
+	}
+
+	want := envConfig{Threshold: 10, Mode: "warn"}
+	if !reflect.DeepEqual(config, want) {
+		t.Errorf("config = %+v, want %+v", config, want)
+	}
+}
+
+func TestApplyConfigEnv_Errors(t *testing.T) {
+	var config envConfig
+	if err := ApplyConfigEnv("my_rule", config); err == nil {
+		t.Error("expected error for non-pointer target")
+	}
+
+	t.Setenv("TFBREAK_RULE_my_rule_THRESHOLD", "many")
+	if err := ApplyConfigEnv("my_rule", &config); err == nil {
+		t.Error("expected error for unparsable value")
+	}
+}
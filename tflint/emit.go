@@ -0,0 +1,42 @@
+package tflint
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/hcl/v2"
+)
+
+// IssueOptions holds the optional attributes of an issue reported with
+// Runner.EmitIssueWithOptions. Fields left at their zero value are not set,
+// so an empty IssueOptions reports the issue as EmitIssue does. Hosts ignore
+// the attributes they do not support.
+type IssueOptions struct {
+	// Severity replaces rule.Severity() for this issue only. A severity
+	// configured for the rule takes precedence.
+	Severity Severity
+	// Kind classifies the issue as breaking, warning or info, so the host
+	// can tally breaking changes separately from advisories.
+	Kind IssueKind
+	// ResourceAddress is the address of the resource the issue is about
+	// (e.g., "azurerm_storage_account.main"), so the host can group issues
+	// by resource. Hosts that do not support addresses may derive one from
+	// the range instead.
+	ResourceAddress string
+	// Side is the configuration the issue range refers to. Use SideOld for
+	// findings that only have a location in the OLD configuration, such as
+	// a removed resource. SideUnspecified refers to the NEW configuration.
+	Side Side
+	// Fix is a suggested remediation for the issue.
+	Fix *Fix
+}
+
+// EmitIssuef formats a message with fmt.Sprintf and emits it as an issue
+// through runner.EmitIssue.
+//
+// Example:
+//
+//	return tflint.EmitIssuef(runner, r, attr.Range,
+//	    "location changed from %q to %q", oldLocation, newLocation)
+func EmitIssuef(runner Runner, rule Rule, issueRange hcl.Range, format string, args ...any) error {
+	return runner.EmitIssue(rule, fmt.Sprintf(format, args...), issueRange)
+}
@@ -0,0 +1,24 @@
+package tflint
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+)
+
+func TestEmitIssuef(t *testing.T) {
+	rule := newTestRule("location_changed", true)
+	runner := &emitRecorder{}
+
+	err := EmitIssuef(runner, rule, hcl.Range{Filename: "main.tf"}, "location changed from %q to %q", "westeurope", "northeurope")
+	if err != nil {
+		t.Fatalf("EmitIssuef error: %v", err)
+	}
+
+	if len(runner.rules) != 1 || runner.rules[0] != rule {
+		t.Fatalf("rules = %v, want [location_changed]", runner.rules)
+	}
+	if want := `location changed from "westeurope" to "northeurope"`; runner.messages[0] != want {
+		t.Errorf("message = %q, want %q", runner.messages[0], want)
+	}
+}
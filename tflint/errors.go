@@ -0,0 +1,157 @@
+package tflint
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+)
+
+// ErrorCategory classifies why a rule failed, so the host can tell a rule
+// bug apart from a problem with the user's configuration.
+type ErrorCategory int
+
+const (
+	// ErrorCategoryInternal indicates the rule returned an unexpected error.
+	ErrorCategoryInternal ErrorCategory = iota + 1
+	// ErrorCategoryPanic indicates the rule panicked during Check.
+	ErrorCategoryPanic
+	// ErrorCategoryConfig indicates the rule could not run because of
+	// invalid user configuration.
+	ErrorCategoryConfig
+)
+
+// String returns the string representation of the category.
+func (c ErrorCategory) String() string {
+	switch c {
+	case ErrorCategoryInternal:
+		return "internal"
+	case ErrorCategoryPanic:
+		return "panic"
+	case ErrorCategoryConfig:
+		return "config"
+	default:
+		return "unknown"
+	}
+}
+
+// ErrInvalidConfig marks a rule error as caused by invalid user configuration.
+// Rules wrap it so the failure is reported with ErrorCategoryConfig:
+//
+//	return fmt.Errorf("%w: threshold must be positive", tflint.ErrInvalidConfig)
+var ErrInvalidConfig = errors.New("invalid config")
+
+// ErrNilRule is returned by the plugin's Runner when an issue is emitted
+// with a nil rule, since the host cannot attribute or suppress it.
+var ErrNilRule = errors.New("issue emitted with a nil rule")
+
+// ErrIndeterminateInstances is returned by ResourceInstanceDelta when count
+// or for_each cannot be evaluated statically, e.g. because it references a
+// variable, so the instances of a resource are not known.
+var ErrIndeterminateInstances = errors.New("resource instances are indeterminate")
+
+// RuleError is the error reported when a single rule fails.
+type RuleError struct {
+	// Rule is the name of the rule that failed.
+	Rule string
+	// Category classifies the failure.
+	Category ErrorCategory
+	// Err is the underlying error.
+	Err error
+}
+
+// NewRuleError returns a RuleError for err returned by the named rule.
+// The category is ErrorCategoryConfig if err wraps ErrInvalidConfig,
+// and ErrorCategoryInternal otherwise.
+func NewRuleError(rule string, err error) *RuleError {
+	category := ErrorCategoryInternal
+	if errors.Is(err, ErrInvalidConfig) {
+		category = ErrorCategoryConfig
+	}
+	return &RuleError{Rule: rule, Category: category, Err: err}
+}
+
+// Error returns the error message, prefixed with the rule name.
+func (e *RuleError) Error() string {
+	if e.Category == ErrorCategoryPanic {
+		return fmt.Sprintf("rule %s panicked: %v", e.Rule, e.Err)
+	}
+	return fmt.Sprintf("rule %s: %v", e.Rule, e.Err)
+}
+
+// Unwrap returns the underlying error.
+func (e *RuleError) Unwrap() error {
+	return e.Err
+}
+
+// MultiRuleError is the error returned from Check when one or more rules fail.
+// Individual failures can be extracted with errors.As:
+//
+//	var ruleErr *tflint.RuleError
+//	if errors.As(err, &ruleErr) {
+//	    fmt.Println(ruleErr.Rule, ruleErr.Category)
+//	}
+type MultiRuleError struct {
+	// Errors holds one entry per failed rule, in rule order.
+	Errors []*RuleError
+}
+
+// Error returns the message of the single failure, or a summary of all
+// failures if there are several.
+func (e *MultiRuleError) Error() string {
+	if len(e.Errors) == 1 {
+		return e.Errors[0].Error()
+	}
+
+	msgs := make([]string, len(e.Errors))
+	for i, err := range e.Errors {
+		msgs[i] = err.Error()
+	}
+	return fmt.Sprintf("%d rules failed: %s", len(e.Errors), strings.Join(msgs, "; "))
+}
+
+// Unwrap returns the individual rule errors.
+func (e *MultiRuleError) Unwrap() []error {
+	errs := make([]error, len(e.Errors))
+	for i, err := range e.Errors {
+		errs[i] = err
+	}
+	return errs
+}
+
+// DiagnosticsError is an error caused by HCL diagnostics, such as a
+// configuration that does not match the requested schema. It is how
+// diagnostics arrive after crossing the gRPC boundary in either direction,
+// so each keeps its severity and source ranges instead of being flattened
+// into a message.
+//
+// DiagnosticsError unwraps to the hcl.Diagnostics, which also matches
+// diagnostics returned directly as an error:
+//
+//	var diags hcl.Diagnostics
+//	if errors.As(err, &diags) {
+//	    for _, diag := range diags {
+//	        fmt.Println(diag.Subject, diag.Summary)
+//	    }
+//	}
+type DiagnosticsError struct {
+	// Message is the error message, which may add context to the
+	// diagnostics. If empty, the diagnostics are formatted instead.
+	Message string
+	// Diagnostics are the diagnostics that caused the error.
+	Diagnostics hcl.Diagnostics
+}
+
+// Error returns Message, or the formatted diagnostics if Message is empty.
+func (e *DiagnosticsError) Error() string {
+	if e.Message != "" {
+		return e.Message
+	}
+	return e.Diagnostics.Error()
+}
+
+// Unwrap returns the diagnostics.
+func (e *DiagnosticsError) Unwrap() error {
+	return e.Diagnostics
+}
@@ -0,0 +1,134 @@
+package tflint
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+)
+
+func TestNewRuleError(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want ErrorCategory
+	}{
+		{"plain error", errors.New("boom"), ErrorCategoryInternal},
+		{"wrapped invalid config", fmt.Errorf("%w: threshold must be positive", ErrInvalidConfig), ErrorCategoryConfig},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := NewRuleError("my_rule", tt.err)
+			if err.Rule != "my_rule" {
+				t.Errorf("Rule = %q, want %q", err.Rule, "my_rule")
+			}
+			if err.Category != tt.want {
+				t.Errorf("Category = %v, want %v", err.Category, tt.want)
+			}
+			if !errors.Is(err, tt.err) {
+				t.Error("RuleError should unwrap to the original error")
+			}
+		})
+	}
+}
+
+func TestRuleError_Error(t *testing.T) {
+	tests := []struct {
+		name string
+		err  *RuleError
+		want string
+	}{
+		{"internal", &RuleError{Rule: "a", Category: ErrorCategoryInternal, Err: errors.New("boom")}, "rule a: boom"},
+		{"config", &RuleError{Rule: "b", Category: ErrorCategoryConfig, Err: errors.New("bad")}, "rule b: bad"},
+		{"panic", &RuleError{Rule: "c", Category: ErrorCategoryPanic, Err: errors.New("nil map")}, "rule c panicked: nil map"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.err.Error(); got != tt.want {
+				t.Errorf("Error() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestErrorCategory_String(t *testing.T) {
+	tests := []struct {
+		category ErrorCategory
+		want     string
+	}{
+		{ErrorCategoryInternal, "internal"},
+		{ErrorCategoryPanic, "panic"},
+		{ErrorCategoryConfig, "config"},
+		{ErrorCategory(0), "unknown"},
+	}
+
+	for _, tt := range tests {
+		if got := tt.category.String(); got != tt.want {
+			t.Errorf("ErrorCategory(%d).String() = %q, want %q", tt.category, got, tt.want)
+		}
+	}
+}
+
+func TestMultiRuleError(t *testing.T) {
+	first := NewRuleError("rule_a", errors.New("boom"))
+	second := NewRuleError("rule_b", fmt.Errorf("%w: missing name", ErrInvalidConfig))
+	var err error = &MultiRuleError{Errors: []*RuleError{first, second}}
+
+	want := "2 rules failed: rule rule_a: boom; rule rule_b: invalid config: missing name"
+	if got := err.Error(); got != want {
+		t.Errorf("Error() = %q, want %q", got, want)
+	}
+
+	var ruleErr *RuleError
+	if !errors.As(err, &ruleErr) {
+		t.Fatal("errors.As should extract a RuleError")
+	}
+	if ruleErr != first {
+		t.Errorf("errors.As extracted %v, want the first rule error", ruleErr)
+	}
+	if !errors.Is(err, ErrInvalidConfig) {
+		t.Error("errors.Is should find ErrInvalidConfig in the second rule error")
+	}
+
+	var rules []string
+	for _, e := range err.(interface{ Unwrap() []error }).Unwrap() {
+		if errors.As(e, &ruleErr) {
+			rules = append(rules, ruleErr.Rule)
+		}
+	}
+	if len(rules) != 2 || rules[0] != "rule_a" || rules[1] != "rule_b" {
+		t.Errorf("unwrapped rules = %v, want [rule_a rule_b]", rules)
+	}
+
+	single := &MultiRuleError{Errors: []*RuleError{first}}
+	if got := single.Error(); got != "rule rule_a: boom" {
+		t.Errorf("single Error() = %q, want %q", got, "rule rule_a: boom")
+	}
+}
+
+func TestDiagnosticsError(t *testing.T) {
+	diags := hcl.Diagnostics{
+		{Severity: hcl.DiagError, Summary: "Unsupported argument", Detail: "An argument named \"foo\" is not expected here."},
+	}
+
+	var err error = &DiagnosticsError{Diagnostics: diags}
+	if got := err.Error(); got != diags.Error() {
+		t.Errorf("Error() = %q, want %q", got, diags.Error())
+	}
+
+	err = &DiagnosticsError{Message: "reading content: " + diags.Error(), Diagnostics: diags}
+	if got, want := err.Error(), "reading content: "+diags.Error(); got != want {
+		t.Errorf("Error() = %q, want %q", got, want)
+	}
+
+	var got hcl.Diagnostics
+	if !errors.As(fmt.Errorf("rule failed: %w", err), &got) {
+		t.Fatal("errors.As should extract hcl.Diagnostics")
+	}
+	if len(got) != 1 || got[0].Summary != "Unsupported argument" {
+		t.Errorf("diagnostics = %v, want the original diagnostics", got)
+	}
+}
@@ -0,0 +1,33 @@
+package tflint
+
+import "slices"
+
+// FileChanges is the default implementation of Runner.FileChanges.
+// It compares the file names returned by ListOldFiles and ListNewFiles.
+//
+// Runner implementations can delegate to this function:
+//
+//	func (r *MyRunner) FileChanges() (added, removed, common []string) {
+//	    return tflint.FileChanges(r)
+//	}
+func FileChanges(runner Runner) (added, removed, common []string) {
+	oldFiles := runner.ListOldFiles()
+	newFiles := runner.ListNewFiles()
+
+	for _, name := range newFiles {
+		if slices.Contains(oldFiles, name) {
+			common = append(common, name)
+		} else {
+			added = append(added, name)
+		}
+	}
+	for _, name := range oldFiles {
+		if !slices.Contains(newFiles, name) {
+			removed = append(removed, name)
+		}
+	}
+	slices.Sort(added)
+	slices.Sort(removed)
+	slices.Sort(common)
+	return added, removed, common
+}
@@ -0,0 +1,23 @@
+package tflint
+
+import "github.com/hashicorp/hcl/v2"
+
+// Fix is a suggested remediation for an issue.
+// A fix consists of one or more text edits that, when applied to the
+// NEW configuration, resolve the issue (e.g., renaming an argument back
+// or adding a moved block).
+//
+// Hosts that do not support fixes ignore them and report the issue as usual.
+type Fix struct {
+	// Edits are the text edits that make up the fix.
+	Edits []TextEdit
+}
+
+// TextEdit replaces the source within Range with NewText.
+// An empty Range (Start == End) inserts NewText at that position.
+type TextEdit struct {
+	// Range is the source range to replace.
+	Range hcl.Range
+	// NewText is the replacement text.
+	NewText string
+}
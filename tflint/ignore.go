@@ -0,0 +1,162 @@
+package tflint
+
+import (
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+)
+
+// ignoreDirective is the comment annotation that suppresses issues.
+const ignoreDirective = "tfbreak:ignore"
+
+// ParseIgnoreDirective parses an ignore directive from a comment.
+// It returns ok == false if the comment is not a directive. A directive
+// without rule names (e.g., "# tfbreak:ignore") returns nil rules, meaning
+// all rules are ignored.
+//
+// Supported forms:
+//
+//	# tfbreak:ignore
+//	# tfbreak:ignore=azurerm_force_new
+//	# tfbreak:ignore=azurerm_force_new,azurerm_removed
+//	// tfbreak:ignore azurerm_force_new
+func ParseIgnoreDirective(comment string) (rules []string, ok bool) {
+	text := strings.TrimSpace(comment)
+	switch {
+	case strings.HasPrefix(text, "#"):
+		text = text[1:]
+	case strings.HasPrefix(text, "//"):
+		text = text[2:]
+	case strings.HasPrefix(text, "/*"):
+		text = strings.TrimSuffix(text[2:], "*/")
+	default:
+		return nil, false
+	}
+
+	text, found := strings.CutPrefix(strings.TrimSpace(text), ignoreDirective)
+	if !found {
+		return nil, false
+	}
+	if text == "" {
+		return nil, true
+	}
+	// Reject longer words such as "tfbreak:ignored"
+	if text[0] != '=' && text[0] != ' ' && text[0] != '\t' {
+		return nil, false
+	}
+
+	for _, name := range strings.Split(text[1:], ",") {
+		if name = strings.TrimSpace(name); name != "" {
+			rules = append(rules, name)
+		}
+	}
+	return rules, true
+}
+
+// IsIgnored reports whether an issue for ruleName at issueRange is suppressed
+// by an ignore directive in src, the source of the file issueRange points to.
+//
+// A directive applies to:
+//   - the line it trails (e.g., `location = "westus" # tfbreak:ignore`), or
+//   - the line below it, skipping further comment lines. If a block starts on
+//     that line, the directive applies to the whole block.
+//
+// JSON files have no comments and are never ignored.
+func IsIgnored(src []byte, ruleName string, issueRange hcl.Range) bool {
+	if strings.HasSuffix(issueRange.Filename, ".json") {
+		return false
+	}
+
+	tokens, diags := hclsyntax.LexConfig(src, issueRange.Filename, hcl.InitialPos)
+	if diags.HasErrors() {
+		return false
+	}
+
+	var blockRanges map[int]hcl.Range
+	line := issueRange.Start.Line
+	for i, tok := range tokens {
+		if tok.Type != hclsyntax.TokenComment {
+			continue
+		}
+		rules, ok := ParseIgnoreDirective(string(tok.Bytes))
+		if !ok || !matchesRule(rules, ruleName) {
+			continue
+		}
+
+		// Trailing directive: applies to its own line
+		if i > 0 && tokens[i-1].Type != hclsyntax.TokenNewline && tokens[i-1].Type != hclsyntax.TokenComment {
+			if tok.Range.Start.Line == line {
+				return true
+			}
+			continue
+		}
+
+		// Leading directive: applies to the next line with code
+		next := nextCodeToken(tokens, i)
+		if next == nil {
+			continue
+		}
+		if next.Range.Start.Line == line {
+			return true
+		}
+		if blockRanges == nil {
+			blockRanges = parseBlockRanges(src, issueRange.Filename)
+		}
+		if r, ok := blockRanges[next.Range.Start.Byte]; ok && r.Start.Line <= line && line <= r.End.Line {
+			return true
+		}
+	}
+	return false
+}
+
+// matchesRule reports whether a directive's rule list applies to ruleName.
+// A nil list applies to all rules.
+func matchesRule(rules []string, ruleName string) bool {
+	if rules == nil {
+		return true
+	}
+	for _, rule := range rules {
+		if rule == ruleName {
+			return true
+		}
+	}
+	return false
+}
+
+// nextCodeToken returns the first token after index i that is not a comment
+// or newline, or nil if there is none.
+func nextCodeToken(tokens hclsyntax.Tokens, i int) *hclsyntax.Token {
+	for j := i + 1; j < len(tokens); j++ {
+		switch tokens[j].Type {
+		case hclsyntax.TokenComment, hclsyntax.TokenNewline:
+			continue
+		case hclsyntax.TokenEOF:
+			return nil
+		}
+		return &tokens[j]
+	}
+	return nil
+}
+
+// parseBlockRanges returns the full range of every block in src (recursively),
+// keyed by the byte offset of the block's type keyword.
+func parseBlockRanges(src []byte, filename string) map[int]hcl.Range {
+	ranges := make(map[int]hcl.Range)
+	file, _ := hclsyntax.ParseConfig(src, filename, hcl.InitialPos)
+	if file == nil {
+		return ranges
+	}
+	if body, ok := file.Body.(*hclsyntax.Body); ok {
+		collectBlockRanges(body, ranges)
+	}
+	return ranges
+}
+
+// collectBlockRanges adds the ranges of all blocks in body to ranges.
+func collectBlockRanges(body *hclsyntax.Body, ranges map[int]hcl.Range) {
+	for _, block := range body.Blocks {
+		ranges[block.TypeRange.Start.Byte] = block.Range()
+		collectBlockRanges(block.Body, ranges)
+	}
+}
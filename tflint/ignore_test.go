@@ -0,0 +1,87 @@
+package tflint
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+)
+
+func TestParseIgnoreDirective(t *testing.T) {
+	tests := []struct {
+		name    string
+		comment string
+		rules   []string
+		ok      bool
+	}{
+		{name: "all rules", comment: "# tfbreak:ignore\n", ok: true},
+		{name: "single rule", comment: "# tfbreak:ignore=rule_a", rules: []string{"rule_a"}, ok: true},
+		{name: "multiple rules", comment: "# tfbreak:ignore=rule_a, rule_b", rules: []string{"rule_a", "rule_b"}, ok: true},
+		{name: "space separated", comment: "// tfbreak:ignore rule_a", rules: []string{"rule_a"}, ok: true},
+		{name: "block comment", comment: "/* tfbreak:ignore=rule_a */", rules: []string{"rule_a"}, ok: true},
+		{name: "longer word", comment: "# tfbreak:ignored", ok: false},
+		{name: "plain comment", comment: "# some comment", ok: false},
+		{name: "not a comment", comment: "tfbreak:ignore", ok: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rules, ok := ParseIgnoreDirective(tt.comment)
+			if ok != tt.ok {
+				t.Errorf("ok = %v, want %v", ok, tt.ok)
+			}
+			if !reflect.DeepEqual(rules, tt.rules) {
+				t.Errorf("rules = %v, want %v", rules, tt.rules)
+			}
+		})
+	}
+}
+
+func TestIsIgnored(t *testing.T) {
+	src := []byte(`# tfbreak:ignore=rule_a
+resource "azurerm_resource_group" "ignored" {
+  location = "westus"
+}
+
+resource "azurerm_resource_group" "trailing" {
+  location = "westus" # tfbreak:ignore=rule_a,rule_b
+  name     = "rg"
+}
+
+resource "azurerm_resource_group" "attribute" {
+  # tfbreak:ignore
+  # the location is pinned on purpose
+  location = "westus"
+  name     = "rg"
+}
+`)
+
+	tests := []struct {
+		name string
+		rule string
+		line int
+		want bool
+	}{
+		{name: "block directive on block", rule: "rule_a", line: 2, want: true},
+		{name: "block directive inside block", rule: "rule_a", line: 3, want: true},
+		{name: "block directive other rule", rule: "rule_b", line: 3, want: false},
+		{name: "trailing directive", rule: "rule_b", line: 7, want: true},
+		{name: "trailing directive next line", rule: "rule_a", line: 8, want: false},
+		{name: "all rules directive", rule: "rule_c", line: 14, want: true},
+		{name: "all rules directive next line", rule: "rule_c", line: 15, want: false},
+		{name: "no directive", rule: "rule_a", line: 6, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			issueRange := hcl.Range{
+				Filename: "main.tf",
+				Start:    hcl.Pos{Line: tt.line, Column: 1},
+				End:      hcl.Pos{Line: tt.line, Column: 10},
+			}
+			if got := IsIgnored(src, tt.rule, issueRange); got != tt.want {
+				t.Errorf("IsIgnored() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
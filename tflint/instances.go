@@ -0,0 +1,167 @@
+package tflint
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/zclconf/go-cty/cty"
+	"github.com/zclconf/go-cty/cty/convert"
+	"github.com/zclconf/go-cty/cty/function"
+	"github.com/zclconf/go-cty/cty/function/stdlib"
+	"github.com/zclconf/go-cty/cty/gocty"
+
+	"github.com/jokarl/tfbreak-plugin-sdk/hclext"
+)
+
+// metaArgumentFunctions are the functions available when evaluating count
+// and for_each, so literal collections wrapped in toset or tomap are known.
+var metaArgumentFunctions = map[string]function.Function{
+	"tolist": stdlib.MakeToFunc(cty.List(cty.DynamicPseudoType)),
+	"tomap":  stdlib.MakeToFunc(cty.Map(cty.DynamicPseudoType)),
+	"toset":  stdlib.MakeToFunc(cty.Set(cty.DynamicPseudoType)),
+}
+
+// MetaArgumentsSchema returns the schema of a resource's count and for_each
+// meta-arguments, as read by Block.HasCount, Block.HasForEach and
+// ResourceInstanceDelta. Add it to a resource schema's attributes to make them
+// available to the rule:
+//
+//	schema := &hclext.BodySchema{
+//	    Attributes: append(tflint.MetaArgumentsSchema(), hclext.AttributeSchema{Name: "location"}),
+//	}
+func MetaArgumentsSchema() []hclext.AttributeSchema {
+	return []hclext.AttributeSchema{{Name: "count"}, {Name: "for_each"}}
+}
+
+// ResourceInstanceDelta compares the instances declared by the count and
+// for_each meta-arguments of the same resource in the OLD and NEW
+// configurations. It returns the indices of the instances only the new block
+// declares and of those only the old block declares, whose addresses
+// disappear and whose state is destroyed.
+//
+// Indices are written as appended to the block address: "[0]" for count,
+// `["key"]` for for_each, and "" for the single instance of a block with
+// neither. A nil block declares no instances. The blocks must be extracted
+// with the count and for_each attributes; see MetaArgumentsSchema.
+//
+// If count or for_each of either block cannot be evaluated statically, e.g.
+// because it references a variable, the error wraps
+// ErrIndeterminateInstances rather than guessing the instances.
+//
+// Example:
+//
+//	added, removed, err := tflint.ResourceInstanceDelta(change.Old, change.New)
+//	if errors.Is(err, tflint.ErrIndeterminateInstances) {
+//	    return nil
+//	}
+//	if err != nil {
+//	    return err
+//	}
+//	for _, index := range removed {
+//	    // change.Address + index is destroyed
+//	}
+func ResourceInstanceDelta(oldBlock, newBlock *hclext.Block) (addedIndices, removedIndices []string, err error) {
+	oldIndices, err := instanceIndices(oldBlock)
+	if err != nil {
+		return nil, nil, err
+	}
+	newIndices, err := instanceIndices(newBlock)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for _, index := range newIndices {
+		if !slices.Contains(oldIndices, index) {
+			addedIndices = append(addedIndices, index)
+		}
+	}
+	for _, index := range oldIndices {
+		if !slices.Contains(newIndices, index) {
+			removedIndices = append(removedIndices, index)
+		}
+	}
+	return addedIndices, removedIndices, nil
+}
+
+// instanceIndices returns the indices of the instances block declares, in
+// count order or for_each key order.
+func instanceIndices(block *hclext.Block) ([]string, error) {
+	if block == nil {
+		return nil, nil
+	}
+	if block.Body == nil {
+		return []string{""}, nil
+	}
+
+	if attr, ok := block.Body.Attributes["count"]; ok {
+		val, ok := metaArgumentValue(attr)
+		if !ok {
+			return nil, fmt.Errorf("%w: count of %s", ErrIndeterminateInstances, BlockAddress(block))
+		}
+		var count int
+		numVal, err := convert.Convert(val, cty.Number)
+		if err == nil && !numVal.IsNull() {
+			err = gocty.FromCtyValue(numVal, &count)
+		}
+		if err != nil || numVal.IsNull() || count < 0 {
+			return nil, fmt.Errorf("invalid count of %s: must be a non-negative whole number", BlockAddress(block))
+		}
+
+		indices := make([]string, count)
+		for i := range indices {
+			indices[i] = fmt.Sprintf("[%d]", i)
+		}
+		return indices, nil
+	}
+
+	if attr, ok := block.Body.Attributes["for_each"]; ok {
+		val, ok := metaArgumentValue(attr)
+		if !ok {
+			return nil, fmt.Errorf("%w: for_each of %s", ErrIndeterminateInstances, BlockAddress(block))
+		}
+		ty := val.Type()
+		if val.IsNull() || !(ty.IsMapType() || ty.IsObjectType() || ty.IsSetType()) {
+			return nil, fmt.Errorf("invalid for_each of %s: must be a map or a set of strings", BlockAddress(block))
+		}
+
+		var indices []string
+		for it := val.ElementIterator(); it.Next(); {
+			key, elem := it.Element()
+			if ty.IsSetType() {
+				if elem.IsNull() || elem.Type() != cty.String {
+					return nil, fmt.Errorf("invalid for_each of %s: must be a map or a set of strings", BlockAddress(block))
+				}
+				key = elem
+			}
+			indices = append(indices, fmt.Sprintf("[%q]", key.AsString()))
+		}
+		return indices, nil
+	}
+
+	return []string{""}, nil
+}
+
+// metaArgumentValue returns the value of a count or for_each attribute.
+// A value that cannot be determined without context is evaluated again from
+// the source with metaArgumentFunctions. It returns false if the value is
+// still unknown.
+func metaArgumentValue(attr *hclext.Attribute) (cty.Value, bool) {
+	if val, ok := hclext.AttributeValue(attr); ok {
+		return val, true
+	}
+	if len(attr.SourceBytes) == 0 {
+		return cty.NilVal, false
+	}
+
+	expr, diags := hclsyntax.ParseExpression(attr.SourceBytes, attr.Range.Filename, hcl.InitialPos)
+	if diags.HasErrors() {
+		return cty.NilVal, false
+	}
+	val, diags := expr.Value(&hcl.EvalContext{Functions: metaArgumentFunctions})
+	if diags.HasErrors() || !val.IsWhollyKnown() {
+		return cty.NilVal, false
+	}
+	return val, true
+}
@@ -0,0 +1,129 @@
+package tflint
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/jokarl/tfbreak-plugin-sdk/hclext"
+)
+
+// resourceWithMetaArgument returns a resource block with the given count or
+// for_each source, as received over gRPC (no Expr). An empty name returns a
+// block with neither.
+func resourceWithMetaArgument(name, src string) *hclext.Block {
+	block := &hclext.Block{
+		Type:   "resource",
+		Labels: []string{"azurerm_subnet", "this"},
+		Body:   &hclext.BodyContent{Attributes: map[string]*hclext.Attribute{}},
+	}
+	if name != "" {
+		block.Body.Attributes[name] = &hclext.Attribute{Name: name, SourceBytes: []byte(src)}
+	}
+	return block
+}
+
+func TestResourceInstanceDelta(t *testing.T) {
+	tests := []struct {
+		name        string
+		old, new    *hclext.Block
+		wantAdded   []string
+		wantRemoved []string
+	}{
+		{
+			name:        "count reduced",
+			old:         resourceWithMetaArgument("count", "3"),
+			new:         resourceWithMetaArgument("count", "1"),
+			wantRemoved: []string{"[1]", "[2]"},
+		},
+		{
+			name:      "count increased",
+			old:       resourceWithMetaArgument("count", "1"),
+			new:       resourceWithMetaArgument("count", "2"),
+			wantAdded: []string{"[1]"},
+		},
+		{
+			name:        "for_each key removed",
+			old:         resourceWithMetaArgument("for_each", `{ a = "10.0.1.0/24", b = "10.0.2.0/24" }`),
+			new:         resourceWithMetaArgument("for_each", `{ a = "10.0.1.0/24" }`),
+			wantRemoved: []string{`["b"]`},
+		},
+		{
+			name:        "for_each set",
+			old:         resourceWithMetaArgument("for_each", `toset(["a", "b"])`),
+			new:         resourceWithMetaArgument("for_each", `toset(["a", "c"])`),
+			wantAdded:   []string{`["c"]`},
+			wantRemoved: []string{`["b"]`},
+		},
+		{
+			name:        "count added",
+			old:         resourceWithMetaArgument("", ""),
+			new:         resourceWithMetaArgument("count", "1"),
+			wantAdded:   []string{"[0]"},
+			wantRemoved: []string{""},
+		},
+		{
+			name: "unchanged",
+			old:  resourceWithMetaArgument("count", "2"),
+			new:  resourceWithMetaArgument("count", "2"),
+		},
+		{
+			name:      "resource added",
+			new:       resourceWithMetaArgument("count", "2"),
+			wantAdded: []string{"[0]", "[1]"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			added, removed, err := ResourceInstanceDelta(tt.old, tt.new)
+			if err != nil {
+				t.Fatalf("ResourceInstanceDelta() error = %v", err)
+			}
+			if !reflect.DeepEqual(added, tt.wantAdded) {
+				t.Errorf("added = %q, want %q", added, tt.wantAdded)
+			}
+			if !reflect.DeepEqual(removed, tt.wantRemoved) {
+				t.Errorf("removed = %q, want %q", removed, tt.wantRemoved)
+			}
+		})
+	}
+}
+
+func TestResourceInstanceDelta_Indeterminate(t *testing.T) {
+	static := resourceWithMetaArgument("count", "2")
+	blocks := map[string]*hclext.Block{
+		"count":    resourceWithMetaArgument("count", "var.instances"),
+		"for_each": resourceWithMetaArgument("for_each", "var.subnets"),
+	}
+	for name, block := range blocks {
+		if _, _, err := ResourceInstanceDelta(static, block); !errors.Is(err, ErrIndeterminateInstances) {
+			t.Errorf("new %s error = %v, want ErrIndeterminateInstances", name, err)
+		}
+		if _, _, err := ResourceInstanceDelta(block, static); !errors.Is(err, ErrIndeterminateInstances) {
+			t.Errorf("old %s error = %v, want ErrIndeterminateInstances", name, err)
+		}
+	}
+}
+
+func TestResourceInstanceDelta_Invalid(t *testing.T) {
+	static := resourceWithMetaArgument("count", "2")
+	for _, block := range []*hclext.Block{
+		resourceWithMetaArgument("count", "-1"),
+		resourceWithMetaArgument("count", "1.5"),
+		resourceWithMetaArgument("for_each", `["a", "b"]`),
+	} {
+		_, _, err := ResourceInstanceDelta(static, block)
+		if err == nil || errors.Is(err, ErrIndeterminateInstances) {
+			t.Errorf("ResourceInstanceDelta() error = %v, want an invalid value error", err)
+		}
+	}
+}
+
+func TestMetaArgumentsSchema(t *testing.T) {
+	schema := MetaArgumentsSchema()
+	want := []hclext.AttributeSchema{{Name: "count"}, {Name: "for_each"}}
+	if !reflect.DeepEqual(schema, want) {
+		t.Errorf("MetaArgumentsSchema() = %+v, want %+v", schema, want)
+	}
+}
@@ -1,6 +1,10 @@
 package tflint
 
-import "github.com/jokarl/tfbreak-plugin-sdk/hclext"
+import (
+	"context"
+
+	"github.com/jokarl/tfbreak-plugin-sdk/hclext"
+)
 
 // Rule is the interface that all tfbreak rules must implement.
 // This aligns with tflint-plugin-sdk's Rule interface for ecosystem familiarity.
@@ -16,7 +20,7 @@ import "github.com/jokarl/tfbreak-plugin-sdk/hclext"
 //
 //	func (r *MyRule) Name() string { return "my_rule" }
 //	func (r *MyRule) Link() string { return "https://example.com/my_rule" }
-//	func (r *MyRule) Check(runner tflint.Runner) error {
+//	func (r *MyRule) Check(ctx context.Context, runner tflint.Runner) error {
 //	    // Access old and new configs via runner
 //	    oldContent, _ := runner.GetOldResourceContent("azurerm_resource_group", schema, nil)
 //	    newContent, _ := runner.GetNewResourceContent("azurerm_resource_group", schema, nil)
@@ -43,7 +47,114 @@ type Rule interface {
 	// Check executes the rule against the configurations accessible via runner.
 	// Call runner.EmitIssue() for each finding.
 	// Return an error only for unexpected failures, not for findings.
-	Check(runner Runner) error
+	//
+	// The context is cancelled when the host aborts the run (e.g., on Ctrl-C).
+	// Long-running rules should check ctx.Err() periodically and return early.
+	Check(ctx context.Context, runner Runner) error
+}
+
+// ScopedRule is an optional interface for rules that only inspect specific
+// resource types. The host uses the declared types to skip rules whose
+// resource types do not appear in either configuration.
+//
+// Rules that do not implement ScopedRule are always run.
+//
+// Example:
+//
+//	func (r *MyRule) ResourceTypes() []string {
+//	    return []string{"azurerm_storage_account"}
+//	}
+type ScopedRule interface {
+	Rule
+
+	// ResourceTypes returns the resource types this rule inspects.
+	ResourceTypes() []string
+}
+
+// RuleMetadata is an optional interface for rules that describe themselves
+// beyond a name and link. Hosts use it to generate documentation and to
+// group issues in their output.
+//
+// Rules that do not implement RuleMetadata report an empty description and no tags.
+//
+// Example:
+//
+//	func (r *MyRule) Description() string {
+//	    return "Detects changes to ForceNew attributes"
+//	}
+//
+//	func (r *MyRule) Tags() []string {
+//	    return []string{"force-new"}
+//	}
+type RuleMetadata interface {
+	Rule
+
+	// Description returns a human-readable description of what the rule checks.
+	Description() string
+
+	// Tags returns labels used to group the rule (e.g., "force-new", "removal").
+	Tags() []string
+}
+
+// DeprecatedRule is an optional interface for rules that are superseded
+// and will be removed. Hosts use it to warn users who still enable the rule
+// and point them to its replacement.
+//
+// Rules that do not implement DeprecatedRule are not deprecated.
+//
+// Example:
+//
+//	func (r *MyRule) Deprecated() (string, bool) {
+//	    return "azurerm_resource_force_new", true
+//	}
+type DeprecatedRule interface {
+	Rule
+
+	// Deprecated reports whether the rule is deprecated and, if so, the
+	// name of the rule replacing it. replacedBy is empty if the rule has
+	// no replacement.
+	Deprecated() (replacedBy string, ok bool)
+}
+
+// FixableRule is an optional interface for rules that suggest fixes with
+// Runner.EmitIssueWithFix. Hosts use it to offer applying fixes only for
+// plugins that provide them.
+//
+// Rules that do not implement FixableRule may still emit fixes, but the
+// plugin does not advertise them.
+//
+// Example:
+//
+//	func (r *MyRule) Fixable() bool {
+//	    return true
+//	}
+type FixableRule interface {
+	Rule
+
+	// Fixable reports whether the rule emits issues with fixes.
+	Fixable() bool
+}
+
+// PreparableRule is an optional interface for rules that need one-time setup
+// before Check, such as caching lookups shared by every check. Prepare is
+// called once per run, before any rule is checked. If it returns an error,
+// the error is reported for the rule and its Check is skipped.
+//
+// Example:
+//
+//	func (r *MyRule) Prepare(runner tflint.Runner) error {
+//	    calls, err := runner.GetNewModuleCalls()
+//	    if err != nil {
+//	        return err
+//	    }
+//	    r.calls = calls
+//	    return nil
+//	}
+type PreparableRule interface {
+	Rule
+
+	// Prepare sets up the rule before Check is called.
+	Prepare(runner Runner) error
 }
 
 // RuleSet is implemented by plugins to provide a collection of rules.
@@ -99,3 +210,24 @@ type RuleSet interface {
 	// Used internally for rule iteration.
 	BuiltinImpl() *BuiltinRuleSet
 }
+
+// Closer is an optional interface for rulesets that hold resources to
+// release when the plugin shuts down, such as cached provider schemas or
+// open files.
+//
+// plugin.Serve calls Close once the host disconnects and the gRPC server
+// has stopped. By then every request, including Check, has returned, so
+// Close never runs concurrently with a rule. It is called at most once and
+// before Serve returns. Errors are logged; they do not change how the
+// plugin exits. Close is not called when the plugin is invoked directly
+// or killed before it could shut down.
+//
+// Example:
+//
+//	func (rs *MyRuleSet) Close() error {
+//	    return rs.schemaCache.Close()
+//	}
+type Closer interface {
+	// Close releases the ruleset's resources.
+	Close() error
+}
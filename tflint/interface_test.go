@@ -1,6 +1,7 @@
 package tflint
 
 import (
+	"context"
 	"testing"
 
 	"github.com/hashicorp/hcl/v2"
@@ -15,7 +16,7 @@ type mockRule struct {
 
 func (r *mockRule) Name() string { return r.name }
 func (r *mockRule) Link() string { return r.link }
-func (r *mockRule) Check(_ Runner) error {
+func (r *mockRule) Check(_ context.Context, _ Runner) error {
 	return nil
 }
 
@@ -56,7 +57,7 @@ func TestRule_Interface(t *testing.T) {
 	})
 
 	t.Run("Check returns nil", func(t *testing.T) {
-		if err := rule.Check(nil); err != nil {
+		if err := rule.Check(context.Background(), nil); err != nil {
 			t.Errorf("Check() = %v, want nil", err)
 		}
 	})
@@ -69,7 +70,7 @@ type mockRuleOverrideSeverity struct {
 
 func (r *mockRuleOverrideSeverity) Name() string     { return "warning_rule" }
 func (r *mockRuleOverrideSeverity) Link() string     { return "" }
-func (r *mockRuleOverrideSeverity) Check(_ Runner) error { return nil }
+func (r *mockRuleOverrideSeverity) Check(_ context.Context, _ Runner) error { return nil }
 func (r *mockRuleOverrideSeverity) Severity() Severity   { return WARNING }
 
 func TestRule_OverrideSeverity(t *testing.T) {
@@ -0,0 +1,33 @@
+package tflint
+
+// IssueKind classifies an issue by its impact on the callers of a
+// configuration, so the host can count breaking changes separately from
+// advisories. It is independent of Severity, which controls how the issue
+// is reported.
+type IssueKind int
+
+const (
+	// KindUnspecified is the kind of issues emitted without one.
+	KindUnspecified IssueKind = iota
+	// KindBreaking indicates a breaking change (e.g., a removed variable or
+	// an attribute change that forces recreation).
+	KindBreaking
+	// KindWarning indicates a change that may break some callers.
+	KindWarning
+	// KindInfo indicates a non-breaking change worth mentioning.
+	KindInfo
+)
+
+// String returns the string representation of the kind.
+func (k IssueKind) String() string {
+	switch k {
+	case KindBreaking:
+		return "BREAKING"
+	case KindWarning:
+		return "WARNING"
+	case KindInfo:
+		return "INFO"
+	default:
+		return "UNSPECIFIED"
+	}
+}
@@ -0,0 +1,22 @@
+package tflint
+
+import "testing"
+
+func TestIssueKind_String(t *testing.T) {
+	tests := []struct {
+		kind IssueKind
+		want string
+	}{
+		{KindUnspecified, "UNSPECIFIED"},
+		{KindBreaking, "BREAKING"},
+		{KindWarning, "WARNING"},
+		{KindInfo, "INFO"},
+		{IssueKind(99), "UNSPECIFIED"},
+	}
+
+	for _, tt := range tests {
+		if got := tt.kind.String(); got != tt.want {
+			t.Errorf("IssueKind(%d).String() = %q, want %q", int(tt.kind), got, tt.want)
+		}
+	}
+}
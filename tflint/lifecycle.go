@@ -0,0 +1,90 @@
+package tflint
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/jokarl/tfbreak-plugin-sdk/hclext"
+)
+
+// IgnoreAllChanges is returned by IgnoredChanges for `ignore_changes = all`.
+const IgnoreAllChanges = "all"
+
+// LifecycleBlockSchema returns the schema of a resource's `lifecycle` block
+// as read by IgnoredChanges. Add it to a resource schema to make
+// ignore_changes available to the rule:
+//
+//	schema := &hclext.BodySchema{
+//	    Attributes: []hclext.AttributeSchema{{Name: "location"}},
+//	    Blocks:     []hclext.BlockSchema{tflint.LifecycleBlockSchema()},
+//	}
+func LifecycleBlockSchema() hclext.BlockSchema {
+	return hclext.BlockSchema{
+		Type: "lifecycle",
+		Body: &hclext.BodySchema{
+			Attributes: []hclext.AttributeSchema{{Name: "ignore_changes"}},
+		},
+	}
+}
+
+// IgnoredChanges returns the attribute paths listed in the resource's
+// `lifecycle { ignore_changes = [...] }`. Terraform does not apply changes
+// to these attributes, so rules comparing attributes can skip them to avoid
+// false positives.
+//
+// Paths are the raw traversals as written in the configuration, e.g.
+// "location" or `tags["env"]`. For `ignore_changes = all`, the result is
+// []string{IgnoreAllChanges}. It returns nil if block has no lifecycle block
+// extracted with an ignore_changes attribute; see LifecycleBlockSchema.
+func IgnoredChanges(block *hclext.Block) []string {
+	if block == nil || block.Body == nil {
+		return nil
+	}
+	lifecycle, ok := block.Body.GetBlock("lifecycle")
+	if !ok || lifecycle.Body == nil {
+		return nil
+	}
+	attr, ok := lifecycle.Body.Attributes["ignore_changes"]
+	if !ok || len(attr.SourceBytes) == 0 {
+		return nil
+	}
+
+	// The traversals cannot be evaluated, and Expr is not available over
+	// gRPC, so the source is parsed instead
+	src := attr.SourceBytes
+	expr, diags := hclsyntax.ParseExpression(src, attr.Range.Filename, hcl.InitialPos)
+	if diags.HasErrors() {
+		return nil
+	}
+	if hcl.ExprAsKeyword(expr) == IgnoreAllChanges {
+		return []string{IgnoreAllChanges}
+	}
+	exprs, diags := hcl.ExprList(expr)
+	if diags.HasErrors() {
+		return nil
+	}
+
+	paths := make([]string, 0, len(exprs))
+	for _, e := range exprs {
+		// Quoted attribute names are accepted for compatibility with
+		// configurations written for Terraform 0.11
+		if val, diags := e.Value(nil); !diags.HasErrors() && val.Type() == cty.String && val.IsKnown() && !val.IsNull() {
+			paths = append(paths, val.AsString())
+			continue
+		}
+		paths = append(paths, strings.TrimSpace(string(e.Range().SliceBytes(src))))
+	}
+	return paths
+}
+
+// IgnoresChange reports whether changes to the attribute at path are ignored
+// by the resource's lifecycle block, either because path is listed in
+// ignore_changes exactly as given or because all changes are ignored.
+func IgnoresChange(block *hclext.Block, path string) bool {
+	ignored := IgnoredChanges(block)
+	return slices.Contains(ignored, IgnoreAllChanges) || slices.Contains(ignored, path)
+}
@@ -0,0 +1,72 @@
+package tflint
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/jokarl/tfbreak-plugin-sdk/hclext"
+)
+
+// resourceWithIgnoreChanges returns a resource block whose lifecycle block
+// has the given ignore_changes source, as received over gRPC (no Expr).
+func resourceWithIgnoreChanges(src string) *hclext.Block {
+	return &hclext.Block{
+		Type:   "resource",
+		Labels: []string{"azurerm_resource_group", "main"},
+		Body: &hclext.BodyContent{
+			Blocks: []*hclext.Block{{
+				Type: "lifecycle",
+				Body: &hclext.BodyContent{
+					Attributes: map[string]*hclext.Attribute{
+						"ignore_changes": {Name: "ignore_changes", SourceBytes: []byte(src)},
+					},
+				},
+			}},
+		},
+	}
+}
+
+func TestIgnoredChanges(t *testing.T) {
+	tests := []struct {
+		name  string
+		block *hclext.Block
+		want  []string
+	}{
+		{"traversals", resourceWithIgnoreChanges(`[location, tags["env"], site_config[0].always_on]`), []string{"location", `tags["env"]`, "site_config[0].always_on"}},
+		{"quoted names", resourceWithIgnoreChanges(`["location"]`), []string{"location"}},
+		{"all", resourceWithIgnoreChanges(`all`), []string{IgnoreAllChanges}},
+		{"empty list", resourceWithIgnoreChanges(`[]`), []string{}},
+		{"invalid", resourceWithIgnoreChanges(`location`), nil},
+		{"no lifecycle", &hclext.Block{Type: "resource", Body: &hclext.BodyContent{}}, nil},
+		{"no body", &hclext.Block{Type: "resource"}, nil},
+		{"nil block", nil, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IgnoredChanges(tt.block); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("IgnoredChanges() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIgnoresChange(t *testing.T) {
+	block := resourceWithIgnoreChanges(`[location, tags["env"]]`)
+	if !IgnoresChange(block, "location") {
+		t.Error("location should be ignored")
+	}
+	if IgnoresChange(block, "tags") {
+		t.Error("tags should not be ignored when only one key is listed")
+	}
+	if !IgnoresChange(resourceWithIgnoreChanges(`all`), "name") {
+		t.Error("every attribute should be ignored with ignore_changes = all")
+	}
+}
+
+func TestLifecycleBlockSchema(t *testing.T) {
+	schema := LifecycleBlockSchema()
+	if schema.Type != "lifecycle" || schema.Body == nil || len(schema.Body.Attributes) != 1 || schema.Body.Attributes[0].Name != "ignore_changes" {
+		t.Errorf("LifecycleBlockSchema() = %+v", schema)
+	}
+}
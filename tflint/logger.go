@@ -0,0 +1,36 @@
+package tflint
+
+import (
+	"context"
+
+	"github.com/hashicorp/go-hclog"
+)
+
+// loggerContextKey is the context key under which the plugin logger is stored.
+type loggerContextKey struct{}
+
+// ContextWithLogger returns a copy of ctx carrying the given logger.
+// The plugin server uses this to pass its logger to Rule.Check.
+func ContextWithLogger(ctx context.Context, logger hclog.Logger) context.Context {
+	return context.WithValue(ctx, loggerContextKey{}, logger)
+}
+
+// LoggerFromContext returns the logger carried by ctx.
+// If no logger is present, a logger that discards all output is returned,
+// so rules can always log without checking for nil.
+//
+// Example:
+//
+//	func (r *MyRule) Check(ctx context.Context, runner tflint.Runner) error {
+//	    logger := tflint.LoggerFromContext(ctx)
+//	    logger.Debug("checking resources", "rule", r.Name())
+//	    ...
+//	}
+func LoggerFromContext(ctx context.Context) hclog.Logger {
+	if ctx != nil {
+		if logger, ok := ctx.Value(loggerContextKey{}).(hclog.Logger); ok && logger != nil {
+			return logger
+		}
+	}
+	return hclog.NewNullLogger()
+}
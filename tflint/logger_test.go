@@ -0,0 +1,26 @@
+package tflint
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/go-hclog"
+)
+
+func TestLoggerFromContext(t *testing.T) {
+	logger := hclog.New(&hclog.LoggerOptions{Name: "test"})
+	ctx := ContextWithLogger(context.Background(), logger)
+
+	if got := LoggerFromContext(ctx); got != logger {
+		t.Error("LoggerFromContext should return the logger stored in the context")
+	}
+}
+
+func TestLoggerFromContext_Missing(t *testing.T) {
+	got := LoggerFromContext(context.Background())
+	if got == nil {
+		t.Fatal("LoggerFromContext should never return nil")
+	}
+	// Must be safe to use
+	got.Debug("discarded")
+}
@@ -0,0 +1,20 @@
+package tflint
+
+import "github.com/hashicorp/hcl/v2"
+
+// ModuleCall is a `module` block, as returned by Runner.GetOldModuleCalls
+// and Runner.GetNewModuleCalls.
+//
+// Pointing a module call at another source or pinning an older version can
+// change or remove the resources it manages.
+type ModuleCall struct {
+	// Name is the module call name, the label of the block.
+	Name string
+	// Source is the module source address (e.g., "Azure/network/azurerm"
+	// or "./modules/network").
+	Source string
+	// Version is the version constraint, or empty if not declared.
+	Version string
+	// DeclRange is the source range of the block header.
+	DeclRange hcl.Range
+}
@@ -0,0 +1,131 @@
+package tflint
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/jokarl/tfbreak-plugin-sdk/hclext"
+)
+
+// ModuleDiff is a combined view of the OLD and NEW module content.
+// Blocks are paired by module path, Type and Labels, so a resource keeps its
+// identity across both configurations; blocks sharing all three are paired
+// in order. Source ranges are never part of the key, so a block moved to a
+// different file is still paired.
+//
+// DEVIATION FROM TFLINT (see ADR-0001):
+// tflint has no equivalent because it only inspects a single configuration.
+type ModuleDiff struct {
+	// Added contains blocks present only in the NEW configuration.
+	Added []*hclext.Block
+	// Removed contains blocks present only in the OLD configuration.
+	Removed []*hclext.Block
+	// Changed contains blocks present in both configurations whose
+	// extracted content differs (as determined by hclext.DiffBodyContent),
+	// and blocks renamed via a moved block once ApplyMovedBlocks is called.
+	Changed []*BlockChange
+}
+
+// BlockChange pairs the OLD and NEW versions of the same block.
+type BlockChange struct {
+	// Address is the resource address of the block (e.g., "aws_instance.web").
+	Address string
+	// Old is the block from the OLD configuration.
+	Old *hclext.Block
+	// New is the block from the NEW configuration.
+	New *hclext.Block
+}
+
+// GetModuleDiff is the default implementation of Runner.GetModuleDiff.
+// It retrieves the OLD and NEW module content with the same schema and
+// pairs the resulting blocks by address.
+//
+// Runner implementations can delegate to this function:
+//
+//	func (r *MyRunner) GetModuleDiff(schema *hclext.BodySchema, opts *tflint.GetModuleContentOption) (*tflint.ModuleDiff, error) {
+//	    return tflint.GetModuleDiff(r, schema, opts)
+//	}
+func GetModuleDiff(runner Runner, schema *hclext.BodySchema, opts *GetModuleContentOption) (*ModuleDiff, error) {
+	oldContent, err := runner.GetOldModuleContent(schema, opts)
+	if err != nil {
+		return nil, err
+	}
+	newContent, err := runner.GetNewModuleContent(schema, opts)
+	if err != nil {
+		return nil, err
+	}
+	return DiffModuleContent(oldContent, newContent), nil
+}
+
+// DiffModuleContent pairs the blocks of two BodyContents by module path,
+// Type and Labels. Blocks sharing all three, such as several `locals`
+// blocks or `provider` blocks of the same provider, are paired in the order
+// they appear. Blocks are reported in the order they appear in their
+// configuration.
+func DiffModuleContent(oldContent, newContent *hclext.BodyContent) *ModuleDiff {
+	diff := &ModuleDiff{
+		Added:   make([]*hclext.Block, 0),
+		Removed: make([]*hclext.Block, 0),
+		Changed: make([]*BlockChange, 0),
+	}
+
+	// Index old blocks by key, preserving order for repeated keys
+	oldBlocks := make(map[string][]*hclext.Block)
+	if oldContent != nil {
+		for _, block := range oldContent.Blocks {
+			key := moduleBlockKey(block)
+			oldBlocks[key] = append(oldBlocks[key], block)
+		}
+	}
+
+	matched := make(map[*hclext.Block]bool)
+	if newContent != nil {
+		for _, block := range newContent.Blocks {
+			key := moduleBlockKey(block)
+			candidates := oldBlocks[key]
+			if len(candidates) == 0 {
+				diff.Added = append(diff.Added, block)
+				continue
+			}
+			oldBlock := candidates[0]
+			oldBlocks[key] = candidates[1:]
+			matched[oldBlock] = true
+
+			if !hclext.DiffBodyContent(oldBlock.Body, block.Body).IsEmpty() {
+				diff.Changed = append(diff.Changed, &BlockChange{
+					Address: BlockAddress(block),
+					Old:     oldBlock,
+					New:     block,
+				})
+			}
+		}
+	}
+
+	if oldContent != nil {
+		for _, block := range oldContent.Blocks {
+			if !matched[block] {
+				diff.Removed = append(diff.Removed, block)
+			}
+		}
+	}
+
+	return diff
+}
+
+// moduleBlockKey returns the key DiffModuleContent pairs blocks by. Unlike
+// the address, it keeps the block type, so a `variable "x"` block and a
+// block of type "var" labeled "x" are told apart.
+func moduleBlockKey(block *hclext.Block) string {
+	key := append(slices.Clone(block.ModulePath), "\x01", block.Type)
+	return strings.Join(append(key, block.Labels...), "\x00")
+}
+
+// BlockAddress returns the Terraform-style address of a block, including
+// its module path, or an empty string for a nil block. See
+// hclext.Block.Address and hclext.Block.FullAddress for the conventions used.
+func BlockAddress(block *hclext.Block) string {
+	if block == nil {
+		return ""
+	}
+	return block.FullAddress()
+}
@@ -0,0 +1,165 @@
+package tflint
+
+import (
+	"testing"
+
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/jokarl/tfbreak-plugin-sdk/hclext"
+)
+
+func TestBlockAddress(t *testing.T) {
+	tests := []struct {
+		name  string
+		block *hclext.Block
+		want  string
+	}{
+		{"nil block", nil, ""},
+		{"resource", &hclext.Block{Type: "resource", Labels: []string{"aws_instance", "web"}}, "aws_instance.web"},
+		{"data source", &hclext.Block{Type: "data", Labels: []string{"aws_ami", "ubuntu"}}, "data.aws_ami.ubuntu"},
+		{"module", &hclext.Block{Type: "module", Labels: []string{"network"}}, "module.network"},
+		{"unlabeled", &hclext.Block{Type: "terraform"}, "terraform"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := BlockAddress(tt.block); got != tt.want {
+				t.Errorf("BlockAddress() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDiffModuleContent(t *testing.T) {
+	resource := func(name, location string) *hclext.Block {
+		return &hclext.Block{
+			Type:   "resource",
+			Labels: []string{"azurerm_resource_group", name},
+			Body: &hclext.BodyContent{
+				Attributes: map[string]*hclext.Attribute{
+					"location": {Name: "location", Value: cty.StringVal(location)},
+				},
+			},
+		}
+	}
+
+	oldContent := &hclext.BodyContent{
+		Blocks: []*hclext.Block{
+			resource("kept", "westus"),
+			resource("changed", "westus"),
+			resource("removed", "westus"),
+		},
+	}
+	newContent := &hclext.BodyContent{
+		Blocks: []*hclext.Block{
+			resource("kept", "westus"),
+			resource("changed", "eastus"),
+			resource("added", "westus"),
+		},
+	}
+
+	diff := DiffModuleContent(oldContent, newContent)
+
+	if len(diff.Added) != 1 || diff.Added[0].Labels[1] != "added" {
+		t.Errorf("Added = %v, want [added]", diff.Added)
+	}
+	if len(diff.Removed) != 1 || diff.Removed[0].Labels[1] != "removed" {
+		t.Errorf("Removed = %v, want [removed]", diff.Removed)
+	}
+	if len(diff.Changed) != 1 {
+		t.Fatalf("expected 1 changed block, got %d", len(diff.Changed))
+	}
+	change := diff.Changed[0]
+	if change.Address != "azurerm_resource_group.changed" {
+		t.Errorf("Address = %q, want %q", change.Address, "azurerm_resource_group.changed")
+	}
+	if change.Old.Body.Attributes["location"].Value.AsString() != "westus" {
+		t.Error("Old should hold the OLD block")
+	}
+	if change.New.Body.Attributes["location"].Value.AsString() != "eastus" {
+		t.Error("New should hold the NEW block")
+	}
+}
+
+func TestDiffModuleContent_MatchesFullLabels(t *testing.T) {
+	oldContent := &hclext.BodyContent{
+		Blocks: []*hclext.Block{
+			{Type: "resource", Labels: []string{"aws_instance", "web"}},
+		},
+	}
+	newContent := &hclext.BodyContent{
+		Blocks: []*hclext.Block{
+			{Type: "resource", Labels: []string{"aws_eip", "web"}},
+		},
+	}
+
+	diff := DiffModuleContent(oldContent, newContent)
+
+	if len(diff.Added) != 1 || len(diff.Removed) != 1 || len(diff.Changed) != 0 {
+		t.Errorf("got added=%d removed=%d changed=%d, want 1/1/0",
+			len(diff.Added), len(diff.Removed), len(diff.Changed))
+	}
+}
+
+func TestDiffModuleContent_DuplicateAddresses(t *testing.T) {
+	provider := func(alias string) *hclext.Block {
+		body := &hclext.BodyContent{Attributes: map[string]*hclext.Attribute{}}
+		if alias != "" {
+			body.Attributes["alias"] = &hclext.Attribute{Name: "alias", Value: cty.StringVal(alias)}
+		}
+		return &hclext.Block{Type: "provider", Labels: []string{"aws"}, Body: body}
+	}
+	locals := func(name string) *hclext.Block {
+		return &hclext.Block{Type: "locals", Body: &hclext.BodyContent{
+			Attributes: map[string]*hclext.Attribute{name: {Name: name, Value: cty.True}},
+		}}
+	}
+
+	t.Run("unchanged", func(t *testing.T) {
+		content := func() *hclext.BodyContent {
+			return &hclext.BodyContent{Blocks: []*hclext.Block{
+				provider(""), provider("west"), locals("a"), locals("b"),
+			}}
+		}
+		diff := DiffModuleContent(content(), content())
+		if len(diff.Added) != 0 || len(diff.Removed) != 0 || len(diff.Changed) != 0 {
+			t.Errorf("got added=%d removed=%d changed=%d, want no change",
+				len(diff.Added), len(diff.Removed), len(diff.Changed))
+		}
+	})
+
+	t.Run("one removed", func(t *testing.T) {
+		oldContent := &hclext.BodyContent{Blocks: []*hclext.Block{locals("a"), locals("b")}}
+		newContent := &hclext.BodyContent{Blocks: []*hclext.Block{locals("a")}}
+
+		diff := DiffModuleContent(oldContent, newContent)
+		if len(diff.Added) != 0 || len(diff.Changed) != 0 {
+			t.Errorf("got added=%d changed=%d, want 0/0", len(diff.Added), len(diff.Changed))
+		}
+		if len(diff.Removed) != 1 || diff.Removed[0].Body.Attributes["b"] == nil {
+			t.Errorf("Removed = %v, want the second locals block", diff.Removed)
+		}
+	})
+}
+
+func TestDiffModuleContent_KeepsBlockType(t *testing.T) {
+	// Both blocks have the address var.region
+	oldContent := &hclext.BodyContent{Blocks: []*hclext.Block{{Type: "variable", Labels: []string{"region"}}}}
+	newContent := &hclext.BodyContent{Blocks: []*hclext.Block{{Type: "var", Labels: []string{"region"}}}}
+
+	diff := DiffModuleContent(oldContent, newContent)
+	if len(diff.Added) != 1 || len(diff.Removed) != 1 || len(diff.Changed) != 0 {
+		t.Errorf("got added=%d removed=%d changed=%d, want 1/1/0",
+			len(diff.Added), len(diff.Removed), len(diff.Changed))
+	}
+}
+
+func TestDiffModuleContent_NilContent(t *testing.T) {
+	diff := DiffModuleContent(nil, nil)
+	if diff == nil {
+		t.Fatal("expected non-nil diff")
+	}
+	if len(diff.Added) != 0 || len(diff.Removed) != 0 || len(diff.Changed) != 0 {
+		t.Error("expected empty diff for nil content")
+	}
+}
@@ -0,0 +1,62 @@
+package tflint
+
+import (
+	"github.com/hashicorp/hcl/v2"
+	"github.com/jokarl/tfbreak-plugin-sdk/hclext"
+)
+
+// MovedBlock is a `moved` block declared in the NEW configuration.
+// Rules use moved blocks to tell a rename apart from a removal plus an addition.
+//
+// From and To are the raw traversal strings as written in the configuration
+// (e.g., "aws_instance.old" or "module.network.aws_subnet.this[0]"). They are
+// not normalized, so whitespace-free traversals compare equal to BlockAddress.
+type MovedBlock struct {
+	// From is the previous address of the object.
+	From string
+	// To is the new address of the object.
+	To string
+	// DeclRange is the range of the moved block in the NEW configuration.
+	DeclRange hcl.Range
+}
+
+// ApplyMovedBlocks reconciles renames in the diff using moved blocks.
+// For each moved block whose From address was removed and whose To address
+// was added, both blocks are taken out of Removed and Added and reported as
+// a single entry in Changed, addressed by the new address.
+//
+// Example:
+//
+//	diff, err := runner.GetModuleDiff(schema, nil)
+//	if err != nil {
+//	    return err
+//	}
+//	diff.ApplyMovedBlocks(runner.GetMovedBlocks())
+//	// diff.Removed no longer contains renamed resources
+func (d *ModuleDiff) ApplyMovedBlocks(moved []MovedBlock) {
+	for _, m := range moved {
+		removedIdx := indexOfAddress(d.Removed, m.From)
+		addedIdx := indexOfAddress(d.Added, m.To)
+		if removedIdx < 0 || addedIdx < 0 {
+			continue
+		}
+
+		d.Changed = append(d.Changed, &BlockChange{
+			Address: m.To,
+			Old:     d.Removed[removedIdx],
+			New:     d.Added[addedIdx],
+		})
+		d.Removed = append(d.Removed[:removedIdx], d.Removed[removedIdx+1:]...)
+		d.Added = append(d.Added[:addedIdx], d.Added[addedIdx+1:]...)
+	}
+}
+
+// indexOfAddress returns the index of the block with the given address, or -1.
+func indexOfAddress(blocks []*hclext.Block, address string) int {
+	for i, block := range blocks {
+		if BlockAddress(block) == address {
+			return i
+		}
+	}
+	return -1
+}
@@ -0,0 +1,38 @@
+package tflint
+
+import (
+	"testing"
+
+	"github.com/jokarl/tfbreak-plugin-sdk/hclext"
+)
+
+func TestModuleDiff_ApplyMovedBlocks(t *testing.T) {
+	oldBlock := &hclext.Block{Type: "resource", Labels: []string{"aws_instance", "old"}}
+	newBlock := &hclext.Block{Type: "resource", Labels: []string{"aws_instance", "new"}}
+	otherAdded := &hclext.Block{Type: "resource", Labels: []string{"aws_instance", "other"}}
+	module := &hclext.Block{Type: "module", Labels: []string{"network"}}
+
+	diff := &ModuleDiff{
+		Added:   []*hclext.Block{otherAdded, newBlock},
+		Removed: []*hclext.Block{oldBlock, module},
+	}
+	diff.ApplyMovedBlocks([]MovedBlock{
+		{From: "aws_instance.old", To: "aws_instance.new"},
+		// Target not added: left alone
+		{From: "module.network", To: "module.vpc"},
+	})
+
+	if len(diff.Added) != 1 || diff.Added[0] != otherAdded {
+		t.Errorf("Added = %d blocks, want only aws_instance.other", len(diff.Added))
+	}
+	if len(diff.Removed) != 1 || diff.Removed[0] != module {
+		t.Errorf("Removed = %d blocks, want only module.network", len(diff.Removed))
+	}
+	if len(diff.Changed) != 1 {
+		t.Fatalf("Changed = %d blocks, want 1", len(diff.Changed))
+	}
+	change := diff.Changed[0]
+	if change.Address != "aws_instance.new" || change.Old != oldBlock || change.New != newBlock {
+		t.Errorf("Changed[0] = %+v, want rename of aws_instance.old to aws_instance.new", change)
+	}
+}
@@ -0,0 +1,14 @@
+package tflint
+
+// ProviderRequirement is an entry of a `required_providers` block, keyed by
+// the provider's local name in the map returned by
+// Runner.GetOldProviderRequirements and Runner.GetNewProviderRequirements.
+//
+// Both fields are empty when not declared. An entry written in the legacy
+// string form (e.g., `azurerm = "~> 3.0"`) only sets VersionConstraint.
+type ProviderRequirement struct {
+	// Source is the provider source address (e.g., "hashicorp/azurerm").
+	Source string
+	// VersionConstraint is the version constraint (e.g., ">= 3.0, < 4.0").
+	VersionConstraint string
+}
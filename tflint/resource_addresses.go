@@ -0,0 +1,49 @@
+package tflint
+
+import (
+	"slices"
+
+	"github.com/jokarl/tfbreak-plugin-sdk/hclext"
+)
+
+// OldResourceAddresses is the default implementation of
+// Runner.OldResourceAddresses. It retrieves the resource blocks of the OLD
+// configuration with an empty body schema, so no attributes are extracted,
+// and returns their addresses sorted.
+//
+// Runner implementations can delegate to this function:
+//
+//	func (r *MyRunner) OldResourceAddresses() ([]string, error) {
+//	    return tflint.OldResourceAddresses(r)
+//	}
+func OldResourceAddresses(runner Runner) ([]string, error) {
+	content, err := runner.GetOldModuleContent(resourcesSchema(nil), nil)
+	if err != nil {
+		return nil, err
+	}
+	return resourceAddresses(content), nil
+}
+
+// NewResourceAddresses is the default implementation of
+// Runner.NewResourceAddresses. It is the NEW configuration equivalent of
+// OldResourceAddresses.
+func NewResourceAddresses(runner Runner) ([]string, error) {
+	content, err := runner.GetNewModuleContent(resourcesSchema(nil), nil)
+	if err != nil {
+		return nil, err
+	}
+	return resourceAddresses(content), nil
+}
+
+// resourceAddresses returns the sorted, unique addresses of the resource
+// blocks in content.
+func resourceAddresses(content *hclext.BodyContent) []string {
+	addresses := []string{}
+	for _, block := range content.Blocks {
+		if block.Type == "resource" {
+			addresses = append(addresses, block.Address())
+		}
+	}
+	slices.Sort(addresses)
+	return slices.Compact(addresses)
+}
@@ -0,0 +1,53 @@
+package tflint
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/jokarl/tfbreak-plugin-sdk/hclext"
+)
+
+// moduleContentRunner serves fixed OLD and NEW module content.
+type moduleContentRunner struct {
+	Runner
+	oldContent *hclext.BodyContent
+	newContent *hclext.BodyContent
+}
+
+func (r *moduleContentRunner) GetOldModuleContent(*hclext.BodySchema, *GetModuleContentOption) (*hclext.BodyContent, error) {
+	return r.oldContent, nil
+}
+
+func (r *moduleContentRunner) GetNewModuleContent(*hclext.BodySchema, *GetModuleContentOption) (*hclext.BodyContent, error) {
+	return r.newContent, nil
+}
+
+func TestResourceAddresses(t *testing.T) {
+	resource := func(resourceType, name string) *hclext.Block {
+		return &hclext.Block{Type: "resource", Labels: []string{resourceType, name}}
+	}
+	runner := &moduleContentRunner{
+		oldContent: &hclext.BodyContent{Blocks: []*hclext.Block{
+			resource("azurerm_storage_account", "main"),
+			resource("azurerm_key_vault", "main"),
+			resource("azurerm_storage_account", "main"),
+		}},
+		newContent: &hclext.BodyContent{},
+	}
+
+	got, err := OldResourceAddresses(runner)
+	if err != nil {
+		t.Fatalf("OldResourceAddresses error: %v", err)
+	}
+	if want := []string{"azurerm_key_vault.main", "azurerm_storage_account.main"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("OldResourceAddresses() = %v, want %v", got, want)
+	}
+
+	got, err = NewResourceAddresses(runner)
+	if err != nil {
+		t.Fatalf("NewResourceAddresses error: %v", err)
+	}
+	if got == nil || len(got) != 0 {
+		t.Errorf("NewResourceAddresses() = %#v, want an empty slice", got)
+	}
+}
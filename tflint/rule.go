@@ -12,7 +12,7 @@ package tflint
 //
 //	func (r *MyRule) Name() string { return "my_rule" }
 //	func (r *MyRule) Link() string { return "https://example.com/my_rule" }
-//	func (r *MyRule) Check(runner Runner) error { ... }
+//	func (r *MyRule) Check(ctx context.Context, runner Runner) error { ... }
 //
 // With DefaultRule embedded, MyRule automatically gets:
 //   - Enabled() returning true (rules are enabled by default)
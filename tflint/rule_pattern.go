@@ -0,0 +1,67 @@
+package tflint
+
+import (
+	"fmt"
+	"path"
+	"regexp"
+	"strings"
+)
+
+// MatchRuleName reports whether the rule name matches pattern, as used by
+// Config.Only and Config.Ignore. A pattern enclosed in slashes is a regular
+// expression that must match the whole name, e.g. `/azurerm_.*_(sku|tier)/`.
+// Any other pattern is a glob as understood by path.Match, e.g. "azurerm_*";
+// a plain rule name without glob characters matches only itself.
+func MatchRuleName(pattern, name string) (bool, error) {
+	match, err := compileRulePattern(pattern)
+	if err != nil {
+		return false, err
+	}
+	return match(name), nil
+}
+
+// rulePattern reports whether a rule name matches a compiled pattern.
+type rulePattern func(name string) bool
+
+// compileRulePattern compiles a pattern as described in MatchRuleName.
+func compileRulePattern(pattern string) (rulePattern, error) {
+	if len(pattern) >= 2 && strings.HasPrefix(pattern, "/") && strings.HasSuffix(pattern, "/") {
+		re, err := regexp.Compile("^(?:" + pattern[1:len(pattern)-1] + ")$")
+		if err != nil {
+			return nil, fmt.Errorf("invalid rule pattern %q: %w", pattern, err)
+		}
+		return re.MatchString, nil
+	}
+
+	if _, err := path.Match(pattern, ""); err != nil {
+		return nil, fmt.Errorf("invalid rule pattern %q: %w", pattern, err)
+	}
+	return func(name string) bool {
+		ok, _ := path.Match(pattern, name)
+		return ok
+	}, nil
+}
+
+// compileRulePatterns compiles every pattern, failing on the first
+// invalid one.
+func compileRulePatterns(patterns []string) ([]rulePattern, error) {
+	compiled := make([]rulePattern, 0, len(patterns))
+	for _, pattern := range patterns {
+		match, err := compileRulePattern(pattern)
+		if err != nil {
+			return nil, err
+		}
+		compiled = append(compiled, match)
+	}
+	return compiled, nil
+}
+
+// matchesAny reports whether name matches any of patterns.
+func matchesAny(patterns []rulePattern, name string) bool {
+	for _, match := range patterns {
+		if match(name) {
+			return true
+		}
+	}
+	return false
+}
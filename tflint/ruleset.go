@@ -1,6 +1,11 @@
 package tflint
 
-import "github.com/jokarl/tfbreak-plugin-sdk/hclext"
+import (
+	"errors"
+	"fmt"
+
+	"github.com/jokarl/tfbreak-plugin-sdk/hclext"
+)
 
 // BuiltinRuleSet provides default implementations for the RuleSet interface.
 // Plugin authors embed this struct and override methods as needed.
@@ -30,6 +35,8 @@ type BuiltinRuleSet struct {
 	Rules []Rule
 	// enabledRules tracks which rules are enabled after configuration.
 	enabledRules map[string]bool
+	// severityOverrides tracks configured severities that replace rule defaults.
+	severityOverrides map[string]Severity
 }
 
 // RuleSetName returns the name of the ruleset.
@@ -66,9 +73,24 @@ func (rs *BuiltinRuleSet) ConfigSchema() *hclext.BodySchema {
 }
 
 // ApplyGlobalConfig applies global tfbreak configuration.
-// Handles DisabledByDefault and Only filtering.
+// Handles DisabledByDefault, Only filtering, per-rule severity overrides,
+// Ignore filtering and MinSeverity filtering. Entries in Only and Ignore
+// may be patterns; see MatchRuleName. An invalid pattern is an error and
+// leaves the current configuration unchanged.
 func (rs *BuiltinRuleSet) ApplyGlobalConfig(config *Config) error {
+	var only, ignore []rulePattern
+	if config != nil {
+		var err error
+		if only, err = compileRulePatterns(config.Only); err != nil {
+			return fmt.Errorf("only: %w", err)
+		}
+		if ignore, err = compileRulePatterns(config.Ignore); err != nil {
+			return fmt.Errorf("ignore: %w", err)
+		}
+	}
+
 	rs.enabledRules = make(map[string]bool)
+	rs.severityOverrides = make(map[string]Severity)
 
 	// Initialize with rule defaults
 	for _, rule := range rs.Rules {
@@ -87,14 +109,9 @@ func (rs *BuiltinRuleSet) ApplyGlobalConfig(config *Config) error {
 	}
 
 	// Handle Only filter
-	if len(config.Only) > 0 {
+	if len(only) > 0 {
 		for name := range rs.enabledRules {
-			rs.enabledRules[name] = false
-		}
-		for _, name := range config.Only {
-			if _, ok := rs.enabledRules[name]; ok {
-				rs.enabledRules[name] = true
-			}
+			rs.enabledRules[name] = matchesAny(only, name)
 		}
 	}
 
@@ -102,12 +119,41 @@ func (rs *BuiltinRuleSet) ApplyGlobalConfig(config *Config) error {
 	for name, ruleConfig := range config.Rules {
 		if _, ok := rs.enabledRules[name]; ok {
 			rs.enabledRules[name] = ruleConfig.Enabled
+			if ruleConfig.Severity != nil {
+				rs.severityOverrides[name] = *ruleConfig.Severity
+			}
+		}
+	}
+
+	// Handle Ignore filter. It is applied after Only and the per-rule
+	// configuration, so an ignored rule stays disabled.
+	for name := range rs.enabledRules {
+		if matchesAny(ignore, name) {
+			rs.enabledRules[name] = false
+		}
+	}
+
+	// Handle MinSeverity filter. This only disables rules, so it composes
+	// with Only and DisabledByDefault. Severity overrides are taken into account.
+	if config.MinSeverity != 0 {
+		for _, rule := range rs.Rules {
+			if rs.RuleSeverity(rule) > config.MinSeverity {
+				rs.enabledRules[rule.Name()] = false
+			}
 		}
 	}
 
 	return nil
 }
 
+// ResetConfig discards the configuration applied by ApplyGlobalConfig, so
+// IsRuleEnabled and RuleSeverity fall back to the rules' defaults again.
+// Use it to reuse a ruleset across tests or before re-applying a config.
+func (rs *BuiltinRuleSet) ResetConfig() {
+	rs.enabledRules = nil
+	rs.severityOverrides = nil
+}
+
 // ApplyConfig applies plugin-specific configuration.
 // Default implementation does nothing.
 // Override this method to handle custom plugin configuration.
@@ -141,6 +187,54 @@ func (rs *BuiltinRuleSet) IsRuleEnabled(name string) bool {
 	return rs.enabledRules[name]
 }
 
+// RuleSeverity returns the effective severity of a rule.
+// This is the configured override if one was applied via ApplyGlobalConfig,
+// otherwise the rule's default severity.
+func (rs *BuiltinRuleSet) RuleSeverity(rule Rule) Severity {
+	if severity, ok := rs.severityOverrides[rule.Name()]; ok {
+		return severity
+	}
+	return rule.Severity()
+}
+
+// RuleDescription returns the description of a rule.
+// Rules that do not implement RuleMetadata have an empty description.
+func (rs *BuiltinRuleSet) RuleDescription(rule Rule) string {
+	if metadata, ok := rule.(RuleMetadata); ok {
+		return metadata.Description()
+	}
+	return ""
+}
+
+// RuleTags returns the tags of a rule.
+// Rules that do not implement RuleMetadata have no tags.
+func (rs *BuiltinRuleSet) RuleTags(rule Rule) []string {
+	if metadata, ok := rule.(RuleMetadata); ok {
+		return metadata.Tags()
+	}
+	return []string{}
+}
+
+// RuleDeprecation reports whether a rule is deprecated and the name of the
+// rule replacing it. Rules that do not implement DeprecatedRule are not
+// deprecated.
+func (rs *BuiltinRuleSet) RuleDeprecation(rule Rule) (replacedBy string, deprecated bool) {
+	if d, ok := rule.(DeprecatedRule); ok {
+		return d.Deprecated()
+	}
+	return "", false
+}
+
+// ApplySeverityOverrides wraps the runner so that issues are emitted with
+// the configured severity of each rule instead of the rule's default.
+// The runner is returned unchanged if no overrides are configured.
+func (rs *BuiltinRuleSet) ApplySeverityOverrides(runner Runner) Runner {
+	if len(rs.severityOverrides) == 0 {
+		return runner
+	}
+	return &severityOverrideRunner{Runner: runner, ruleset: rs}
+}
+
 // GetRule returns a rule by name, or nil if not found.
 func (rs *BuiltinRuleSet) GetRule(name string) Rule {
 	for _, rule := range rs.Rules {
@@ -161,3 +255,27 @@ func (rs *BuiltinRuleSet) EnabledRules() []Rule {
 	}
 	return enabled
 }
+
+// Validate reports rules with an empty name and rule names declared more
+// than once. Rules are identified by name, so GetRule, EnabledRules and
+// the rule configuration cannot tell such rules apart. plugin.Serve calls
+// Validate and refuses to serve an invalid ruleset.
+func (rs *BuiltinRuleSet) Validate() error {
+	var errs []error
+	counts := make(map[string]int)
+	for i, rule := range rs.Rules {
+		name := rule.Name()
+		if name == "" {
+			errs = append(errs, fmt.Errorf("rule %d (%T) has an empty name", i, rule))
+			continue
+		}
+		counts[name]++
+		if counts[name] == 2 {
+			errs = append(errs, fmt.Errorf("rule %q is declared more than once", name))
+		}
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("ruleset %q: %w", rs.Name, errors.Join(errs...))
+	}
+	return nil
+}
@@ -1,7 +1,9 @@
 package tflint
 
 import (
+	"context"
 	"reflect"
+	"strings"
 	"testing"
 )
 
@@ -14,7 +16,7 @@ type testRule struct {
 
 func (r *testRule) Name() string     { return r.name }
 func (r *testRule) Link() string     { return "" }
-func (r *testRule) Check(_ Runner) error { return nil }
+func (r *testRule) Check(_ context.Context, _ Runner) error { return nil }
 func (r *testRule) Enabled() bool    { return r.enabled }
 
 func newTestRule(name string, enabled bool) *testRule {
@@ -139,6 +141,321 @@ func TestBuiltinRuleSet_ApplyGlobalConfig_Only(t *testing.T) {
 	}
 }
 
+func TestBuiltinRuleSet_ApplyGlobalConfig_Patterns(t *testing.T) {
+	tests := []struct {
+		name   string
+		config *Config
+		want   map[string]bool
+	}{
+		{
+			name:   "glob in Only",
+			config: &Config{Only: []string{"azurerm_*"}},
+			want:   map[string]bool{"azurerm_location": true, "azurerm_sku": true, "azuread_app": false},
+		},
+		{
+			name:   "regex in Only",
+			config: &Config{Only: []string{"/azure(rm|ad)_(sku|app)/"}},
+			want:   map[string]bool{"azurerm_location": false, "azurerm_sku": true, "azuread_app": true},
+		},
+		{
+			name:   "regex matches the whole name",
+			config: &Config{Only: []string{"/azurerm/"}},
+			want:   map[string]bool{"azurerm_location": false, "azurerm_sku": false, "azuread_app": false},
+		},
+		{
+			name:   "Ignore alone",
+			config: &Config{Ignore: []string{"*_sku"}},
+			want:   map[string]bool{"azurerm_location": true, "azurerm_sku": false, "azuread_app": true},
+		},
+		{
+			name:   "Ignore beats Only",
+			config: &Config{Only: []string{"azurerm_*"}, Ignore: []string{"/.*_sku/"}},
+			want:   map[string]bool{"azurerm_location": true, "azurerm_sku": false, "azuread_app": false},
+		},
+		{
+			name: "Ignore beats rule configuration",
+			config: &Config{
+				Ignore: []string{"azurerm_sku"},
+				Rules:  map[string]*RuleConfig{"azurerm_sku": {Name: "azurerm_sku", Enabled: true}},
+			},
+			want: map[string]bool{"azurerm_location": true, "azurerm_sku": false, "azuread_app": true},
+		},
+		{
+			name:   "Only beats defaults",
+			config: &Config{DisabledByDefault: true, Only: []string{"azuread_*"}},
+			want:   map[string]bool{"azurerm_location": false, "azurerm_sku": false, "azuread_app": true},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rs := &BuiltinRuleSet{
+				Rules: []Rule{
+					newTestRule("azurerm_location", true),
+					newTestRule("azurerm_sku", true),
+					newTestRule("azuread_app", true),
+				},
+			}
+			if err := rs.ApplyGlobalConfig(tt.config); err != nil {
+				t.Fatalf("ApplyGlobalConfig() = %v, want nil", err)
+			}
+			for name, want := range tt.want {
+				if got := rs.IsRuleEnabled(name); got != want {
+					t.Errorf("IsRuleEnabled(%q) = %v, want %v", name, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestBuiltinRuleSet_ApplyGlobalConfig_Ignore(t *testing.T) {
+	rs := &BuiltinRuleSet{
+		Rules: []Rule{
+			newTestRule("rule_a", true),
+			newTestRule("rule_b", true),
+			newTestRule("rule_c", false),
+		},
+	}
+
+	if err := rs.ApplyGlobalConfig(&Config{Ignore: []string{"rule_a"}}); err != nil {
+		t.Fatalf("ApplyGlobalConfig() = %v, want nil", err)
+	}
+	if rs.IsRuleEnabled("rule_a") {
+		t.Error("rule_a should be disabled (ignored, although enabled by default)")
+	}
+	if !rs.IsRuleEnabled("rule_b") {
+		t.Error("rule_b should be enabled (not ignored)")
+	}
+
+	config := &Config{Only: []string{"rule_b", "rule_c"}, Ignore: []string{"rule_b"}}
+	if err := rs.ApplyGlobalConfig(config); err != nil {
+		t.Fatalf("ApplyGlobalConfig() = %v, want nil", err)
+	}
+	if rs.IsRuleEnabled("rule_b") {
+		t.Error("rule_b should be disabled (ignored, although in Only list)")
+	}
+	if !rs.IsRuleEnabled("rule_c") {
+		t.Error("rule_c should be enabled (in Only list)")
+	}
+}
+
+func TestBuiltinRuleSet_ApplyGlobalConfig_InvalidPattern(t *testing.T) {
+	rs := &BuiltinRuleSet{Rules: []Rule{newTestRule("rule_a", true)}}
+	if err := rs.ApplyGlobalConfig(&Config{Only: []string{"rule_a"}}); err != nil {
+		t.Fatalf("ApplyGlobalConfig() = %v, want nil", err)
+	}
+
+	for _, config := range []*Config{{Only: []string{"rule_["}}, {Ignore: []string{"/rule_(/"}}} {
+		if err := rs.ApplyGlobalConfig(config); err == nil {
+			t.Errorf("ApplyGlobalConfig(%+v) = nil, want error", config)
+		}
+	}
+	if !rs.IsRuleEnabled("rule_a") {
+		t.Error("rule_a should stay enabled after an invalid configuration")
+	}
+}
+
+func TestMatchRuleName(t *testing.T) {
+	tests := []struct {
+		pattern string
+		name    string
+		want    bool
+	}{
+		{"azurerm_location", "azurerm_location", true},
+		{"azurerm_location", "azurerm_location_v2", false},
+		{"azurerm_*", "azurerm_location", true},
+		{"azurerm_?ku", "azurerm_sku", true},
+		{"/azurerm_.*/", "azurerm_location", true},
+		{"/azurerm_.*/", "my_azurerm_location", false},
+		{"/", "/", true},
+	}
+	for _, tt := range tests {
+		got, err := MatchRuleName(tt.pattern, tt.name)
+		if err != nil {
+			t.Errorf("MatchRuleName(%q, %q) error = %v", tt.pattern, tt.name, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("MatchRuleName(%q, %q) = %v, want %v", tt.pattern, tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestBuiltinRuleSet_ResetConfig(t *testing.T) {
+	warning := WARNING
+	rs := &BuiltinRuleSet{
+		Rules: []Rule{
+			newTestRule("rule_a", true),
+			newSeverityTestRule("rule_b", ERROR),
+			newTestRule("rule_c", false),
+		},
+	}
+
+	config := &Config{
+		DisabledByDefault: true,
+		Rules:             map[string]*RuleConfig{"rule_b": {Name: "rule_b", Enabled: true, Severity: &warning}},
+	}
+	if err := rs.ApplyGlobalConfig(config); err != nil {
+		t.Fatalf("ApplyGlobalConfig() = %v, want nil", err)
+	}
+	if rs.IsRuleEnabled("rule_a") {
+		t.Fatal("rule_a should be disabled (DisabledByDefault)")
+	}
+
+	rs.ResetConfig()
+
+	for name, want := range map[string]bool{"rule_a": true, "rule_b": true, "rule_c": false} {
+		if got := rs.IsRuleEnabled(name); got != want {
+			t.Errorf("IsRuleEnabled(%q) = %v, want %v (rule default)", name, got, want)
+		}
+	}
+	if got := rs.RuleSeverity(rs.GetRule("rule_b")); got != ERROR {
+		t.Errorf("RuleSeverity(rule_b) = %v, want ERROR (rule default)", got)
+	}
+}
+
+// severityTestRule is a test rule with a configurable severity.
+type severityTestRule struct {
+	testRule
+	severity Severity
+}
+
+func (r *severityTestRule) Severity() Severity { return r.severity }
+
+func newSeverityTestRule(name string, severity Severity) *severityTestRule {
+	return &severityTestRule{testRule: testRule{name: name, enabled: true}, severity: severity}
+}
+
+// metadataTestRule is a test rule that implements RuleMetadata.
+type metadataTestRule struct {
+	testRule
+}
+
+func (r *metadataTestRule) Description() string { return "Detects removed resources" }
+func (r *metadataTestRule) Tags() []string      { return []string{"removal"} }
+
+func TestBuiltinRuleSet_RuleMetadata(t *testing.T) {
+	rs := &BuiltinRuleSet{}
+	withMetadata := &metadataTestRule{testRule: testRule{name: "with_metadata"}}
+	withoutMetadata := &testRule{name: "without_metadata"}
+
+	if got := rs.RuleDescription(withMetadata); got != "Detects removed resources" {
+		t.Errorf("RuleDescription() = %q, want %q", got, "Detects removed resources")
+	}
+	if got := rs.RuleTags(withMetadata); len(got) != 1 || got[0] != "removal" {
+		t.Errorf("RuleTags() = %v, want [removal]", got)
+	}
+
+	if got := rs.RuleDescription(withoutMetadata); got != "" {
+		t.Errorf("RuleDescription() = %q, want empty", got)
+	}
+	if got := rs.RuleTags(withoutMetadata); got == nil || len(got) != 0 {
+		t.Errorf("RuleTags() = %#v, want empty slice", got)
+	}
+}
+
+// deprecatedTestRule is a test rule that implements DeprecatedRule.
+type deprecatedTestRule struct {
+	testRule
+}
+
+func (r *deprecatedTestRule) Deprecated() (string, bool) { return "new_rule", true }
+
+func TestBuiltinRuleSet_RuleDeprecation(t *testing.T) {
+	rs := &BuiltinRuleSet{}
+
+	replacedBy, ok := rs.RuleDeprecation(&deprecatedTestRule{testRule: testRule{name: "old_rule"}})
+	if !ok || replacedBy != "new_rule" {
+		t.Errorf("RuleDeprecation() = (%q, %v), want (\"new_rule\", true)", replacedBy, ok)
+	}
+
+	replacedBy, ok = rs.RuleDeprecation(&testRule{name: "new_rule"})
+	if ok || replacedBy != "" {
+		t.Errorf("RuleDeprecation() = (%q, %v), want (\"\", false)", replacedBy, ok)
+	}
+}
+
+func TestBuiltinRuleSet_ApplyGlobalConfig_MinSeverity(t *testing.T) {
+	tests := []struct {
+		name        string
+		minSeverity Severity
+		want        map[string]bool
+	}{
+		{"unset keeps all", 0, map[string]bool{"error_rule": true, "warning_rule": true, "notice_rule": true}},
+		{"ERROR keeps only ERROR", ERROR, map[string]bool{"error_rule": true, "warning_rule": false, "notice_rule": false}},
+		{"WARNING drops NOTICE", WARNING, map[string]bool{"error_rule": true, "warning_rule": true, "notice_rule": false}},
+		{"NOTICE keeps all", NOTICE, map[string]bool{"error_rule": true, "warning_rule": true, "notice_rule": true}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rs := &BuiltinRuleSet{
+				Rules: []Rule{
+					newSeverityTestRule("error_rule", ERROR),
+					newSeverityTestRule("warning_rule", WARNING),
+					newSeverityTestRule("notice_rule", NOTICE),
+				},
+			}
+
+			if err := rs.ApplyGlobalConfig(&Config{MinSeverity: tt.minSeverity}); err != nil {
+				t.Fatalf("ApplyGlobalConfig() = %v, want nil", err)
+			}
+
+			for name, want := range tt.want {
+				if got := rs.IsRuleEnabled(name); got != want {
+					t.Errorf("IsRuleEnabled(%q) = %v, want %v", name, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestBuiltinRuleSet_ApplyGlobalConfig_MinSeverityComposes(t *testing.T) {
+	notice := NOTICE
+	rs := &BuiltinRuleSet{
+		Rules: []Rule{
+			newSeverityTestRule("error_rule", ERROR),
+			newSeverityTestRule("other_error_rule", ERROR),
+			newSeverityTestRule("warning_rule", WARNING),
+			newSeverityTestRule("overridden_rule", ERROR),
+		},
+	}
+
+	config := &Config{
+		Only:        []string{"error_rule", "warning_rule", "overridden_rule"},
+		MinSeverity: ERROR,
+		Rules: map[string]*RuleConfig{
+			"overridden_rule": {Name: "overridden_rule", Enabled: true, Severity: &notice},
+		},
+	}
+	if err := rs.ApplyGlobalConfig(config); err != nil {
+		t.Fatalf("ApplyGlobalConfig() = %v, want nil", err)
+	}
+
+	if !rs.IsRuleEnabled("error_rule") {
+		t.Error("error_rule should be enabled (in Only list and severe enough)")
+	}
+	if rs.IsRuleEnabled("other_error_rule") {
+		t.Error("other_error_rule should be disabled (not in Only list)")
+	}
+	if rs.IsRuleEnabled("warning_rule") {
+		t.Error("warning_rule should be disabled (below MinSeverity)")
+	}
+	if rs.IsRuleEnabled("overridden_rule") {
+		t.Error("overridden_rule should be disabled (overridden below MinSeverity)")
+	}
+
+	disabledByDefault := &BuiltinRuleSet{
+		Rules: []Rule{newSeverityTestRule("error_rule", ERROR)},
+	}
+	if err := disabledByDefault.ApplyGlobalConfig(&Config{DisabledByDefault: true, MinSeverity: NOTICE}); err != nil {
+		t.Fatalf("ApplyGlobalConfig() = %v, want nil", err)
+	}
+	if disabledByDefault.IsRuleEnabled("error_rule") {
+		t.Error("MinSeverity must not re-enable rules disabled by DisabledByDefault")
+	}
+}
+
 func TestBuiltinRuleSet_ApplyGlobalConfig_RuleConfig(t *testing.T) {
 	rs := &BuiltinRuleSet{
 		Rules: []Rule{
@@ -258,6 +575,57 @@ func TestBuiltinRuleSet_BuiltinImpl(t *testing.T) {
 	}
 }
 
+func TestBuiltinRuleSet_Validate(t *testing.T) {
+	tests := []struct {
+		name  string
+		rules []Rule
+		want  []string
+	}{
+		{
+			name:  "valid",
+			rules: []Rule{newTestRule("rule1", true), newTestRule("rule2", false)},
+		},
+		{
+			name:  "no rules",
+			rules: nil,
+		},
+		{
+			name:  "duplicate name",
+			rules: []Rule{newTestRule("rule1", true), newTestRule("rule2", true), newTestRule("rule1", false), newTestRule("rule1", true)},
+			want:  []string{`ruleset "test"`, `rule "rule1" is declared more than once`},
+		},
+		{
+			name:  "empty name",
+			rules: []Rule{newTestRule("rule1", true), newTestRule("", true)},
+			want:  []string{`ruleset "test"`, "rule 1 (*tflint.testRule) has an empty name"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rs := &BuiltinRuleSet{Name: "test", Rules: tt.rules}
+			err := rs.Validate()
+			if len(tt.want) == 0 {
+				if err != nil {
+					t.Errorf("Validate() error = %v, want nil", err)
+				}
+				return
+			}
+			if err == nil {
+				t.Fatal("Validate() error = nil, want error")
+			}
+			for _, want := range tt.want {
+				if !strings.Contains(err.Error(), want) {
+					t.Errorf("Validate() error = %q, want it to contain %q", err, want)
+				}
+			}
+			if got := strings.Count(err.Error(), "more than once"); got > 1 {
+				t.Errorf("duplicate reported %d times, want once", got)
+			}
+		})
+	}
+}
+
 // TestBuiltinRuleSet_ImplementsRuleSet verifies BuiltinRuleSet satisfies RuleSet.
 func TestBuiltinRuleSet_ImplementsRuleSet(t *testing.T) {
 	var _ RuleSet = &BuiltinRuleSet{}
@@ -271,3 +639,31 @@ func contains(slice []string, s string) bool {
 	}
 	return false
 }
+
+func TestBuiltinRuleSet_RuleSeverity(t *testing.T) {
+	ruleA := newTestRule("rule_a", true)
+	ruleB := newTestRule("rule_b", true)
+	rs := &BuiltinRuleSet{Rules: []Rule{ruleA, ruleB}}
+
+	if got := rs.RuleSeverity(ruleA); got != ERROR {
+		t.Errorf("RuleSeverity() before config = %v, want ERROR", got)
+	}
+
+	notice := NOTICE
+	err := rs.ApplyGlobalConfig(&Config{
+		Rules: map[string]*RuleConfig{
+			"rule_a": {Name: "rule_a", Enabled: true, Severity: &notice},
+			"rule_b": {Name: "rule_b", Enabled: true},
+		},
+	})
+	if err != nil {
+		t.Fatalf("ApplyGlobalConfig failed: %v", err)
+	}
+
+	if got := rs.RuleSeverity(ruleA); got != NOTICE {
+		t.Errorf("RuleSeverity(rule_a) = %v, want NOTICE", got)
+	}
+	if got := rs.RuleSeverity(ruleB); got != ERROR {
+		t.Errorf("RuleSeverity(rule_b) = %v, want ERROR", got)
+	}
+}
@@ -1,7 +1,11 @@
 package tflint
 
 import (
+	"fmt"
+
 	"github.com/hashicorp/hcl/v2"
+	"github.com/zclconf/go-cty/cty"
+
 	"github.com/jokarl/tfbreak-plugin-sdk/hclext"
 )
 
@@ -52,9 +56,296 @@ type Runner interface {
 	//	}, nil)
 	GetNewResourceContent(resourceType string, schema *hclext.BodySchema, opts *GetModuleContentOption) (*hclext.BodyContent, error)
 
+	// GetResourceContentPair retrieves resources of a specific type from both
+	// the OLD and NEW configurations in a single call. It is equivalent to
+	// calling GetOldResourceContent and GetNewResourceContent with the same
+	// arguments, but makes one callback to the host instead of two.
+	//
+	// Example:
+	//
+	//	oldContent, newContent, err := runner.GetResourceContentPair("azurerm_storage_account", &hclext.BodySchema{
+	//	    Attributes: []hclext.AttributeSchema{{Name: "account_tier"}},
+	//	}, nil)
+	GetResourceContentPair(resourceType string, schema *hclext.BodySchema, opts *GetModuleContentOption) (oldContent, newContent *hclext.BodyContent, err error)
+
+	// GetOldDataSourceContent retrieves data sources of a specific type from the OLD configuration.
+	// This is the equivalent of GetOldResourceContent for "data" blocks.
+	//
+	// Example:
+	//
+	//	content, err := runner.GetOldDataSourceContent("azurerm_client_config", &hclext.BodySchema{}, nil)
+	GetOldDataSourceContent(dataSourceType string, schema *hclext.BodySchema, opts *GetModuleContentOption) (*hclext.BodyContent, error)
+
+	// GetNewDataSourceContent retrieves data sources of a specific type from the NEW configuration.
+	// This is the equivalent of GetNewResourceContent for "data" blocks.
+	GetNewDataSourceContent(dataSourceType string, schema *hclext.BodySchema, opts *GetModuleContentOption) (*hclext.BodyContent, error)
+
+	// GetOldFile returns the parsed file with the given name from the OLD configuration.
+	// Use this when a rule needs the entire file rather than schema-filtered content.
+	// Returns an error if the file does not exist.
+	GetOldFile(filename string) (*hcl.File, error)
+
+	// GetNewFile returns the parsed file with the given name from the NEW configuration.
+	// Returns an error if the file does not exist.
+	GetNewFile(filename string) (*hcl.File, error)
+
+	// GetFileSource returns the raw source of the file with the given name from
+	// the configuration selected by side. Use it to map an hcl.Range to exact
+	// byte offsets, e.g. to extract a snippet or build a Fix.
+	// Returns an error if the file does not exist.
+	// Implementations typically delegate to the package-level GetFileSource.
+	//
+	// Example:
+	//
+	//	src, err := runner.GetFileSource(attr.Range.Filename, tflint.SideNew)
+	//	if err != nil {
+	//	    return err
+	//	}
+	//	snippet := attr.Range.SliceBytes(src)
+	GetFileSource(filename string, side Side) ([]byte, error)
+
+	// ListOldFiles returns the names of all files in the OLD configuration, sorted.
+	ListOldFiles() []string
+
+	// ListNewFiles returns the names of all files in the NEW configuration, sorted.
+	ListNewFiles() []string
+
+	// FileChanges compares the file names of both configurations. It returns
+	// the files only in the NEW configuration (added), only in the OLD
+	// configuration (removed), and in both (common), each sorted. Rules can
+	// use it to tell a resource in an added file from one that was added to
+	// an existing file.
+	//
+	// Custom Runner implementations can delegate to tflint.FileChanges.
+	//
+	// Example:
+	//
+	//	added, _, _ := runner.FileChanges()
+	//	if slices.Contains(added, block.DefRange.Filename) {
+	//	    // the whole file is new
+	//	}
+	FileChanges() (added, removed, common []string)
+
+	// GetOldProviderRequirements returns the providers declared in
+	// `terraform { required_providers { ... } }` blocks of the OLD configuration,
+	// keyed by local name. Returns an empty map if there are none.
+	//
+	// Example:
+	//
+	//	reqs, err := runner.GetNewProviderRequirements()
+	//	if err != nil {
+	//	    return err
+	//	}
+	//	if req, ok := reqs["azurerm"]; ok {
+	//	    // gate the rule on req.VersionConstraint
+	//	}
+	GetOldProviderRequirements() (map[string]ProviderRequirement, error)
+
+	// GetNewProviderRequirements returns the providers declared in
+	// `terraform { required_providers { ... } }` blocks of the NEW configuration.
+	// See GetOldProviderRequirements.
+	GetNewProviderRequirements() (map[string]ProviderRequirement, error)
+
+	// GetOldTerraformSettings returns the required_version, backend and
+	// required_providers of the `terraform` blocks in the OLD configuration's
+	// root module. A configuration without terraform blocks returns empty
+	// settings with a nil Backend.
+	//
+	// Example:
+	//
+	//	oldSettings, err := runner.GetOldTerraformSettings()
+	//	...
+	//	newSettings, err := runner.GetNewTerraformSettings()
+	//	...
+	//	if oldSettings.Backend != nil && newSettings.Backend != nil &&
+	//	    oldSettings.Backend.Type != newSettings.Backend.Type {
+	//	    runner.EmitIssue(rule, "backend type changed", newSettings.Backend.DeclRange)
+	//	}
+	GetOldTerraformSettings() (*TerraformSettings, error)
+
+	// GetNewTerraformSettings returns the terraform block settings of the
+	// NEW configuration. See GetOldTerraformSettings.
+	GetNewTerraformSettings() (*TerraformSettings, error)
+
+	// GetOldProviderConfig returns the `provider` block of the OLD
+	// configuration's root module with the given name, with its body
+	// extracted using schema. The name is the provider's local name for the
+	// default configuration (e.g., "azurerm"), or the local name and alias
+	// joined with a dot for an aliased one (e.g., "azurerm.west"). The
+	// alias attribute is always extracted. Returns nil if there is no such
+	// block.
+	//
+	// Example:
+	//
+	//	schema := hclext.NewSchema().Block("features", nil).Build()
+	//	oldProvider, err := runner.GetOldProviderConfig("azurerm", schema)
+	//	...
+	//	newProvider, err := runner.GetNewProviderConfig("azurerm", schema)
+	//	...
+	//	if oldProvider != nil && newProvider != nil && len(newProvider.Body.Blocks) == 0 {
+	//	    runner.EmitIssue(rule, "features block removed", newProvider.DefRange)
+	//	}
+	GetOldProviderConfig(name string, schema *hclext.BodySchema) (*hclext.Block, error)
+
+	// GetNewProviderConfig returns the `provider` block of the NEW
+	// configuration with the given name. See GetOldProviderConfig.
+	GetNewProviderConfig(name string, schema *hclext.BodySchema) (*hclext.Block, error)
+
+	// GetOldVariables returns the `variable` blocks of the OLD configuration,
+	// ordered by file name and then by position in the file.
+	//
+	// Example:
+	//
+	//	oldVars, err := runner.GetOldVariables()
+	//	...
+	//	for _, v := range newVars {
+	//	    if v.Required && !declaredIn(oldVars, v.Name) {
+	//	        // a new required variable breaks existing callers
+	//	    }
+	//	}
+	GetOldVariables() ([]VariableDef, error)
+
+	// GetNewVariables returns the `variable` blocks of the NEW configuration.
+	// See GetOldVariables.
+	GetNewVariables() ([]VariableDef, error)
+
+	// GetOldOutputs returns the `output` blocks of the OLD configuration,
+	// ordered by file name and then by position in the file.
+	GetOldOutputs() ([]OutputDef, error)
+
+	// GetNewOutputs returns the `output` blocks of the NEW configuration.
+	// See GetOldOutputs.
+	GetNewOutputs() ([]OutputDef, error)
+
+	// GetOldModuleCalls returns the `module` blocks of the OLD configuration,
+	// ordered by file name and then by position in the file.
+	//
+	// Example:
+	//
+	//	oldCalls, err := runner.GetOldModuleCalls()
+	//	...
+	//	for _, call := range newCalls {
+	//	    if old, ok := callsByName[call.Name]; ok && old.Source != call.Source {
+	//	        runner.EmitIssue(rule, "module source changed", call.DeclRange)
+	//	    }
+	//	}
+	GetOldModuleCalls() ([]ModuleCall, error)
+
+	// GetNewModuleCalls returns the `module` blocks of the NEW configuration.
+	// See GetOldModuleCalls.
+	GetNewModuleCalls() ([]ModuleCall, error)
+
+	// GetOldLocals evaluates the `locals` blocks of the OLD configuration and
+	// returns the local values by name. Locals that cannot be determined
+	// statically, such as those referencing variables or resources, are
+	// unknown. Use them to build an evaluation context for expressions
+	// referencing `local.<name>`. Over gRPC, attribute expressions are not
+	// available, so parse the attribute's SourceBytes instead.
+	//
+	// Example:
+	//
+	//	locals, err := runner.GetNewLocals()
+	//	...
+	//	ctx := &hcl.EvalContext{
+	//	    Variables: map[string]cty.Value{"local": cty.ObjectVal(locals)},
+	//	}
+	//	expr, _ := hclsyntax.ParseExpression(attr.SourceBytes, attr.Range.Filename, attr.Range.Start)
+	//	val, diags := expr.Value(ctx)
+	GetOldLocals() (map[string]cty.Value, error)
+
+	// GetNewLocals evaluates the `locals` blocks of the NEW configuration.
+	// See GetOldLocals.
+	GetNewLocals() (map[string]cty.Value, error)
+
+	// GetAllOldResources returns every `resource` block of the OLD
+	// configuration without requiring a schema. Each block's body holds all
+	// of its attributes and nested blocks, recursively, so exploratory rules
+	// can inspect resources of any type. Prefer GetOldResourceContent when
+	// the attributes of interest are known.
+	//
+	// Example:
+	//
+	//	resources, err := runner.GetAllNewResources()
+	//	...
+	//	for _, resource := range resources {
+	//	    if _, ok := resource.Body.Attributes["tags"]; !ok {
+	//	        runner.EmitIssue(rule, resource.Address()+" has no tags", resource.DefRange)
+	//	    }
+	//	}
+	GetAllOldResources() ([]*hclext.Block, error)
+
+	// GetAllNewResources returns every `resource` block of the NEW
+	// configuration. See GetAllOldResources.
+	GetAllNewResources() ([]*hclext.Block, error)
+
+	// OldResourceAddresses returns the addresses ("type.name") of the
+	// `resource` blocks in the OLD configuration's root module, sorted and
+	// without duplicates. It is cheaper than retrieving resource content when
+	// a rule only needs to know which resources exist.
+	//
+	// Custom Runner implementations can delegate to tflint.OldResourceAddresses.
+	//
+	// Example:
+	//
+	//	oldAddrs, err := runner.OldResourceAddresses()
+	//	...
+	//	newAddrs, err := runner.NewResourceAddresses()
+	//	...
+	//	for _, addr := range oldAddrs {
+	//	    if _, found := slices.BinarySearch(newAddrs, addr); !found {
+	//	        // the resource was removed
+	//	    }
+	//	}
+	OldResourceAddresses() ([]string, error)
+
+	// NewResourceAddresses returns the addresses of the `resource` blocks in
+	// the NEW configuration. See OldResourceAddresses.
+	NewResourceAddresses() ([]string, error)
+
+	// GetMovedBlocks returns the `moved` blocks declared in the NEW configuration.
+	// Addresses are the raw traversal strings as written; see MovedBlock.
+	// Use ModuleDiff.ApplyMovedBlocks to treat renamed blocks as changed.
+	GetMovedBlocks() []MovedBlock
+
+	// GetModuleDiff retrieves module content from both configurations using the
+	// same schema and pairs blocks by module path, Type and Labels.
+	// Implementations typically delegate to the package-level GetModuleDiff.
+	//
+	// Example:
+	//
+	//	diff, err := runner.GetModuleDiff(&hclext.BodySchema{
+	//	    Blocks: []hclext.BlockSchema{
+	//	        {Type: "resource", LabelNames: []string{"type", "name"}},
+	//	    },
+	//	}, nil)
+	//	for _, block := range diff.Removed {
+	//	    runner.EmitIssue(rule, "resource removed", block.DefRange)
+	//	}
+	GetModuleDiff(schema *hclext.BodySchema, opts *GetModuleContentOption) (*ModuleDiff, error)
+
+	// WalkOldResources calls fn for every resource block in the OLD configuration,
+	// regardless of type, with schema applied to each block's body.
+	// The walk stops at the first error returned by fn, which is returned.
+	// Implementations typically delegate to the package-level WalkOldResources.
+	//
+	// Example:
+	//
+	//	err := runner.WalkOldResources(&hclext.BodySchema{
+	//	    Blocks: []hclext.BlockSchema{{Type: "lifecycle"}},
+	//	}, func(resourceType string, block *hclext.Block) error {
+	//	    // inspect block.Body.Blocks
+	//	    return nil
+	//	})
+	WalkOldResources(schema *hclext.BodySchema, fn WalkResourcesFunc) error
+
+	// WalkNewResources calls fn for every resource block in the NEW configuration.
+	// See WalkOldResources.
+	WalkNewResources(schema *hclext.BodySchema, fn WalkResourcesFunc) error
+
 	// EmitIssue reports a finding from the rule.
 	// The issueRange should point to the relevant location in the NEW configuration.
 	// For breaking changes, this is typically where the problematic change was made.
+	// Use EmitIssueWithOptions for findings located in the OLD configuration.
 	//
 	// Example:
 	//
@@ -63,6 +354,48 @@ type Runner interface {
 	//	}
 	EmitIssue(rule Rule, message string, issueRange hcl.Range) error
 
+	// EmitIssueWithFix reports a finding along with a suggested fix.
+	// Hosts that do not support fixes report the issue as if EmitIssue
+	// had been called, so rules can always provide a fix when one is known.
+	//
+	// Example:
+	//
+	//	runner.EmitIssueWithFix(rule, "argument renamed", newAttr.NameRange, &tflint.Fix{
+	//	    Edits: []tflint.TextEdit{
+	//	        {Range: newAttr.NameRange, NewText: oldAttr.Name},
+	//	    },
+	//	})
+	EmitIssueWithFix(rule Rule, message string, issueRange hcl.Range, fix *Fix) error
+
+	// EmitIssueWithSeverity reports a finding with a severity that replaces
+	// rule.Severity() for this issue only. Use this when a single rule detects
+	// changes of different impact.
+	//
+	// Example:
+	//
+	//	if removed {
+	//	    runner.EmitIssueWithSeverity(rule, tflint.ERROR, "attribute removed", oldAttr.Range)
+	//	} else {
+	//	    runner.EmitIssueWithSeverity(rule, tflint.WARNING, "default changed", newAttr.Range)
+	//	}
+	EmitIssueWithSeverity(rule Rule, severity Severity, message string, issueRange hcl.Range) error
+
+	// EmitIssueWithOptions reports a finding with any combination of the
+	// attributes in opts: a per-issue severity, a kind, a resource address,
+	// the side the range refers to, and a fix. Hosts that do not support an
+	// attribute report the issue without it.
+	//
+	// Example:
+	//
+	//	for _, block := range diff.Removed {
+	//	    runner.EmitIssueWithOptions(rule, "resource removed", block.DefRange, tflint.IssueOptions{
+	//	        Kind:            tflint.KindBreaking,
+	//	        ResourceAddress: tflint.BlockAddress(block),
+	//	        Side:            tflint.SideOld,
+	//	    })
+	//	}
+	EmitIssueWithOptions(rule Rule, message string, issueRange hcl.Range, opts IssueOptions) error
+
 	// DecodeRuleConfig retrieves and decodes the rule's configuration.
 	// The target should be a pointer to a struct with hcl tags.
 	// Returns nil if no configuration is provided for the rule.
@@ -77,6 +410,22 @@ type Runner interface {
 	//	    return err
 	//	}
 	DecodeRuleConfig(ruleName string, target any) error
+
+	// DecodeRuleConfigExists works like DecodeRuleConfig, but also reports
+	// whether configuration was provided for the rule, so a rule can tell
+	// "no config, use defaults" from a config that sets zero values.
+	//
+	// Example:
+	//
+	//	var config MyRuleConfig
+	//	exists, err := runner.DecodeRuleConfigExists("my_rule", &config)
+	//	if err != nil {
+	//	    return err
+	//	}
+	//	if !exists {
+	//	    config = defaultConfig
+	//	}
+	DecodeRuleConfigExists(ruleName string, target any) (bool, error)
 }
 
 // GetModuleContentOption configures how content is retrieved.
@@ -108,10 +457,39 @@ type ExpandMode int
 const (
 	// ExpandModeNone does not expand dynamic blocks.
 	ExpandModeNone ExpandMode = iota
-	// ExpandModeExpand expands dynamic blocks (not currently implemented).
+	// ExpandModeExpand expands dynamic blocks into the blocks they generate.
+	// Dynamic blocks whose for_each cannot be evaluated are skipped.
 	ExpandModeExpand
 )
 
+// Side selects the OLD or NEW configuration.
+type Side int
+
+const (
+	// SideUnspecified is the zero value. Issues emitted without a side
+	// refer to the NEW configuration.
+	SideUnspecified Side = iota
+	// SideOld selects the OLD (baseline) configuration.
+	SideOld
+	// SideNew selects the NEW configuration.
+	SideNew
+)
+
+// String returns the lowercase name of the side ("old", "new" or
+// "unspecified").
+func (s Side) String() string {
+	switch s {
+	case SideUnspecified:
+		return "unspecified"
+	case SideOld:
+		return "old"
+	case SideNew:
+		return "new"
+	default:
+		return fmt.Sprintf("Side(%d)", int(s))
+	}
+}
+
 // GetModuleContentHint provides optimization hints for content retrieval.
 type GetModuleContentHint struct {
 	// ResourceType hints at the expected resource type for optimization.
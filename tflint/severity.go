@@ -7,6 +7,7 @@
 //
 // Key types:
 //   - Severity: Issue severity levels (ERROR, WARNING, NOTICE)
+//   - IssueKind: Issue classification (breaking, warning, info)
 //   - DefaultRule: Embeddable struct providing default Rule method implementations
 //   - Rule: Interface that plugins implement for each detection rule
 //   - Runner: Interface providing config access and issue emission (dual-config model)
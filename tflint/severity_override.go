@@ -0,0 +1,67 @@
+package tflint
+
+import "github.com/hashicorp/hcl/v2"
+
+// severityOverrideRunner is a Runner that replaces the severity of emitted
+// issues with the severity configured on the BuiltinRuleSet.
+type severityOverrideRunner struct {
+	Runner
+	ruleset *BuiltinRuleSet
+}
+
+// EmitIssue reports a finding using the rule's configured severity.
+func (r *severityOverrideRunner) EmitIssue(rule Rule, message string, issueRange hcl.Range) error {
+	return r.Runner.EmitIssue(r.wrap(rule), message, issueRange)
+}
+
+// EmitIssueWithFix reports a finding with a fix using the rule's configured severity.
+func (r *severityOverrideRunner) EmitIssueWithFix(rule Rule, message string, issueRange hcl.Range, fix *Fix) error {
+	return r.Runner.EmitIssueWithFix(r.wrap(rule), message, issueRange, fix)
+}
+
+// EmitIssueWithSeverity reports a finding with a per-issue severity.
+// A severity configured for the rule takes precedence over the per-issue one.
+func (r *severityOverrideRunner) EmitIssueWithSeverity(rule Rule, severity Severity, message string, issueRange hcl.Range) error {
+	if rule != nil {
+		if override, ok := r.ruleset.severityOverrides[rule.Name()]; ok {
+			severity = override
+		}
+	}
+	return r.Runner.EmitIssueWithSeverity(rule, severity, message, issueRange)
+}
+
+// EmitIssueWithOptions reports a finding with options using the rule's
+// configured severity. A severity configured for the rule takes precedence
+// over opts.Severity.
+func (r *severityOverrideRunner) EmitIssueWithOptions(rule Rule, message string, issueRange hcl.Range, opts IssueOptions) error {
+	if opts.Severity != 0 && rule != nil {
+		if override, ok := r.ruleset.severityOverrides[rule.Name()]; ok {
+			opts.Severity = override
+		}
+		return r.Runner.EmitIssueWithOptions(rule, message, issueRange, opts)
+	}
+	return r.Runner.EmitIssueWithOptions(r.wrap(rule), message, issueRange, opts)
+}
+
+// wrap returns the rule with its severity overridden, if configured.
+func (r *severityOverrideRunner) wrap(rule Rule) Rule {
+	if rule == nil {
+		return nil
+	}
+	severity, ok := r.ruleset.severityOverrides[rule.Name()]
+	if !ok || severity == rule.Severity() {
+		return rule
+	}
+	return &severityOverrideRule{Rule: rule, severity: severity}
+}
+
+// severityOverrideRule wraps a Rule to report a configured severity.
+type severityOverrideRule struct {
+	Rule
+	severity Severity
+}
+
+// Severity returns the configured severity.
+func (r *severityOverrideRule) Severity() Severity {
+	return r.severity
+}
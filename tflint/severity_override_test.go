@@ -0,0 +1,168 @@
+package tflint
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+)
+
+// emitRecorder is a Runner that records the rules passed to EmitIssue.
+// Methods other than the EmitIssue variants are not implemented.
+type emitRecorder struct {
+	Runner
+	rules      []Rule
+	messages   []string
+	severities []Severity
+	options    []IssueOptions
+}
+
+func (r *emitRecorder) EmitIssue(rule Rule, message string, _ hcl.Range) error {
+	r.rules = append(r.rules, rule)
+	r.messages = append(r.messages, message)
+	return nil
+}
+
+func (r *emitRecorder) EmitIssueWithFix(rule Rule, _ string, _ hcl.Range, _ *Fix) error {
+	r.rules = append(r.rules, rule)
+	return nil
+}
+
+func (r *emitRecorder) EmitIssueWithSeverity(rule Rule, severity Severity, _ string, _ hcl.Range) error {
+	r.rules = append(r.rules, rule)
+	r.severities = append(r.severities, severity)
+	return nil
+}
+
+func (r *emitRecorder) EmitIssueWithOptions(rule Rule, message string, _ hcl.Range, opts IssueOptions) error {
+	r.rules = append(r.rules, rule)
+	r.messages = append(r.messages, message)
+	r.options = append(r.options, opts)
+	return nil
+}
+
+func TestApplySeverityOverrides(t *testing.T) {
+	overridden := newTestRule("overridden", true)
+	untouched := newTestRule("untouched", true)
+	rs := &BuiltinRuleSet{Rules: []Rule{overridden, untouched}}
+
+	warning := WARNING
+	if err := rs.ApplyGlobalConfig(&Config{
+		Rules: map[string]*RuleConfig{
+			"overridden": {Name: "overridden", Enabled: true, Severity: &warning},
+		},
+	}); err != nil {
+		t.Fatalf("ApplyGlobalConfig failed: %v", err)
+	}
+
+	recorder := &emitRecorder{}
+	runner := rs.ApplySeverityOverrides(recorder)
+
+	_ = runner.EmitIssue(overridden, "issue", hcl.Range{})
+	_ = runner.EmitIssueWithFix(overridden, "issue", hcl.Range{}, &Fix{})
+	_ = runner.EmitIssue(untouched, "issue", hcl.Range{})
+
+	if len(recorder.rules) != 3 {
+		t.Fatalf("expected 3 emitted issues, got %d", len(recorder.rules))
+	}
+	for i, want := range []Severity{WARNING, WARNING, ERROR} {
+		if got := recorder.rules[i].Severity(); got != want {
+			t.Errorf("issue %d severity = %v, want %v", i, got, want)
+		}
+	}
+	if recorder.rules[0].Name() != "overridden" {
+		t.Errorf("Name() = %q, want %q", recorder.rules[0].Name(), "overridden")
+	}
+	if recorder.rules[2] != untouched {
+		t.Error("rules without an override should be passed through unchanged")
+	}
+}
+
+func TestApplySeverityOverrides_NoOverrides(t *testing.T) {
+	rs := &BuiltinRuleSet{Rules: []Rule{newTestRule("rule_a", true)}}
+	if err := rs.ApplyGlobalConfig(nil); err != nil {
+		t.Fatalf("ApplyGlobalConfig failed: %v", err)
+	}
+
+	recorder := &emitRecorder{}
+	if got := rs.ApplySeverityOverrides(recorder); got != recorder {
+		t.Error("expected runner to be returned unchanged without overrides")
+	}
+}
+
+func TestApplySeverityOverrides_EmitIssueWithSeverity(t *testing.T) {
+	overridden := newTestRule("overridden", true)
+	untouched := newTestRule("untouched", true)
+	rs := &BuiltinRuleSet{Rules: []Rule{overridden, untouched}}
+
+	notice := NOTICE
+	if err := rs.ApplyGlobalConfig(&Config{
+		Rules: map[string]*RuleConfig{
+			"overridden": {Name: "overridden", Enabled: true, Severity: &notice},
+		},
+	}); err != nil {
+		t.Fatalf("ApplyGlobalConfig failed: %v", err)
+	}
+
+	recorder := &emitRecorder{}
+	runner := rs.ApplySeverityOverrides(recorder)
+
+	_ = runner.EmitIssueWithSeverity(overridden, WARNING, "issue", hcl.Range{})
+	_ = runner.EmitIssueWithSeverity(untouched, WARNING, "issue", hcl.Range{})
+
+	// The configured severity takes precedence over the per-issue severity
+	want := []Severity{NOTICE, WARNING}
+	if len(recorder.severities) != len(want) {
+		t.Fatalf("expected %d emitted issues, got %d", len(want), len(recorder.severities))
+	}
+	for i := range want {
+		if recorder.severities[i] != want[i] {
+			t.Errorf("issue %d severity = %v, want %v", i, recorder.severities[i], want[i])
+		}
+	}
+}
+
+func TestApplySeverityOverrides_EmitIssueWithOptions(t *testing.T) {
+	overridden := newTestRule("overridden", true)
+	untouched := newTestRule("untouched", true)
+	rs := &BuiltinRuleSet{Rules: []Rule{overridden, untouched}}
+
+	notice := NOTICE
+	if err := rs.ApplyGlobalConfig(&Config{
+		Rules: map[string]*RuleConfig{
+			"overridden": {Name: "overridden", Enabled: true, Severity: &notice},
+		},
+	}); err != nil {
+		t.Fatalf("ApplyGlobalConfig failed: %v", err)
+	}
+
+	recorder := &emitRecorder{}
+	runner := rs.ApplySeverityOverrides(recorder)
+
+	opts := IssueOptions{
+		Kind:            KindBreaking,
+		ResourceAddress: "azurerm_storage_account.main",
+		Side:            SideOld,
+	}
+	_ = runner.EmitIssueWithOptions(overridden, "issue", hcl.Range{}, opts)
+	withSeverity := opts
+	withSeverity.Severity = WARNING
+	_ = runner.EmitIssueWithOptions(overridden, "issue", hcl.Range{}, withSeverity)
+	_ = runner.EmitIssueWithOptions(untouched, "issue", hcl.Range{}, withSeverity)
+
+	if len(recorder.rules) != 3 {
+		t.Fatalf("expected 3 emitted issues, got %d", len(recorder.rules))
+	}
+	if got := recorder.rules[0].Severity(); got != NOTICE {
+		t.Errorf("issue 0 rule severity = %s, want NOTICE", got)
+	}
+	if recorder.options[0] != opts {
+		t.Errorf("issue 0 options = %+v, want %+v", recorder.options[0], opts)
+	}
+
+	// The configured severity takes precedence over the per-issue severity
+	for i, want := range []Severity{NOTICE, WARNING} {
+		if got := recorder.options[i+1].Severity; got != want {
+			t.Errorf("issue %d severity = %s, want %s", i+1, got, want)
+		}
+	}
+}
@@ -0,0 +1,33 @@
+package tflint
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/hcl/v2"
+)
+
+// GetFileSource is the default implementation of Runner.GetFileSource.
+// It returns the Bytes of the file retrieved with GetOldFile or GetNewFile.
+//
+// Runner implementations can delegate to this function:
+//
+//	func (r *MyRunner) GetFileSource(filename string, side tflint.Side) ([]byte, error) {
+//	    return tflint.GetFileSource(r, filename, side)
+//	}
+func GetFileSource(runner Runner, filename string, side Side) ([]byte, error) {
+	var getFile func(string) (*hcl.File, error)
+	switch side {
+	case SideOld:
+		getFile = runner.GetOldFile
+	case SideNew:
+		getFile = runner.GetNewFile
+	default:
+		return nil, fmt.Errorf("unknown side: %s", side)
+	}
+
+	file, err := getFile(filename)
+	if err != nil {
+		return nil, err
+	}
+	return file.Bytes, nil
+}
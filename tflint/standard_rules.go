@@ -0,0 +1,210 @@
+package tflint
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jokarl/tfbreak-plugin-sdk/hclext"
+)
+
+// NewAttributeChangeRule returns a rule that reports every resource of
+// resourceType whose attr attribute was added, removed or changed between
+// the OLD and NEW configurations, as determined by CompareAttributes. The
+// issue points at the attribute in the NEW configuration, or at the
+// resource when the attribute was removed.
+//
+// Resources are paired by address, and renames declared with moved blocks
+// are followed. Only the root module is inspected. The rule is enabled by
+// default and implements ScopedRule.
+//
+// Example:
+//
+//	rules := []tflint.Rule{
+//	    tflint.NewAttributeChangeRule("azurerm_storage_account_kind", "azurerm_storage_account", "account_kind", tflint.ERROR),
+//	}
+func NewAttributeChangeRule(name, resourceType, attr string, severity Severity) Rule {
+	return &attributeChangeRule{
+		standardRule: standardRule{name: name, resourceType: resourceType, severity: severity},
+		attr:         attr,
+	}
+}
+
+// NewResourceRemovedRule returns a rule that reports every resource of
+// resourceType present in the OLD configuration but not in the NEW one.
+// The issue points at the resource in the OLD configuration, so it is
+// emitted with SideOld, and carries the resource address. A resource renamed
+// with a moved block is not reported.
+//
+// Only the root module is inspected. The rule is enabled by default and
+// implements ScopedRule.
+//
+// Example:
+//
+//	rules := []tflint.Rule{
+//	    tflint.NewResourceRemovedRule("azurerm_key_vault_removed", "azurerm_key_vault", tflint.ERROR),
+//	}
+func NewResourceRemovedRule(name, resourceType string, severity Severity) Rule {
+	return &resourceRemovedRule{
+		standardRule: standardRule{name: name, resourceType: resourceType, severity: severity},
+	}
+}
+
+// NewBlockRemovedRule returns a rule that reports every resource of
+// resourceType that has at least one nested blockType block in the OLD
+// configuration and none in the NEW one. The issue points at the resource
+// in the NEW configuration.
+//
+// Resources are paired by address, and renames declared with moved blocks
+// are followed. Only the root module is inspected. The rule is enabled by
+// default and implements ScopedRule.
+//
+// Example:
+//
+//	rules := []tflint.Rule{
+//	    tflint.NewBlockRemovedRule("azurerm_storage_network_rules_removed", "azurerm_storage_account", "network_rules", tflint.WARNING),
+//	}
+func NewBlockRemovedRule(name, resourceType, blockType string, severity Severity) Rule {
+	return &blockRemovedRule{
+		standardRule: standardRule{name: name, resourceType: resourceType, severity: severity},
+		blockType:    blockType,
+	}
+}
+
+// standardRule holds the metadata shared by the rules built by the
+// New*Rule constructors.
+type standardRule struct {
+	name         string
+	resourceType string
+	severity     Severity
+}
+
+func (r *standardRule) Name() string { return r.name }
+
+func (r *standardRule) Enabled() bool { return true }
+
+func (r *standardRule) Severity() Severity { return r.severity }
+
+func (r *standardRule) Link() string { return "" }
+
+// ResourceTypes implements ScopedRule.
+func (r *standardRule) ResourceTypes() []string { return []string{r.resourceType} }
+
+// resourceDiff retrieves the resources of the rule's type from both
+// configurations with schema and pairs them, following moved blocks.
+func (r *standardRule) resourceDiff(runner Runner, schema *hclext.BodySchema) (*ModuleDiff, error) {
+	oldContent, newContent, err := runner.GetResourceContentPair(r.resourceType, schema, nil)
+	if err != nil {
+		return nil, err
+	}
+	diff := DiffModuleContent(oldContent, newContent)
+	diff.ApplyMovedBlocks(runner.GetMovedBlocks())
+	return diff, nil
+}
+
+type attributeChangeRule struct {
+	standardRule
+	attr string
+}
+
+func (r *attributeChangeRule) Check(_ context.Context, runner Runner) error {
+	diff, err := r.resourceDiff(runner, &hclext.BodySchema{
+		Attributes: []hclext.AttributeSchema{{Name: r.attr}},
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, change := range diff.Changed {
+		changed, _, _ := CompareAttributes(change.Old.Body, change.New.Body, r.attr)
+		if !changed {
+			continue
+		}
+
+		oldAttr := contentAttribute(change.Old.Body, r.attr)
+		newAttr := contentAttribute(change.New.Body, r.attr)
+		issueRange := change.New.DefRange
+		if newAttr != nil {
+			issueRange = newAttr.Range
+		}
+
+		var message string
+		switch {
+		case oldAttr == nil:
+			message = fmt.Sprintf("%s: %s was added", change.Address, r.attr)
+		case newAttr == nil:
+			message = fmt.Sprintf("%s: %s was removed", change.Address, r.attr)
+		case len(oldAttr.SourceBytes) > 0 && len(newAttr.SourceBytes) > 0:
+			message = fmt.Sprintf("%s: %s changed from %s to %s", change.Address, r.attr, oldAttr.SourceBytes, newAttr.SourceBytes)
+		default:
+			message = fmt.Sprintf("%s: %s changed", change.Address, r.attr)
+		}
+		if err := runner.EmitIssueWithOptions(r, message, issueRange, IssueOptions{ResourceAddress: change.Address}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type resourceRemovedRule struct {
+	standardRule
+}
+
+func (r *resourceRemovedRule) Check(_ context.Context, runner Runner) error {
+	diff, err := r.resourceDiff(runner, &hclext.BodySchema{})
+	if err != nil {
+		return err
+	}
+
+	for _, block := range diff.Removed {
+		address := BlockAddress(block)
+		opts := IssueOptions{ResourceAddress: address, Side: SideOld}
+		if err := runner.EmitIssueWithOptions(r, address+" was removed", block.DefRange, opts); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type blockRemovedRule struct {
+	standardRule
+	blockType string
+}
+
+func (r *blockRemovedRule) Check(_ context.Context, runner Runner) error {
+	diff, err := r.resourceDiff(runner, &hclext.BodySchema{
+		Blocks: []hclext.BlockSchema{{Type: r.blockType, Body: &hclext.BodySchema{}}},
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, change := range diff.Changed {
+		if !hasBlock(change.Old.Body, r.blockType) || hasBlock(change.New.Body, r.blockType) {
+			continue
+		}
+		message := fmt.Sprintf("%s: %s block was removed", change.Address, r.blockType)
+		if err := runner.EmitIssueWithOptions(r, message, change.New.DefRange, IssueOptions{ResourceAddress: change.Address}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// hasBlock reports whether content has a block of type blockType.
+func hasBlock(content *hclext.BodyContent, blockType string) bool {
+	if content == nil {
+		return false
+	}
+	for _, block := range content.Blocks {
+		if block.Type == blockType {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	_ ScopedRule = (*attributeChangeRule)(nil)
+	_ ScopedRule = (*resourceRemovedRule)(nil)
+	_ ScopedRule = (*blockRemovedRule)(nil)
+)
@@ -0,0 +1,157 @@
+package tflint
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/jokarl/tfbreak-plugin-sdk/hclext"
+)
+
+// pairRunner serves fixed OLD and NEW resources to GetResourceContentPair
+// and records the emitted issues.
+type pairRunner struct {
+	emitRecorder
+	oldBlocks []*hclext.Block
+	newBlocks []*hclext.Block
+	moved     []MovedBlock
+}
+
+func (r *pairRunner) GetResourceContentPair(_ string, _ *hclext.BodySchema, _ *GetModuleContentOption) (*hclext.BodyContent, *hclext.BodyContent, error) {
+	return &hclext.BodyContent{Blocks: r.oldBlocks}, &hclext.BodyContent{Blocks: r.newBlocks}, nil
+}
+
+func (r *pairRunner) GetMovedBlocks() []MovedBlock {
+	return r.moved
+}
+
+// storageAccount returns an azurerm_storage_account resource block with the
+// given attributes and nested blocks.
+func storageAccount(name string, attrs map[string]string, blocks ...string) *hclext.Block {
+	body := &hclext.BodyContent{Attributes: map[string]*hclext.Attribute{}}
+	for attrName, value := range attrs {
+		body.Attributes[attrName] = &hclext.Attribute{
+			Name:        attrName,
+			Value:       cty.StringVal(value),
+			SourceBytes: []byte(`"` + value + `"`),
+		}
+	}
+	for _, blockType := range blocks {
+		body.Blocks = append(body.Blocks, &hclext.Block{Type: blockType, Body: &hclext.BodyContent{}})
+	}
+	return &hclext.Block{Type: "resource", Labels: []string{"azurerm_storage_account", name}, Body: body}
+}
+
+func TestNewAttributeChangeRule(t *testing.T) {
+	tests := []struct {
+		name string
+		old  []*hclext.Block
+		new  []*hclext.Block
+		want []string
+	}{
+		{
+			name: "changed",
+			old:  []*hclext.Block{storageAccount("main", map[string]string{"account_kind": "StorageV2"})},
+			new:  []*hclext.Block{storageAccount("main", map[string]string{"account_kind": "BlobStorage"})},
+			want: []string{`azurerm_storage_account.main: account_kind changed from "StorageV2" to "BlobStorage"`},
+		},
+		{
+			name: "unchanged",
+			old:  []*hclext.Block{storageAccount("main", map[string]string{"account_kind": "StorageV2"})},
+			new:  []*hclext.Block{storageAccount("main", map[string]string{"account_kind": "StorageV2"})},
+		},
+		{
+			name: "added",
+			old:  []*hclext.Block{storageAccount("main", nil)},
+			new:  []*hclext.Block{storageAccount("main", map[string]string{"account_kind": "StorageV2"})},
+			want: []string{"azurerm_storage_account.main: account_kind was added"},
+		},
+		{
+			name: "removed",
+			old:  []*hclext.Block{storageAccount("main", map[string]string{"account_kind": "StorageV2"})},
+			new:  []*hclext.Block{storageAccount("main", nil)},
+			want: []string{"azurerm_storage_account.main: account_kind was removed"},
+		},
+		{
+			name: "new resource",
+			new:  []*hclext.Block{storageAccount("main", map[string]string{"account_kind": "StorageV2"})},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rule := NewAttributeChangeRule("storage_account_kind", "azurerm_storage_account", "account_kind", WARNING)
+			runner := &pairRunner{oldBlocks: tt.old, newBlocks: tt.new}
+
+			if err := rule.Check(context.Background(), runner); err != nil {
+				t.Fatalf("Check error: %v", err)
+			}
+			if !reflect.DeepEqual(runner.messages, tt.want) {
+				t.Errorf("messages = %q, want %q", runner.messages, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewAttributeChangeRule_Metadata(t *testing.T) {
+	rule := NewAttributeChangeRule("storage_account_kind", "azurerm_storage_account", "account_kind", WARNING)
+
+	if rule.Name() != "storage_account_kind" || !rule.Enabled() || rule.Severity() != WARNING {
+		t.Errorf("got name %q, enabled %v, severity %s", rule.Name(), rule.Enabled(), rule.Severity())
+	}
+	scoped, ok := rule.(ScopedRule)
+	if !ok {
+		t.Fatal("rule does not implement ScopedRule")
+	}
+	if got := scoped.ResourceTypes(); !reflect.DeepEqual(got, []string{"azurerm_storage_account"}) {
+		t.Errorf("ResourceTypes() = %v", got)
+	}
+}
+
+func TestNewResourceRemovedRule(t *testing.T) {
+	rule := NewResourceRemovedRule("storage_account_removed", "azurerm_storage_account", ERROR)
+	runner := &pairRunner{
+		oldBlocks: []*hclext.Block{storageAccount("kept", nil), storageAccount("removed", nil), storageAccount("renamed", nil)},
+		newBlocks: []*hclext.Block{storageAccount("kept", nil), storageAccount("new_name", nil)},
+		moved: []MovedBlock{{
+			From: "azurerm_storage_account.renamed",
+			To:   "azurerm_storage_account.new_name",
+		}},
+	}
+
+	if err := rule.Check(context.Background(), runner); err != nil {
+		t.Fatalf("Check error: %v", err)
+	}
+	if want := []string{"azurerm_storage_account.removed was removed"}; !reflect.DeepEqual(runner.messages, want) {
+		t.Errorf("messages = %q, want %q", runner.messages, want)
+	}
+	want := []IssueOptions{{ResourceAddress: "azurerm_storage_account.removed", Side: SideOld}}
+	if !reflect.DeepEqual(runner.options, want) {
+		t.Errorf("options = %+v, want %+v", runner.options, want)
+	}
+}
+
+func TestNewBlockRemovedRule(t *testing.T) {
+	rule := NewBlockRemovedRule("network_rules_removed", "azurerm_storage_account", "network_rules", ERROR)
+	runner := &pairRunner{
+		oldBlocks: []*hclext.Block{
+			storageAccount("kept", nil, "network_rules"),
+			storageAccount("removed", nil, "network_rules"),
+			storageAccount("added", nil),
+		},
+		newBlocks: []*hclext.Block{
+			storageAccount("kept", nil, "network_rules"),
+			storageAccount("removed", nil),
+			storageAccount("added", nil, "network_rules"),
+		},
+	}
+
+	if err := rule.Check(context.Background(), runner); err != nil {
+		t.Fatalf("Check error: %v", err)
+	}
+	if want := []string{"azurerm_storage_account.removed: network_rules block was removed"}; !reflect.DeepEqual(runner.messages, want) {
+		t.Errorf("messages = %q, want %q", runner.messages, want)
+	}
+}
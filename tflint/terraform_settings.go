@@ -0,0 +1,38 @@
+package tflint
+
+import (
+	"github.com/hashicorp/hcl/v2"
+
+	"github.com/jokarl/tfbreak-plugin-sdk/hclext"
+)
+
+// TerraformSettings is the content of the `terraform` blocks of the root
+// module, as returned by Runner.GetOldTerraformSettings and
+// Runner.GetNewTerraformSettings.
+//
+// Changing the backend or the required Terraform version is operationally
+// breaking even when no resource changes.
+type TerraformSettings struct {
+	// RequiredVersion is the required_version constraint (e.g., ">= 1.5"),
+	// or empty if not declared.
+	RequiredVersion string
+	// RequiredVersionRange is the source range of the required_version
+	// attribute, or the zero range if not declared.
+	RequiredVersionRange hcl.Range
+	// Backend is the backend block, or nil if the configuration has none.
+	Backend *TerraformBackend
+	// RequiredProviders holds the required_providers entries, keyed by
+	// local name, as returned by Runner.GetOldProviderRequirements.
+	RequiredProviders map[string]ProviderRequirement
+}
+
+// TerraformBackend is a `backend` block nested in a `terraform` block.
+type TerraformBackend struct {
+	// Type is the backend type, the label of the block (e.g., "azurerm").
+	Type string
+	// Body holds every attribute and nested block of the backend block,
+	// since backend arguments depend on its type.
+	Body *hclext.BodyContent
+	// DeclRange is the source range of the block header.
+	DeclRange hcl.Range
+}
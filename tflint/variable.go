@@ -0,0 +1,44 @@
+package tflint
+
+import (
+	"github.com/hashicorp/hcl/v2"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// VariableDef is a `variable` block, as returned by Runner.GetOldVariables
+// and Runner.GetNewVariables.
+//
+// Removing a variable, adding a required one, or narrowing its type are
+// breaking changes for callers of a module.
+type VariableDef struct {
+	// Name is the variable name.
+	Name string
+	// Type is the declared type constraint, or cty.DynamicPseudoType
+	// if the variable has no type argument.
+	Type cty.Type
+	// Default is the default value converted to Type. It is cty.NilVal
+	// when HasDefault is false, and may be null for `default = null`.
+	Default cty.Value
+	// HasDefault reports whether the variable declares a default.
+	HasDefault bool
+	// Required reports whether callers must set the variable,
+	// which is the case when it has no default.
+	Required bool
+	// DeclRange is the source range of the block header.
+	DeclRange hcl.Range
+}
+
+// OutputDef is an `output` block, as returned by Runner.GetOldOutputs
+// and Runner.GetNewOutputs.
+//
+// Removing an output is a breaking change for callers of a module.
+type OutputDef struct {
+	// Name is the output name.
+	Name string
+	// Description is the literal description, or empty if not declared.
+	Description string
+	// Sensitive reports whether the output is marked sensitive.
+	Sensitive bool
+	// DeclRange is the source range of the block header.
+	DeclRange hcl.Range
+}
@@ -0,0 +1,64 @@
+package tflint
+
+import (
+	"github.com/jokarl/tfbreak-plugin-sdk/hclext"
+)
+
+// WalkResourcesFunc is called for each resource block visited by
+// Runner.WalkOldResources and Runner.WalkNewResources.
+// Returning an error stops the walk, and the error is returned to the caller.
+type WalkResourcesFunc func(resourceType string, block *hclext.Block) error
+
+// WalkOldResources is the default implementation of Runner.WalkOldResources.
+// It retrieves every resource block from the OLD configuration with a single
+// GetOldModuleContent call and invokes fn for each one in order.
+//
+// Runner implementations can delegate to this function:
+//
+//	func (r *MyRunner) WalkOldResources(schema *hclext.BodySchema, fn tflint.WalkResourcesFunc) error {
+//	    return tflint.WalkOldResources(r, schema, fn)
+//	}
+func WalkOldResources(runner Runner, schema *hclext.BodySchema, fn WalkResourcesFunc) error {
+	content, err := runner.GetOldModuleContent(resourcesSchema(schema), nil)
+	if err != nil {
+		return err
+	}
+	return walkResources(content, fn)
+}
+
+// WalkNewResources is the default implementation of Runner.WalkNewResources.
+// It is the NEW configuration equivalent of WalkOldResources.
+func WalkNewResources(runner Runner, schema *hclext.BodySchema, fn WalkResourcesFunc) error {
+	content, err := runner.GetNewModuleContent(resourcesSchema(schema), nil)
+	if err != nil {
+		return err
+	}
+	return walkResources(content, fn)
+}
+
+// resourcesSchema returns a module schema matching every resource block,
+// with schema applied to each block's body.
+func resourcesSchema(schema *hclext.BodySchema) *hclext.BodySchema {
+	return &hclext.BodySchema{
+		Blocks: []hclext.BlockSchema{
+			{Type: "resource", LabelNames: []string{"type", "name"}, Body: schema},
+		},
+	}
+}
+
+// walkResources invokes fn for each resource block in content, stopping on
+// the first error.
+func walkResources(content *hclext.BodyContent, fn WalkResourcesFunc) error {
+	if content == nil {
+		return nil
+	}
+	for _, block := range content.Blocks {
+		if block.Type != "resource" {
+			continue
+		}
+		if err := fn(block.TypeName(), block); err != nil {
+			return err
+		}
+	}
+	return nil
+}